@@ -1,8 +1,8 @@
-INSERT INTO cards (token, holder, expiration, brand)
+INSERT INTO cards (token, holder, expiration, brand, expires_at)
 VALUES
-	('461c9432d4d7eca7ba32b783aa22ca5c89e4f396288de5128b73b461c42d4f40', 'Bruce Wayne', '01/2025', 'VISA'),
-	('7d2cd4f89ffe5374013d68c64ec104182366f786a377da1d3103db201149d3b5', 'Tony Stark', '02/2026', 'VISA'),
-	('4939de8e7acf6011a9b4aa4abdd6496cec40240e418a7892723ef16c4cbb44f2', 'Peter Park', '03/2027', 'MASTERCARD'),
-	('d840c6fb8401c4bbefdc4ceddc1a88f1636734bdde88c344b8969d0cd5cfdaed', 'Diana Prince', '04/2028', 'MASTERCARD'),
-	('f8a8a91d9626b66a74ff7c11b5f1c2cc59a103f5b2a3119b4729a70b25304074', 'Frank Castle', '05/2029', 'AMERICAN EXPRESS'),
-	('cc89fefc83d423395b11998646cc7eb7c32c04ece114d1373c3a519fbb612724', 'Natasha Romanova', '06/2030', 'AMERICAN EXPRESS');
+	('461c9432d4d7eca7ba32b783aa22ca5c89e4f396288de5128b73b461c42d4f40', 'Bruce Wayne', '01/2025', 'VISA', '2025-02-01 00:00:00+00'),
+	('7d2cd4f89ffe5374013d68c64ec104182366f786a377da1d3103db201149d3b5', 'Tony Stark', '02/2026', 'VISA', '2026-03-01 00:00:00+00'),
+	('4939de8e7acf6011a9b4aa4abdd6496cec40240e418a7892723ef16c4cbb44f2', 'Peter Park', '03/2027', 'MASTERCARD', '2027-04-01 00:00:00+00'),
+	('d840c6fb8401c4bbefdc4ceddc1a88f1636734bdde88c344b8969d0cd5cfdaed', 'Diana Prince', '04/2028', 'MASTERCARD', '2028-05-01 00:00:00+00'),
+	('f8a8a91d9626b66a74ff7c11b5f1c2cc59a103f5b2a3119b4729a70b25304074', 'Frank Castle', '05/2029', 'AMERICAN EXPRESS', '2029-06-01 00:00:00+00'),
+	('cc89fefc83d423395b11998646cc7eb7c32c04ece114d1373c3a519fbb612724', 'Natasha Romanova', '06/2030', 'AMERICAN EXPRESS', '2030-07-01 00:00:00+00');
@@ -0,0 +1,4 @@
+INSERT INTO stores (id, identification, document_type, address, cep, street, number, city, state, created_at, updated_at)
+VALUES
+	('5b0b8b3e-0f8e-4d52-9d8a-3c1f6e2a7b10', '11222333000181', 'cnpj', 'Avenida Paulista, 1000', '01310100', 'Avenida Paulista', '1000', 'São Paulo', 'SP', NOW(), NOW()),
+	('9e4c2f71-6a3d-4b8e-8f25-1d7a0c9b4e62', '52998224725', 'cpf', 'Rua da Assembleia, 10', '20011000', 'Rua da Assembleia', '10', 'Rio de Janeiro', 'RJ', NOW(), NOW());
@@ -1,17 +1,21 @@
 package main
 
 import (
-	"crypto/rsa"
-	"crypto/x509"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/sesaquecruz/go-payment-processor/config"
 	"github.com/sesaquecruz/go-payment-processor/di"
 	"github.com/sesaquecruz/go-payment-processor/internal/acquirer"
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/service"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/worker"
 )
 
 //	@title			Payment Processor
@@ -25,7 +29,7 @@ import (
 //	@license.name	MIT
 //	@license.url	https://github.com/sesaquecruz/go-payment-processor
 
-//	@BasePath	/api/v1
+//	@BasePath	/api
 
 //	@securityDefinitions.apikey	Bearer token
 //	@in							header
@@ -35,37 +39,138 @@ import (
 func main() {
 	cfg := config.GetConfig()
 
+	// the background workers stop and the app shuts down on interrupt or termination
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	db, err := connection.DBConnection(cfg.DbDsn)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	authPublicKey, err := decodeAuthPublicKey(cfg.AuthPublicKey)
+	var authKeys *service.AuthKeySet
+	if cfg.AuthJwksUrl != "" {
+		authKeys = service.NewJwksAuthKeySet(cfg.AuthJwksUrl)
+	} else {
+		authPublicKey, err := service.ParseRSAPublicKey(cfg.AuthPublicKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		authKeys = service.NewStaticAuthKeySet(authPublicKey)
+	}
+	go authKeys.Run(ctx)
+
+	authConfig := web.AuthConfig{
+		Keys:     authKeys,
+		Issuer:   cfg.AuthIssuer,
+		Audience: cfg.AuthAudience,
+	}
+
+	cardMasterKey, err := base64.StdEncoding.DecodeString(cfg.CardMasterKey)
+	if err != nil {
+		log.Fatal(fmt.Errorf("failed to decode card master key from base64: %w", err))
+	}
+
+	keyManager, err := service.NewLocalKeyManager(cfg.CardKeystoreFile, cardMasterKey)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	app := di.NewApp(
-		db,
-		authPublicKey,
+	routingRules := service.DefaultRoutingRules()
+	if cfg.RoutingRulesFile != "" {
+		routingRules, err = service.LoadRoutingRules(cfg.RoutingRulesFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	binService := service.NewBinService(service.DefaultBinRanges())
+	if cfg.BinTableFile != "" {
+		binService, err = service.NewFileBinService(cfg.BinTableFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	addressDataset := service.DefaultAddressDataset()
+	if cfg.AddressDatasetFile != "" {
+		addressDataset, err = service.LoadAddressDataset(cfg.AddressDatasetFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	addressLookup := service.NewLocalAddressLookup(addressDataset)
+
+	options := []service.PaymentOption{
 		service.PaymentWithAcquirer(acquirer.NewCielo(cfg.CieloUrl, cfg.CieloKey)),
 		service.PaymentWithAcquirer(acquirer.NewRede(cfg.RedeUrl, cfg.RedeKey)),
 		service.PaymentWithAcquirer(acquirer.NewStone(cfg.StoneUrl, cfg.StoneKey)),
-	)
+	}
 
-	app.Listen(":8080")
-}
+	if cfg.CircuitBreakersFile != "" {
+		breakers, err := service.LoadCircuitBreakerConfigs(cfg.CircuitBreakersFile)
+		if err != nil {
+			log.Fatal(err)
+		}
 
-func decodeAuthPublicKey(key string) (*rsa.PublicKey, error) {
-	keyBytes, err := base64.StdEncoding.DecodeString(key)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode key from base64: %w", err)
+		for name, breaker := range breakers {
+			options = append(options, service.PaymentWithCircuitBreaker(name, breaker))
+		}
 	}
 
-	pubKey, err := x509.ParsePKCS1PublicKey(keyBytes)
+	if cfg.AcquirerTransportsFile != "" {
+		transports, err := service.LoadTransportConfigs(cfg.AcquirerTransportsFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for name, transport := range transports {
+			options = append(options, service.PaymentWithTransport(name, transport))
+		}
+	}
+
+	// the app and the workers share the payment service, and so its clients and circuit breakers
+	paymentService := service.NewPaymentService(options...)
+
+	reversalWorker := di.NewReversalWorker(db, worker.DefaultReversalConfig(), paymentService)
+	go reversalWorker.Run(ctx)
+
+	paymentQueueConfig := worker.DefaultPaymentQueueConfig()
+	if cfg.PaymentWorkers > 0 {
+		paymentQueueConfig.Workers = cfg.PaymentWorkers
+	}
+
+	paymentQueueWorker := di.NewPaymentQueueWorker(db, routingRules, keyManager, paymentQueueConfig, paymentService)
+	go paymentQueueWorker.Run(ctx)
+
+	paymentBatchWorker := di.NewPaymentBatchWorker(db, routingRules, keyManager, worker.DefaultPaymentBatchConfig(), paymentService)
+	go paymentBatchWorker.Run(ctx)
+
+	webhookWorker := di.NewWebhookWorker(db, worker.DefaultWebhookConfig())
+	go webhookWorker.Run(ctx)
+
+	outboxWorker, err := di.NewOutboxWorker(db, service.EventPublisherConfig{File: cfg.EventsFile}, worker.DefaultOutboxConfig())
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse key format: %w", err)
+		log.Fatal(err)
+	}
+	go outboxWorker.Run(ctx)
+
+	expiringCardsConfig := worker.DefaultExpiringCardsConfig()
+	if cfg.ExpiringCardsDays > 0 {
+		expiringCardsConfig.Days = cfg.ExpiringCardsDays
 	}
 
-	return pubKey, nil
+	expiringCardsWorker := di.NewExpiringCardsWorker(db, keyManager, expiringCardsConfig)
+	go expiringCardsWorker.Run(ctx)
+
+	app := di.NewApp(db, authConfig, routingRules, keyManager, binService, addressLookup, paymentService)
+
+	go func() {
+		<-ctx.Done()
+		app.Shutdown()
+	}()
+
+	app.Listen(":8080")
 }
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/sesaquecruz/go-payment-processor/di"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+)
+
+func main() {
+	acquirer := flag.String("acquirer", "", "acquirer that sent the settlement file")
+	format := flag.String("format", "csv", "settlement file format, csv or edi")
+	flag.Parse()
+
+	if *acquirer == "" || flag.NArg() != 1 {
+		log.Fatal("usage: reconcile-settlement -acquirer name [-format csv|edi] file")
+	}
+
+	dbDsn, ok := os.LookupEnv("DB_DSN")
+	if !ok || dbDsn == "" {
+		log.Fatal("env var DB_DSN is required")
+	}
+
+	// the file is read from stdin when it is -, as from outside of the container
+	file := os.Stdin
+	fileName := ""
+	if flag.Arg(0) != "-" {
+		f, err := os.Open(flag.Arg(0))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+
+		file = f
+		fileName = filepath.Base(f.Name())
+	}
+
+	db, err := connection.DBConnection(dbDsn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	output, err := di.NewImportSettlement(db).Execute(context.Background(), &usecase.ImportSettlementInput{
+		Acquirer: *acquirer,
+		Format:   *format,
+		FileName: fileName,
+		File:     file,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	settlement := output.Settlement
+	for _, d := range settlement.Discrepancies {
+		log.Printf(
+			"%s line=%d payment=%s acquirer_id=%s expected=%d %s settled=%d %s",
+			d.Type, d.Line, d.PaymentId, d.AcquirerId, d.ExpectedAmount, d.ExpectedCurrency, d.SettledAmount, d.SettledCurrency,
+		)
+	}
+
+	log.Printf(
+		"settlement %s: %d lines, %d matched, %d missing, %d unexpected, %d amount mismatches",
+		settlement.SettlementId, settlement.Lines, settlement.Matched, settlement.Missing, settlement.Unexpected, settlement.AmountMismatches,
+	)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/sesaquecruz/go-payment-processor/di"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/service"
+)
+
+// rotate-card-keys creates a new card data key and re-encrypts the vaulted card numbers with
+// it while the payment processor keeps running. Without -rotate it only resumes the
+// re-encryption of the cards still under an older key.
+func main() {
+	rotate := flag.Bool("rotate", true, "create a new key version before re-encrypting")
+	batchSize := flag.Int("batch-size", 100, "cards re-encrypted per transaction")
+	flag.Parse()
+
+	dbDsn, ok := os.LookupEnv("DB_DSN")
+	if !ok || dbDsn == "" {
+		log.Fatal("env var DB_DSN is required")
+	}
+
+	cardMasterKey, ok := os.LookupEnv("CARD_MASTER_KEY")
+	if !ok || cardMasterKey == "" {
+		log.Fatal("env var CARD_MASTER_KEY is required")
+	}
+
+	cardKeystoreFile, ok := os.LookupEnv("CARD_KEYSTORE_FILE")
+	if !ok || cardKeystoreFile == "" {
+		log.Fatal("env var CARD_KEYSTORE_FILE is required")
+	}
+
+	masterKey, err := base64.StdEncoding.DecodeString(cardMasterKey)
+	if err != nil {
+		log.Fatalf("failed to decode card master key from base64: %v", err)
+	}
+
+	keyManager, err := service.NewLocalKeyManager(cardKeystoreFile, masterKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	db, err := connection.DBConnection(dbDsn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	output, err := di.NewReencryptCards(db, keyManager).Execute(context.Background(), &usecase.ReencryptCardsInput{
+		Rotate:    *rotate,
+		BatchSize: *batchSize,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("re-encrypted %d cards with key version %d", output.Cards, output.KeyVersion)
+}
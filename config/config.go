@@ -3,25 +3,51 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
 )
 
 type Config struct {
-	AuthPublicKey string
-	DbDsn         string
-	CieloUrl      string
-	RedeUrl       string
-	StoneUrl      string
-	CieloKey      string
-	RedeKey       string
-	StoneKey      string
+	AuthPublicKey          string
+	DbDsn                  string
+	CieloUrl               string
+	RedeUrl                string
+	StoneUrl               string
+	CieloKey               string
+	RedeKey                string
+	StoneKey               string
+	AuthJwksUrl            string
+	AuthIssuer             string
+	AuthAudience           string
+	CardMasterKey          string
+	CardKeystoreFile       string
+	RoutingRulesFile       string
+	CircuitBreakersFile    string
+	AcquirerTransportsFile string
+	BinTableFile           string
+	AddressDatasetFile     string
+	ExpiringCardsDays      int
+	EventsFile             string
+	PaymentWorkers         int
 }
 
 var config Config
 
 func init() {
+	authJwksUrl := os.Getenv("AUTH_JWKS_URL")
+
 	authPublicKey, ok := os.LookupEnv("AUTH_PUBLIC_KEY")
-	if !ok || authPublicKey == "" {
-		log.Fatal("env var AUTH_PUBLIC_KEY is required")
+	if (!ok || authPublicKey == "") && authJwksUrl == "" {
+		log.Fatal("env var AUTH_PUBLIC_KEY or AUTH_JWKS_URL is required")
+	}
+
+	authIssuer, ok := os.LookupEnv("AUTH_ISSUER")
+	if !ok || authIssuer == "" {
+		log.Fatal("env var AUTH_ISSUER is required")
+	}
+
+	authAudience, ok := os.LookupEnv("AUTH_AUDIENCE")
+	if !ok || authAudience == "" {
+		log.Fatal("env var AUTH_AUDIENCE is required")
 	}
 
 	dbDsn, ok := os.LookupEnv("DB_DSN")
@@ -59,6 +85,41 @@ func init() {
 		log.Fatal("env var STONE_KEY is required")
 	}
 
+	cardMasterKey, ok := os.LookupEnv("CARD_MASTER_KEY")
+	if !ok || cardMasterKey == "" {
+		log.Fatal("env var CARD_MASTER_KEY is required")
+	}
+
+	cardKeystoreFile, ok := os.LookupEnv("CARD_KEYSTORE_FILE")
+	if !ok || cardKeystoreFile == "" {
+		log.Fatal("env var CARD_KEYSTORE_FILE is required")
+	}
+
+	routingRulesFile := os.Getenv("ROUTING_RULES_FILE")
+	circuitBreakersFile := os.Getenv("CIRCUIT_BREAKERS_FILE")
+	acquirerTransportsFile := os.Getenv("ACQUIRER_TRANSPORTS_FILE")
+	binTableFile := os.Getenv("BIN_TABLE_FILE")
+	addressDatasetFile := os.Getenv("ADDRESS_DATASET_FILE")
+	eventsFile := os.Getenv("EVENTS_FILE")
+
+	var expiringCardsDays int
+	if days := os.Getenv("EXPIRING_CARDS_DAYS"); days != "" {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			log.Fatal("env var EXPIRING_CARDS_DAYS is invalid")
+		}
+		expiringCardsDays = n
+	}
+
+	var paymentWorkers int
+	if workers := os.Getenv("PAYMENT_WORKERS"); workers != "" {
+		n, err := strconv.Atoi(workers)
+		if err != nil || n <= 0 {
+			log.Fatal("env var PAYMENT_WORKERS is invalid")
+		}
+		paymentWorkers = n
+	}
+
 	config = Config{
 		AuthPublicKey: authPublicKey,
 		DbDsn:         dbDsn,
@@ -68,6 +129,22 @@ func init() {
 		CieloKey:      cieloKey,
 		RedeKey:       redeKey,
 		StoneKey:      stoneKey,
+
+		AuthJwksUrl:  authJwksUrl,
+		AuthIssuer:   authIssuer,
+		AuthAudience: authAudience,
+
+		CardMasterKey:    cardMasterKey,
+		CardKeystoreFile: cardKeystoreFile,
+
+		RoutingRulesFile:       routingRulesFile,
+		CircuitBreakersFile:    circuitBreakersFile,
+		AcquirerTransportsFile: acquirerTransportsFile,
+		BinTableFile:           binTableFile,
+		AddressDatasetFile:     addressDatasetFile,
+		ExpiringCardsDays:      expiringCardsDays,
+		EventsFile:             eventsFile,
+		PaymentWorkers:         paymentWorkers,
 	}
 }
 
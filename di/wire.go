@@ -4,9 +4,9 @@
 package di
 
 import (
-	"crypto/rsa"
 	"database/sql"
 
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
 	irepository "github.com/sesaquecruz/go-payment-processor/internal/core/repository"
 	iservice "github.com/sesaquecruz/go-payment-processor/internal/core/service"
 	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
@@ -14,6 +14,7 @@ import (
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/service"
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/web"
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/handler"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/worker"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/wire"
@@ -24,9 +25,79 @@ var setCardRepository = wire.NewSet(
 	wire.Bind(new(irepository.ICardRepository), new(*repository.CardRepository)),
 )
 
-var setPaymentService = wire.NewSet(
-	service.NewPaymentService,
-	wire.Bind(new(iservice.IPaymentService), new(*service.PaymentService)),
+var setPaymentRepository = wire.NewSet(
+	repository.NewPaymentRepository,
+	wire.Bind(new(irepository.IPaymentRepository), new(*repository.PaymentRepository)),
+)
+
+var setRefundRepository = wire.NewSet(
+	repository.NewRefundRepository,
+	wire.Bind(new(irepository.IRefundRepository), new(*repository.RefundRepository)),
+)
+
+var setReversalRepository = wire.NewSet(
+	repository.NewReversalRepository,
+	wire.Bind(new(irepository.IReversalRepository), new(*repository.ReversalRepository)),
+)
+
+var setStoreRepository = wire.NewSet(
+	repository.NewStoreRepository,
+	wire.Bind(new(irepository.IStoreRepository), new(*repository.StoreRepository)),
+)
+
+var setWebhookRepository = wire.NewSet(
+	repository.NewWebhookRepository,
+	wire.Bind(new(irepository.IWebhookRepository), new(*repository.WebhookRepository)),
+)
+
+var setWebhookDeliveryRepository = wire.NewSet(
+	repository.NewWebhookDeliveryRepository,
+	wire.Bind(new(irepository.IWebhookDeliveryRepository), new(*repository.WebhookDeliveryRepository)),
+)
+
+var setOutboxRepository = wire.NewSet(
+	repository.NewOutboxRepository,
+	wire.Bind(new(irepository.IOutboxRepository), new(*repository.OutboxRepository)),
+)
+
+var setPaymentJobRepository = wire.NewSet(
+	repository.NewPaymentJobRepository,
+	wire.Bind(new(irepository.IPaymentJobRepository), new(*repository.PaymentJobRepository)),
+)
+
+var setPaymentBatchRepository = wire.NewSet(
+	repository.NewPaymentBatchRepository,
+	wire.Bind(new(irepository.IPaymentBatchRepository), new(*repository.PaymentBatchRepository)),
+)
+
+var setSettlementRepository = wire.NewSet(
+	repository.NewSettlementRepository,
+	wire.Bind(new(irepository.ISettlementRepository), new(*repository.SettlementRepository)),
+)
+
+var setIdempotencyRepository = wire.NewSet(
+	repository.NewIdempotencyRepository,
+	wire.Bind(new(irepository.IIdempotencyRepository), new(*repository.IdempotencyRepository)),
+)
+
+var setRoutingService = wire.NewSet(
+	service.NewRoutingService,
+	wire.Bind(new(iservice.IRoutingService), new(*service.RoutingService)),
+)
+
+var setSettlementParser = wire.NewSet(
+	service.NewSettlementParser,
+	wire.Bind(new(iservice.ISettlementParser), new(*service.SettlementParser)),
+)
+
+var setWebhookSender = wire.NewSet(
+	service.NewWebhookSender,
+	wire.Bind(new(iservice.IWebhookSender), new(*service.WebhookSender)),
+)
+
+var setEventPublisher = wire.NewSet(
+	service.NewEventPublisher,
+	wire.Bind(new(iservice.IEventPublisher), new(*service.LogEventPublisher)),
 )
 
 var setProcessPaymentUsecase = wire.NewSet(
@@ -34,19 +105,416 @@ var setProcessPaymentUsecase = wire.NewSet(
 	wire.Bind(new(usecase.IProcessPayment), new(*usecase.ProcessPayment)),
 )
 
+var setProcessQueuedPaymentsUsecase = wire.NewSet(
+	usecase.NewProcessQueuedPayments,
+	wire.Bind(new(usecase.IProcessQueuedPayments), new(*usecase.ProcessQueuedPayments)),
+)
+
+var setCreatePaymentBatchUsecase = wire.NewSet(
+	usecase.NewCreatePaymentBatch,
+	wire.Bind(new(usecase.ICreatePaymentBatch), new(*usecase.CreatePaymentBatch)),
+)
+
+var setFindPaymentBatchUsecase = wire.NewSet(
+	usecase.NewFindPaymentBatch,
+	wire.Bind(new(usecase.IFindPaymentBatch), new(*usecase.FindPaymentBatch)),
+)
+
+var setProcessPaymentBatchesUsecase = wire.NewSet(
+	usecase.NewProcessPaymentBatches,
+	wire.Bind(new(usecase.IProcessPaymentBatches), new(*usecase.ProcessPaymentBatches)),
+)
+
+var setImportSettlementUsecase = wire.NewSet(
+	usecase.NewImportSettlement,
+	wire.Bind(new(usecase.IImportSettlement), new(*usecase.ImportSettlement)),
+)
+
+var setFindSettlementUsecase = wire.NewSet(
+	usecase.NewFindSettlement,
+	wire.Bind(new(usecase.IFindSettlement), new(*usecase.FindSettlement)),
+)
+
+var setFindPaymentUsecase = wire.NewSet(
+	usecase.NewFindPayment,
+	wire.Bind(new(usecase.IFindPayment), new(*usecase.FindPayment)),
+)
+
+var setSearchPaymentsUsecase = wire.NewSet(
+	usecase.NewSearchPayments,
+	wire.Bind(new(usecase.ISearchPayments), new(*usecase.SearchPayments)),
+)
+
+var setCapturePaymentUsecase = wire.NewSet(
+	usecase.NewCapturePayment,
+	wire.Bind(new(usecase.ICapturePayment), new(*usecase.CapturePayment)),
+)
+
+var setRefundPaymentUsecase = wire.NewSet(
+	usecase.NewRefundPayment,
+	wire.Bind(new(usecase.IRefundPayment), new(*usecase.RefundPayment)),
+)
+
+var setRouteTransactionUsecase = wire.NewSet(
+	usecase.NewRouteTransaction,
+	wire.Bind(new(usecase.IRouteTransaction), new(*usecase.RouteTransaction)),
+)
+
+var setFindAcquirerHealthUsecase = wire.NewSet(
+	usecase.NewFindAcquirerHealth,
+	wire.Bind(new(usecase.IFindAcquirerHealth), new(*usecase.FindAcquirerHealth)),
+)
+
+var setResolveReversalsUsecase = wire.NewSet(
+	usecase.NewResolveReversals,
+	wire.Bind(new(usecase.IResolveReversals), new(*usecase.ResolveReversals)),
+)
+
+var setTokenizeCardUsecase = wire.NewSet(
+	usecase.NewTokenizeCard,
+	wire.Bind(new(usecase.ITokenizeCard), new(*usecase.TokenizeCard)),
+)
+
+var setDeleteCardUsecase = wire.NewSet(
+	usecase.NewDeleteCard,
+	wire.Bind(new(usecase.IDeleteCard), new(*usecase.DeleteCard)),
+)
+
+var setReencryptCardsUsecase = wire.NewSet(
+	usecase.NewReencryptCards,
+	wire.Bind(new(usecase.IReencryptCards), new(*usecase.ReencryptCards)),
+)
+
+var setReportExpiringCardsUsecase = wire.NewSet(
+	usecase.NewReportExpiringCards,
+	wire.Bind(new(usecase.IReportExpiringCards), new(*usecase.ReportExpiringCards)),
+)
+
+var setCreateStoreUsecase = wire.NewSet(
+	usecase.NewCreateStore,
+	wire.Bind(new(usecase.ICreateStore), new(*usecase.CreateStore)),
+)
+
+var setListStoresUsecase = wire.NewSet(
+	usecase.NewListStores,
+	wire.Bind(new(usecase.IListStores), new(*usecase.ListStores)),
+)
+
+var setFindStoreUsecase = wire.NewSet(
+	usecase.NewFindStore,
+	wire.Bind(new(usecase.IFindStore), new(*usecase.FindStore)),
+)
+
+var setUpdateStoreUsecase = wire.NewSet(
+	usecase.NewUpdateStore,
+	wire.Bind(new(usecase.IUpdateStore), new(*usecase.UpdateStore)),
+)
+
+var setDeleteStoreUsecase = wire.NewSet(
+	usecase.NewDeleteStore,
+	wire.Bind(new(usecase.IDeleteStore), new(*usecase.DeleteStore)),
+)
+
+var setCreateWebhookUsecase = wire.NewSet(
+	usecase.NewCreateWebhook,
+	wire.Bind(new(usecase.ICreateWebhook), new(*usecase.CreateWebhook)),
+)
+
+var setListWebhooksUsecase = wire.NewSet(
+	usecase.NewListWebhooks,
+	wire.Bind(new(usecase.IListWebhooks), new(*usecase.ListWebhooks)),
+)
+
+var setFindWebhookUsecase = wire.NewSet(
+	usecase.NewFindWebhook,
+	wire.Bind(new(usecase.IFindWebhook), new(*usecase.FindWebhook)),
+)
+
+var setUpdateWebhookUsecase = wire.NewSet(
+	usecase.NewUpdateWebhook,
+	wire.Bind(new(usecase.IUpdateWebhook), new(*usecase.UpdateWebhook)),
+)
+
+var setDeleteWebhookUsecase = wire.NewSet(
+	usecase.NewDeleteWebhook,
+	wire.Bind(new(usecase.IDeleteWebhook), new(*usecase.DeleteWebhook)),
+)
+
+var setListWebhookDeliveriesUsecase = wire.NewSet(
+	usecase.NewListWebhookDeliveries,
+	wire.Bind(new(usecase.IListWebhookDeliveries), new(*usecase.ListWebhookDeliveries)),
+)
+
+var setRedeliverWebhookUsecase = wire.NewSet(
+	usecase.NewRedeliverWebhook,
+	wire.Bind(new(usecase.IRedeliverWebhook), new(*usecase.RedeliverWebhook)),
+)
+
+var setDeliverWebhooksUsecase = wire.NewSet(
+	usecase.NewDeliverWebhooks,
+	wire.Bind(new(usecase.IDeliverWebhooks), new(*usecase.DeliverWebhooks)),
+)
+
+var setPublishEventsUsecase = wire.NewSet(
+	usecase.NewPublishEvents,
+	wire.Bind(new(usecase.IPublishEvents), new(*usecase.PublishEvents)),
+)
+
+var setStartIdempotentRequestUsecase = wire.NewSet(
+	usecase.NewStartIdempotentRequest,
+	wire.Bind(new(usecase.IStartIdempotentRequest), new(*usecase.StartIdempotentRequest)),
+)
+
+var setCompleteIdempotentRequestUsecase = wire.NewSet(
+	usecase.NewCompleteIdempotentRequest,
+	wire.Bind(new(usecase.ICompleteIdempotentRequest), new(*usecase.CompleteIdempotentRequest)),
+)
+
 var setPaymentHandler = wire.NewSet(
 	handler.NewPaymentHandler,
 	wire.Bind(new(handler.IPaymentHandler), new(*handler.PaymentHandler)),
 )
 
-func NewApp(db *sql.DB, authPublicKey *rsa.PublicKey, options ...service.PaymentOption) *fiber.App {
+var setIdempotencyHandler = wire.NewSet(
+	handler.NewIdempotencyHandler,
+	wire.Bind(new(handler.IIdempotencyHandler), new(*handler.IdempotencyHandler)),
+)
+
+var setRoutingHandler = wire.NewSet(
+	handler.NewRoutingHandler,
+	wire.Bind(new(handler.IRoutingHandler), new(*handler.RoutingHandler)),
+)
+
+var setAcquirerHandler = wire.NewSet(
+	handler.NewAcquirerHandler,
+	wire.Bind(new(handler.IAcquirerHandler), new(*handler.AcquirerHandler)),
+)
+
+var setCardHandler = wire.NewSet(
+	handler.NewCardHandler,
+	wire.Bind(new(handler.ICardHandler), new(*handler.CardHandler)),
+)
+
+var setStoreHandler = wire.NewSet(
+	handler.NewStoreHandler,
+	wire.Bind(new(handler.IStoreHandler), new(*handler.StoreHandler)),
+)
+
+var setWebhookHandler = wire.NewSet(
+	handler.NewWebhookHandler,
+	wire.Bind(new(handler.IWebhookHandler), new(*handler.WebhookHandler)),
+)
+
+var setPaymentBatchHandler = wire.NewSet(
+	handler.NewPaymentBatchHandler,
+	wire.Bind(new(handler.IPaymentBatchHandler), new(*handler.PaymentBatchHandler)),
+)
+
+var setSettlementHandler = wire.NewSet(
+	handler.NewSettlementHandler,
+	wire.Bind(new(handler.ISettlementHandler), new(*handler.SettlementHandler)),
+)
+
+var setPaymentSearchHandler = wire.NewSet(
+	handler.NewPaymentSearchHandler,
+	wire.Bind(new(handler.IPaymentSearchHandler), new(*handler.PaymentSearchHandler)),
+)
+
+func NewApp(
+	db *sql.DB,
+	authConfig web.AuthConfig,
+	routingRules []*entity.RouteRule,
+	keyManager *service.LocalKeyManager,
+	binService *service.BinService,
+	addressLookup *service.LocalAddressLookup,
+	paymentService *service.PaymentService,
+) *fiber.App {
 	wire.Build(
+		wire.Bind(new(iservice.IKeyManager), new(*service.LocalKeyManager)),
+		wire.Bind(new(iservice.IBinService), new(*service.BinService)),
+		wire.Bind(new(iservice.IAddressLookup), new(*service.LocalAddressLookup)),
+		wire.Bind(new(iservice.IPaymentService), new(*service.PaymentService)),
+		wire.Bind(new(iservice.IAcquirerHealthService), new(*service.PaymentService)),
 		setCardRepository,
-		setPaymentService,
+		setPaymentRepository,
+		setRefundRepository,
+		setReversalRepository,
+		setStoreRepository,
+		setIdempotencyRepository,
+		setWebhookRepository,
+		setWebhookDeliveryRepository,
+		setPaymentBatchRepository,
+		setSettlementRepository,
+		setRoutingService,
+		setSettlementParser,
 		setProcessPaymentUsecase,
+		setCreatePaymentBatchUsecase,
+		setFindPaymentBatchUsecase,
+		setImportSettlementUsecase,
+		setFindSettlementUsecase,
+		setFindPaymentUsecase,
+		setSearchPaymentsUsecase,
+		setCapturePaymentUsecase,
+		setRefundPaymentUsecase,
+		setRouteTransactionUsecase,
+		setFindAcquirerHealthUsecase,
+		setTokenizeCardUsecase,
+		setDeleteCardUsecase,
+		setCreateStoreUsecase,
+		setListStoresUsecase,
+		setFindStoreUsecase,
+		setUpdateStoreUsecase,
+		setDeleteStoreUsecase,
+		setCreateWebhookUsecase,
+		setListWebhooksUsecase,
+		setFindWebhookUsecase,
+		setUpdateWebhookUsecase,
+		setDeleteWebhookUsecase,
+		setListWebhookDeliveriesUsecase,
+		setRedeliverWebhookUsecase,
+		setStartIdempotentRequestUsecase,
+		setCompleteIdempotentRequestUsecase,
 		setPaymentHandler,
+		setIdempotencyHandler,
+		setRoutingHandler,
+		setAcquirerHandler,
+		setCardHandler,
+		setStoreHandler,
+		setWebhookHandler,
+		setPaymentBatchHandler,
+		setSettlementHandler,
+		setPaymentSearchHandler,
 		web.InitApp,
 	)
 
 	return &fiber.App{}
 }
+
+func NewReversalWorker(
+	db *sql.DB,
+	config worker.ReversalConfig,
+	paymentService *service.PaymentService,
+) *worker.ReversalWorker {
+	wire.Build(
+		wire.Bind(new(iservice.IPaymentService), new(*service.PaymentService)),
+		setPaymentRepository,
+		setReversalRepository,
+		setResolveReversalsUsecase,
+		worker.NewReversalWorker,
+	)
+
+	return &worker.ReversalWorker{}
+}
+
+func NewPaymentQueueWorker(
+	db *sql.DB,
+	routingRules []*entity.RouteRule,
+	keyManager *service.LocalKeyManager,
+	config worker.PaymentQueueConfig,
+	paymentService *service.PaymentService,
+) *worker.PaymentQueueWorker {
+	wire.Build(
+		wire.Bind(new(iservice.IKeyManager), new(*service.LocalKeyManager)),
+		wire.Bind(new(iservice.IPaymentService), new(*service.PaymentService)),
+		wire.Bind(new(iservice.IAcquirerHealthService), new(*service.PaymentService)),
+		setCardRepository,
+		setPaymentRepository,
+		setReversalRepository,
+		setStoreRepository,
+		setPaymentJobRepository,
+		setRoutingService,
+		usecase.NewProcessPayment,
+		setProcessQueuedPaymentsUsecase,
+		worker.NewPaymentQueueWorker,
+	)
+
+	return &worker.PaymentQueueWorker{}
+}
+
+func NewPaymentBatchWorker(
+	db *sql.DB,
+	routingRules []*entity.RouteRule,
+	keyManager *service.LocalKeyManager,
+	config worker.PaymentBatchConfig,
+	paymentService *service.PaymentService,
+) *worker.PaymentBatchWorker {
+	wire.Build(
+		wire.Bind(new(iservice.IKeyManager), new(*service.LocalKeyManager)),
+		wire.Bind(new(iservice.IPaymentService), new(*service.PaymentService)),
+		wire.Bind(new(iservice.IAcquirerHealthService), new(*service.PaymentService)),
+		setCardRepository,
+		setPaymentRepository,
+		setReversalRepository,
+		setStoreRepository,
+		setPaymentBatchRepository,
+		setRoutingService,
+		usecase.NewProcessPayment,
+		setProcessPaymentBatchesUsecase,
+		worker.NewPaymentBatchWorker,
+	)
+
+	return &worker.PaymentBatchWorker{}
+}
+
+func NewWebhookWorker(db *sql.DB, config worker.WebhookConfig) *worker.WebhookWorker {
+	wire.Build(
+		setWebhookRepository,
+		setWebhookDeliveryRepository,
+		setWebhookSender,
+		setDeliverWebhooksUsecase,
+		worker.NewWebhookWorker,
+	)
+
+	return &worker.WebhookWorker{}
+}
+
+func NewOutboxWorker(
+	db *sql.DB,
+	publisherConfig service.EventPublisherConfig,
+	config worker.OutboxConfig,
+) (*worker.OutboxWorker, error) {
+	wire.Build(
+		setOutboxRepository,
+		setWebhookDeliveryRepository,
+		setEventPublisher,
+		setPublishEventsUsecase,
+		worker.NewOutboxWorker,
+	)
+
+	return &worker.OutboxWorker{}, nil
+}
+
+func NewExpiringCardsWorker(
+	db *sql.DB,
+	keyManager *service.LocalKeyManager,
+	config worker.ExpiringCardsConfig,
+) *worker.ExpiringCardsWorker {
+	wire.Build(
+		wire.Bind(new(iservice.IKeyManager), new(*service.LocalKeyManager)),
+		setCardRepository,
+		setReportExpiringCardsUsecase,
+		worker.NewExpiringCardsWorker,
+	)
+
+	return &worker.ExpiringCardsWorker{}
+}
+
+func NewReencryptCards(db *sql.DB, keyManager *service.LocalKeyManager) usecase.IReencryptCards {
+	wire.Build(
+		wire.Bind(new(iservice.IKeyManager), new(*service.LocalKeyManager)),
+		setCardRepository,
+		setReencryptCardsUsecase,
+	)
+
+	return &usecase.ReencryptCards{}
+}
+
+func NewImportSettlement(db *sql.DB) usecase.IImportSettlement {
+	wire.Build(
+		setSettlementRepository,
+		setSettlementParser,
+		setImportSettlementUsecase,
+	)
+
+	return &usecase.ImportSettlement{}
+}
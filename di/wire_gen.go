@@ -7,10 +7,10 @@
 package di
 
 import (
-	"crypto/rsa"
 	"database/sql"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/wire"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
 	repository2 "github.com/sesaquecruz/go-payment-processor/internal/core/repository"
 	service2 "github.com/sesaquecruz/go-payment-processor/internal/core/service"
 	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
@@ -18,25 +18,258 @@ import (
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/service"
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/web"
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/handler"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/worker"
 )
 
 // Injectors from wire.go:
 
-func NewApp(db *sql.DB, authPublicKey *rsa.PublicKey, options ...service.PaymentOption) *fiber.App {
-	cardRepository := repository.NewCardRepository(db)
-	paymentService := service.NewPaymentService(options...)
-	processPayment := usecase.NewProcessPayment(cardRepository, paymentService)
-	paymentHandler := handler.NewPaymentHandler(processPayment)
-	app := web.InitApp(authPublicKey, paymentHandler)
+func NewApp(db *sql.DB, authConfig web.AuthConfig, routingRules []*entity.RouteRule, keyManager *service.LocalKeyManager, binService *service.BinService, addressLookup *service.LocalAddressLookup, paymentService *service.PaymentService) *fiber.App {
+	cardRepository := repository.NewCardRepository(db, keyManager)
+	paymentRepository := repository.NewPaymentRepository(db)
+	reversalRepository := repository.NewReversalRepository(db)
+	storeRepository := repository.NewStoreRepository(db)
+	routingService := service.NewRoutingService(routingRules, paymentService)
+	processPayment := usecase.NewProcessPayment(cardRepository, paymentRepository, reversalRepository, storeRepository, paymentService, routingService)
+	findPayment := usecase.NewFindPayment(paymentRepository)
+	capturePayment := usecase.NewCapturePayment(paymentRepository, paymentService)
+	refundRepository := repository.NewRefundRepository(db)
+	refundPayment := usecase.NewRefundPayment(paymentRepository, refundRepository, paymentService)
+	paymentHandler := handler.NewPaymentHandler(processPayment, findPayment, capturePayment, refundPayment)
+	idempotencyRepository := repository.NewIdempotencyRepository(db)
+	startIdempotentRequest := usecase.NewStartIdempotentRequest(idempotencyRepository)
+	completeIdempotentRequest := usecase.NewCompleteIdempotentRequest(idempotencyRepository)
+	idempotencyHandler := handler.NewIdempotencyHandler(startIdempotentRequest, completeIdempotentRequest)
+	routeTransaction := usecase.NewRouteTransaction(storeRepository, routingService)
+	routingHandler := handler.NewRoutingHandler(routeTransaction)
+	findAcquirerHealth := usecase.NewFindAcquirerHealth(paymentService)
+	acquirerHandler := handler.NewAcquirerHandler(findAcquirerHealth)
+	tokenizeCard := usecase.NewTokenizeCard(cardRepository, binService)
+	deleteCard := usecase.NewDeleteCard(cardRepository)
+	cardHandler := handler.NewCardHandler(tokenizeCard, deleteCard)
+	createStore := usecase.NewCreateStore(storeRepository, addressLookup)
+	listStores := usecase.NewListStores(storeRepository)
+	findStore := usecase.NewFindStore(storeRepository)
+	updateStore := usecase.NewUpdateStore(storeRepository, addressLookup)
+	deleteStore := usecase.NewDeleteStore(storeRepository)
+	storeHandler := handler.NewStoreHandler(createStore, listStores, findStore, updateStore, deleteStore)
+	webhookRepository := repository.NewWebhookRepository(db)
+	createWebhook := usecase.NewCreateWebhook(webhookRepository)
+	listWebhooks := usecase.NewListWebhooks(webhookRepository)
+	findWebhook := usecase.NewFindWebhook(webhookRepository)
+	updateWebhook := usecase.NewUpdateWebhook(webhookRepository)
+	deleteWebhook := usecase.NewDeleteWebhook(webhookRepository)
+	webhookDeliveryRepository := repository.NewWebhookDeliveryRepository(db)
+	listWebhookDeliveries := usecase.NewListWebhookDeliveries(webhookRepository, webhookDeliveryRepository)
+	redeliverWebhook := usecase.NewRedeliverWebhook(webhookRepository, webhookDeliveryRepository)
+	webhookHandler := handler.NewWebhookHandler(createWebhook, listWebhooks, findWebhook, updateWebhook, deleteWebhook, listWebhookDeliveries, redeliverWebhook)
+	paymentBatchRepository := repository.NewPaymentBatchRepository(db)
+	createPaymentBatch := usecase.NewCreatePaymentBatch(paymentBatchRepository, processPayment)
+	findPaymentBatch := usecase.NewFindPaymentBatch(paymentBatchRepository)
+	paymentBatchHandler := handler.NewPaymentBatchHandler(createPaymentBatch, findPaymentBatch)
+	settlementRepository := repository.NewSettlementRepository(db)
+	settlementParser := service.NewSettlementParser()
+	importSettlement := usecase.NewImportSettlement(settlementRepository, settlementParser)
+	findSettlement := usecase.NewFindSettlement(settlementRepository)
+	settlementHandler := handler.NewSettlementHandler(importSettlement, findSettlement)
+	searchPayments := usecase.NewSearchPayments(paymentRepository)
+	paymentSearchHandler := handler.NewPaymentSearchHandler(searchPayments)
+	app := web.InitApp(authConfig, paymentHandler, idempotencyHandler, routingHandler, acquirerHandler, cardHandler, storeHandler, webhookHandler, paymentBatchHandler, settlementHandler, paymentSearchHandler)
 	return app
 }
 
+func NewReversalWorker(db *sql.DB, config worker.ReversalConfig, paymentService *service.PaymentService) *worker.ReversalWorker {
+	paymentRepository := repository.NewPaymentRepository(db)
+	reversalRepository := repository.NewReversalRepository(db)
+	resolveReversals := usecase.NewResolveReversals(paymentRepository, reversalRepository, paymentService)
+	reversalWorker := worker.NewReversalWorker(resolveReversals, config)
+	return reversalWorker
+}
+
+func NewPaymentQueueWorker(db *sql.DB, routingRules []*entity.RouteRule, keyManager *service.LocalKeyManager, config worker.PaymentQueueConfig, paymentService *service.PaymentService) *worker.PaymentQueueWorker {
+	paymentJobRepository := repository.NewPaymentJobRepository(db)
+	cardRepository := repository.NewCardRepository(db, keyManager)
+	paymentRepository := repository.NewPaymentRepository(db)
+	reversalRepository := repository.NewReversalRepository(db)
+	storeRepository := repository.NewStoreRepository(db)
+	routingService := service.NewRoutingService(routingRules, paymentService)
+	processPayment := usecase.NewProcessPayment(cardRepository, paymentRepository, reversalRepository, storeRepository, paymentService, routingService)
+	processQueuedPayments := usecase.NewProcessQueuedPayments(paymentJobRepository, processPayment)
+	paymentQueueWorker := worker.NewPaymentQueueWorker(processQueuedPayments, config)
+	return paymentQueueWorker
+}
+
+func NewPaymentBatchWorker(db *sql.DB, routingRules []*entity.RouteRule, keyManager *service.LocalKeyManager, config worker.PaymentBatchConfig, paymentService *service.PaymentService) *worker.PaymentBatchWorker {
+	paymentBatchRepository := repository.NewPaymentBatchRepository(db)
+	cardRepository := repository.NewCardRepository(db, keyManager)
+	paymentRepository := repository.NewPaymentRepository(db)
+	reversalRepository := repository.NewReversalRepository(db)
+	storeRepository := repository.NewStoreRepository(db)
+	routingService := service.NewRoutingService(routingRules, paymentService)
+	processPayment := usecase.NewProcessPayment(cardRepository, paymentRepository, reversalRepository, storeRepository, paymentService, routingService)
+	processPaymentBatches := usecase.NewProcessPaymentBatches(paymentBatchRepository, processPayment)
+	paymentBatchWorker := worker.NewPaymentBatchWorker(processPaymentBatches, config)
+	return paymentBatchWorker
+}
+
+func NewWebhookWorker(db *sql.DB, config worker.WebhookConfig) *worker.WebhookWorker {
+	webhookRepository := repository.NewWebhookRepository(db)
+	webhookDeliveryRepository := repository.NewWebhookDeliveryRepository(db)
+	webhookSender := service.NewWebhookSender()
+	deliverWebhooks := usecase.NewDeliverWebhooks(webhookRepository, webhookDeliveryRepository, webhookSender)
+	webhookWorker := worker.NewWebhookWorker(deliverWebhooks, config)
+	return webhookWorker
+}
+
+func NewOutboxWorker(db *sql.DB, publisherConfig service.EventPublisherConfig, config worker.OutboxConfig) (*worker.OutboxWorker, error) {
+	outboxRepository := repository.NewOutboxRepository(db)
+	webhookDeliveryRepository := repository.NewWebhookDeliveryRepository(db)
+	logEventPublisher, err := service.NewEventPublisher(publisherConfig)
+	if err != nil {
+		return nil, err
+	}
+	publishEvents := usecase.NewPublishEvents(outboxRepository, webhookDeliveryRepository, logEventPublisher)
+	outboxWorker := worker.NewOutboxWorker(publishEvents, config)
+	return outboxWorker, nil
+}
+
+func NewExpiringCardsWorker(db *sql.DB, keyManager *service.LocalKeyManager, config worker.ExpiringCardsConfig) *worker.ExpiringCardsWorker {
+	cardRepository := repository.NewCardRepository(db, keyManager)
+	reportExpiringCards := usecase.NewReportExpiringCards(cardRepository)
+	expiringCardsWorker := worker.NewExpiringCardsWorker(reportExpiringCards, config)
+	return expiringCardsWorker
+}
+
+func NewReencryptCards(db *sql.DB, keyManager *service.LocalKeyManager) usecase.IReencryptCards {
+	cardRepository := repository.NewCardRepository(db, keyManager)
+	reencryptCards := usecase.NewReencryptCards(cardRepository, keyManager)
+	return reencryptCards
+}
+
+func NewImportSettlement(db *sql.DB) usecase.IImportSettlement {
+	settlementRepository := repository.NewSettlementRepository(db)
+	settlementParser := service.NewSettlementParser()
+	importSettlement := usecase.NewImportSettlement(settlementRepository, settlementParser)
+	return importSettlement
+}
+
 // wire.go:
 
 var setCardRepository = wire.NewSet(repository.NewCardRepository, wire.Bind(new(repository2.ICardRepository), new(*repository.CardRepository)))
 
-var setPaymentService = wire.NewSet(service.NewPaymentService, wire.Bind(new(service2.IPaymentService), new(*service.PaymentService)))
+var setPaymentRepository = wire.NewSet(repository.NewPaymentRepository, wire.Bind(new(repository2.IPaymentRepository), new(*repository.PaymentRepository)))
+
+var setRefundRepository = wire.NewSet(repository.NewRefundRepository, wire.Bind(new(repository2.IRefundRepository), new(*repository.RefundRepository)))
+
+var setReversalRepository = wire.NewSet(repository.NewReversalRepository, wire.Bind(new(repository2.IReversalRepository), new(*repository.ReversalRepository)))
+
+var setStoreRepository = wire.NewSet(repository.NewStoreRepository, wire.Bind(new(repository2.IStoreRepository), new(*repository.StoreRepository)))
+
+var setWebhookRepository = wire.NewSet(repository.NewWebhookRepository, wire.Bind(new(repository2.IWebhookRepository), new(*repository.WebhookRepository)))
+
+var setWebhookDeliveryRepository = wire.NewSet(repository.NewWebhookDeliveryRepository, wire.Bind(new(repository2.IWebhookDeliveryRepository), new(*repository.WebhookDeliveryRepository)))
+
+var setOutboxRepository = wire.NewSet(repository.NewOutboxRepository, wire.Bind(new(repository2.IOutboxRepository), new(*repository.OutboxRepository)))
+
+var setPaymentJobRepository = wire.NewSet(repository.NewPaymentJobRepository, wire.Bind(new(repository2.IPaymentJobRepository), new(*repository.PaymentJobRepository)))
+
+var setPaymentBatchRepository = wire.NewSet(repository.NewPaymentBatchRepository, wire.Bind(new(repository2.IPaymentBatchRepository), new(*repository.PaymentBatchRepository)))
+
+var setSettlementRepository = wire.NewSet(repository.NewSettlementRepository, wire.Bind(new(repository2.ISettlementRepository), new(*repository.SettlementRepository)))
+
+var setIdempotencyRepository = wire.NewSet(repository.NewIdempotencyRepository, wire.Bind(new(repository2.IIdempotencyRepository), new(*repository.IdempotencyRepository)))
+
+var setRoutingService = wire.NewSet(service.NewRoutingService, wire.Bind(new(service2.IRoutingService), new(*service.RoutingService)))
+
+var setSettlementParser = wire.NewSet(service.NewSettlementParser, wire.Bind(new(service2.ISettlementParser), new(*service.SettlementParser)))
+
+var setWebhookSender = wire.NewSet(service.NewWebhookSender, wire.Bind(new(service2.IWebhookSender), new(*service.WebhookSender)))
+
+var setEventPublisher = wire.NewSet(service.NewEventPublisher, wire.Bind(new(service2.IEventPublisher), new(*service.LogEventPublisher)))
 
 var setProcessPaymentUsecase = wire.NewSet(usecase.NewProcessPayment, wire.Bind(new(usecase.IProcessPayment), new(*usecase.ProcessPayment)))
 
+var setProcessQueuedPaymentsUsecase = wire.NewSet(usecase.NewProcessQueuedPayments, wire.Bind(new(usecase.IProcessQueuedPayments), new(*usecase.ProcessQueuedPayments)))
+
+var setCreatePaymentBatchUsecase = wire.NewSet(usecase.NewCreatePaymentBatch, wire.Bind(new(usecase.ICreatePaymentBatch), new(*usecase.CreatePaymentBatch)))
+
+var setFindPaymentBatchUsecase = wire.NewSet(usecase.NewFindPaymentBatch, wire.Bind(new(usecase.IFindPaymentBatch), new(*usecase.FindPaymentBatch)))
+
+var setProcessPaymentBatchesUsecase = wire.NewSet(usecase.NewProcessPaymentBatches, wire.Bind(new(usecase.IProcessPaymentBatches), new(*usecase.ProcessPaymentBatches)))
+
+var setImportSettlementUsecase = wire.NewSet(usecase.NewImportSettlement, wire.Bind(new(usecase.IImportSettlement), new(*usecase.ImportSettlement)))
+
+var setFindSettlementUsecase = wire.NewSet(usecase.NewFindSettlement, wire.Bind(new(usecase.IFindSettlement), new(*usecase.FindSettlement)))
+
+var setFindPaymentUsecase = wire.NewSet(usecase.NewFindPayment, wire.Bind(new(usecase.IFindPayment), new(*usecase.FindPayment)))
+
+var setSearchPaymentsUsecase = wire.NewSet(usecase.NewSearchPayments, wire.Bind(new(usecase.ISearchPayments), new(*usecase.SearchPayments)))
+
+var setCapturePaymentUsecase = wire.NewSet(usecase.NewCapturePayment, wire.Bind(new(usecase.ICapturePayment), new(*usecase.CapturePayment)))
+
+var setRefundPaymentUsecase = wire.NewSet(usecase.NewRefundPayment, wire.Bind(new(usecase.IRefundPayment), new(*usecase.RefundPayment)))
+
+var setRouteTransactionUsecase = wire.NewSet(usecase.NewRouteTransaction, wire.Bind(new(usecase.IRouteTransaction), new(*usecase.RouteTransaction)))
+
+var setFindAcquirerHealthUsecase = wire.NewSet(usecase.NewFindAcquirerHealth, wire.Bind(new(usecase.IFindAcquirerHealth), new(*usecase.FindAcquirerHealth)))
+
+var setResolveReversalsUsecase = wire.NewSet(usecase.NewResolveReversals, wire.Bind(new(usecase.IResolveReversals), new(*usecase.ResolveReversals)))
+
+var setTokenizeCardUsecase = wire.NewSet(usecase.NewTokenizeCard, wire.Bind(new(usecase.ITokenizeCard), new(*usecase.TokenizeCard)))
+
+var setDeleteCardUsecase = wire.NewSet(usecase.NewDeleteCard, wire.Bind(new(usecase.IDeleteCard), new(*usecase.DeleteCard)))
+
+var setReencryptCardsUsecase = wire.NewSet(usecase.NewReencryptCards, wire.Bind(new(usecase.IReencryptCards), new(*usecase.ReencryptCards)))
+
+var setReportExpiringCardsUsecase = wire.NewSet(usecase.NewReportExpiringCards, wire.Bind(new(usecase.IReportExpiringCards), new(*usecase.ReportExpiringCards)))
+
+var setCreateStoreUsecase = wire.NewSet(usecase.NewCreateStore, wire.Bind(new(usecase.ICreateStore), new(*usecase.CreateStore)))
+
+var setListStoresUsecase = wire.NewSet(usecase.NewListStores, wire.Bind(new(usecase.IListStores), new(*usecase.ListStores)))
+
+var setFindStoreUsecase = wire.NewSet(usecase.NewFindStore, wire.Bind(new(usecase.IFindStore), new(*usecase.FindStore)))
+
+var setUpdateStoreUsecase = wire.NewSet(usecase.NewUpdateStore, wire.Bind(new(usecase.IUpdateStore), new(*usecase.UpdateStore)))
+
+var setDeleteStoreUsecase = wire.NewSet(usecase.NewDeleteStore, wire.Bind(new(usecase.IDeleteStore), new(*usecase.DeleteStore)))
+
+var setCreateWebhookUsecase = wire.NewSet(usecase.NewCreateWebhook, wire.Bind(new(usecase.ICreateWebhook), new(*usecase.CreateWebhook)))
+
+var setListWebhooksUsecase = wire.NewSet(usecase.NewListWebhooks, wire.Bind(new(usecase.IListWebhooks), new(*usecase.ListWebhooks)))
+
+var setFindWebhookUsecase = wire.NewSet(usecase.NewFindWebhook, wire.Bind(new(usecase.IFindWebhook), new(*usecase.FindWebhook)))
+
+var setUpdateWebhookUsecase = wire.NewSet(usecase.NewUpdateWebhook, wire.Bind(new(usecase.IUpdateWebhook), new(*usecase.UpdateWebhook)))
+
+var setDeleteWebhookUsecase = wire.NewSet(usecase.NewDeleteWebhook, wire.Bind(new(usecase.IDeleteWebhook), new(*usecase.DeleteWebhook)))
+
+var setListWebhookDeliveriesUsecase = wire.NewSet(usecase.NewListWebhookDeliveries, wire.Bind(new(usecase.IListWebhookDeliveries), new(*usecase.ListWebhookDeliveries)))
+
+var setRedeliverWebhookUsecase = wire.NewSet(usecase.NewRedeliverWebhook, wire.Bind(new(usecase.IRedeliverWebhook), new(*usecase.RedeliverWebhook)))
+
+var setDeliverWebhooksUsecase = wire.NewSet(usecase.NewDeliverWebhooks, wire.Bind(new(usecase.IDeliverWebhooks), new(*usecase.DeliverWebhooks)))
+
+var setPublishEventsUsecase = wire.NewSet(usecase.NewPublishEvents, wire.Bind(new(usecase.IPublishEvents), new(*usecase.PublishEvents)))
+
+var setStartIdempotentRequestUsecase = wire.NewSet(usecase.NewStartIdempotentRequest, wire.Bind(new(usecase.IStartIdempotentRequest), new(*usecase.StartIdempotentRequest)))
+
+var setCompleteIdempotentRequestUsecase = wire.NewSet(usecase.NewCompleteIdempotentRequest, wire.Bind(new(usecase.ICompleteIdempotentRequest), new(*usecase.CompleteIdempotentRequest)))
+
 var setPaymentHandler = wire.NewSet(handler.NewPaymentHandler, wire.Bind(new(handler.IPaymentHandler), new(*handler.PaymentHandler)))
+
+var setIdempotencyHandler = wire.NewSet(handler.NewIdempotencyHandler, wire.Bind(new(handler.IIdempotencyHandler), new(*handler.IdempotencyHandler)))
+
+var setRoutingHandler = wire.NewSet(handler.NewRoutingHandler, wire.Bind(new(handler.IRoutingHandler), new(*handler.RoutingHandler)))
+
+var setAcquirerHandler = wire.NewSet(handler.NewAcquirerHandler, wire.Bind(new(handler.IAcquirerHandler), new(*handler.AcquirerHandler)))
+
+var setCardHandler = wire.NewSet(handler.NewCardHandler, wire.Bind(new(handler.ICardHandler), new(*handler.CardHandler)))
+
+var setStoreHandler = wire.NewSet(handler.NewStoreHandler, wire.Bind(new(handler.IStoreHandler), new(*handler.StoreHandler)))
+
+var setWebhookHandler = wire.NewSet(handler.NewWebhookHandler, wire.Bind(new(handler.IWebhookHandler), new(*handler.WebhookHandler)))
+
+var setPaymentBatchHandler = wire.NewSet(handler.NewPaymentBatchHandler, wire.Bind(new(handler.IPaymentBatchHandler), new(*handler.PaymentBatchHandler)))
+
+var setSettlementHandler = wire.NewSet(handler.NewSettlementHandler, wire.Bind(new(handler.ISettlementHandler), new(*handler.SettlementHandler)))
+
+var setPaymentSearchHandler = wire.NewSet(handler.NewPaymentSearchHandler, wire.Bind(new(handler.IPaymentSearchHandler), new(*handler.PaymentSearchHandler)))
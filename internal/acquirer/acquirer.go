@@ -10,5 +10,11 @@ import (
 type IAcquirer interface {
 	Name() string
 	RequestBuilder(context.Context, *entity.Transaction) (*http.Request, error)
-	ResponseExtractor(*http.Response) (*entity.Payment, error)
+	ResponseExtractor(*http.Response) (*entity.AcquirerResponse, error)
+	CaptureRequestBuilder(context.Context, *entity.Payment, entity.Money) (*http.Request, error)
+	CaptureResponseExtractor(*http.Response) (*entity.AcquirerResponse, error)
+	RefundRequestBuilder(context.Context, *entity.Payment, *entity.Refund) (*http.Request, error)
+	RefundResponseExtractor(*http.Response) (*entity.AcquirerResponse, error)
+	ReversalRequestBuilder(context.Context, *entity.Reversal) (*http.Request, error)
+	ReversalResponseExtractor(*http.Response) (*entity.AcquirerResponse, error)
 }
@@ -0,0 +1,51 @@
+package acquirer
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestBuilderFormatsStoreIdentification(t *testing.T) {
+	acquirers := []IAcquirer{
+		NewCielo("http://cielo", "key"),
+		NewRede("http://rede", "key"),
+		NewStone("http://stone", "key"),
+	}
+
+	testCases := []struct {
+		Identification string
+		Formatted      string
+		DocumentType   string
+	}{
+		{"11222333000181", "11.222.333/0001-81", "cnpj"},
+		{"529.982.247-25", "529.982.247-25", "cpf"},
+	}
+
+	for _, acquirer := range acquirers {
+		for _, tc := range testCases {
+			t.Run(acquirer.Name()+" "+tc.DocumentType, func(t *testing.T) {
+				transaction := entity.NewTransaction(
+					entity.NewCard("Token", "Holder", "12/2099", "VISA"),
+					entity.NewPurchase(entity.NewMoney(1000, "BRL"), []string{"Item"}, 1),
+					entity.NewStore(tc.Identification, "Address", "01310100"),
+					entity.NewAcquirer(acquirer.Name()),
+				)
+
+				request, err := acquirer.RequestBuilder(context.Background(), transaction)
+				require.Nil(t, err)
+
+				var body map[string]any
+				err = json.NewDecoder(request.Body).Decode(&body)
+				require.Nil(t, err)
+				assert.Equal(t, tc.Formatted, body["store_identification"])
+				assert.Equal(t, tc.DocumentType, body["store_document_type"])
+			})
+		}
+	}
+}
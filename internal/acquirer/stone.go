@@ -32,30 +32,38 @@ func (a *Stone) Name() string {
 func (a *Stone) RequestBuilder(ctx context.Context, transaction *entity.Transaction) (*http.Request, error) {
 	type StoneRequest struct {
 		CardToken            string   `json:"card_token"`
+		CardNumber           string   `json:"card_number,omitempty"`
 		CardHolder           string   `json:"card_holder"`
 		CardExpiration       string   `json:"card_expiration"`
 		CardBrand            string   `json:"card_brand"`
-		PurchaseValue        float64  `json:"purchase_value"`
+		PurchaseAmount       int64    `json:"purchase_amount"`
+		PurchaseCurrency     string   `json:"purchase_currency"`
 		PurchaseItems        []string `json:"purchase_items"`
 		PurchaseInstallments int      `json:"purchase_installments"`
 		StoreIdentification  string   `json:"store_identification"`
+		StoreDocumentType    string   `json:"store_document_type"`
 		StoreAddress         string   `json:"store_address"`
 		StoreCep             string   `json:"store_cep"`
 		StoreName            string   `json:"store_name"`
+		Reference            string   `json:"reference"`
 	}
 
 	data := StoneRequest{
 		CardToken:            transaction.Card.Token,
+		CardNumber:           transaction.Card.Number,
 		CardHolder:           transaction.Card.Holder,
 		CardExpiration:       transaction.Card.Expiration,
 		CardBrand:            transaction.Card.Brand,
-		PurchaseValue:        transaction.Purchase.Value,
+		PurchaseAmount:       transaction.Purchase.Value.Amount,
+		PurchaseCurrency:     transaction.Purchase.Value.Currency,
 		PurchaseItems:        transaction.Purchase.Items,
 		PurchaseInstallments: transaction.Purchase.Installments,
-		StoreIdentification:  transaction.Store.Identification,
+		StoreIdentification:  transaction.Store.FormattedIdentification(),
+		StoreDocumentType:    string(transaction.Store.DocumentType),
 		StoreAddress:         transaction.Store.Address,
 		StoreCep:             transaction.Store.Cep,
 		StoreName:            transaction.Acquirer.Name,
+		Reference:            transaction.Reference,
 	}
 
 	body, err := json.Marshal(data)
@@ -63,7 +71,12 @@ func (a *Stone) RequestBuilder(ctx context.Context, transaction *entity.Transact
 		return nil, errors.NewInternalError(err)
 	}
 
-	request, err := http.NewRequest(http.MethodPost, a.url, bytes.NewReader(body))
+	url := a.url
+	if transaction.AuthorizeOnly {
+		url = a.url + "/authorizations"
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
 		return nil, errors.NewInternalError(err)
 	}
@@ -73,7 +86,7 @@ func (a *Stone) RequestBuilder(ctx context.Context, transaction *entity.Transact
 	return request, nil
 }
 
-func (a *Stone) ResponseExtractor(response *http.Response) (*entity.Payment, error) {
+func (a *Stone) ResponseExtractor(response *http.Response) (*entity.AcquirerResponse, error) {
 	type StoneResponse struct {
 		Code    int    `json:"code"`
 		Message string `json:"message"`
@@ -94,6 +107,107 @@ func (a *Stone) ResponseExtractor(response *http.Response) (*entity.Payment, err
 		return nil, errors.NewAcquirerError(data.Code, data.Message)
 	}
 
-	payment := entity.NewPayment(data.Message)
-	return payment, nil
+	result := entity.NewAcquirerResponse(data.Message, data.Code, data.Message)
+	return result, nil
+}
+
+func (a *Stone) CaptureRequestBuilder(ctx context.Context, payment *entity.Payment, value entity.Money) (*http.Request, error) {
+	type StoneCaptureRequest struct {
+		TransactionId   string `json:"transaction_id"`
+		CaptureAmount   int64  `json:"capture_amount"`
+		CaptureCurrency string `json:"capture_currency"`
+	}
+
+	data := StoneCaptureRequest{
+		TransactionId:   payment.AcquirerId,
+		CaptureAmount:   value.Amount,
+		CaptureCurrency: value.Currency,
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url+"/captures", bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	request.Header.Set("Api-Key", a.key)
+	request.Header.Set("Content-Type", "application/json")
+	return request, nil
+}
+
+func (a *Stone) CaptureResponseExtractor(response *http.Response) (*entity.AcquirerResponse, error) {
+	return a.ResponseExtractor(response)
+}
+
+func (a *Stone) RefundRequestBuilder(ctx context.Context, payment *entity.Payment, refund *entity.Refund) (*http.Request, error) {
+	type StoneRefundRequest struct {
+		TransactionId  string `json:"transaction_id"`
+		RefundAmount   int64  `json:"refund_amount"`
+		RefundCurrency string `json:"refund_currency"`
+	}
+
+	data := StoneRefundRequest{
+		TransactionId:  payment.AcquirerId,
+		RefundAmount:   refund.Value.Amount,
+		RefundCurrency: refund.Value.Currency,
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	url := a.url + "/refunds"
+	if refund.Type == entity.RefundTypeVoid {
+		url = a.url + "/voids"
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	request.Header.Set("Api-Key", a.key)
+	request.Header.Set("Content-Type", "application/json")
+	return request, nil
+}
+
+func (a *Stone) RefundResponseExtractor(response *http.Response) (*entity.AcquirerResponse, error) {
+	return a.ResponseExtractor(response)
+}
+
+func (a *Stone) ReversalRequestBuilder(ctx context.Context, reversal *entity.Reversal) (*http.Request, error) {
+	type StoneReversalRequest struct {
+		Reference        string `json:"reference"`
+		ReversalAmount   int64  `json:"reversal_amount"`
+		ReversalCurrency string `json:"reversal_currency"`
+	}
+
+	data := StoneReversalRequest{
+		Reference:        reversal.AttemptId,
+		ReversalAmount:   reversal.Value.Amount,
+		ReversalCurrency: reversal.Value.Currency,
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url+"/reversals", bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	request.Header.Set("Api-Key", a.key)
+	request.Header.Set("Content-Type", "application/json")
+	return request, nil
+}
+
+func (a *Stone) ReversalResponseExtractor(response *http.Response) (*entity.AcquirerResponse, error) {
+	return a.ResponseExtractor(response)
 }
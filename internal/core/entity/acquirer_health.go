@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+type CircuitState string
+
+const (
+	CircuitStateClosed   CircuitState = "closed"
+	CircuitStateOpen     CircuitState = "open"
+	CircuitStateHalfOpen CircuitState = "half_open"
+)
+
+// AcquirerHealth is the circuit breaker state of an acquirer. The counters are cumulative
+// while the rates are measured over the recent calls kept by the breaker.
+type AcquirerHealth struct {
+	Acquirer     string
+	State        CircuitState
+	Requests     int64
+	Failures     int64
+	SlowCalls    int64
+	Rejections   int64
+	ErrorRate    float64
+	SlowCallRate float64
+	OpenedAt     time.Time
+}
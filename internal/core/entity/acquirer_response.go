@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+type AcquirerResponse struct {
+	Id      string
+	Code    int
+	Message string
+}
+
+func NewAcquirerResponse(id string, code int, message string) *AcquirerResponse {
+	return &AcquirerResponse{
+		Id:      id,
+		Code:    code,
+		Message: message,
+	}
+}
+
+func (r *AcquirerResponse) Validate() error {
+	msgs := make([]string, 0)
+
+	if r.Id == "" {
+		msgs = append(msgs, "acquirer response id is required")
+	}
+
+	if len(msgs) > 0 {
+		return errors.NewValidationError(msgs...)
+	}
+
+	return nil
+}
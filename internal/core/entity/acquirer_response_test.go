@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcquirerResponseFactory(t *testing.T) {
+	response := NewAcquirerResponse("Id", 200, "Message")
+	assert.NotNil(t, response)
+	assert.Equal(t, response.Id, "Id")
+	assert.Equal(t, response.Code, 200)
+	assert.Equal(t, response.Message, "Message")
+}
+
+func TestAcquirerResponseValidator(t *testing.T) {
+	testCases := []struct {
+		TestName   string
+		ResponseId string
+		Err        *errors.ValidationError
+	}{
+		{
+			"id is empty",
+			"",
+			errors.NewValidationError("acquirer response id is required"),
+		},
+		{
+			"all fields are valid",
+			"Id",
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := NewAcquirerResponse(tc.ResponseId, 200, "Message").Validate()
+			if tc.Err == nil && err == nil {
+				return
+			}
+
+			var verr *errors.ValidationError
+			assert.ErrorAs(t, err, &verr)
+			assert.Equal(t, len(tc.Err.Messages), len(verr.Messages))
+
+			for i, msg := range tc.Err.Messages {
+				assert.Equal(t, msg, verr.Messages[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCep(t *testing.T) {
+	assert.Equal(t, "01310100", NormalizeCep(" 01.310-100 "))
+	assert.Equal(t, "01310-100", FormatCep("01310100"))
+	assert.Equal(t, "Cep", FormatCep("Cep"))
+
+	assert.True(t, ValidCep("01310100"))
+	assert.False(t, ValidCep("00999999"))
+	assert.False(t, ValidCep("0131010"))
+	assert.False(t, ValidCep("0131010A"))
+
+	assert.Equal(t, "SP", CepState("01310100"))
+	assert.Equal(t, "RJ", CepState("22250040"))
+	assert.Equal(t, "DF", CepState("70150900"))
+	assert.Equal(t, "GO", CepState("74000000"))
+	assert.Equal(t, "RR", CepState("69301000"))
+	assert.Equal(t, "AM", CepState("69400000"))
+	assert.Equal(t, "RS", CepState("99999999"))
+	assert.Empty(t, CepState("00000000"))
+
+	assert.True(t, ValidState("SP"))
+	assert.False(t, ValidState("XX"))
+}
+
+func TestStoreApplyAddress(t *testing.T) {
+	address := &Address{Cep: "01310100", Street: "Avenida Paulista", District: "Bela Vista", City: "São Paulo", State: "SP"}
+
+	t.Run("complete the location", func(t *testing.T) {
+		store := NewStore("11222333000181", "Address", "01310-100")
+		store.SetLocation("", " 1000 ", "são paulo", "")
+		store.ApplyAddress(address)
+
+		assert.Equal(t, "Avenida Paulista", store.Street)
+		assert.Equal(t, "1000", store.Number)
+		assert.Equal(t, "São Paulo", store.City)
+		assert.Equal(t, "SP", store.State)
+		assert.Nil(t, store.Validate())
+	})
+
+	t.Run("unknown cep takes the state of its range", func(t *testing.T) {
+		store := NewStore("11222333000181", "Address", "01310-999")
+		store.ApplyAddress(nil)
+
+		assert.Equal(t, "SP", store.State)
+		assert.Nil(t, store.Validate())
+	})
+
+	t.Run("state contradicts the cep", func(t *testing.T) {
+		store := NewStore("11222333000181", "Address", "01310-100")
+		store.SetLocation("Avenida Paulista", "1000", "São Paulo", "rj")
+		store.ApplyAddress(address)
+
+		var verr *errors.ValidationError
+		assert.ErrorAs(t, store.Validate(), &verr)
+		assert.Equal(t, []string{"store state does not match the cep"}, verr.Messages)
+	})
+
+	t.Run("invalid cep and state", func(t *testing.T) {
+		var verr *errors.ValidationError
+
+		store := NewStore("11222333000181", "Address", "1310-100")
+		assert.ErrorAs(t, store.Validate(), &verr)
+		assert.Equal(t, []string{"store cep is invalid"}, verr.Messages)
+
+		store = NewStore("11222333000181", "Address", "01310-100")
+		store.SetLocation("", "", "", "XX")
+		assert.ErrorAs(t, store.Validate(), &verr)
+		assert.Equal(t, []string{"store state is invalid"}, verr.Messages)
+	})
+}
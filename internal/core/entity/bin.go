@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+type FundingType string
+
+const (
+	FundingTypeCredit  FundingType = "credit"
+	FundingTypeDebit   FundingType = "debit"
+	FundingTypePrepaid FundingType = "prepaid"
+)
+
+// BinRange has an empty funding type and country when it covers several issuers.
+type BinRange struct {
+	Start       string      `json:"start"`
+	End         string      `json:"end"`
+	Brand       string      `json:"brand"`
+	FundingType FundingType `json:"funding_type"`
+	Country     string      `json:"country"`
+}
+
+func (b *BinRange) Match(number string) bool {
+	if len(number) < len(b.Start) {
+		return false
+	}
+
+	prefix := number[:len(b.Start)]
+	return prefix >= b.Start && prefix <= b.End
+}
+
+func (b *BinRange) Validate() error {
+	msgs := make([]string, 0)
+
+	if !isDigits(b.Start, 1, 8) || !isDigits(b.End, 1, 8) || len(b.Start) != len(b.End) || b.Start > b.End {
+		msgs = append(msgs, "bin range is invalid")
+	}
+
+	if b.Brand == "" {
+		msgs = append(msgs, "bin brand is required")
+	}
+
+	switch b.FundingType {
+	case "", FundingTypeCredit, FundingTypeDebit, FundingTypePrepaid:
+	default:
+		msgs = append(msgs, "bin funding type is invalid")
+	}
+
+	if b.Country != "" && len(b.Country) != 2 {
+		msgs = append(msgs, "bin country is invalid")
+	}
+
+	if len(msgs) > 0 {
+		return errors.NewValidationError(msgs...)
+	}
+
+	return nil
+}
+
+// FindBinRange returns the most specific range of the card number.
+func FindBinRange(ranges []*BinRange, number string) *BinRange {
+	var found *BinRange
+
+	for _, r := range ranges {
+		if r.Match(number) && (found == nil || len(r.Start) > len(found.Start)) {
+			found = r
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinRangeMatch(t *testing.T) {
+	binRange := &BinRange{Start: "2221", End: "2720", Brand: "MASTERCARD"}
+
+	assert.True(t, binRange.Match("2221000000000009"))
+	assert.True(t, binRange.Match("2720990000000007"))
+	assert.False(t, binRange.Match("2220990000000000"))
+	assert.False(t, binRange.Match("2721000000000000"))
+	assert.False(t, binRange.Match("222"))
+}
+
+func TestFindBinRange(t *testing.T) {
+	visa := &BinRange{Start: "4", End: "4", Brand: "VISA"}
+	elo := &BinRange{Start: "438935", End: "438935", Brand: "ELO", Country: "BR"}
+	ranges := []*BinRange{elo, visa}
+
+	assert.Equal(t, visa, FindBinRange(ranges, "4111111111111111"))
+	assert.Equal(t, elo, FindBinRange(ranges, "4389351111111111"))
+	assert.Nil(t, FindBinRange(ranges, "5555555555554444"))
+}
+
+func TestBinRangeValidator(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		BinRange *BinRange
+		Err      *errors.ValidationError
+	}{
+		{"valid range", &BinRange{Start: "51", End: "55", Brand: "MASTERCARD", FundingType: FundingTypeDebit, Country: "BR"}, nil},
+		{"start after end", &BinRange{Start: "55", End: "51", Brand: "MASTERCARD"}, errors.NewValidationError("bin range is invalid")},
+		{"different lengths", &BinRange{Start: "4", End: "49", Brand: "VISA"}, errors.NewValidationError("bin range is invalid")},
+		{
+			"all fields are invalid",
+			&BinRange{Start: "A", End: "B", FundingType: "charge", Country: "BRA"},
+			errors.NewValidationError("bin range is invalid", "bin brand is required", "bin funding type is invalid", "bin country is invalid"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := tc.BinRange.Validate()
+			if tc.Err == nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			var verr *errors.ValidationError
+			assert.ErrorAs(t, err, &verr)
+			assert.Equal(t, tc.Err.Messages, verr.Messages)
+		})
+	}
+}
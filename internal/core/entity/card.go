@@ -1,6 +1,12 @@
 package entity
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
 )
 
@@ -9,6 +15,14 @@ type Card struct {
 	Holder     string
 	Expiration string
 	Brand      string
+	Bin        string
+	Last4      string
+
+	FundingType FundingType
+	Country     string
+
+	// Number is only held in memory while the card is tokenized or sent to an acquirer.
+	Number string
 }
 
 func NewCard(token string, holder string, expiration string, brand string) *Card {
@@ -20,6 +34,65 @@ func NewCard(token string, holder string, expiration string, brand string) *Card
 	}
 }
 
+func NewVaultCard(number string, holder string, expiration string, brand string) *Card {
+	card := NewCard(newCardToken(), holder, expiration, brand)
+	card.Number = number
+
+	if len(number) >= 6 {
+		card.Bin = number[:6]
+	}
+
+	if len(number) >= 4 {
+		card.Last4 = number[len(number)-4:]
+	}
+
+	return card
+}
+
+// ParseExpiration returns the start of the month after the expiration month, in UTC.
+func ParseExpiration(expiration string) (time.Time, error) {
+	month, year, ok := strings.Cut(expiration, "/")
+	if !ok || len(month) != 2 || !isDigits(month, 2, 2) || (len(year) != 2 && len(year) != 4) || !isDigits(year, 2, 4) {
+		return time.Time{}, errors.NewValidationError("card expiration is invalid")
+	}
+
+	m, _ := strconv.Atoi(month)
+	y, _ := strconv.Atoi(year)
+	if m < 1 || m > 12 {
+		return time.Time{}, errors.NewValidationError("card expiration is invalid")
+	}
+
+	if len(year) == 2 {
+		y += 2000
+	}
+
+	return time.Date(y, time.Month(m)+1, 1, 0, 0, 0, 0, time.UTC), nil
+}
+
+func (c *Card) ExpiresAt() time.Time {
+	expiresAt, err := ParseExpiration(c.Expiration)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return expiresAt
+}
+
+// Expired is false for an invalid expiration, since Validate rejects it.
+func (c *Card) Expired(now time.Time) bool {
+	expiresAt := c.ExpiresAt()
+	return !expiresAt.IsZero() && !now.Before(expiresAt)
+}
+
+func newCardToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(b)
+}
+
 func (c *Card) Validate() error {
 	msgs := make([]string, 0)
 
@@ -33,15 +106,116 @@ func (c *Card) Validate() error {
 
 	if c.Expiration == "" {
 		msgs = append(msgs, "card expiration is required")
+	} else if _, err := ParseExpiration(c.Expiration); err != nil {
+		msgs = append(msgs, "card expiration is invalid")
 	}
 
 	if c.Brand == "" {
 		msgs = append(msgs, "card brand is required")
 	}
 
+	if c.Number != "" && !ValidLuhn(c.Number) {
+		msgs = append(msgs, "card number is invalid")
+	}
+
 	if len(msgs) > 0 {
 		return errors.NewValidationError(msgs...)
 	}
 
 	return nil
 }
+
+// ValidateData only validates the security code, since it must never be stored.
+func (c *Card) ValidateData(securityCode string) error {
+	msgs := make([]string, 0)
+
+	if !isDigits(c.Number, 12, 19) || !ValidLuhn(c.Number) {
+		msgs = append(msgs, "card number is invalid")
+	}
+
+	if !isDigits(securityCode, 3, 4) {
+		msgs = append(msgs, "card security code is invalid")
+	}
+
+	if c.Holder == "" {
+		msgs = append(msgs, "card holder is required")
+	}
+
+	if c.Expiration == "" {
+		msgs = append(msgs, "card expiration is required")
+	} else if _, err := ParseExpiration(c.Expiration); err != nil {
+		msgs = append(msgs, "card expiration is invalid")
+	} else if c.Expired(time.Now().UTC()) {
+		msgs = append(msgs, "card is expired")
+	}
+
+	if len(msgs) > 0 {
+		return errors.NewValidationError(msgs...)
+	}
+
+	return nil
+}
+
+// ApplyBin keeps the declared brand when the bin is unknown, but it must match the brand of a
+// known bin.
+func (c *Card) ApplyBin(binRange *BinRange) error {
+	if binRange == nil {
+		if c.Brand == "" {
+			return errors.NewValidationError("card brand is required")
+		}
+
+		return nil
+	}
+
+	if c.Brand != "" && !strings.EqualFold(strings.TrimSpace(c.Brand), binRange.Brand) {
+		return errors.NewValidationError("card brand does not match the card number")
+	}
+
+	c.Brand = binRange.Brand
+	c.FundingType = binRange.FundingType
+	c.Country = binRange.Country
+
+	return nil
+}
+
+func ValidLuhn(number string) bool {
+	if number == "" {
+		return false
+	}
+
+	sum := 0
+	double := false
+
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+func isDigits(s string, min int, max int) bool {
+	if len(s) < min || len(s) > max {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"testing"
+	"time"
 
 	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
 	"github.com/stretchr/testify/assert"
@@ -29,7 +30,7 @@ func TestCardValidator(t *testing.T) {
 			"token is empty",
 			"",
 			"Holder",
-			"Expiration",
+			"12/2099",
 			"Brand",
 			errors.NewValidationError("card token is required"),
 		},
@@ -37,7 +38,7 @@ func TestCardValidator(t *testing.T) {
 			"holder is empty",
 			"Token",
 			"",
-			"Expiration",
+			"12/2099",
 			"Brand",
 			errors.NewValidationError("card holder is required"),
 		},
@@ -49,11 +50,19 @@ func TestCardValidator(t *testing.T) {
 			"Brand",
 			errors.NewValidationError("card expiration is required"),
 		},
+		{
+			"expiration is malformed",
+			"Token",
+			"Holder",
+			"13/2030",
+			"Brand",
+			errors.NewValidationError("card expiration is invalid"),
+		},
 		{
 			"brand is empty",
 			"Token",
 			"Holder",
-			"Expiration",
+			"12/2099",
 			"",
 			errors.NewValidationError("card brand is required"),
 		},
@@ -74,7 +83,7 @@ func TestCardValidator(t *testing.T) {
 			"all fields are valid",
 			"Token",
 			"Holder",
-			"Expiration",
+			"12/2099",
 			"Brand",
 			nil,
 		},
@@ -97,3 +106,154 @@ func TestCardValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestVaultCardFactory(t *testing.T) {
+	card := NewVaultCard("4111111111111111", "Holder", "01/2030", "VISA")
+	assert.Len(t, card.Token, 64)
+	assert.Equal(t, "4111111111111111", card.Number)
+	assert.Equal(t, "411111", card.Bin)
+	assert.Equal(t, "1111", card.Last4)
+	assert.Nil(t, card.Validate())
+
+	other := NewVaultCard("4111111111111111", "Holder", "01/2030", "VISA")
+	assert.NotEqual(t, card.Token, other.Token)
+}
+
+func TestCardDataValidator(t *testing.T) {
+	testCases := []struct {
+		TestName     string
+		Number       string
+		SecurityCode string
+		Holder       string
+		Err          *errors.ValidationError
+	}{
+		{"number is too short", "41111111111", "123", "Holder", errors.NewValidationError("card number is invalid")},
+		{"number has letters", "411111111111111A", "123", "Holder", errors.NewValidationError("card number is invalid")},
+		{"security code is too long", "4111111111111111", "12345", "Holder", errors.NewValidationError("card security code is invalid")},
+		{
+			"all fields are invalid",
+			"",
+			"",
+			"",
+			errors.NewValidationError("card number is invalid", "card security code is invalid", "card holder is required"),
+		},
+		{"all fields are valid", "4111111111111111", "1234", "Holder", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			card := NewVaultCard(tc.Number, tc.Holder, "01/2030", "VISA")
+
+			err := card.ValidateData(tc.SecurityCode)
+			if tc.Err == nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			var verr *errors.ValidationError
+			assert.ErrorAs(t, err, &verr)
+			assert.Equal(t, tc.Err.Messages, verr.Messages)
+		})
+	}
+}
+
+func TestLuhn(t *testing.T) {
+	assert.True(t, ValidLuhn("4111111111111111"))
+	assert.True(t, ValidLuhn("5555555555554444"))
+	assert.True(t, ValidLuhn("378282246310005"))
+	assert.False(t, ValidLuhn("4111111111111112"))
+	assert.False(t, ValidLuhn("411111111111111A"))
+	assert.False(t, ValidLuhn(""))
+
+	card := NewVaultCard("4111111111111112", "Holder", "01/2030", "VISA")
+	var verr *errors.ValidationError
+	assert.ErrorAs(t, card.Validate(), &verr)
+	assert.Equal(t, []string{"card number is invalid"}, verr.Messages)
+	assert.ErrorAs(t, card.ValidateData("123"), &verr)
+	assert.Equal(t, []string{"card number is invalid"}, verr.Messages)
+}
+
+func TestCardApplyBin(t *testing.T) {
+	elo := &BinRange{Start: "506699", End: "506778", Brand: "ELO", FundingType: FundingTypeDebit, Country: "BR"}
+
+	t.Run("infer the brand", func(t *testing.T) {
+		card := NewVaultCard("5066991111111118", "Holder", "01/2030", "")
+		assert.Nil(t, card.ApplyBin(elo))
+		assert.Equal(t, "ELO", card.Brand)
+		assert.Equal(t, FundingTypeDebit, card.FundingType)
+		assert.Equal(t, "BR", card.Country)
+	})
+
+	t.Run("declared brand matches", func(t *testing.T) {
+		card := NewVaultCard("5066991111111118", "Holder", "01/2030", "elo")
+		assert.Nil(t, card.ApplyBin(elo))
+		assert.Equal(t, "ELO", card.Brand)
+	})
+
+	t.Run("declared brand contradicts the bin", func(t *testing.T) {
+		card := NewVaultCard("5066991111111118", "Holder", "01/2030", "MASTERCARD")
+
+		var verr *errors.ValidationError
+		assert.ErrorAs(t, card.ApplyBin(elo), &verr)
+		assert.Equal(t, []string{"card brand does not match the card number"}, verr.Messages)
+	})
+
+	t.Run("unknown bin keeps the declared brand", func(t *testing.T) {
+		card := NewVaultCard("9111111111111119", "Holder", "01/2030", "PRIVATE LABEL")
+		assert.Nil(t, card.ApplyBin(nil))
+		assert.Equal(t, "PRIVATE LABEL", card.Brand)
+
+		card = NewVaultCard("9111111111111119", "Holder", "01/2030", "")
+		var verr *errors.ValidationError
+		assert.ErrorAs(t, card.ApplyBin(nil), &verr)
+		assert.Equal(t, []string{"card brand is required"}, verr.Messages)
+	})
+}
+
+func TestParseExpiration(t *testing.T) {
+	testCases := []struct {
+		Expiration string
+		ExpiresAt  time.Time
+		Valid      bool
+	}{
+		{"01/2030", time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC), true},
+		{"12/30", time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{"06/25", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), true},
+		{"00/2030", time.Time{}, false},
+		{"13/30", time.Time{}, false},
+		{"1/2030", time.Time{}, false},
+		{"01/030", time.Time{}, false},
+		{"01-2030", time.Time{}, false},
+		{"AB/CD", time.Time{}, false},
+		{"", time.Time{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Expiration, func(t *testing.T) {
+			expiresAt, err := ParseExpiration(tc.Expiration)
+			if !tc.Valid {
+				var verr *errors.ValidationError
+				assert.ErrorAs(t, err, &verr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.ExpiresAt, expiresAt)
+		})
+	}
+}
+
+func TestCardExpired(t *testing.T) {
+	card := NewCard("Token", "Holder", "06/2030", "VISA")
+	assert.False(t, card.Expired(time.Date(2030, 6, 30, 23, 59, 59, 0, time.UTC)))
+	assert.True(t, card.Expired(time.Date(2030, 7, 1, 0, 0, 0, 0, time.UTC)))
+
+	card = NewCard("Token", "Holder", "Expiration", "VISA")
+	assert.True(t, card.ExpiresAt().IsZero())
+	assert.False(t, card.Expired(time.Now()))
+
+	card = NewVaultCard("4111111111111111", "Holder", "01/20", "VISA")
+	var verr *errors.ValidationError
+	assert.ErrorAs(t, card.ValidateData("123"), &verr)
+	assert.Equal(t, []string{"card is expired"}, verr.Messages)
+}
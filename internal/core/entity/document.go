@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"strings"
+)
+
+type DocumentType string
+
+const (
+	DocumentTypeCpf  DocumentType = "cpf"
+	DocumentTypeCnpj DocumentType = "cnpj"
+)
+
+// NormalizeDocument keeps the characters other than punctuation, so an invalid document stays
+// invalid.
+func NormalizeDocument(document string) string {
+	document = strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '-', '/', ' ':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(document))
+
+	if upper := strings.ToUpper(document); ValidCnpj(upper) {
+		return upper
+	}
+
+	return document
+}
+
+func DetectDocumentType(document string) DocumentType {
+	if ValidCpf(document) {
+		return DocumentTypeCpf
+	}
+
+	if ValidCnpj(document) {
+		return DocumentTypeCnpj
+	}
+
+	return ""
+}
+
+func FormatDocument(document string) string {
+	switch DetectDocumentType(document) {
+	case DocumentTypeCpf:
+		return document[:3] + "." + document[3:6] + "." + document[6:9] + "-" + document[9:]
+	case DocumentTypeCnpj:
+		return document[:2] + "." + document[2:5] + "." + document[5:8] + "/" + document[8:12] + "-" + document[12:]
+	default:
+		return document
+	}
+}
+
+func ValidCpf(cpf string) bool {
+	if !isDigits(cpf, 11, 11) || repeatedDigits(cpf) {
+		return false
+	}
+
+	return checkDigit(cpf[:9], []int{10, 9, 8, 7, 6, 5, 4, 3, 2}) == cpf[9] &&
+		checkDigit(cpf[:10], []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}) == cpf[10]
+}
+
+// ValidCnpj accepts the alphanumeric CNPJ, whose letters count as their ASCII code minus 48 in
+// the check digits.
+func ValidCnpj(cnpj string) bool {
+	if len(cnpj) != 14 || !isDigits(cnpj[12:], 2, 2) || repeatedDigits(cnpj) {
+		return false
+	}
+
+	for _, r := range cnpj[:12] {
+		if (r < '0' || r > '9') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+
+	return checkDigit(cnpj[:12], []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == cnpj[12] &&
+		checkDigit(cnpj[:13], []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == cnpj[13]
+}
+
+func checkDigit(digits string, weights []int) byte {
+	sum := 0
+	for i, weight := range weights {
+		sum += int(digits[i]-'0') * weight
+	}
+
+	rest := sum % 11
+	if rest < 2 {
+		return '0'
+	}
+
+	return byte('0' + 11 - rest)
+}
+
+// repeatedDigits documents pass the check digit calculation but are not valid.
+func repeatedDigits(document string) bool {
+	return strings.Count(document, document[:1]) == len(document)
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeDocument(t *testing.T) {
+	assert.Equal(t, "11222333000181", NormalizeDocument(" 11.222.333/0001-81 "))
+	assert.Equal(t, "52998224725", NormalizeDocument("529.982.247-25"))
+	assert.Equal(t, "5299822472A", NormalizeDocument("529.982.247-2A"))
+	assert.Equal(t, "12ABC34501DE35", NormalizeDocument("12.abc.345/01de-35"))
+}
+
+func TestValidCpf(t *testing.T) {
+	assert.True(t, ValidCpf("52998224725"))
+	assert.True(t, ValidCpf("11144477735"))
+	assert.False(t, ValidCpf("52998224726"))
+	assert.False(t, ValidCpf("11111111111"))
+	assert.False(t, ValidCpf("5299822472"))
+	assert.False(t, ValidCpf("529.982.247-25"))
+}
+
+func TestValidCnpj(t *testing.T) {
+	assert.True(t, ValidCnpj("11222333000181"))
+	assert.True(t, ValidCnpj("45997418000153"))
+	assert.False(t, ValidCnpj("11222333000182"))
+	assert.False(t, ValidCnpj("00000000000000"))
+	assert.False(t, ValidCnpj("1122233300018"))
+
+	assert.True(t, ValidCnpj("12ABC34501DE35"))
+	assert.False(t, ValidCnpj("12ABC34501DE36"))
+	assert.False(t, ValidCnpj("12abc34501de35"))
+	assert.False(t, ValidCnpj("12ABC34501DEA5"))
+	assert.False(t, ValidCnpj("12ABC3450#DE35"))
+}
+
+func TestDocumentType(t *testing.T) {
+	assert.Equal(t, DocumentTypeCpf, DetectDocumentType("52998224725"))
+	assert.Equal(t, DocumentTypeCnpj, DetectDocumentType("11222333000181"))
+	assert.Equal(t, DocumentTypeCnpj, DetectDocumentType("12ABC34501DE35"))
+	assert.Empty(t, DetectDocumentType("Identification"))
+
+	assert.Equal(t, "529.982.247-25", FormatDocument("52998224725"))
+	assert.Equal(t, "11.222.333/0001-81", FormatDocument("11222333000181"))
+	assert.Equal(t, "12.ABC.345/01DE-35", FormatDocument("12ABC34501DE35"))
+	assert.Equal(t, "Identification", FormatDocument("Identification"))
+}
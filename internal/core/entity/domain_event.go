@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentCreatedEvent is followed by the events named after the status the payment moved to.
+const PaymentCreatedEvent = "payment.created"
+
+// DomainEvent is published at least once, in the order of its sequence among the events of
+// the same payment.
+type DomainEvent struct {
+	Id          string          `json:"id"`
+	Sequence    int64           `json:"sequence"`
+	Type        string          `json:"type"`
+	PaymentId   string          `json:"payment_id"`
+	Payload     json.RawMessage `json:"data"`
+	CreatedAt   time.Time       `json:"created_at"`
+	Attempts    int             `json:"-"`
+	LastError   string          `json:"-"`
+	PublishedAt time.Time       `json:"-"`
+}
+
+type PaymentSnapshot struct {
+	PaymentId       string    `json:"payment_id"`
+	Status          string    `json:"status"`
+	Amount          int64     `json:"amount"`
+	Currency        string    `json:"currency"`
+	Installments    int       `json:"installments"`
+	CapturedAmount  int64     `json:"captured_amount"`
+	RefundedAmount  int64     `json:"refunded_amount"`
+	StoreId         string    `json:"store_id,omitempty"`
+	AcquirerName    string    `json:"acquirer_name"`
+	AcquirerId      string    `json:"acquirer_id"`
+	AcquirerCode    int       `json:"acquirer_code"`
+	AcquirerMessage string    `json:"acquirer_message"`
+	Caller          string    `json:"caller"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func NewPaymentCreatedEvent(payment *Payment) (*DomainEvent, error) {
+	return newPaymentDomainEvent(PaymentCreatedEvent, payment)
+}
+
+func NewPaymentChangedEvent(payment *Payment) (*DomainEvent, error) {
+	return newPaymentDomainEvent("payment."+string(payment.Status), payment)
+}
+
+func newPaymentDomainEvent(eventType string, payment *Payment) (*DomainEvent, error) {
+	transaction := payment.Transaction
+
+	snapshot := PaymentSnapshot{
+		PaymentId:       payment.Id,
+		Status:          string(payment.Status),
+		Amount:          transaction.Purchase.Value.Amount,
+		Currency:        transaction.Purchase.Value.Currency,
+		Installments:    transaction.Purchase.Installments,
+		CapturedAmount:  payment.CapturedValue.Amount,
+		RefundedAmount:  payment.RefundedValue.Amount,
+		AcquirerId:      payment.AcquirerId,
+		AcquirerCode:    payment.AcquirerCode,
+		AcquirerMessage: payment.AcquirerMessage,
+		Caller:          payment.Caller,
+		CreatedAt:       payment.CreatedAt,
+		UpdatedAt:       payment.UpdatedAt,
+	}
+
+	if transaction.Store != nil {
+		snapshot.StoreId = transaction.Store.Id
+	}
+
+	if transaction.Acquirer != nil {
+		snapshot.AcquirerName = transaction.Acquirer.Name
+	}
+
+	payload, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DomainEvent{
+		Id:        uuid.NewString(),
+		Type:      eventType,
+		PaymentId: payment.Id,
+		Payload:   payload,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+func (e *DomainEvent) Published() bool {
+	return !e.PublishedAt.IsZero()
+}
+
+func (e *DomainEvent) Publish(now time.Time) {
+	e.Attempts++
+	e.LastError = ""
+	e.PublishedAt = now
+}
+
+func (e *DomainEvent) Fail(message string) {
+	e.Attempts++
+	e.LastError = message
+}
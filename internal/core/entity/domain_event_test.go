@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePaymentDomainEvents(t *testing.T) {
+	card := NewCard("Token", "Holder", "12/2099", "Brand")
+	purchase := NewPurchase(NewMoney(1000, "BRL"), []string{"Item"}, 2)
+	store := NewStore("11222333000181", "Address", "01310100")
+	store.Id = "Store Id"
+	payment := NewPayment(NewTransaction(card, purchase, store, NewAcquirer("Acquirer")))
+	payment.Caller = "Caller"
+
+	created, err := NewPaymentCreatedEvent(payment)
+	require.Nil(t, err)
+	assert.NotEmpty(t, created.Id)
+	assert.Equal(t, "payment.created", created.Type)
+	assert.Equal(t, payment.Id, created.PaymentId)
+	assert.False(t, created.Published())
+
+	var snapshot PaymentSnapshot
+	err = json.Unmarshal(created.Payload, &snapshot)
+	require.Nil(t, err)
+	assert.Equal(t, "pending", snapshot.Status)
+	assert.Equal(t, int64(1000), snapshot.Amount)
+	assert.Equal(t, 2, snapshot.Installments)
+	assert.Equal(t, "Store Id", snapshot.StoreId)
+	assert.Equal(t, "Caller", snapshot.Caller)
+
+	payment.Approve(NewAcquirerResponse("Acquirer Id", 200, "Approved"))
+
+	changed, err := NewPaymentChangedEvent(payment)
+	require.Nil(t, err)
+	assert.NotEqual(t, created.Id, changed.Id)
+	assert.Equal(t, "payment.approved", changed.Type)
+
+	err = json.Unmarshal(changed.Payload, &snapshot)
+	require.Nil(t, err)
+	assert.Equal(t, "approved", snapshot.Status)
+	assert.Equal(t, int64(1000), snapshot.CapturedAmount)
+	assert.Equal(t, "Acquirer Id", snapshot.AcquirerId)
+
+	// the published event wraps the snapshot
+	body, err := json.Marshal(changed)
+	require.Nil(t, err)
+	assert.Contains(t, string(body), `"data":{"payment_id":"`+payment.Id+`"`)
+	assert.NotContains(t, string(body), "attempts")
+}
+
+func TestPublishDomainEvent(t *testing.T) {
+	event := &DomainEvent{Id: "Event Id"}
+	now := time.Now().UTC()
+
+	event.Fail("connection refused")
+	assert.Equal(t, 1, event.Attempts)
+	assert.Equal(t, "connection refused", event.LastError)
+	assert.False(t, event.Published())
+
+	event.Publish(now)
+	assert.Equal(t, 2, event.Attempts)
+	assert.Empty(t, event.LastError)
+	assert.Equal(t, now, event.PublishedAt)
+	assert.True(t, event.Published())
+}
@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+const (
+	IdempotencyKeyMaxLength = 255
+
+	// IdempotencyProcessingTimeout is longer than any request is allowed to last.
+	IdempotencyProcessingTimeout = 5 * time.Minute
+)
+
+type IdempotencyStatus string
+
+const (
+	IdempotencyStatusProcessing IdempotencyStatus = "processing"
+	IdempotencyStatusCompleted  IdempotencyStatus = "completed"
+)
+
+// Idempotency keys belong to a caller, so the callers do not share them.
+type Idempotency struct {
+	Caller       string
+	Key          string
+	RequestHash  string
+	Status       IdempotencyStatus
+	ResponseCode int
+	ResponseBody []byte
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func NewIdempotency(caller string, key string, requestHash string) *Idempotency {
+	now := time.Now().UTC()
+
+	return &Idempotency{
+		Caller:      caller,
+		Key:         key,
+		RequestHash: requestHash,
+		Status:      IdempotencyStatusProcessing,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func (i *Idempotency) Complete(responseCode int, responseBody []byte) {
+	i.Status = IdempotencyStatusCompleted
+	i.ResponseCode = responseCode
+	i.ResponseBody = responseBody
+	i.UpdatedAt = time.Now().UTC()
+}
+
+func (i *Idempotency) Abandoned() bool {
+	return i.Status == IdempotencyStatusProcessing && time.Since(i.UpdatedAt) > IdempotencyProcessingTimeout
+}
+
+func (i *Idempotency) Validate() error {
+	msgs := make([]string, 0)
+
+	if i.Key == "" {
+		msgs = append(msgs, "idempotency key is required")
+	} else if len(i.Key) > IdempotencyKeyMaxLength {
+		msgs = append(msgs, "idempotency key is invalid")
+	}
+
+	if i.RequestHash == "" {
+		msgs = append(msgs, "idempotency request hash is required")
+	}
+
+	if len(msgs) > 0 {
+		return errors.NewValidationError(msgs...)
+	}
+
+	return nil
+}
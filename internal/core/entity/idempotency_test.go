@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyFactory(t *testing.T) {
+	idempotency := NewIdempotency("Caller", "Key", "Hash")
+	assert.NotNil(t, idempotency)
+	assert.Equal(t, idempotency.Caller, "Caller")
+	assert.Equal(t, idempotency.Key, "Key")
+	assert.Equal(t, idempotency.RequestHash, "Hash")
+	assert.Equal(t, idempotency.Status, IdempotencyStatusProcessing)
+	assert.Zero(t, idempotency.ResponseCode)
+	assert.Nil(t, idempotency.ResponseBody)
+}
+
+func TestIdempotencyComplete(t *testing.T) {
+	idempotency := NewIdempotency("Caller", "Key", "Hash")
+	idempotency.Complete(200, []byte("Body"))
+	assert.Equal(t, idempotency.Status, IdempotencyStatusCompleted)
+	assert.Equal(t, idempotency.ResponseCode, 200)
+	assert.Equal(t, idempotency.ResponseBody, []byte("Body"))
+}
+
+func TestIdempotencyAbandoned(t *testing.T) {
+	idempotency := NewIdempotency("Caller", "Key", "Hash")
+	assert.False(t, idempotency.Abandoned())
+
+	idempotency.UpdatedAt = idempotency.UpdatedAt.Add(-IdempotencyProcessingTimeout - time.Minute)
+	assert.True(t, idempotency.Abandoned())
+
+	idempotency.Complete(200, []byte("Body"))
+	idempotency.UpdatedAt = idempotency.UpdatedAt.Add(-IdempotencyProcessingTimeout - time.Minute)
+	assert.False(t, idempotency.Abandoned())
+}
+
+func TestIdempotencyValidator(t *testing.T) {
+	testCases := []struct {
+		TestName    string
+		Key         string
+		RequestHash string
+		Err         *errors.ValidationError
+	}{
+		{
+			"key is empty",
+			"",
+			"Hash",
+			errors.NewValidationError("idempotency key is required"),
+		},
+		{
+			"key is too long",
+			strings.Repeat("k", IdempotencyKeyMaxLength+1),
+			"Hash",
+			errors.NewValidationError("idempotency key is invalid"),
+		},
+		{
+			"request hash is empty",
+			"Key",
+			"",
+			errors.NewValidationError("idempotency request hash is required"),
+		},
+		{
+			"all fields are invalid",
+			"",
+			"",
+			errors.NewValidationError(
+				"idempotency key is required",
+				"idempotency request hash is required",
+			),
+		},
+		{
+			"all fields are valid",
+			"Key",
+			"Hash",
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := NewIdempotency("Caller", tc.Key, tc.RequestHash).Validate()
+			if tc.Err == nil && err == nil {
+				return
+			}
+
+			var verr *errors.ValidationError
+			assert.ErrorAs(t, err, &verr)
+			assert.Equal(t, len(tc.Err.Messages), len(verr.Messages))
+
+			for i, msg := range tc.Err.Messages {
+				assert.Equal(t, msg, verr.Messages[i])
+			}
+		})
+	}
+}
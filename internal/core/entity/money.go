@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"math"
+)
+
+var currencies = map[string]int{
+	"ARS": 2,
+	"BRL": 2,
+	"CAD": 2,
+	"CHF": 2,
+	"CLP": 0,
+	"CNY": 2,
+	"COP": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"JPY": 0,
+	"KRW": 0,
+	"MXN": 2,
+	"PEN": 2,
+	"PYG": 0,
+	"USD": 2,
+	"UYU": 2,
+}
+
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func NewMoney(amount int64, currency string) Money {
+	return Money{
+		Amount:   amount,
+		Currency: currency,
+	}
+}
+
+func NewMoneyFromDecimal(value float64, currency string) Money {
+	factor := math.Pow10(currencies[currency])
+	return NewMoney(int64(math.Round(value*factor)), currency)
+}
+
+func (m Money) Decimal() float64 {
+	return float64(m.Amount) / math.Pow10(currencies[m.Currency])
+}
+
+func (m Money) Add(other Money) Money {
+	return NewMoney(m.Amount+other.Amount, m.Currency)
+}
+
+func (m Money) Sub(other Money) Money {
+	return NewMoney(m.Amount-other.Amount, m.Currency)
+}
+
+func (m Money) IsCurrencyValid() bool {
+	_, ok := currencies[m.Currency]
+	return ok
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyFactory(t *testing.T) {
+	money := NewMoney(999, "BRL")
+	assert.Equal(t, int64(999), money.Amount)
+	assert.Equal(t, "BRL", money.Currency)
+}
+
+func TestMoneyDecimalConversion(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Value    float64
+		Currency string
+		Amount   int64
+	}{
+		{"two minor units", 9.99, "BRL", 999},
+		{"rounding error", 0.1 + 0.2, "USD", 30},
+		{"zero minor units", 1500, "JPY", 1500},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			money := NewMoneyFromDecimal(tc.Value, tc.Currency)
+			assert.Equal(t, tc.Amount, money.Amount)
+			assert.Equal(t, tc.Currency, money.Currency)
+			assert.InDelta(t, tc.Value, money.Decimal(), 0.001)
+		})
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	a := NewMoney(10, "BRL")
+	b := NewMoney(20, "BRL")
+
+	assert.Equal(t, NewMoney(30, "BRL"), a.Add(b))
+	assert.Equal(t, NewMoney(10, "BRL"), b.Sub(a))
+}
+
+func TestMoneyCurrency(t *testing.T) {
+	assert.True(t, NewMoney(1, "BRL").IsCurrencyValid())
+	assert.False(t, NewMoney(1, "brl").IsCurrencyValid())
+	assert.False(t, NewMoney(1, "").IsCurrencyValid())
+}
@@ -1,17 +1,247 @@
 package entity
 
 import (
+	"time"
+
 	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/google/uuid"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending    PaymentStatus = "pending"
+	PaymentStatusAuthorized PaymentStatus = "authorized"
+	PaymentStatusApproved   PaymentStatus = "approved"
+	PaymentStatusDeclined   PaymentStatus = "declined"
+	PaymentStatusFailed     PaymentStatus = "failed"
+
+	// PaymentStatusCapturing keeps another capture or void from being sent meanwhile.
+	PaymentStatusCapturing PaymentStatus = "capturing"
+
+	// PaymentStatusUnknown lasts until the reversals of its attempts tell whether the card was charged.
+	PaymentStatusUnknown  PaymentStatus = "unknown"
+	PaymentStatusReversed PaymentStatus = "reversed"
+
+	PaymentStatusPartiallyRefunded PaymentStatus = "partially_refunded"
+	PaymentStatusRefunded          PaymentStatus = "refunded"
+	PaymentStatusVoided            PaymentStatus = "voided"
 )
 
 type Payment struct {
-	Id string
+	Id              string
+	Transaction     *Transaction
+	Status          PaymentStatus
+	AcquirerId      string
+	AcquirerCode    int
+	AcquirerMessage string
+	CapturedValue   Money
+	RefundedValue   Money
+	Attempts        []*PaymentAttempt
+	Caller          string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
-func NewPayment(id string) *Payment {
+func NewPayment(transaction *Transaction) *Payment {
+	now := time.Now().UTC()
+
+	var currency string
+	if transaction != nil && transaction.Purchase != nil {
+		currency = transaction.Purchase.Value.Currency
+	}
+
 	return &Payment{
-		Id: id,
+		Id:            uuid.NewString(),
+		Transaction:   transaction,
+		Status:        PaymentStatusPending,
+		CapturedValue: NewMoney(0, currency),
+		RefundedValue: NewMoney(0, currency),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+func (p *Payment) Approve(response *AcquirerResponse) {
+	p.Status = PaymentStatusApproved
+	p.AcquirerId = response.Id
+	p.AcquirerCode = response.Code
+	p.AcquirerMessage = response.Message
+	p.CapturedValue = p.Transaction.Purchase.Value
+	p.UpdatedAt = time.Now().UTC()
+}
+
+func (p *Payment) Authorize(response *AcquirerResponse) {
+	p.Status = PaymentStatusAuthorized
+	p.AcquirerId = response.Id
+	p.AcquirerCode = response.Code
+	p.AcquirerMessage = response.Message
+	p.UpdatedAt = time.Now().UTC()
+}
+
+func (p *Payment) CanCapture(value Money) error {
+	if p.Status != PaymentStatusAuthorized {
+		return errors.NewValidationError("payment cannot be captured")
+	}
+
+	if value.Amount <= 0 {
+		return errors.NewValidationError("capture value is invalid")
+	}
+
+	if value.Amount > p.Transaction.Purchase.Value.Amount {
+		return errors.NewValidationError("capture value exceeds the authorized value")
+	}
+
+	return nil
+}
+
+func (p *Payment) Capture(value Money) {
+	p.Status = PaymentStatusApproved
+	p.CapturedValue = value
+	p.UpdatedAt = time.Now().UTC()
+}
+
+// IsSettleable is true once the value was captured, even when it was refunded later.
+func (p *Payment) IsSettleable() bool {
+	switch p.Status {
+	case PaymentStatusApproved, PaymentStatusPartiallyRefunded, PaymentStatusRefunded:
+		return true
+	}
+
+	return false
+}
+
+func (p *Payment) AddAttempt(acquirer string) *PaymentAttempt {
+	attempt := NewPaymentAttempt(p.Id, acquirer)
+	p.Attempts = append(p.Attempts, attempt)
+	return attempt
+}
+
+func (p *Payment) Decline(code int, message string) {
+	p.Status = PaymentStatusDeclined
+	p.AcquirerCode = code
+	p.AcquirerMessage = message
+	p.UpdatedAt = time.Now().UTC()
+}
+
+func (p *Payment) Fail(message string) {
+	p.Status = PaymentStatusFailed
+	p.AcquirerMessage = message
+	p.UpdatedAt = time.Now().UTC()
+}
+
+func (p *Payment) Unknown(message string) {
+	p.Status = PaymentStatusUnknown
+	p.AcquirerMessage = message
+	p.UpdatedAt = time.Now().UTC()
+}
+
+// ApplyReversals resolves a payment with an unknown outcome once all of its reversals are
+// resolved. It is reversed when an acquirer had charged the card and failed otherwise.
+func (p *Payment) ApplyReversals(reversals []*Reversal) {
+	if p.Status != PaymentStatusUnknown || len(reversals) == 0 {
+		return
+	}
+
+	reversed := false
+	for _, reversal := range reversals {
+		if !reversal.Resolved() {
+			return
+		}
+
+		if reversal.Status == ReversalStatusReversed {
+			reversed = true
+		}
+	}
+
+	if reversed {
+		p.Status = PaymentStatusReversed
+		p.AcquirerMessage = "transaction reversed after timeout"
+	} else {
+		p.Status = PaymentStatusFailed
+		p.AcquirerMessage = "transaction not processed by the acquirer"
+	}
+
+	p.UpdatedAt = time.Now().UTC()
+}
+
+func (p *Payment) RefundableValue() Money {
+	return p.CapturedValue.Sub(p.RefundedValue)
+}
+
+func (p *Payment) CanRefund(value Money) error {
+	if p.Status != PaymentStatusApproved && p.Status != PaymentStatusPartiallyRefunded {
+		return errors.NewValidationError("payment cannot be refunded")
+	}
+
+	if value.Amount > p.RefundableValue().Amount {
+		return errors.NewValidationError("refund value exceeds the refundable value")
+	}
+
+	return nil
+}
+
+// CanVoid checks that the payment is an uncaptured authorization, or that it was approved
+// on the same day and has no refunds, which is when acquirers still accept a cancellation
+// instead of a refund.
+func (p *Payment) CanVoid(now time.Time) error {
+	if p.Status == PaymentStatusAuthorized {
+		return nil
+	}
+
+	if p.Status != PaymentStatusApproved || p.RefundedValue.Amount > 0 {
+		return errors.NewValidationError("payment cannot be voided")
+	}
+
+	y1, m1, d1 := p.CreatedAt.UTC().Date()
+	y2, m2, d2 := now.UTC().Date()
+	if y1 != y2 || m1 != m2 || d1 != d2 {
+		return errors.NewValidationError("payment can only be voided on the day it was processed")
 	}
+
+	return nil
+}
+
+func (p *Payment) VoidValue() Money {
+	if p.Status == PaymentStatusAuthorized {
+		return p.Transaction.Purchase.Value
+	}
+
+	return p.CapturedValue
+}
+
+func (p *Payment) ApplyRefunds(refunds []*Refund) {
+	refunded := NewMoney(0, p.Transaction.Purchase.Value.Currency)
+	voided := false
+
+	for _, refund := range refunds {
+		if refund.Status != RefundStatusApproved {
+			continue
+		}
+
+		refunded = refunded.Add(refund.Value)
+		if refund.Type == RefundTypeVoid {
+			voided = true
+		}
+	}
+
+	if refunded.Amount == 0 {
+		return
+	}
+
+	p.RefundedValue = refunded
+
+	switch {
+	case voided:
+		p.Status = PaymentStatusVoided
+	case refunded.Amount >= p.CapturedValue.Amount:
+		p.Status = PaymentStatusRefunded
+	default:
+		p.Status = PaymentStatusPartiallyRefunded
+	}
+
+	p.UpdatedAt = time.Now().UTC()
 }
 
 func (p *Payment) Validate() error {
@@ -21,6 +251,17 @@ func (p *Payment) Validate() error {
 		msgs = append(msgs, "payment id is required")
 	}
 
+	if p.Transaction == nil {
+		msgs = append(msgs, "payment transaction is required")
+	}
+
+	switch p.Status {
+	case PaymentStatusPending, PaymentStatusAuthorized, PaymentStatusApproved, PaymentStatusDeclined, PaymentStatusFailed,
+		PaymentStatusCapturing, PaymentStatusUnknown, PaymentStatusReversed, PaymentStatusPartiallyRefunded, PaymentStatusRefunded, PaymentStatusVoided:
+	default:
+		msgs = append(msgs, "payment status is invalid")
+	}
+
 	if len(msgs) > 0 {
 		return errors.NewValidationError(msgs...)
 	}
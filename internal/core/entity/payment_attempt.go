@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentAttemptStatus string
+
+const (
+	PaymentAttemptStatusSent      PaymentAttemptStatus = "sent"
+	PaymentAttemptStatusSucceeded PaymentAttemptStatus = "succeeded"
+	PaymentAttemptStatusDeclined  PaymentAttemptStatus = "declined"
+	PaymentAttemptStatusFailed    PaymentAttemptStatus = "failed"
+	PaymentAttemptStatusTimedOut  PaymentAttemptStatus = "timed_out"
+	PaymentAttemptStatusUnknown   PaymentAttemptStatus = "unknown"
+)
+
+// PaymentAttempt is recorded as sent before the acquirer is called, so one left sent tells that
+// the acquirer may have processed a payment whose processing was interrupted.
+type PaymentAttempt struct {
+	Id              string
+	PaymentId       string
+	Acquirer        string
+	Status          PaymentAttemptStatus
+	AcquirerId      string
+	AcquirerCode    int
+	AcquirerMessage string
+	CreatedAt       time.Time
+}
+
+func NewPaymentAttempt(paymentId string, acquirer string) *PaymentAttempt {
+	return &PaymentAttempt{
+		Id:        uuid.NewString(),
+		PaymentId: paymentId,
+		Acquirer:  acquirer,
+		Status:    PaymentAttemptStatusSent,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+func (a *PaymentAttempt) Succeed(response *AcquirerResponse) {
+	a.Status = PaymentAttemptStatusSucceeded
+	a.AcquirerId = response.Id
+	a.AcquirerCode = response.Code
+	a.AcquirerMessage = response.Message
+}
+
+func (a *PaymentAttempt) Decline(code int, message string) {
+	a.Status = PaymentAttemptStatusDeclined
+	a.AcquirerCode = code
+	a.AcquirerMessage = message
+}
+
+func (a *PaymentAttempt) Fail(message string) {
+	a.Status = PaymentAttemptStatusFailed
+	a.AcquirerMessage = message
+}
+
+func (a *PaymentAttempt) TimeOut(message string) {
+	a.Status = PaymentAttemptStatusTimedOut
+	a.AcquirerMessage = message
+}
+
+func (a *PaymentAttempt) Unknown(message string) {
+	a.Status = PaymentAttemptStatusUnknown
+	a.AcquirerMessage = message
+}
+
+func (a *PaymentAttempt) Unresolved() bool {
+	return a.Status == PaymentAttemptStatusTimedOut || a.Status == PaymentAttemptStatusUnknown
+}
+
+func (a *PaymentAttempt) MayHaveCharged() bool {
+	return a.Status == PaymentAttemptStatusSent || a.Status == PaymentAttemptStatusSucceeded || a.Unresolved()
+}
@@ -0,0 +1,161 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentBatchStatus string
+
+const (
+	PaymentBatchStatusQueued     PaymentBatchStatus = "queued"
+	PaymentBatchStatusProcessing PaymentBatchStatus = "processing"
+	PaymentBatchStatusDone       PaymentBatchStatus = "done"
+)
+
+type PaymentBatchItemStatus string
+
+const (
+	PaymentBatchItemStatusPending    PaymentBatchItemStatus = "pending"
+	PaymentBatchItemStatusProcessing PaymentBatchItemStatus = "processing"
+	PaymentBatchItemStatusSucceeded  PaymentBatchItemStatus = "succeeded"
+	PaymentBatchItemStatusFailed     PaymentBatchItemStatus = "failed"
+)
+
+type PaymentBatchErrorType string
+
+const (
+	PaymentBatchErrorValidation PaymentBatchErrorType = "validation"
+	PaymentBatchErrorNotFound   PaymentBatchErrorType = "not_found"
+	PaymentBatchErrorForbidden  PaymentBatchErrorType = "forbidden"
+	PaymentBatchErrorAcquirer   PaymentBatchErrorType = "acquirer"
+	PaymentBatchErrorTimeout    PaymentBatchErrorType = "timeout"
+	PaymentBatchErrorInternal   PaymentBatchErrorType = "internal"
+)
+
+// PaymentBatchLease is renewed while the batch is processed, so a batch is only claimed again
+// when its worker stopped.
+const PaymentBatchLease = 5 * time.Minute
+
+type PaymentBatchTransaction struct {
+	CardToken            string   `json:"card_token"`
+	PurchaseAmount       int64    `json:"purchase_amount"`
+	PurchaseCurrency     string   `json:"purchase_currency"`
+	PurchaseItems        []string `json:"purchase_items"`
+	PurchaseInstallments int      `json:"purchase_installments"`
+	StoreId              string   `json:"store_id"`
+	AcquirerName         string   `json:"acquirer_name"`
+	AuthorizeOnly        bool     `json:"authorize_only"`
+}
+
+type PaymentBatchItem struct {
+	Index         int
+	Transaction   *PaymentBatchTransaction
+	Status        PaymentBatchItemStatus
+	PaymentId     string
+	PaymentStatus PaymentStatus
+	ErrorType     PaymentBatchErrorType
+	ErrorCode     int
+	ErrorMessages []string
+	UpdatedAt     time.Time
+}
+
+func NewPaymentBatchItem(index int, transaction *PaymentBatchTransaction) *PaymentBatchItem {
+	return &PaymentBatchItem{
+		Index:         index,
+		Transaction:   transaction,
+		Status:        PaymentBatchItemStatusPending,
+		ErrorMessages: make([]string, 0),
+		UpdatedAt:     time.Now().UTC(),
+	}
+}
+
+func (i *PaymentBatchItem) Start(payment *Payment, now time.Time) {
+	i.Status = PaymentBatchItemStatusProcessing
+	i.PaymentId = payment.Id
+	i.PaymentStatus = payment.Status
+	i.UpdatedAt = now
+}
+
+func (i *PaymentBatchItem) Succeed(payment *Payment, now time.Time) {
+	i.Status = PaymentBatchItemStatusSucceeded
+	i.PaymentId = payment.Id
+	i.PaymentStatus = payment.Status
+	i.UpdatedAt = now
+}
+
+func (i *PaymentBatchItem) Fail(payment *Payment, errorType PaymentBatchErrorType, code int, messages []string, now time.Time) {
+	i.Status = PaymentBatchItemStatusFailed
+	if payment != nil {
+		i.PaymentId = payment.Id
+		i.PaymentStatus = payment.Status
+	}
+	i.ErrorType = errorType
+	i.ErrorCode = code
+	i.ErrorMessages = messages
+	i.UpdatedAt = now
+}
+
+type PaymentBatch struct {
+	Id            string
+	Caller        string
+	AllowedStores []string
+	Status        PaymentBatchStatus
+	Attempts      int
+	LockedUntil   time.Time
+	Items         []*PaymentBatchItem
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewPaymentBatch(caller string, allowedStores []string, items []*PaymentBatchItem) *PaymentBatch {
+	now := time.Now().UTC()
+
+	return &PaymentBatch{
+		Id:            uuid.NewString(),
+		Caller:        caller,
+		AllowedStores: allowedStores,
+		Status:        PaymentBatchStatusQueued,
+		Items:         items,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+func (b *PaymentBatch) Claim(now time.Time) {
+	b.Status = PaymentBatchStatusProcessing
+	b.Attempts++
+	b.LockedUntil = now.Add(PaymentBatchLease)
+	b.UpdatedAt = now
+}
+
+func (b *PaymentBatch) Renew(now time.Time) bool {
+	if now.Before(b.LockedUntil.Add(-PaymentBatchLease / 2)) {
+		return false
+	}
+
+	b.LockedUntil = now.Add(PaymentBatchLease)
+	b.UpdatedAt = now
+	return true
+}
+
+func (b *PaymentBatch) Complete(now time.Time) {
+	b.Status = PaymentBatchStatusDone
+	b.UpdatedAt = now
+}
+
+func (b *PaymentBatch) Count() (succeeded int, failed int, pending int) {
+	for _, item := range b.Items {
+		switch item.Status {
+		case PaymentBatchItemStatusSucceeded:
+			succeeded++
+		case PaymentBatchItemStatusFailed:
+			failed++
+		default:
+			pending++
+		}
+	}
+
+	return succeeded, failed, pending
+}
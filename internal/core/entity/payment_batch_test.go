@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePaymentBatch(t *testing.T) {
+	items := []*PaymentBatchItem{
+		NewPaymentBatchItem(0, &PaymentBatchTransaction{CardToken: "Token"}),
+		NewPaymentBatchItem(1, &PaymentBatchTransaction{CardToken: "Token"}),
+	}
+
+	batch := NewPaymentBatch("Caller", []string{"Store"}, items)
+	assert.NotEmpty(t, batch.Id)
+	assert.Equal(t, "Caller", batch.Caller)
+	assert.Equal(t, PaymentBatchStatusQueued, batch.Status)
+	assert.Equal(t, PaymentBatchItemStatusPending, batch.Items[0].Status)
+
+	succeeded, failed, pending := batch.Count()
+	assert.Equal(t, 0, succeeded)
+	assert.Equal(t, 0, failed)
+	assert.Equal(t, 2, pending)
+}
+
+func TestClaimPaymentBatch(t *testing.T) {
+	now := time.Now().UTC()
+	batch := NewPaymentBatch("Caller", nil, nil)
+
+	batch.Claim(now)
+	assert.Equal(t, PaymentBatchStatusProcessing, batch.Status)
+	assert.Equal(t, 1, batch.Attempts)
+	assert.Equal(t, now.Add(PaymentBatchLease), batch.LockedUntil)
+
+	// the lease is only renewed after half of it has passed
+	assert.False(t, batch.Renew(now.Add(PaymentBatchLease/4)))
+	assert.Equal(t, now.Add(PaymentBatchLease), batch.LockedUntil)
+
+	later := now.Add(PaymentBatchLease / 2)
+	assert.True(t, batch.Renew(later))
+	assert.Equal(t, later.Add(PaymentBatchLease), batch.LockedUntil)
+
+	batch.Claim(now)
+	assert.Equal(t, 2, batch.Attempts)
+
+	batch.Complete(now)
+	assert.Equal(t, PaymentBatchStatusDone, batch.Status)
+}
+
+func TestFinishPaymentBatchItems(t *testing.T) {
+	now := time.Now().UTC()
+	payment := NewPayment(nil)
+
+	succeeded := NewPaymentBatchItem(0, &PaymentBatchTransaction{})
+	succeeded.Start(payment, now)
+	assert.Equal(t, PaymentBatchItemStatusProcessing, succeeded.Status)
+
+	payment.Status = PaymentStatusApproved
+	succeeded.Succeed(payment, now)
+	assert.Equal(t, PaymentBatchItemStatusSucceeded, succeeded.Status)
+	assert.Equal(t, payment.Id, succeeded.PaymentId)
+	assert.Equal(t, PaymentStatusApproved, succeeded.PaymentStatus)
+
+	declined := NewPaymentBatchItem(1, &PaymentBatchTransaction{})
+	payment.Status = PaymentStatusDeclined
+	declined.Fail(payment, PaymentBatchErrorAcquirer, 402, []string{"insufficient funds"}, now)
+	assert.Equal(t, PaymentBatchItemStatusFailed, declined.Status)
+	assert.Equal(t, payment.Id, declined.PaymentId)
+	assert.Equal(t, PaymentStatusDeclined, declined.PaymentStatus)
+	assert.Equal(t, PaymentBatchErrorAcquirer, declined.ErrorType)
+	assert.Equal(t, 402, declined.ErrorCode)
+
+	rejected := NewPaymentBatchItem(2, &PaymentBatchTransaction{})
+	rejected.Fail(nil, PaymentBatchErrorValidation, 0, []string{"card token is required"}, now)
+	assert.Empty(t, rejected.PaymentId)
+	assert.Equal(t, []string{"card token is required"}, rejected.ErrorMessages)
+
+	batch := NewPaymentBatch("Caller", nil, []*PaymentBatchItem{succeeded, declined, rejected, NewPaymentBatchItem(3, nil)})
+	s, f, p := batch.Count()
+	assert.Equal(t, 1, s)
+	assert.Equal(t, 2, f)
+	assert.Equal(t, 1, p)
+}
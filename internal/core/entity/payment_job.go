@@ -0,0 +1,64 @@
+package entity
+
+import "time"
+
+type PaymentJobStatus string
+
+const (
+	PaymentJobStatusQueued     PaymentJobStatus = "queued"
+	PaymentJobStatusProcessing PaymentJobStatus = "processing"
+	PaymentJobStatusDone       PaymentJobStatus = "done"
+	PaymentJobStatusFailed     PaymentJobStatus = "failed"
+)
+
+// PaymentJobLease is how long a claimed job is reserved to its worker. Jobs are claimed one
+// at a time and the lease outlasts the calls to the acquirer and its fallbacks, so a job is
+// only claimed again when its worker stopped.
+const PaymentJobLease = 2 * time.Minute
+
+// PaymentJob is a payment queued to be processed in the background. A job claimed again after
+// its lease expired was interrupted, maybe after its payment was sent to the acquirer.
+type PaymentJob struct {
+	PaymentId     string
+	AuthorizeOnly bool
+	Fallbacks     []string
+	Status        PaymentJobStatus
+	Attempts      int
+	LockedUntil   time.Time
+	LastError     string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewPaymentJob(payment *Payment) *PaymentJob {
+	job := &PaymentJob{
+		PaymentId: payment.Id,
+		Fallbacks: make([]string, 0),
+		Status:    PaymentJobStatusQueued,
+		CreatedAt: payment.CreatedAt,
+		UpdatedAt: payment.CreatedAt,
+	}
+
+	if payment.Transaction != nil {
+		job.AuthorizeOnly = payment.Transaction.AuthorizeOnly
+		job.Fallbacks = append(job.Fallbacks, payment.Transaction.Fallbacks()...)
+	}
+
+	return job
+}
+
+func (j *PaymentJob) Interrupted() bool {
+	return j.Attempts > 1
+}
+
+func (j *PaymentJob) Complete(now time.Time) {
+	j.Status = PaymentJobStatusDone
+	j.LastError = ""
+	j.UpdatedAt = now
+}
+
+func (j *PaymentJob) Fail(message string, now time.Time) {
+	j.Status = PaymentJobStatusFailed
+	j.LastError = message
+	j.UpdatedAt = now
+}
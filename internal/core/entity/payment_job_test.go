@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePaymentJob(t *testing.T) {
+	payment := NewPayment(nil)
+
+	job := NewPaymentJob(payment)
+	assert.Equal(t, payment.Id, job.PaymentId)
+	assert.Equal(t, PaymentJobStatusQueued, job.Status)
+	assert.Equal(t, 0, job.Attempts)
+	assert.Equal(t, payment.CreatedAt, job.CreatedAt)
+	assert.False(t, job.Interrupted())
+
+	job.Attempts = 2
+	assert.True(t, job.Interrupted())
+}
+
+func TestCreatePaymentJobOfRoutedTransaction(t *testing.T) {
+	card := NewCard("Token", "Holder", "12/2099", "Brand")
+	purchase := NewPurchase(NewMoney(1000, "BRL"), []string{"Item"}, 1)
+	transaction := NewTransaction(card, purchase, NewStore("11222333000181", "Address", "01310100"), NewAcquirer("Cielo"))
+	transaction.AuthorizeOnly = true
+	transaction.Route = NewRoute("Cielo", "Rule", nil)
+	transaction.Route.Fallbacks = []string{"Rede", "Stone"}
+
+	job := NewPaymentJob(NewPayment(transaction))
+	assert.True(t, job.AuthorizeOnly)
+	assert.Equal(t, []string{"Rede", "Stone"}, job.Fallbacks)
+}
+
+func TestFinishPaymentJob(t *testing.T) {
+	now := time.Now().UTC()
+
+	job := NewPaymentJob(NewPayment(nil))
+	job.Fail("connection refused", now)
+	assert.Equal(t, PaymentJobStatusFailed, job.Status)
+	assert.Equal(t, "connection refused", job.LastError)
+	assert.Equal(t, now, job.UpdatedAt)
+
+	job.Complete(now)
+	assert.Equal(t, PaymentJobStatusDone, job.Status)
+	assert.Empty(t, job.LastError)
+}
@@ -8,32 +8,280 @@ import (
 )
 
 func TestPaymentFactory(t *testing.T) {
-	payment := NewPayment("Id")
+	transaction := createTestTransaction()
+	payment := NewPayment(transaction)
 	assert.NotNil(t, payment)
-	assert.Equal(t, payment.Id, "Id")
+	assert.NotEmpty(t, payment.Id)
+	assert.Equal(t, payment.Transaction, transaction)
+	assert.Equal(t, payment.Status, PaymentStatusPending)
+	assert.False(t, payment.CreatedAt.IsZero())
+	assert.Equal(t, payment.CreatedAt, payment.UpdatedAt)
+}
+
+func TestPaymentStatusTransitions(t *testing.T) {
+	t.Run("approve", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Approve(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+		assert.Equal(t, PaymentStatusApproved, payment.Status)
+		assert.Equal(t, "Acquirer Id", payment.AcquirerId)
+		assert.Equal(t, 200, payment.AcquirerCode)
+		assert.Equal(t, "Message", payment.AcquirerMessage)
+		assert.Equal(t, NewMoney(999, "BRL"), payment.CapturedValue)
+	})
+
+	t.Run("authorize", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Authorize(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+		assert.Equal(t, PaymentStatusAuthorized, payment.Status)
+		assert.Equal(t, "Acquirer Id", payment.AcquirerId)
+		assert.Equal(t, NewMoney(0, "BRL"), payment.CapturedValue)
+	})
+
+	t.Run("decline", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Decline(422, "Message")
+		assert.Equal(t, PaymentStatusDeclined, payment.Status)
+		assert.Empty(t, payment.AcquirerId)
+		assert.Equal(t, 422, payment.AcquirerCode)
+		assert.Equal(t, "Message", payment.AcquirerMessage)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Fail("Message")
+		assert.Equal(t, PaymentStatusFailed, payment.Status)
+		assert.Empty(t, payment.AcquirerId)
+		assert.Equal(t, "Message", payment.AcquirerMessage)
+	})
+}
+
+func TestPaymentAttempts(t *testing.T) {
+	payment := NewPayment(createTestTransaction())
+
+	failed := payment.AddAttempt("cielo")
+	failed.Fail("timeout")
+
+	declined := payment.AddAttempt("rede")
+	declined.Decline(503, "unavailable")
+
+	succeeded := payment.AddAttempt("stone")
+	succeeded.Succeed(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+	assert.Equal(t, []*PaymentAttempt{failed, declined, succeeded}, payment.Attempts)
+
+	assert.Equal(t, payment.Id, failed.PaymentId)
+	assert.Equal(t, "cielo", failed.Acquirer)
+	assert.Equal(t, PaymentAttemptStatusFailed, failed.Status)
+	assert.Equal(t, "timeout", failed.AcquirerMessage)
+
+	assert.Equal(t, PaymentAttemptStatusDeclined, declined.Status)
+	assert.Equal(t, 503, declined.AcquirerCode)
+
+	assert.Equal(t, PaymentAttemptStatusSucceeded, succeeded.Status)
+	assert.Equal(t, "Acquirer Id", succeeded.AcquirerId)
+	assert.False(t, succeeded.CreatedAt.IsZero())
+}
+
+func TestPaymentCaptures(t *testing.T) {
+	t.Run("capture part of an authorized payment", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Authorize(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+		assert.Nil(t, payment.CanCapture(NewMoney(999, "BRL")))
+		assert.Nil(t, payment.CanCapture(NewMoney(500, "BRL")))
+
+		payment.Capture(NewMoney(500, "BRL"))
+		assert.Equal(t, PaymentStatusApproved, payment.Status)
+		assert.Equal(t, NewMoney(500, "BRL"), payment.CapturedValue)
+		assert.Equal(t, NewMoney(500, "BRL"), payment.RefundableValue())
+	})
+
+	t.Run("capture more than the authorized value", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Authorize(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+		err := payment.CanCapture(NewMoney(1000, "BRL"))
+		var verr *errors.ValidationError
+		assert.ErrorAs(t, err, &verr)
+		assert.Equal(t, []string{"capture value exceeds the authorized value"}, verr.Messages)
+	})
+
+	t.Run("capture an approved payment", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Approve(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+		err := payment.CanCapture(NewMoney(999, "BRL"))
+		var verr *errors.ValidationError
+		assert.ErrorAs(t, err, &verr)
+		assert.Equal(t, []string{"payment cannot be captured"}, verr.Messages)
+	})
+
+	t.Run("refund an uncaptured payment", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Authorize(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+		err := payment.CanRefund(NewMoney(100, "BRL"))
+		var verr *errors.ValidationError
+		assert.ErrorAs(t, err, &verr)
+		assert.Equal(t, []string{"payment cannot be refunded"}, verr.Messages)
+	})
+
+	t.Run("void an uncaptured payment on another day", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Authorize(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+		assert.Nil(t, payment.CanVoid(payment.CreatedAt.AddDate(0, 0, 7)))
+		assert.Equal(t, NewMoney(999, "BRL"), payment.VoidValue())
+	})
+
+	t.Run("void a partially captured payment", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Authorize(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+		payment.Capture(NewMoney(500, "BRL"))
+
+		assert.Nil(t, payment.CanVoid(payment.CreatedAt))
+		assert.Equal(t, NewMoney(500, "BRL"), payment.VoidValue())
+	})
+}
+
+func TestPaymentRefunds(t *testing.T) {
+	t.Run("refund an approved payment", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Approve(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+		assert.Nil(t, payment.CanRefund(NewMoney(499, "BRL")))
+		assert.Nil(t, payment.CanRefund(NewMoney(999, "BRL")))
+		assert.Equal(t, NewMoney(999, "BRL"), payment.RefundableValue())
+	})
+
+	t.Run("refund more than the refundable value", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Approve(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+		payment.RefundedValue = NewMoney(500, "BRL")
+
+		err := payment.CanRefund(NewMoney(500, "BRL"))
+		var verr *errors.ValidationError
+		assert.ErrorAs(t, err, &verr)
+		assert.Equal(t, []string{"refund value exceeds the refundable value"}, verr.Messages)
+	})
+
+	t.Run("refund a declined payment", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Decline(422, "Message")
+
+		err := payment.CanRefund(NewMoney(100, "BRL"))
+		var verr *errors.ValidationError
+		assert.ErrorAs(t, err, &verr)
+		assert.Equal(t, []string{"payment cannot be refunded"}, verr.Messages)
+	})
+
+	t.Run("void on the same day", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Approve(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+		assert.Nil(t, payment.CanVoid(payment.CreatedAt))
+	})
+
+	t.Run("void on another day", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Approve(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+		err := payment.CanVoid(payment.CreatedAt.AddDate(0, 0, 1))
+		var verr *errors.ValidationError
+		assert.ErrorAs(t, err, &verr)
+		assert.Equal(t, []string{"payment can only be voided on the day it was processed"}, verr.Messages)
+	})
+
+	t.Run("apply partial and full refunds", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Approve(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+		first := NewRefund(payment.Id, RefundTypeRefund, NewMoney(499, "BRL"))
+		first.Approve(NewAcquirerResponse("Refund Id", 200, "Message"))
+		declined := NewRefund(payment.Id, RefundTypeRefund, NewMoney(500, "BRL"))
+		declined.Decline(422, "Message")
+
+		payment.ApplyRefunds([]*Refund{first, declined})
+		assert.Equal(t, PaymentStatusPartiallyRefunded, payment.Status)
+		assert.Equal(t, NewMoney(499, "BRL"), payment.RefundedValue)
+		assert.Equal(t, NewMoney(500, "BRL"), payment.RefundableValue())
+
+		second := NewRefund(payment.Id, RefundTypeRefund, NewMoney(500, "BRL"))
+		second.Approve(NewAcquirerResponse("Refund Id", 200, "Message"))
+
+		payment.ApplyRefunds([]*Refund{first, declined, second})
+		assert.Equal(t, PaymentStatusRefunded, payment.Status)
+		assert.Equal(t, NewMoney(999, "BRL"), payment.RefundedValue)
+	})
+
+	t.Run("apply void", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Approve(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+		void := NewRefund(payment.Id, RefundTypeVoid, NewMoney(999, "BRL"))
+		void.Approve(NewAcquirerResponse("Void Id", 200, "Message"))
+
+		payment.ApplyRefunds([]*Refund{void})
+		assert.Equal(t, PaymentStatusVoided, payment.Status)
+	})
 }
 
 func TestPaymentValidator(t *testing.T) {
 	testCases := []struct {
-		TestName  string
-		PaymentId string
-		Err       *errors.ValidationError
+		TestName           string
+		PaymentId          string
+		PaymentTransaction *Transaction
+		PaymentStatus      PaymentStatus
+		Err                *errors.ValidationError
 	}{
 		{
 			"id is empty",
 			"",
+			createTestTransaction(),
+			PaymentStatusPending,
 			errors.NewValidationError("payment id is required"),
 		},
+		{
+			"transaction is nil",
+			"Id",
+			nil,
+			PaymentStatusPending,
+			errors.NewValidationError("payment transaction is required"),
+		},
+		{
+			"status is invalid",
+			"Id",
+			createTestTransaction(),
+			PaymentStatus("Status"),
+			errors.NewValidationError("payment status is invalid"),
+		},
+		{
+			"all fields are invalid",
+			"",
+			nil,
+			PaymentStatus(""),
+			errors.NewValidationError(
+				"payment id is required",
+				"payment transaction is required",
+				"payment status is invalid",
+			),
+		},
 		{
 			"all fields are valid",
 			"Id",
+			createTestTransaction(),
+			PaymentStatusApproved,
 			nil,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.TestName, func(t *testing.T) {
-			err := NewPayment(tc.PaymentId).Validate()
+			payment := NewPayment(tc.PaymentTransaction)
+			payment.Id = tc.PaymentId
+			payment.Status = tc.PaymentStatus
+
+			err := payment.Validate()
 			if tc.Err == nil && err == nil {
 				return
 			}
@@ -48,3 +296,12 @@ func TestPaymentValidator(t *testing.T) {
 		})
 	}
 }
+
+func createTestTransaction() *Transaction {
+	return NewTransaction(
+		NewCard("Token", "Holder", "12/2099", "Brand"),
+		NewPurchase(NewMoney(999, "BRL"), []string{"Item 1", "Item 2"}, 3),
+		NewStore("11222333000181", "Address", "01310100"),
+		NewAcquirer("Acquirer"),
+	)
+}
@@ -5,12 +5,12 @@ import (
 )
 
 type Purchase struct {
-	Value        float64
+	Value        Money
 	Items        []string
 	Installments int
 }
 
-func NewPurchase(value float64, items []string, installments int) *Purchase {
+func NewPurchase(value Money, items []string, installments int) *Purchase {
 	return &Purchase{
 		Value:        value,
 		Items:        items,
@@ -21,10 +21,14 @@ func NewPurchase(value float64, items []string, installments int) *Purchase {
 func (p *Purchase) Validate() error {
 	msgs := make([]string, 0)
 
-	if p.Value <= 0 {
+	if p.Value.Amount <= 0 {
 		msgs = append(msgs, "purchase value is invalid")
 	}
 
+	if !p.Value.IsCurrencyValid() {
+		msgs = append(msgs, "purchase currency is invalid")
+	}
+
 	if p.Items == nil || len(p.Items) == 0 {
 		msgs = append(msgs, "purchase items is required")
 	} else {
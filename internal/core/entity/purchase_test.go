@@ -10,9 +10,9 @@ import (
 func TestPurchaseFactory(t *testing.T) {
 	items := []string{"Item 1", "Item 2"}
 
-	purchase := NewPurchase(9.99, items, 5)
+	purchase := NewPurchase(NewMoney(999, "BRL"), items, 5)
 	assert.NotNil(t, purchase)
-	assert.Equal(t, purchase.Value, 9.99)
+	assert.Equal(t, purchase.Value, NewMoney(999, "BRL"))
 	assert.EqualValues(t, purchase.Items, items)
 	assert.Equal(t, purchase.Installments, 5)
 }
@@ -20,63 +20,70 @@ func TestPurchaseFactory(t *testing.T) {
 func TestPurchaseValidator(t *testing.T) {
 	testCases := []struct {
 		TestName             string
-		PurchaseValue        float64
+		PurchaseValue        Money
 		PurchaseItems        []string
 		PurchaseInstallments int
 		Err                  *errors.ValidationError
 	}{
 		{
 			"value is negative",
-			-1,
+			NewMoney(-1, "BRL"),
 			[]string{"Item 1", "Item 2"},
 			1,
 			errors.NewValidationError("purchase value is invalid"),
 		},
 		{
 			"value is zero",
-			0,
+			NewMoney(0, "BRL"),
 			[]string{"Item 1", "Item 2"},
 			1,
 			errors.NewValidationError("purchase value is invalid"),
 		},
 		{
-			"items is nil",
+			"currency is invalid",
+			NewMoney(1, "XYZ"),
+			[]string{"Item 1", "Item 2"},
 			1,
+			errors.NewValidationError("purchase currency is invalid"),
+		},
+		{
+			"items is nil",
+			NewMoney(1, "BRL"),
 			nil,
 			1,
 			errors.NewValidationError("purchase items is required"),
 		},
 		{
 			"items is empty",
-			1,
+			NewMoney(1, "BRL"),
 			[]string{},
 			1,
 			errors.NewValidationError("purchase items is required"),
 		},
 		{
 			"items has empty elements",
-			1,
+			NewMoney(1, "BRL"),
 			[]string{"Item 1", ""},
 			1,
 			errors.NewValidationError("purchase items is invalid"),
 		},
 		{
 			"installments is negative",
-			1,
+			NewMoney(1, "BRL"),
 			[]string{"Item 1", "Item 2"},
 			-1,
 			errors.NewValidationError("purchase installments is invalid"),
 		},
 		{
 			"installments is zero",
-			1,
+			NewMoney(1, "BRL"),
 			[]string{"Item 1", "Item 2"},
 			0,
 			errors.NewValidationError("purchase installments is invalid"),
 		},
 		{
 			"all fields are invalid",
-			0,
+			NewMoney(0, "BRL"),
 			[]string{""},
 			0,
 			errors.NewValidationError(
@@ -87,7 +94,7 @@ func TestPurchaseValidator(t *testing.T) {
 		},
 		{
 			"all fields are valid",
-			1,
+			NewMoney(1, "BRL"),
 			[]string{"Item 1", "Item 2"},
 			1,
 			nil,
@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/google/uuid"
+)
+
+type RefundType string
+
+const (
+	RefundTypeRefund RefundType = "refund"
+	RefundTypeVoid   RefundType = "void"
+)
+
+type RefundStatus string
+
+const (
+	RefundStatusPending  RefundStatus = "pending"
+	RefundStatusApproved RefundStatus = "approved"
+	RefundStatusDeclined RefundStatus = "declined"
+	RefundStatusFailed   RefundStatus = "failed"
+
+	// RefundStatusUnknown is set when the acquirer may have refunded the value without telling
+	// it, so the value stays reserved to the refund until the acquirer confirms its outcome.
+	RefundStatusUnknown RefundStatus = "unknown"
+)
+
+type Refund struct {
+	Id              string
+	PaymentId       string
+	Type            RefundType
+	Value           Money
+	Status          RefundStatus
+	AcquirerId      string
+	AcquirerCode    int
+	AcquirerMessage string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func NewRefund(paymentId string, refundType RefundType, value Money) *Refund {
+	now := time.Now().UTC()
+
+	return &Refund{
+		Id:        uuid.NewString(),
+		PaymentId: paymentId,
+		Type:      refundType,
+		Value:     value,
+		Status:    RefundStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (r *Refund) Approve(response *AcquirerResponse) {
+	r.Status = RefundStatusApproved
+	r.AcquirerId = response.Id
+	r.AcquirerCode = response.Code
+	r.AcquirerMessage = response.Message
+	r.UpdatedAt = time.Now().UTC()
+}
+
+func (r *Refund) Decline(code int, message string) {
+	r.Status = RefundStatusDeclined
+	r.AcquirerCode = code
+	r.AcquirerMessage = message
+	r.UpdatedAt = time.Now().UTC()
+}
+
+func (r *Refund) Fail(message string) {
+	r.Status = RefundStatusFailed
+	r.AcquirerMessage = message
+	r.UpdatedAt = time.Now().UTC()
+}
+
+func (r *Refund) Unknown(message string) {
+	r.Status = RefundStatusUnknown
+	r.AcquirerMessage = message
+	r.UpdatedAt = time.Now().UTC()
+}
+
+func (r *Refund) Validate() error {
+	msgs := make([]string, 0)
+
+	if r.PaymentId == "" {
+		msgs = append(msgs, "refund payment id is required")
+	}
+
+	if r.Type != RefundTypeRefund && r.Type != RefundTypeVoid {
+		msgs = append(msgs, "refund type is invalid")
+	}
+
+	if r.Value.Amount <= 0 {
+		msgs = append(msgs, "refund value is invalid")
+	}
+
+	if len(msgs) > 0 {
+		return errors.NewValidationError(msgs...)
+	}
+
+	return nil
+}
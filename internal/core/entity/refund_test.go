@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefundFactory(t *testing.T) {
+	refund := NewRefund("Payment Id", RefundTypeRefund, NewMoney(999, "BRL"))
+	assert.NotNil(t, refund)
+	assert.NotEmpty(t, refund.Id)
+	assert.Equal(t, refund.PaymentId, "Payment Id")
+	assert.Equal(t, refund.Type, RefundTypeRefund)
+	assert.Equal(t, refund.Value, NewMoney(999, "BRL"))
+	assert.Equal(t, refund.Status, RefundStatusPending)
+}
+
+func TestRefundStatusTransitions(t *testing.T) {
+	t.Run("approve", func(t *testing.T) {
+		refund := NewRefund("Payment Id", RefundTypeRefund, NewMoney(999, "BRL"))
+		refund.Approve(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+		assert.Equal(t, RefundStatusApproved, refund.Status)
+		assert.Equal(t, "Acquirer Id", refund.AcquirerId)
+	})
+
+	t.Run("decline", func(t *testing.T) {
+		refund := NewRefund("Payment Id", RefundTypeRefund, NewMoney(999, "BRL"))
+		refund.Decline(422, "Message")
+		assert.Equal(t, RefundStatusDeclined, refund.Status)
+		assert.Equal(t, 422, refund.AcquirerCode)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		refund := NewRefund("Payment Id", RefundTypeRefund, NewMoney(999, "BRL"))
+		refund.Fail("Message")
+		assert.Equal(t, RefundStatusFailed, refund.Status)
+		assert.Equal(t, "Message", refund.AcquirerMessage)
+	})
+}
+
+func TestRefundValidator(t *testing.T) {
+	testCases := []struct {
+		TestName        string
+		RefundPaymentId string
+		RefundType      RefundType
+		RefundAmount    int64
+		Err             *errors.ValidationError
+	}{
+		{
+			"payment id is empty",
+			"",
+			RefundTypeRefund,
+			1,
+			errors.NewValidationError("refund payment id is required"),
+		},
+		{
+			"type is invalid",
+			"Payment Id",
+			RefundType("Type"),
+			1,
+			errors.NewValidationError("refund type is invalid"),
+		},
+		{
+			"value is zero",
+			"Payment Id",
+			RefundTypeVoid,
+			0,
+			errors.NewValidationError("refund value is invalid"),
+		},
+		{
+			"all fields are invalid",
+			"",
+			RefundType(""),
+			-1,
+			errors.NewValidationError(
+				"refund payment id is required",
+				"refund type is invalid",
+				"refund value is invalid",
+			),
+		},
+		{
+			"all fields are valid",
+			"Payment Id",
+			RefundTypeRefund,
+			1,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := NewRefund(tc.RefundPaymentId, tc.RefundType, NewMoney(tc.RefundAmount, "BRL")).Validate()
+			if tc.Err == nil && err == nil {
+				return
+			}
+
+			var verr *errors.ValidationError
+			assert.ErrorAs(t, err, &verr)
+			assert.Equal(t, len(tc.Err.Messages), len(verr.Messages))
+
+			for i, msg := range tc.Err.Messages {
+				assert.Equal(t, msg, verr.Messages[i])
+			}
+		})
+	}
+}
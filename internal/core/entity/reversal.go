@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReversalStatus string
+
+const (
+	ReversalStatusPending  ReversalStatus = "pending"
+	ReversalStatusReversed ReversalStatus = "reversed"
+	ReversalStatusNotFound ReversalStatus = "not_found"
+)
+
+const (
+	ReversalRetryDelay    = 5 * time.Second
+	ReversalMaxRetryDelay = 10 * time.Minute
+)
+
+// Reversal is sent until the acquirer either reverses the transaction or tells that it never
+// received it.
+type Reversal struct {
+	Id              string
+	PaymentId       string
+	AttemptId       string
+	Acquirer        string
+	Value           Money
+	Status          ReversalStatus
+	Retries         int
+	NextRetryAt     time.Time
+	AcquirerId      string
+	AcquirerCode    int
+	AcquirerMessage string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func NewReversal(payment *Payment, attempt *PaymentAttempt) *Reversal {
+	now := time.Now().UTC()
+
+	return &Reversal{
+		Id:          uuid.NewString(),
+		PaymentId:   payment.Id,
+		AttemptId:   attempt.Id,
+		Acquirer:    attempt.Acquirer,
+		Value:       payment.Transaction.Purchase.Value,
+		Status:      ReversalStatusPending,
+		NextRetryAt: now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func (r *Reversal) Reverse(response *AcquirerResponse) {
+	r.Status = ReversalStatusReversed
+	r.AcquirerId = response.Id
+	r.AcquirerCode = response.Code
+	r.AcquirerMessage = response.Message
+	r.UpdatedAt = time.Now().UTC()
+}
+
+func (r *Reversal) NotFound(code int, message string) {
+	r.Status = ReversalStatusNotFound
+	r.AcquirerCode = code
+	r.AcquirerMessage = message
+	r.UpdatedAt = time.Now().UTC()
+}
+
+func (r *Reversal) Retry(message string, now time.Time) {
+	delay := ReversalRetryDelay
+	for i := 0; i < r.Retries && delay < ReversalMaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > ReversalMaxRetryDelay {
+		delay = ReversalMaxRetryDelay
+	}
+
+	r.Retries++
+	r.NextRetryAt = now.Add(delay)
+	r.AcquirerMessage = message
+	r.UpdatedAt = now
+}
+
+func (r *Reversal) Resolved() bool {
+	return r.Status != ReversalStatusPending
+}
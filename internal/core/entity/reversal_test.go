@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReversalFactory(t *testing.T) {
+	payment := NewPayment(createTestTransaction())
+	attempt := payment.AddAttempt("cielo")
+
+	reversal := NewReversal(payment, attempt)
+	assert.NotEmpty(t, reversal.Id)
+	assert.Equal(t, payment.Id, reversal.PaymentId)
+	assert.Equal(t, attempt.Id, reversal.AttemptId)
+	assert.Equal(t, "cielo", reversal.Acquirer)
+	assert.Equal(t, NewMoney(999, "BRL"), reversal.Value)
+	assert.Equal(t, ReversalStatusPending, reversal.Status)
+	assert.Equal(t, reversal.CreatedAt, reversal.NextRetryAt)
+	assert.False(t, reversal.Resolved())
+}
+
+func TestReversalRetries(t *testing.T) {
+	payment := NewPayment(createTestTransaction())
+	reversal := NewReversal(payment, payment.AddAttempt("cielo"))
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	reversal.Retry("acquirer timed out", now)
+	assert.Equal(t, 1, reversal.Retries)
+	assert.Equal(t, now.Add(ReversalRetryDelay), reversal.NextRetryAt)
+	assert.Equal(t, "acquirer timed out", reversal.AcquirerMessage)
+
+	reversal.Retry("acquirer timed out", now)
+	assert.Equal(t, now.Add(2*ReversalRetryDelay), reversal.NextRetryAt)
+
+	reversal.Retries = 20
+	reversal.Retry("acquirer timed out", now)
+	assert.Equal(t, now.Add(ReversalMaxRetryDelay), reversal.NextRetryAt)
+	assert.False(t, reversal.Resolved())
+}
+
+func TestPaymentReversals(t *testing.T) {
+	t.Run("unknown until all reversals are resolved", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Unknown("acquirer timed out")
+		assert.Equal(t, PaymentStatusUnknown, payment.Status)
+
+		first := NewReversal(payment, payment.AddAttempt("cielo"))
+		first.NotFound(404, "the transaction was not found")
+		second := NewReversal(payment, payment.AddAttempt("rede"))
+
+		payment.ApplyReversals([]*Reversal{first, second})
+		assert.Equal(t, PaymentStatusUnknown, payment.Status)
+
+		second.Reverse(NewAcquirerResponse("Reversal Id", 200, "Message"))
+
+		payment.ApplyReversals([]*Reversal{first, second})
+		assert.Equal(t, PaymentStatusReversed, payment.Status)
+		assert.Equal(t, "transaction reversed after timeout", payment.AcquirerMessage)
+	})
+
+	t.Run("failed when the acquirers never processed it", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		payment.Unknown("acquirer timed out")
+
+		reversal := NewReversal(payment, payment.AddAttempt("cielo"))
+		reversal.NotFound(404, "the transaction was not found")
+
+		payment.ApplyReversals([]*Reversal{reversal})
+		assert.Equal(t, PaymentStatusFailed, payment.Status)
+		assert.Equal(t, "transaction not processed by the acquirer", payment.AcquirerMessage)
+	})
+
+	t.Run("keeps the status of a payment approved by a fallback", func(t *testing.T) {
+		payment := NewPayment(createTestTransaction())
+		reversal := NewReversal(payment, payment.AddAttempt("cielo"))
+		payment.Approve(NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+		reversal.Reverse(NewAcquirerResponse("Reversal Id", 200, "Message"))
+
+		payment.ApplyReversals([]*Reversal{reversal})
+		assert.Equal(t, PaymentStatusApproved, payment.Status)
+	})
+}
@@ -0,0 +1,145 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+type RouteTarget struct {
+	Acquirer string `json:"acquirer"`
+	Weight   int    `json:"weight"`
+}
+
+// RouteRule has empty conditions match any transaction.
+type RouteRule struct {
+	Name            string         `json:"name"`
+	Currency        string         `json:"currency"`
+	MinAmount       int64          `json:"min_amount"`
+	MaxAmount       int64          `json:"max_amount"`
+	CardBrands      []string       `json:"card_brands"`
+	MinInstallments int            `json:"min_installments"`
+	MaxInstallments int            `json:"max_installments"`
+	Stores          []string       `json:"stores"`
+	Targets         []*RouteTarget `json:"targets"`
+	Fallbacks       []string       `json:"fallbacks"`
+}
+
+func NewRouteRule(name string, targets ...*RouteTarget) *RouteRule {
+	return &RouteRule{
+		Name:    name,
+		Targets: targets,
+	}
+}
+
+func (r *RouteRule) Match(transaction *Transaction) (bool, string) {
+	value := transaction.Purchase.Value
+
+	if r.Currency != "" && r.Currency != value.Currency {
+		return false, fmt.Sprintf("currency is not %s", r.Currency)
+	}
+
+	if value.Amount < r.MinAmount {
+		return false, fmt.Sprintf("amount is below %d", r.MinAmount)
+	}
+
+	if r.MaxAmount > 0 && value.Amount > r.MaxAmount {
+		return false, fmt.Sprintf("amount is above %d", r.MaxAmount)
+	}
+
+	if len(r.CardBrands) > 0 && !slices.Contains(r.CardBrands, transaction.Card.Brand) {
+		return false, fmt.Sprintf("card brand %s is not allowed", transaction.Card.Brand)
+	}
+
+	installments := transaction.Purchase.Installments
+	if installments < r.MinInstallments {
+		return false, fmt.Sprintf("installments are below %d", r.MinInstallments)
+	}
+
+	if r.MaxInstallments > 0 && installments > r.MaxInstallments {
+		return false, fmt.Sprintf("installments are above %d", r.MaxInstallments)
+	}
+
+	if len(r.Stores) > 0 && !slices.ContainsFunc(r.Stores, func(store string) bool {
+		return NormalizeDocument(store) == transaction.Store.Identification
+	}) {
+		return false, fmt.Sprintf("store %s is not allowed", transaction.Store.Identification)
+	}
+
+	return true, ""
+}
+
+// Chain never includes the chosen acquirer.
+func (r *RouteRule) Chain(acquirer string) []string {
+	chain := make([]string, 0, len(r.Fallbacks))
+	for _, fallback := range r.Fallbacks {
+		if fallback != acquirer && !slices.Contains(chain, fallback) {
+			chain = append(chain, fallback)
+		}
+	}
+
+	return chain
+}
+
+func (r *RouteRule) Validate() error {
+	msgs := make([]string, 0)
+
+	if r.Name == "" {
+		msgs = append(msgs, "route rule name is required")
+	}
+
+	if r.MaxAmount > 0 && r.MaxAmount < r.MinAmount {
+		msgs = append(msgs, "route rule amount limits are invalid")
+	}
+
+	if r.MaxInstallments > 0 && r.MaxInstallments < r.MinInstallments {
+		msgs = append(msgs, "route rule installments limits are invalid")
+	}
+
+	if len(r.Targets) == 0 {
+		msgs = append(msgs, "route rule targets is required")
+	} else {
+		for _, target := range r.Targets {
+			if target.Acquirer == "" || target.Weight <= 0 {
+				msgs = append(msgs, "route rule targets is invalid")
+				break
+			}
+		}
+	}
+
+	for _, fallback := range r.Fallbacks {
+		if fallback == "" {
+			msgs = append(msgs, "route rule fallbacks is invalid")
+			break
+		}
+	}
+
+	if len(msgs) > 0 {
+		return errors.NewValidationError(msgs...)
+	}
+
+	return nil
+}
+
+type RouteEvaluation struct {
+	Rule    string
+	Matched bool
+	Reason  string
+}
+
+// Route has an empty rule when the acquirer was informed by the client.
+type Route struct {
+	Acquirer    string
+	Rule        string
+	Fallbacks   []string
+	Evaluations []*RouteEvaluation
+}
+
+func NewRoute(acquirer string, rule string, evaluations []*RouteEvaluation) *Route {
+	return &Route{
+		Acquirer:    acquirer,
+		Rule:        rule,
+		Evaluations: evaluations,
+	}
+}
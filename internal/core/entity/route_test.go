@@ -0,0 +1,164 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRouteRule(t *testing.T) {
+	target := &RouteTarget{Acquirer: "cielo", Weight: 100}
+	rule := NewRouteRule("Name", target)
+	assert.NotNil(t, rule)
+	assert.Equal(t, "Name", rule.Name)
+	assert.Equal(t, []*RouteTarget{target}, rule.Targets)
+}
+
+func TestRouteRuleMatch(t *testing.T) {
+	testCase := []struct {
+		TestName string
+		Rule     *RouteRule
+		Matched  bool
+		Reason   string
+	}{
+		{
+			"rule without conditions",
+			&RouteRule{},
+			true,
+			"",
+		},
+		{
+			"currency does not match",
+			&RouteRule{Currency: "USD"},
+			false,
+			"currency is not USD",
+		},
+		{
+			"amount is below the minimum",
+			&RouteRule{MinAmount: 10000},
+			false,
+			"amount is below 10000",
+		},
+		{
+			"amount is above the maximum",
+			&RouteRule{MaxAmount: 1000},
+			false,
+			"amount is above 1000",
+		},
+		{
+			"card brand is not allowed",
+			&RouteRule{CardBrands: []string{"elo"}},
+			false,
+			"card brand visa is not allowed",
+		},
+		{
+			"installments are below the minimum",
+			&RouteRule{MinInstallments: 3},
+			false,
+			"installments are below 3",
+		},
+		{
+			"installments are above the maximum",
+			&RouteRule{MaxInstallments: 1},
+			false,
+			"installments are above 1",
+		},
+		{
+			"store is not allowed",
+			&RouteRule{Stores: []string{"Other"}},
+			false,
+			"store 11222333000181 is not allowed",
+		},
+		{
+			"all conditions match",
+			&RouteRule{
+				Currency:        "BRL",
+				MinAmount:       1000,
+				MaxAmount:       10000,
+				CardBrands:      []string{"visa", "master"},
+				MinInstallments: 1,
+				MaxInstallments: 2,
+				Stores:          []string{"11.222.333/0001-81"},
+			},
+			true,
+			"",
+		},
+	}
+
+	card := NewCard("Token", "Holder", "Expiration", "visa")
+	purchase := NewPurchase(NewMoney(5000, "BRL"), []string{"Item"}, 2)
+	store := NewStore("11222333000181", "Address", "01310100")
+	transaction := NewTransaction(card, purchase, store, nil)
+
+	for _, tc := range testCase {
+		t.Run(tc.TestName, func(t *testing.T) {
+			matched, reason := tc.Rule.Match(transaction)
+			assert.Equal(t, tc.Matched, matched)
+			assert.Equal(t, tc.Reason, reason)
+		})
+	}
+}
+
+func TestRouteRuleValidator(t *testing.T) {
+	testCase := []struct {
+		TestName string
+		Rule     *RouteRule
+		Err      *errors.ValidationError
+	}{
+		{
+			"name is empty",
+			&RouteRule{Targets: []*RouteTarget{{Acquirer: "cielo", Weight: 100}}},
+			errors.NewValidationError("route rule name is required"),
+		},
+		{
+			"amount limits are invalid",
+			&RouteRule{Name: "Name", MinAmount: 200, MaxAmount: 100, Targets: []*RouteTarget{{Acquirer: "cielo", Weight: 100}}},
+			errors.NewValidationError("route rule amount limits are invalid"),
+		},
+		{
+			"installments limits are invalid",
+			&RouteRule{Name: "Name", MinInstallments: 3, MaxInstallments: 2, Targets: []*RouteTarget{{Acquirer: "cielo", Weight: 100}}},
+			errors.NewValidationError("route rule installments limits are invalid"),
+		},
+		{
+			"targets are empty",
+			&RouteRule{Name: "Name"},
+			errors.NewValidationError("route rule targets is required"),
+		},
+		{
+			"target weight is invalid",
+			&RouteRule{Name: "Name", Targets: []*RouteTarget{{Acquirer: "cielo", Weight: 0}}},
+			errors.NewValidationError("route rule targets is invalid"),
+		},
+		{
+			"all fields are valid",
+			&RouteRule{Name: "Name", MaxAmount: 10000, Targets: []*RouteTarget{{Acquirer: "cielo", Weight: 100}}},
+			nil,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := tc.Rule.Validate()
+			if tc.Err == nil && err == nil {
+				return
+			}
+
+			var verr *errors.ValidationError
+			assert.ErrorAs(t, err, &verr)
+			assert.Equal(t, len(tc.Err.Messages), len(verr.Messages))
+
+			for i, msg := range tc.Err.Messages {
+				assert.Equal(t, msg, verr.Messages[i])
+			}
+		})
+	}
+}
+
+func TestRouteRuleChain(t *testing.T) {
+	rule := &RouteRule{Fallbacks: []string{"rede", "cielo", "stone", "rede"}}
+	assert.Equal(t, []string{"rede", "stone"}, rule.Chain("cielo"))
+	assert.Equal(t, []string{"rede", "cielo", "stone"}, rule.Chain("getnet"))
+	assert.Empty(t, (&RouteRule{}).Chain("cielo"))
+}
@@ -0,0 +1,198 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SettlementFormat string
+
+const (
+	SettlementFormatCsv SettlementFormat = "csv"
+	SettlementFormatEdi SettlementFormat = "edi"
+)
+
+type SettlementDiscrepancyType string
+
+const (
+	SettlementDiscrepancyMissing SettlementDiscrepancyType = "missing"
+
+	SettlementDiscrepancyUnexpected SettlementDiscrepancyType = "unexpected"
+
+	SettlementDiscrepancyAmountMismatch SettlementDiscrepancyType = "amount_mismatch"
+)
+
+type SettlementLine struct {
+	Number     int
+	AcquirerId string
+	Value      Money
+	Date       time.Time
+}
+
+func NewSettlementLine(number int, acquirerId string, value Money, date time.Time) *SettlementLine {
+	return &SettlementLine{
+		Number:     number,
+		AcquirerId: acquirerId,
+		Value:      value,
+		Date:       date,
+	}
+}
+
+// SettlementDiscrepancy has line 0 for a missing payment and no payment for an unexpected line.
+type SettlementDiscrepancy struct {
+	Type          SettlementDiscrepancyType
+	Line          int
+	PaymentId     string
+	AcquirerId    string
+	ExpectedValue Money
+	SettledValue  Money
+	Date          time.Time
+}
+
+type Settlement struct {
+	Id            string
+	Acquirer      string
+	Format        SettlementFormat
+	FileName      string
+	Lines         int
+	Matched       int
+	PeriodStart   time.Time
+	PeriodEnd     time.Time
+	Discrepancies []*SettlementDiscrepancy
+	CreatedAt     time.Time
+}
+
+func NewSettlement(acquirer string, format SettlementFormat, fileName string, lines []*SettlementLine) *Settlement {
+	settlement := &Settlement{
+		Id:            uuid.NewString(),
+		Acquirer:      acquirer,
+		Format:        format,
+		FileName:      fileName,
+		Lines:         len(lines),
+		Discrepancies: make([]*SettlementDiscrepancy, 0),
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	for _, line := range lines {
+		if settlement.PeriodStart.IsZero() || line.Date.Before(settlement.PeriodStart) {
+			settlement.PeriodStart = line.Date
+		}
+
+		if line.Date.After(settlement.PeriodEnd) {
+			settlement.PeriodEnd = line.Date
+		}
+	}
+
+	return settlement
+}
+
+// Reconcile matches the lines to the payments and records their discrepancies. A line is
+// matched to the payment with its acquirer id and, when it has none, to a payment of the same
+// value and date not matched yet. The settleable payments of the acquirer created in the
+// period of the file that no line matched are missing.
+func (s *Settlement) Reconcile(lines []*SettlementLine, payments []*Payment) {
+	byAcquirerId := make(map[string]*Payment)
+	for _, payment := range payments {
+		if payment.AcquirerId != "" {
+			byAcquirerId[payment.AcquirerId] = payment
+		}
+	}
+
+	matched := make(map[string]bool)
+
+	match := func(line *SettlementLine) *Payment {
+		if line.AcquirerId != "" {
+			return byAcquirerId[line.AcquirerId]
+		}
+
+		for _, payment := range payments {
+			if !matched[payment.Id] && payment.IsSettleable() &&
+				payment.CapturedValue == line.Value && sameDay(payment.CreatedAt, line.Date) {
+				return payment
+			}
+		}
+
+		return nil
+	}
+
+	for _, line := range lines {
+		payment := match(line)
+		if payment == nil || !payment.IsSettleable() || matched[payment.Id] {
+			discrepancy := &SettlementDiscrepancy{
+				Type:         SettlementDiscrepancyUnexpected,
+				Line:         line.Number,
+				AcquirerId:   line.AcquirerId,
+				SettledValue: line.Value,
+				Date:         line.Date,
+			}
+
+			// a payment not captured or already settled is still informed
+			if payment != nil {
+				discrepancy.PaymentId = payment.Id
+				discrepancy.ExpectedValue = NewMoney(0, payment.CapturedValue.Currency)
+			}
+
+			s.Discrepancies = append(s.Discrepancies, discrepancy)
+			continue
+		}
+
+		matched[payment.Id] = true
+
+		if payment.CapturedValue != line.Value {
+			s.Discrepancies = append(s.Discrepancies, &SettlementDiscrepancy{
+				Type:          SettlementDiscrepancyAmountMismatch,
+				Line:          line.Number,
+				PaymentId:     payment.Id,
+				AcquirerId:    line.AcquirerId,
+				ExpectedValue: payment.CapturedValue,
+				SettledValue:  line.Value,
+				Date:          line.Date,
+			})
+			continue
+		}
+
+		s.Matched++
+	}
+
+	for _, payment := range payments {
+		if matched[payment.Id] || !payment.IsSettleable() || !s.InPeriod(payment.CreatedAt) {
+			continue
+		}
+
+		s.Discrepancies = append(s.Discrepancies, &SettlementDiscrepancy{
+			Type:          SettlementDiscrepancyMissing,
+			PaymentId:     payment.Id,
+			AcquirerId:    payment.AcquirerId,
+			ExpectedValue: payment.CapturedValue,
+			SettledValue:  NewMoney(0, payment.CapturedValue.Currency),
+			Date:          payment.CreatedAt,
+		})
+	}
+}
+
+func (s *Settlement) InPeriod(t time.Time) bool {
+	t = t.UTC()
+	return !t.Before(s.PeriodStart) && t.Before(s.PeriodEnd.AddDate(0, 0, 1))
+}
+
+func (s *Settlement) Count() (missing int, unexpected int, amountMismatches int) {
+	for _, discrepancy := range s.Discrepancies {
+		switch discrepancy.Type {
+		case SettlementDiscrepancyMissing:
+			missing++
+		case SettlementDiscrepancyUnexpected:
+			unexpected++
+		case SettlementDiscrepancyAmountMismatch:
+			amountMismatches++
+		}
+	}
+
+	return missing, unexpected, amountMismatches
+}
+
+func sameDay(t time.Time, date time.Time) bool {
+	y1, m1, d1 := t.UTC().Date()
+	y2, m2, d2 := date.UTC().Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
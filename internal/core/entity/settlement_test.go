@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSettlement(t *testing.T) {
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	lines := []*SettlementLine{
+		NewSettlementLine(1, "A1", NewMoney(1000, "BRL"), day.AddDate(0, 0, 1)),
+		NewSettlementLine(2, "A2", NewMoney(2000, "BRL"), day),
+	}
+
+	settlement := NewSettlement("cielo", SettlementFormatCsv, "cielo.csv", lines)
+	assert.NotEmpty(t, settlement.Id)
+	assert.Equal(t, 2, settlement.Lines)
+	assert.Equal(t, day, settlement.PeriodStart)
+	assert.Equal(t, day.AddDate(0, 0, 1), settlement.PeriodEnd)
+
+	assert.True(t, settlement.InPeriod(day))
+	assert.True(t, settlement.InPeriod(day.AddDate(0, 0, 2).Add(-time.Nanosecond)))
+	assert.False(t, settlement.InPeriod(day.Add(-time.Nanosecond)))
+	assert.False(t, settlement.InPeriod(day.AddDate(0, 0, 2)))
+}
+
+func TestReconcileSettlement(t *testing.T) {
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	newPayment := func(acquirerId string, amount int64, status PaymentStatus, createdAt time.Time) *Payment {
+		payment := NewPayment(nil)
+		payment.AcquirerId = acquirerId
+		payment.Status = status
+		payment.CapturedValue = NewMoney(amount, "BRL")
+		payment.CreatedAt = createdAt
+		return payment
+	}
+
+	matched := newPayment("A1", 1000, PaymentStatusApproved, day.Add(time.Hour))
+	mismatched := newPayment("A2", 2000, PaymentStatusPartiallyRefunded, day.Add(2*time.Hour))
+	authorized := newPayment("A3", 0, PaymentStatusAuthorized, day.Add(3*time.Hour))
+	missing := newPayment("A4", 4000, PaymentStatusApproved, day.Add(4*time.Hour))
+	withoutId := newPayment("A5", 5000, PaymentStatusApproved, day.Add(5*time.Hour))
+	outside := newPayment("A6", 6000, PaymentStatusApproved, day.AddDate(0, 0, 1))
+
+	lines := []*SettlementLine{
+		NewSettlementLine(1, "A1", NewMoney(1000, "BRL"), day),
+		NewSettlementLine(2, "A2", NewMoney(2500, "BRL"), day),
+		NewSettlementLine(3, "A3", NewMoney(3000, "BRL"), day),
+		NewSettlementLine(4, "A9", NewMoney(9000, "BRL"), day),
+		NewSettlementLine(5, "", NewMoney(5000, "BRL"), day),
+		NewSettlementLine(6, "A1", NewMoney(1000, "BRL"), day),
+	}
+
+	settlement := NewSettlement("cielo", SettlementFormatCsv, "cielo.csv", lines)
+	settlement.Reconcile(lines, []*Payment{matched, mismatched, authorized, missing, withoutId, outside})
+
+	assert.Equal(t, 2, settlement.Matched)
+
+	missingCount, unexpected, amountMismatches := settlement.Count()
+	assert.Equal(t, 1, missingCount)
+	assert.Equal(t, 3, unexpected)
+	assert.Equal(t, 1, amountMismatches)
+
+	byLine := make(map[int]*SettlementDiscrepancy)
+	for _, discrepancy := range settlement.Discrepancies {
+		byLine[discrepancy.Line] = discrepancy
+	}
+
+	assert.Equal(t, SettlementDiscrepancyAmountMismatch, byLine[2].Type)
+	assert.Equal(t, mismatched.Id, byLine[2].PaymentId)
+	assert.Equal(t, NewMoney(2000, "BRL"), byLine[2].ExpectedValue)
+	assert.Equal(t, NewMoney(2500, "BRL"), byLine[2].SettledValue)
+
+	// a payment that was not captured is not expected to be settled
+	assert.Equal(t, SettlementDiscrepancyUnexpected, byLine[3].Type)
+	assert.Equal(t, authorized.Id, byLine[3].PaymentId)
+
+	assert.Equal(t, SettlementDiscrepancyUnexpected, byLine[4].Type)
+	assert.Empty(t, byLine[4].PaymentId)
+
+	// a payment is settled once
+	assert.Equal(t, SettlementDiscrepancyUnexpected, byLine[6].Type)
+	assert.Equal(t, matched.Id, byLine[6].PaymentId)
+
+	assert.Equal(t, SettlementDiscrepancyMissing, byLine[0].Type)
+	assert.Equal(t, missing.Id, byLine[0].PaymentId)
+	assert.Equal(t, NewMoney(4000, "BRL"), byLine[0].ExpectedValue)
+}
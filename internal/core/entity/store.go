@@ -1,21 +1,86 @@
 package entity
 
 import (
+	"strings"
+	"time"
+
 	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/google/uuid"
 )
 
 type Store struct {
-	Identification string `json:"identification"`
-	Address        string `json:"address"`
-	Cep            string `json:"cep"`
+	// Id is empty for stores informed in the payment requests before the registry existed.
+	Id string `json:"id"`
+
+	Identification string       `json:"identification"`
+	DocumentType   DocumentType `json:"document_type"`
+	Address        string       `json:"address"`
+
+	Cep string `json:"cep"`
+
+	Street string `json:"street"`
+	Number string `json:"number"`
+	City   string `json:"city"`
+	State  string `json:"state"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 func NewStore(identification string, address string, cep string) *Store {
+	identification = NormalizeDocument(identification)
+
 	return &Store{
 		Identification: identification,
+		DocumentType:   DetectDocumentType(identification),
 		Address:        address,
-		Cep:            cep,
+		Cep:            NormalizeCep(cep),
+	}
+}
+
+func NewRegisteredStore(identification string, address string, cep string) *Store {
+	now := time.Now().UTC()
+
+	store := NewStore(identification, address, cep)
+	store.Id = uuid.NewString()
+	store.CreatedAt = now
+	store.UpdatedAt = now
+
+	return store
+}
+
+func (s *Store) SetLocation(street string, number string, city string, state string) {
+	s.Street = strings.TrimSpace(street)
+	s.Number = strings.TrimSpace(number)
+	s.City = strings.TrimSpace(city)
+	s.State = strings.ToUpper(strings.TrimSpace(state))
+}
+
+// ApplyAddress keeps the informed state, so Validate reports the ones that contradict the CEP.
+func (s *Store) ApplyAddress(address *Address) {
+	if address == nil {
+		if s.State == "" {
+			s.State = CepState(s.Cep)
+		}
+		return
+	}
+
+	if s.Street == "" {
+		s.Street = address.Street
 	}
+
+	if s.City == "" || strings.EqualFold(s.City, address.City) {
+		s.City = address.City
+	}
+
+	if s.State == "" {
+		s.State = address.State
+	}
+}
+
+func (s *Store) FormattedIdentification() string {
+	return FormatDocument(s.Identification)
 }
 
 func (s *Store) Validate() error {
@@ -23,6 +88,8 @@ func (s *Store) Validate() error {
 
 	if s.Identification == "" {
 		msgs = append(msgs, "store identification is required")
+	} else if DetectDocumentType(s.Identification) == "" {
+		msgs = append(msgs, "store identification is not a valid cpf or cnpj")
 	}
 
 	if s.Address == "" {
@@ -31,6 +98,12 @@ func (s *Store) Validate() error {
 
 	if s.Cep == "" {
 		msgs = append(msgs, "store cep is required")
+	} else if !ValidCep(s.Cep) {
+		msgs = append(msgs, "store cep is invalid")
+	} else if s.State != "" && !ValidState(s.State) {
+		msgs = append(msgs, "store state is invalid")
+	} else if s.State != "" && s.State != CepState(s.Cep) {
+		msgs = append(msgs, "store state does not match the cep")
 	}
 
 	if len(msgs) > 0 {
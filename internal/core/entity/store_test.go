@@ -8,11 +8,36 @@ import (
 )
 
 func TestCreateStore(t *testing.T) {
-	store := NewStore("Identification", "Address", "Cep")
+	store := NewStore("11.222.333/0001-81", "Address", "01310100")
 	assert.NotNil(t, store)
-	assert.Equal(t, store.Identification, "Identification")
+	assert.Equal(t, store.Identification, "11222333000181")
+	assert.Equal(t, store.DocumentType, DocumentTypeCnpj)
 	assert.Equal(t, store.Address, "Address")
-	assert.Equal(t, store.Cep, "Cep")
+	assert.Equal(t, store.Cep, "01310100")
+	assert.Equal(t, store.FormattedIdentification(), "11.222.333/0001-81")
+
+	store = NewStore("529.982.247-25", "Address", "01310100")
+	assert.Equal(t, store.Identification, "52998224725")
+	assert.Equal(t, store.DocumentType, DocumentTypeCpf)
+	assert.Equal(t, store.FormattedIdentification(), "529.982.247-25")
+
+	store = NewStore("Identification", "Address", "01310100")
+	assert.Equal(t, store.Identification, "Identification")
+	assert.Empty(t, store.DocumentType)
+	assert.Equal(t, store.FormattedIdentification(), "Identification")
+	assert.Empty(t, store.Id)
+}
+
+func TestCreateRegisteredStore(t *testing.T) {
+	store := NewRegisteredStore("11.222.333/0001-81", "Address", "01310-100")
+	assert.NotEmpty(t, store.Id)
+	assert.Equal(t, store.Identification, "11222333000181")
+	assert.Equal(t, store.Cep, "01310100")
+	assert.False(t, store.CreatedAt.IsZero())
+	assert.Equal(t, store.CreatedAt, store.UpdatedAt)
+
+	other := NewRegisteredStore("11.222.333/0001-81", "Address", "01310-100")
+	assert.NotEqual(t, store.Id, other.Id)
 }
 
 func TestStoreValidator(t *testing.T) {
@@ -27,19 +52,33 @@ func TestStoreValidator(t *testing.T) {
 			"identification is empty",
 			"",
 			"Address",
-			"Cep",
+			"01310-100",
 			errors.NewValidationError("store identification is required"),
 		},
 		{
-			"address is empty",
+			"identification is not a document",
 			"Identification",
+			"Address",
+			"01310-100",
+			errors.NewValidationError("store identification is not a valid cpf or cnpj"),
+		},
+		{
+			"identification has an invalid check digit",
+			"11.222.333/0001-82",
+			"Address",
+			"01310-100",
+			errors.NewValidationError("store identification is not a valid cpf or cnpj"),
+		},
+		{
+			"address is empty",
+			"11222333000181",
 			"",
-			"Cep",
+			"01310-100",
 			errors.NewValidationError("store address is required"),
 		},
 		{
 			"cep is empty",
-			"Identification",
+			"11222333000181",
 			"Address",
 			"",
 			errors.NewValidationError("store cep is required"),
@@ -56,10 +95,17 @@ func TestStoreValidator(t *testing.T) {
 			),
 		},
 		{
-			"all fields are valid",
-			"Identification",
+			"all fields are valid with a cnpj",
+			"11222333000181",
+			"Address",
+			"01310-100",
+			nil,
+		},
+		{
+			"all fields are valid with a cpf",
+			"529.982.247-25",
 			"Address",
-			"Cep",
+			"01310-100",
 			nil,
 		},
 	}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"errors"
+	"time"
 
 	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
 )
@@ -11,6 +12,12 @@ type Transaction struct {
 	Purchase *Purchase `json:"purchase"`
 	Store    *Store    `json:"store"`
 	Acquirer *Acquirer `json:"-"`
+
+	AuthorizeOnly bool   `json:"-"`
+	Route         *Route `json:"-"`
+
+	// Reference lets the attempt be reversed when the acquirer does not answer with its own id.
+	Reference string `json:"-"`
 }
 
 func NewTransaction(card *Card, purchase *Purchase, store *Store, acquirer *Acquirer) *Transaction {
@@ -22,6 +29,14 @@ func NewTransaction(card *Card, purchase *Purchase, store *Store, acquirer *Acqu
 	}
 }
 
+func (t *Transaction) Fallbacks() []string {
+	if t.Route == nil {
+		return nil
+	}
+
+	return t.Route.Fallbacks
+}
+
 func (t *Transaction) Validate() error {
 	msgs := make([]string, 0)
 
@@ -31,6 +46,8 @@ func (t *Transaction) Validate() error {
 		if errors.As(err, &v) {
 			msgs = append(msgs, v.Messages...)
 		}
+	} else if t.Card.Expired(time.Now().UTC()) {
+		msgs = append(msgs, "card is expired")
 	}
 
 	err = t.Purchase.Validate()
@@ -8,9 +8,9 @@ import (
 )
 
 func TestTransactionFactory(t *testing.T) {
-	card := NewCard("Token", "Holder", "Expiration", "Brand")
-	purchase := NewPurchase(9.99, []string{"Item 1", "Item 2"}, 3)
-	store := NewStore("Identification", "Address", "Cep")
+	card := NewCard("Token", "Holder", "12/2099", "Brand")
+	purchase := NewPurchase(NewMoney(999, "BRL"), []string{"Item 1", "Item 2"}, 3)
+	store := NewStore("11222333000181", "Address", "01310100")
 	acquirer := NewAcquirer("Acquirer")
 
 	transaction := NewTransaction(card, purchase, store, acquirer)
@@ -33,19 +33,35 @@ func TestTransactionValidator(t *testing.T) {
 		{
 			"card is invalid",
 			NewCard("Token", "Holder", "", ""),
-			NewPurchase(6.99, []string{"Item 1"}, 1),
-			NewStore("Identification", "Address", "Cep"),
+			NewPurchase(NewMoney(699, "BRL"), []string{"Item 1"}, 1),
+			NewStore("11222333000181", "Address", "01310100"),
 			NewAcquirer("Acquirer"),
 			errors.NewValidationError(
 				"card expiration is required",
 				"card brand is required",
 			),
 		},
+		{
+			"card is expired",
+			NewCard("Token", "Holder", "01/20", "Brand"),
+			NewPurchase(NewMoney(699, "BRL"), []string{"Item 1"}, 1),
+			NewStore("11222333000181", "Address", "01310100"),
+			NewAcquirer("Acquirer"),
+			errors.NewValidationError("card is expired"),
+		},
+		{
+			"card expiration is malformed",
+			NewCard("Token", "Holder", "2030-01", "Brand"),
+			NewPurchase(NewMoney(699, "BRL"), []string{"Item 1"}, 1),
+			NewStore("11222333000181", "Address", "01310100"),
+			NewAcquirer("Acquirer"),
+			errors.NewValidationError("card expiration is invalid"),
+		},
 		{
 			"purchase is invalid",
-			NewCard("Token", "Holder", "Expiration", "Brand"),
-			NewPurchase(0, []string{"Item 1"}, -1),
-			NewStore("Identification", "Address", "Cep"),
+			NewCard("Token", "Holder", "12/2099", "Brand"),
+			NewPurchase(NewMoney(0, "BRL"), []string{"Item 1"}, -1),
+			NewStore("11222333000181", "Address", "01310100"),
 			NewAcquirer("Acquirer"),
 			errors.NewValidationError(
 				"purchase value is invalid",
@@ -54,8 +70,8 @@ func TestTransactionValidator(t *testing.T) {
 		},
 		{
 			"store is invalid",
-			NewCard("Token", "Holder", "Expiration", "Brand"),
-			NewPurchase(6.99, []string{"Item 1"}, 1),
+			NewCard("Token", "Holder", "12/2099", "Brand"),
+			NewPurchase(NewMoney(699, "BRL"), []string{"Item 1"}, 1),
 			NewStore("", "", ""),
 			NewAcquirer("Acquirer"),
 			errors.NewValidationError(
@@ -66,17 +82,17 @@ func TestTransactionValidator(t *testing.T) {
 		},
 		{
 			"acquirer is invalid",
-			NewCard("Token", "Holder", "Expiration", "Brand"),
-			NewPurchase(6.99, []string{"Item 1"}, 1),
-			NewStore("Identification", "Address", "Cep"),
+			NewCard("Token", "Holder", "12/2099", "Brand"),
+			NewPurchase(NewMoney(699, "BRL"), []string{"Item 1"}, 1),
+			NewStore("11222333000181", "Address", "01310100"),
 			NewAcquirer(""),
 			errors.NewValidationError("acquirer name is required"),
 		},
 		{
 			"all fields are invalid",
 			NewCard("Token", "Holder", "", "Brand"),
-			NewPurchase(6.99, []string{}, 1),
-			NewStore("", "Address", "Cep"),
+			NewPurchase(NewMoney(699, "BRL"), []string{}, 1),
+			NewStore("", "Address", "01310100"),
 			NewAcquirer(""),
 			errors.NewValidationError(
 				"card expiration is required",
@@ -87,9 +103,9 @@ func TestTransactionValidator(t *testing.T) {
 		},
 		{
 			"all fields are valid",
-			NewCard("Token", "Holder", "Expiration", "Brand"),
-			NewPurchase(6.99, []string{"Item 1"}, 1),
-			NewStore("Identification", "Address", "Cep"),
+			NewCard("Token", "Holder", "12/2099", "Brand"),
+			NewPurchase(NewMoney(699, "BRL"), []string{"Item 1"}, 1),
+			NewStore("11222333000181", "Address", "01310100"),
 			NewAcquirer("Acquirer"),
 			nil,
 		},
@@ -0,0 +1,284 @@
+package entity
+
+import (
+	"encoding/json"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/google/uuid"
+)
+
+type WebhookEventType string
+
+const (
+	WebhookEventPaymentAuthorized WebhookEventType = "payment.authorized"
+	WebhookEventPaymentApproved   WebhookEventType = "payment.approved"
+	WebhookEventPaymentDeclined   WebhookEventType = "payment.declined"
+	WebhookEventPaymentFailed     WebhookEventType = "payment.failed"
+	WebhookEventPaymentReversed   WebhookEventType = "payment.reversed"
+	WebhookEventPaymentRefunded   WebhookEventType = "payment.refunded"
+	WebhookEventPaymentVoided     WebhookEventType = "payment.voided"
+)
+
+var WebhookEventTypes = []WebhookEventType{
+	WebhookEventPaymentAuthorized,
+	WebhookEventPaymentApproved,
+	WebhookEventPaymentDeclined,
+	WebhookEventPaymentFailed,
+	WebhookEventPaymentReversed,
+	WebhookEventPaymentRefunded,
+	WebhookEventPaymentVoided,
+}
+
+const WebhookSecretMinLength = 16
+
+type Webhook struct {
+	Id        string
+	Caller    string
+	Url       string
+	Events    []WebhookEventType
+	Secret    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewWebhook(caller string, url string, events []string, secret string) *Webhook {
+	now := time.Now().UTC()
+
+	webhook := &Webhook{
+		Id:        uuid.NewString(),
+		Caller:    caller,
+		CreatedAt: now,
+	}
+	webhook.Update(url, events, secret, now)
+
+	return webhook
+}
+
+func (w *Webhook) Update(url string, events []string, secret string, now time.Time) {
+	w.Url = strings.TrimSpace(url)
+	w.Events = make([]WebhookEventType, 0, len(events))
+	for _, event := range events {
+		event := WebhookEventType(strings.TrimSpace(event))
+		if !slices.Contains(w.Events, event) {
+			w.Events = append(w.Events, event)
+		}
+	}
+
+	if secret != "" {
+		w.Secret = secret
+	}
+
+	w.UpdatedAt = now
+}
+
+func (w *Webhook) Subscribed(event WebhookEventType) bool {
+	return slices.Contains(w.Events, event)
+}
+
+func (w *Webhook) Validate() error {
+	msgs := make([]string, 0)
+
+	if w.Caller == "" {
+		msgs = append(msgs, "webhook caller is required")
+	}
+
+	if w.Url == "" {
+		msgs = append(msgs, "webhook url is required")
+	} else if u, err := url.Parse(w.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		msgs = append(msgs, "webhook url is invalid")
+	} else if isInternalHost(u.Hostname()) {
+		msgs = append(msgs, "webhook url must not point to an internal address")
+	}
+
+	if len(w.Events) == 0 {
+		msgs = append(msgs, "webhook events are required")
+	}
+
+	for _, event := range w.Events {
+		if !slices.Contains(WebhookEventTypes, event) {
+			msgs = append(msgs, "webhook event "+string(event)+" is invalid")
+		}
+	}
+
+	if len(w.Secret) < WebhookSecretMinLength {
+		msgs = append(msgs, "webhook secret must have at least 16 characters")
+	}
+
+	if len(msgs) > 0 {
+		return errors.NewValidationError(msgs...)
+	}
+
+	return nil
+}
+
+// WebhookEvent keeps its id in every delivery and redelivery, so receivers can discard the
+// events already handled.
+type WebhookEvent struct {
+	Id        string           `json:"id"`
+	Type      WebhookEventType `json:"type"`
+	Caller    string           `json:"-"`
+	Payment   WebhookPayment   `json:"data"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+type WebhookPayment struct {
+	PaymentId       string `json:"payment_id"`
+	Status          string `json:"status"`
+	Amount          int64  `json:"amount"`
+	Currency        string `json:"currency"`
+	CapturedAmount  int64  `json:"captured_amount"`
+	RefundedAmount  int64  `json:"refunded_amount"`
+	StoreId         string `json:"store_id,omitempty"`
+	AcquirerName    string `json:"acquirer_name"`
+	AcquirerCode    int    `json:"acquirer_code"`
+	AcquirerMessage string `json:"acquirer_message"`
+}
+
+// NewWebhookEvent returns nil for the statuses that are not notified and the payments not
+// requested by a client. The event keeps the id of the domain event, so relaying it again does
+// not queue the deliveries twice.
+func NewWebhookEvent(event *DomainEvent) (*WebhookEvent, error) {
+	var snapshot PaymentSnapshot
+
+	err := json.Unmarshal(event.Payload, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.Caller == "" {
+		return nil, nil
+	}
+
+	var eventType WebhookEventType
+
+	switch PaymentStatus(snapshot.Status) {
+	case PaymentStatusAuthorized:
+		eventType = WebhookEventPaymentAuthorized
+	case PaymentStatusApproved:
+		eventType = WebhookEventPaymentApproved
+	case PaymentStatusDeclined:
+		eventType = WebhookEventPaymentDeclined
+	case PaymentStatusFailed:
+		eventType = WebhookEventPaymentFailed
+	case PaymentStatusReversed:
+		eventType = WebhookEventPaymentReversed
+	case PaymentStatusPartiallyRefunded, PaymentStatusRefunded:
+		eventType = WebhookEventPaymentRefunded
+	case PaymentStatusVoided:
+		eventType = WebhookEventPaymentVoided
+	default:
+		return nil, nil
+	}
+
+	return &WebhookEvent{
+		Id:     event.Id,
+		Type:   eventType,
+		Caller: snapshot.Caller,
+		Payment: WebhookPayment{
+			PaymentId:       snapshot.PaymentId,
+			Status:          snapshot.Status,
+			Amount:          snapshot.Amount,
+			Currency:        snapshot.Currency,
+			CapturedAmount:  snapshot.CapturedAmount,
+			RefundedAmount:  snapshot.RefundedAmount,
+			StoreId:         snapshot.StoreId,
+			AcquirerName:    snapshot.AcquirerName,
+			AcquirerCode:    snapshot.AcquirerCode,
+			AcquirerMessage: snapshot.AcquirerMessage,
+		},
+		CreatedAt: event.CreatedAt,
+	}, nil
+}
+
+func (e *WebhookEvent) Payload() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+const (
+	WebhookRetryDelay    = 10 * time.Second
+	WebhookMaxRetryDelay = time.Hour
+	WebhookMaxAttempts   = 15
+)
+
+type WebhookDelivery struct {
+	Id              string
+	WebhookId       string
+	EventId         string
+	EventType       WebhookEventType
+	PaymentId       string
+	Payload         []byte
+	Status          WebhookDeliveryStatus
+	Attempts        int
+	NextAttemptAt   time.Time
+	ResponseCode    int
+	ResponseMessage string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (d *WebhookDelivery) Deliver(code int, now time.Time) {
+	d.Status = WebhookDeliveryStatusDelivered
+	d.Attempts++
+	d.ResponseCode = code
+	d.ResponseMessage = ""
+	d.UpdatedAt = now
+}
+
+func (d *WebhookDelivery) Retry(code int, message string, now time.Time) {
+	delay := WebhookRetryDelay
+	for i := 0; i < d.Attempts && delay < WebhookMaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > WebhookMaxRetryDelay {
+		delay = WebhookMaxRetryDelay
+	}
+
+	d.Attempts++
+	d.ResponseCode = code
+	d.ResponseMessage = message
+	d.UpdatedAt = now
+
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = WebhookDeliveryStatusFailed
+		return
+	}
+
+	d.NextAttemptAt = now.Add(delay)
+}
+
+func (d *WebhookDelivery) Redeliver(now time.Time) {
+	d.Status = WebhookDeliveryStatusPending
+	d.Attempts = 0
+	d.NextAttemptAt = now
+	d.UpdatedAt = now
+}
+
+// isInternalHost only checks the literal host; names are checked again by the sender once resolved.
+func isInternalHost(host string) bool {
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return true
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	return !addr.IsGlobalUnicast() || addr.IsPrivate()
+}
@@ -0,0 +1,193 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	webhook := NewWebhook("Caller", " https://example.com/webhooks ", []string{"payment.approved", "payment.declined", "payment.approved"}, "a-secret-of-16-chars")
+	assert.NotEmpty(t, webhook.Id)
+	assert.Equal(t, "Caller", webhook.Caller)
+	assert.Equal(t, "https://example.com/webhooks", webhook.Url)
+	assert.Equal(t, []WebhookEventType{WebhookEventPaymentApproved, WebhookEventPaymentDeclined}, webhook.Events)
+	assert.Equal(t, "a-secret-of-16-chars", webhook.Secret)
+	assert.Equal(t, webhook.CreatedAt, webhook.UpdatedAt)
+	assert.True(t, webhook.Subscribed(WebhookEventPaymentApproved))
+	assert.False(t, webhook.Subscribed(WebhookEventPaymentRefunded))
+	assert.Nil(t, webhook.Validate())
+
+	now := webhook.CreatedAt.Add(time.Minute)
+	webhook.Update("https://example.com/payments", []string{"payment.refunded"}, "", now)
+	assert.Equal(t, "https://example.com/payments", webhook.Url)
+	assert.Equal(t, []WebhookEventType{WebhookEventPaymentRefunded}, webhook.Events)
+	assert.Equal(t, "a-secret-of-16-chars", webhook.Secret)
+	assert.Equal(t, now, webhook.UpdatedAt)
+}
+
+func TestValidateWebhook(t *testing.T) {
+	testCases := []struct {
+		Webhook *Webhook
+		Error   error
+	}{
+		{
+			NewWebhook("", "", nil, ""),
+			errors.NewValidationError(
+				"webhook caller is required",
+				"webhook url is required",
+				"webhook events are required",
+				"webhook secret must have at least 16 characters",
+			),
+		},
+		{
+			NewWebhook("Caller", "ftp://example.com", []string{"payment.approved"}, "a-secret-of-16-chars"),
+			errors.NewValidationError("webhook url is invalid"),
+		},
+		{
+			NewWebhook("Caller", "https://", []string{"payment.approved"}, "a-secret-of-16-chars"),
+			errors.NewValidationError("webhook url is invalid"),
+		},
+		{
+			NewWebhook("Caller", "http://localhost:8080/events", []string{"payment.approved"}, "a-secret-of-16-chars"),
+			errors.NewValidationError("webhook url must not point to an internal address"),
+		},
+		{
+			NewWebhook("Caller", "http://169.254.169.254/latest", []string{"payment.approved"}, "a-secret-of-16-chars"),
+			errors.NewValidationError("webhook url must not point to an internal address"),
+		},
+		{
+			NewWebhook("Caller", "https://[::ffff:10.0.0.1]", []string{"payment.approved"}, "a-secret-of-16-chars"),
+			errors.NewValidationError("webhook url must not point to an internal address"),
+		},
+		{
+			NewWebhook("Caller", "https://example.com", []string{"payment.created"}, "a-secret-of-16-chars"),
+			errors.NewValidationError("webhook event payment.created is invalid"),
+		},
+		{
+			NewWebhook("Caller", "https://example.com", []string{"payment.approved"}, "short"),
+			errors.NewValidationError("webhook secret must have at least 16 characters"),
+		},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.Error, tc.Webhook.Validate())
+	}
+}
+
+func TestPaymentEvent(t *testing.T) {
+	testCases := []struct {
+		Status PaymentStatus
+		Event  WebhookEventType
+	}{
+		{PaymentStatusAuthorized, WebhookEventPaymentAuthorized},
+		{PaymentStatusApproved, WebhookEventPaymentApproved},
+		{PaymentStatusDeclined, WebhookEventPaymentDeclined},
+		{PaymentStatusFailed, WebhookEventPaymentFailed},
+		{PaymentStatusReversed, WebhookEventPaymentReversed},
+		{PaymentStatusPartiallyRefunded, WebhookEventPaymentRefunded},
+		{PaymentStatusRefunded, WebhookEventPaymentRefunded},
+		{PaymentStatusVoided, WebhookEventPaymentVoided},
+		{PaymentStatusPending, ""},
+		{PaymentStatusUnknown, ""},
+	}
+
+	for _, tc := range testCases {
+		payment := NewPayment(createTestTransaction())
+		payment.Status = tc.Status
+		payment.Caller = "Caller"
+
+		domainEvent, err := NewPaymentChangedEvent(payment)
+		require.Nil(t, err)
+
+		event, err := NewWebhookEvent(domainEvent)
+		require.Nil(t, err)
+		if tc.Event == "" {
+			assert.Nil(t, event)
+			continue
+		}
+
+		require.NotNil(t, event)
+		assert.Equal(t, domainEvent.Id, event.Id)
+		assert.Equal(t, tc.Event, event.Type)
+		assert.Equal(t, "Caller", event.Caller)
+		assert.Equal(t, payment.Id, event.Payment.PaymentId)
+		assert.Equal(t, string(tc.Status), event.Payment.Status)
+	}
+}
+
+func TestPaymentEventPayload(t *testing.T) {
+	payment := NewPayment(createTestTransaction())
+	payment.Transaction.Store.Id = "Store Id"
+	payment.Caller = "Caller"
+	payment.Approve(NewAcquirerResponse("Acquirer Id", 200, "Approved"))
+
+	domainEvent, err := NewPaymentChangedEvent(payment)
+	require.Nil(t, err)
+
+	event, err := NewWebhookEvent(domainEvent)
+	require.Nil(t, err)
+	payload, err := event.Payload()
+	require.Nil(t, err)
+
+	var data map[string]any
+	err = json.Unmarshal(payload, &data)
+	require.Nil(t, err)
+
+	assert.Equal(t, event.Id, data["id"])
+	assert.Equal(t, "payment.approved", data["type"])
+	assert.NotContains(t, data, "Caller")
+	assert.Equal(t, map[string]any{
+		"payment_id":       payment.Id,
+		"status":           "approved",
+		"amount":           float64(999),
+		"currency":         "BRL",
+		"captured_amount":  float64(999),
+		"refunded_amount":  float64(0),
+		"store_id":         "Store Id",
+		"acquirer_name":    "Acquirer",
+		"acquirer_code":    float64(200),
+		"acquirer_message": "Approved",
+	}, data["data"])
+}
+
+func TestWebhookDeliveryRetries(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	delivery := &WebhookDelivery{Status: WebhookDeliveryStatusPending, NextAttemptAt: now}
+
+	delivery.Retry(500, "webhook answered with status 500", now)
+	assert.Equal(t, WebhookDeliveryStatusPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, now.Add(WebhookRetryDelay), delivery.NextAttemptAt)
+	assert.Equal(t, 500, delivery.ResponseCode)
+	assert.Equal(t, "webhook answered with status 500", delivery.ResponseMessage)
+
+	delivery.Retry(0, "connection refused", now)
+	assert.Equal(t, now.Add(2*WebhookRetryDelay), delivery.NextAttemptAt)
+
+	delivery.Attempts = WebhookMaxAttempts - 2
+	delivery.Retry(0, "connection refused", now)
+	assert.Equal(t, WebhookDeliveryStatusPending, delivery.Status)
+	assert.Equal(t, now.Add(WebhookMaxRetryDelay), delivery.NextAttemptAt)
+
+	delivery.Retry(0, "connection refused", now)
+	assert.Equal(t, WebhookDeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, WebhookMaxAttempts, delivery.Attempts)
+
+	later := now.Add(time.Hour)
+	delivery.Redeliver(later)
+	assert.Equal(t, WebhookDeliveryStatusPending, delivery.Status)
+	assert.Equal(t, 0, delivery.Attempts)
+	assert.Equal(t, later, delivery.NextAttemptAt)
+
+	delivery.Deliver(204, later)
+	assert.Equal(t, WebhookDeliveryStatusDelivered, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, 204, delivery.ResponseCode)
+	assert.Empty(t, delivery.ResponseMessage)
+}
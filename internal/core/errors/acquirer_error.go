@@ -15,3 +15,8 @@ func NewAcquirerError(code int, message string) *AcquirerError {
 func (e *AcquirerError) Error() string {
 	return e.Message
 }
+
+// Temporary reports whether the acquirer failed technically instead of declining the transaction.
+func (e *AcquirerError) Temporary() bool {
+	return e.Code >= 500
+}
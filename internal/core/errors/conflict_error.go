@@ -0,0 +1,15 @@
+package errors
+
+type ConflictError struct {
+	Message string
+}
+
+func NewConflictError(message string) *ConflictError {
+	return &ConflictError{
+		Message: message,
+	}
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
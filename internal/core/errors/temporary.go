@@ -0,0 +1,23 @@
+package errors
+
+import (
+	stderrors "errors"
+)
+
+// IsTemporary reports whether a transaction may be sent to another acquirer after err. A
+// canceled request or a response that could not be read may follow a transaction the acquirer
+// processed, so they are not temporary.
+func IsTemporary(err error) bool {
+	var acquirerErr *AcquirerError
+	if stderrors.As(err, &acquirerErr) {
+		return acquirerErr.Temporary()
+	}
+
+	var timeoutErr *TimeoutError
+	if stderrors.As(err, &timeoutErr) {
+		return true
+	}
+
+	var unavailableErr *UnavailableError
+	return stderrors.As(err, &unavailableErr)
+}
@@ -0,0 +1,17 @@
+package errors
+
+// TimeoutError means the acquirer did not answer in time, so the outcome of the transaction
+// is not known, unlike a decline.
+type TimeoutError struct {
+	Message string
+}
+
+func NewTimeoutError(message string) *TimeoutError {
+	return &TimeoutError{
+		Message: message,
+	}
+}
+
+func (e *TimeoutError) Error() string {
+	return e.Message
+}
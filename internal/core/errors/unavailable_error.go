@@ -0,0 +1,21 @@
+package errors
+
+// UnavailableError means the transaction was not sent to the acquirer, because its connection
+// could not be established or its circuit breaker is open, so the acquirer did not process it.
+type UnavailableError struct {
+	err error
+}
+
+func NewUnavailableError(err error) *UnavailableError {
+	return &UnavailableError{
+		err: err,
+	}
+}
+
+func (e *UnavailableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *UnavailableError) Unwrap() error {
+	return e.err
+}
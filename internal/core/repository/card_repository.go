@@ -2,10 +2,16 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
 )
 
 type ICardRepository interface {
+	CreateCard(ctx context.Context, card *entity.Card) error
+	DeleteCard(ctx context.Context, cardToken string) error
 	FindCard(ctx context.Context, cardToken string) (*entity.Card, error)
+	FindCardNumber(ctx context.Context, cardToken string) (string, error)
+	FindExpiringCards(ctx context.Context, from time.Time, until time.Time) ([]*entity.Card, error)
+	ReencryptCards(ctx context.Context, limit int) (int, error)
 }
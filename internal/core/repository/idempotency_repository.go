@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IIdempotencyRepository interface {
+	CreateIdempotency(ctx context.Context, idempotency *entity.Idempotency) error
+	UpdateIdempotency(ctx context.Context, idempotency *entity.Idempotency) error
+	DeleteIdempotency(ctx context.Context, caller string, key string) error
+	FindIdempotency(ctx context.Context, caller string, key string) (*entity.Idempotency, error)
+}
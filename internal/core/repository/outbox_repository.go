@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+// IOutboxRepository reads the domain events recorded along with the payment changes.
+type IOutboxRepository interface {
+	LockOutbox(ctx context.Context) (func(), error)
+	FindPendingEvents(ctx context.Context, limit int) ([]*entity.DomainEvent, error)
+	UpdateEvent(ctx context.Context, event *entity.DomainEvent) error
+}
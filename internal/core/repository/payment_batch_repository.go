@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IPaymentBatchRepository interface {
+	CreateBatch(ctx context.Context, batch *entity.PaymentBatch) error
+	FindBatch(ctx context.Context, batchId string) (*entity.PaymentBatch, error)
+
+	// ClaimBatches reserves to the caller, for entity.PaymentBatchLease, the queued batches and
+	// the ones whose lease expired, skipping the batches being claimed by other workers.
+	ClaimBatches(ctx context.Context, now time.Time, limit int) ([]*entity.PaymentBatch, error)
+	UpdateBatch(ctx context.Context, batch *entity.PaymentBatch) error
+	UpdateBatchItem(ctx context.Context, batchId string, item *entity.PaymentBatchItem) error
+}
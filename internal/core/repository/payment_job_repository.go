@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IPaymentJobRepository interface {
+	// ClaimJobs reserves to the caller, for entity.PaymentJobLease, the queued jobs and the ones
+	// whose lease expired, skipping the jobs being claimed by other workers.
+	ClaimJobs(ctx context.Context, now time.Time, limit int) ([]*entity.PaymentJob, error)
+	UpdateJob(ctx context.Context, job *entity.PaymentJob) error
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type PaymentSort string
+
+const (
+	PaymentSortCreatedAt PaymentSort = "created_at"
+	PaymentSortAmount    PaymentSort = "amount"
+)
+
+// PaymentSearch only finds the payments of StoreIds, even when it is empty.
+type PaymentSearch struct {
+	StoreIds     []string
+	AcquirerName string
+	Statuses     []entity.PaymentStatus
+	CardLast4    string
+	MinAmount    int64
+	MaxAmount    int64
+	CreatedFrom  time.Time
+	CreatedTo    time.Time
+	Sort         PaymentSort
+	Descending   bool
+
+	// After continues the search after the payment with this id, whose creation time or
+	// purchase amount, as sorted, is AfterCreatedAt or AfterAmount.
+	After          string
+	AfterCreatedAt time.Time
+	AfterAmount    int64
+	Limit          int
+}
+
+type IPaymentRepository interface {
+	CreatePayment(ctx context.Context, payment *entity.Payment) error
+	CreateQueuedPayment(ctx context.Context, payment *entity.Payment) error
+	UpdatePayment(ctx context.Context, payment *entity.Payment) error
+
+	UpdatePaymentFrom(ctx context.Context, payment *entity.Payment, fromStatus entity.PaymentStatus) error
+
+	StartCapture(ctx context.Context, paymentId string) error
+	CancelCapture(ctx context.Context, paymentId string) error
+	FindPayment(ctx context.Context, paymentId string) (*entity.Payment, error)
+	CreatePaymentAttempt(ctx context.Context, attempt *entity.PaymentAttempt) error
+	UpdatePaymentAttempt(ctx context.Context, attempt *entity.PaymentAttempt) error
+
+	SearchPayments(ctx context.Context, search *PaymentSearch) ([]*entity.Payment, error)
+}
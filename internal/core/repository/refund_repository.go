@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IRefundRepository interface {
+	CreateRefund(ctx context.Context, refund *entity.Refund) error
+	UpdateRefund(ctx context.Context, refund *entity.Refund) error
+	FindRefunds(ctx context.Context, paymentId string) ([]*entity.Refund, error)
+}
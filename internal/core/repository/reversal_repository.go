@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IReversalRepository interface {
+	CreateReversal(ctx context.Context, reversal *entity.Reversal) error
+	UpdateReversal(ctx context.Context, reversal *entity.Reversal) error
+	FindReversals(ctx context.Context, paymentId string) ([]*entity.Reversal, error)
+	FindDueReversals(ctx context.Context, now time.Time, limit int) ([]*entity.Reversal, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type ISettlementRepository interface {
+	CreateSettlement(ctx context.Context, settlement *entity.Settlement) error
+	FindSettlement(ctx context.Context, settlementId string) (*entity.Settlement, error)
+
+	// FindSettlementPayments returns the payments of the acquirer with one of the acquirer ids
+	// or created in [from, to), to be reconciled with its settlement file.
+	FindSettlementPayments(ctx context.Context, acquirer string, acquirerIds []string, from time.Time, to time.Time) ([]*entity.Payment, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IStoreRepository interface {
+	CreateStore(ctx context.Context, store *entity.Store) error
+	UpdateStore(ctx context.Context, store *entity.Store) error
+	DeleteStore(ctx context.Context, storeId string) error
+	FindStore(ctx context.Context, storeId string) (*entity.Store, error)
+	FindStores(ctx context.Context) ([]*entity.Store, error)
+}
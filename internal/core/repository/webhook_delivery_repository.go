@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IWebhookDeliveryRepository interface {
+	CreateDeliveries(ctx context.Context, event *entity.WebhookEvent) error
+	UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+	FindDelivery(ctx context.Context, deliveryId string) (*entity.WebhookDelivery, error)
+	FindDeliveries(ctx context.Context, webhookId string, limit int) ([]*entity.WebhookDelivery, error)
+	FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*entity.WebhookDelivery, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IWebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *entity.Webhook) error
+	UpdateWebhook(ctx context.Context, webhook *entity.Webhook) error
+	DeleteWebhook(ctx context.Context, webhookId string) error
+	FindWebhook(ctx context.Context, webhookId string) (*entity.Webhook, error)
+	FindWebhooks(ctx context.Context, caller string) ([]*entity.Webhook, error)
+}
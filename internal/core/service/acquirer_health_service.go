@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IAcquirerHealthService interface {
+	IsAvailable(acquirer string) bool
+	AcquirerHealth(ctx context.Context) []*entity.AcquirerHealth
+}
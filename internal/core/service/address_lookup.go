@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IAddressLookup interface {
+	// LookupAddress returns the address of the normalized CEP, or nil when it is unknown.
+	LookupAddress(ctx context.Context, cep string) (*entity.Address, error)
+}
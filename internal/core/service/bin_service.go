@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IBinService interface {
+	// FindBin returns the bin range of the card number, or nil when the bin is unknown.
+	FindBin(ctx context.Context, number string) (*entity.BinRange, error)
+}
@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IEventPublisher interface {
+	// Publish hands the event to the downstream services. An event may be published more than
+	// once, so the receivers discard the ids already handled.
+	Publish(ctx context.Context, event *entity.DomainEvent) error
+}
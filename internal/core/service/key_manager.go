@@ -0,0 +1,10 @@
+package service
+
+// IKeyManager encrypts data with versioned data keys. The version returned on encryption must
+// be stored with the ciphertext, so it can be decrypted after the keys are rotated.
+type IKeyManager interface {
+	CurrentVersion() int
+	Encrypt(plaintext []byte) ([]byte, int, error)
+	Decrypt(ciphertext []byte, version int) ([]byte, error)
+	Rotate() (int, error)
+}
@@ -7,5 +7,8 @@ import (
 )
 
 type IPaymentService interface {
-	ProcessTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.Payment, error)
+	ProcessTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.AcquirerResponse, error)
+	CaptureTransaction(ctx context.Context, payment *entity.Payment, value entity.Money) (*entity.AcquirerResponse, error)
+	RefundTransaction(ctx context.Context, payment *entity.Payment, refund *entity.Refund) (*entity.AcquirerResponse, error)
+	ReverseTransaction(ctx context.Context, reversal *entity.Reversal) (*entity.AcquirerResponse, error)
 }
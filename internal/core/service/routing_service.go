@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IRoutingService interface {
+	Route(ctx context.Context, transaction *entity.Transaction) (*entity.Route, error)
+	Explain(ctx context.Context, transaction *entity.Transaction) *entity.Route
+}
@@ -0,0 +1,13 @@
+package service
+
+import (
+	"io"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type ISettlementParser interface {
+	// Parse reads the lines of a settlement file of the acquirer. A file that is malformed or
+	// of another acquirer fails with a validation error telling its invalid lines.
+	Parse(format entity.SettlementFormat, acquirer string, r io.Reader) ([]*entity.SettlementLine, error)
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type submissionKey struct{}
+
+type submission struct {
+	submitted atomic.Bool
+	parent    *submission
+}
+
+// WithSubmission records whether a transaction was sent to an acquirer with the context, which
+// tells the callers if a failed request may have had effects. A submission within another one
+// marks both.
+func WithSubmission(ctx context.Context) context.Context {
+	parent, _ := ctx.Value(submissionKey{}).(*submission)
+	return context.WithValue(ctx, submissionKey{}, &submission{parent: parent})
+}
+
+// MarkSubmitted is called by the payment services before each request.
+func MarkSubmitted(ctx context.Context) {
+	s, _ := ctx.Value(submissionKey{}).(*submission)
+	for ; s != nil; s = s.parent {
+		s.submitted.Store(true)
+	}
+}
+
+func Submitted(ctx context.Context) bool {
+	s, ok := ctx.Value(submissionKey{}).(*submission)
+	return ok && s.submitted.Load()
+}
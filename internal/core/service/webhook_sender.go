@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type IWebhookSender interface {
+	// Send posts the delivery to the webhook signed with its secret, returning the status code
+	// of the answer. Answers out of the 2xx range are returned as errors along with their code.
+	Send(ctx context.Context, webhook *entity.Webhook, delivery *entity.WebhookDelivery) (int, error)
+}
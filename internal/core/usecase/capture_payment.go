@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+type CapturePaymentInput struct {
+	AllowedStores []string
+	PaymentId     string
+	CaptureAmount int64
+
+	// An empty currency accepts any, and the amount is in the currency of the payment.
+	Currency string
+}
+
+type CapturePaymentOutput struct {
+	PaymentId      string
+	PaymentStatus  string
+	CapturedAmount int64
+	Currency       string
+}
+
+type ICapturePayment interface {
+	Execute(ctx context.Context, input *CapturePaymentInput) (*CapturePaymentOutput, error)
+}
+
+type CapturePayment struct {
+	paymentRepository repository.IPaymentRepository
+	paymentService    service.IPaymentService
+}
+
+func NewCapturePayment(
+	paymentRepository repository.IPaymentRepository,
+	paymentService service.IPaymentService,
+) *CapturePayment {
+	return &CapturePayment{
+		paymentRepository: paymentRepository,
+		paymentService:    paymentService,
+	}
+}
+
+// Execute reserves the payment before it is sent to the acquirer, so a capture that loses a race
+// with another capture or a void is rejected without being sent.
+func (c *CapturePayment) Execute(ctx context.Context, input *CapturePaymentInput) (*CapturePaymentOutput, error) {
+	payment, err := findStorePayment(ctx, c.paymentRepository, input.AllowedStores, input.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	captureValue := payment.Transaction.Purchase.Value
+	if input.Currency != "" && input.Currency != captureValue.Currency {
+		return nil, core_errors.NewValidationError(fmt.Sprintf("payment currency must be %s", input.Currency))
+	}
+
+	if input.CaptureAmount != 0 {
+		captureValue = entity.NewMoney(input.CaptureAmount, captureValue.Currency)
+	}
+
+	err = payment.CanCapture(captureValue)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.paymentRepository.StartCapture(ctx, payment.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the outcome is recorded even when the request expired meanwhile
+	recordCtx := context.WithoutCancel(ctx)
+
+	_, err = c.paymentService.CaptureTransaction(ctx, payment, captureValue)
+	if err != nil {
+		cancelErr := c.paymentRepository.CancelCapture(recordCtx, payment.Id)
+		if cancelErr != nil {
+			slog.Error(cancelErr.Error(), "payment", payment.Id)
+		}
+
+		return nil, err
+	}
+
+	payment.Capture(captureValue)
+
+	err = c.paymentRepository.UpdatePaymentFrom(recordCtx, payment, entity.PaymentStatusCapturing)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &CapturePaymentOutput{
+		PaymentId:      payment.Id,
+		PaymentStatus:  string(payment.Status),
+		CapturedAmount: payment.CapturedValue.Amount,
+		Currency:       payment.CapturedValue.Currency,
+	}
+
+	return output, nil
+}
@@ -0,0 +1,291 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapturePaymentWithFullCapture(t *testing.T) {
+	ctx := context.Background()
+	payment := createAuthorizedPayment(1000)
+	payment.Caller = "Caller"
+
+	input := CapturePaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		StartCapture(ctx, payment.Id).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentFrom(mock.Anything, payment, entity.PaymentStatusCapturing).
+		Run(func(ctx context.Context, payment *entity.Payment, fromStatus entity.PaymentStatus) {
+			assert.Equal(t, entity.PaymentStatusApproved, payment.Status)
+			assert.Equal(t, entity.NewMoney(1000, "BRL"), payment.CapturedValue)
+		}).
+		Return(nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		CaptureTransaction(ctx, payment, entity.NewMoney(1000, "BRL")).
+		Return(entity.NewAcquirerResponse("Capture Id", 200, "Capture Id"), nil).
+		Once()
+
+	capturePayment := NewCapturePayment(paymentRepository, paymentService)
+
+	output, err := capturePayment.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, payment.Id, output.PaymentId)
+	assert.Equal(t, "approved", output.PaymentStatus)
+	assert.Equal(t, int64(1000), output.CapturedAmount)
+	assert.Equal(t, "BRL", output.Currency)
+}
+
+func TestCapturePaymentWithPartialCapture(t *testing.T) {
+	ctx := context.Background()
+	payment := createAuthorizedPayment(1000)
+
+	input := CapturePaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+		CaptureAmount: 450,
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		StartCapture(ctx, payment.Id).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentFrom(mock.Anything, payment, entity.PaymentStatusCapturing).
+		Return(nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		CaptureTransaction(ctx, payment, entity.NewMoney(450, "BRL")).
+		Return(entity.NewAcquirerResponse("Capture Id", 200, "Capture Id"), nil).
+		Once()
+
+	capturePayment := NewCapturePayment(paymentRepository, paymentService)
+
+	output, err := capturePayment.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, int64(450), output.CapturedAmount)
+	assert.Equal(t, entity.NewMoney(450, "BRL"), payment.RefundableValue())
+}
+
+func TestCapturePaymentWithConcurrentCapture(t *testing.T) {
+	ctx := context.Background()
+	payment := createAuthorizedPayment(1000)
+
+	input := CapturePaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		StartCapture(ctx, payment.Id).
+		Return(core_errors.NewConflictError("payment status has changed")).
+		Once()
+
+	// the payment is not sent to the acquirer
+	capturePayment := NewCapturePayment(paymentRepository, service.NewIPaymentServiceMock(t))
+
+	output, err := capturePayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var e *core_errors.ConflictError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, "payment status has changed", e.Message)
+}
+
+func TestCapturePaymentWithAnotherCurrency(t *testing.T) {
+	ctx := context.Background()
+	payment := createAuthorizedPayment(1000)
+	payment.Transaction.Purchase.Value = entity.NewMoney(1000, "USD")
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+
+	capturePayment := NewCapturePayment(paymentRepository, service.NewIPaymentServiceMock(t))
+
+	output, err := capturePayment.Execute(ctx, &CapturePaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+		CaptureAmount: 450,
+		Currency:      "BRL",
+	})
+	assert.Nil(t, output)
+	assert.Equal(t, core_errors.NewValidationError("payment currency must be BRL"), err)
+}
+
+func TestCapturePaymentWithApprovedPayment(t *testing.T) {
+	ctx := context.Background()
+	payment := createApprovedPayment(1000)
+
+	input := CapturePaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	capturePayment := NewCapturePayment(paymentRepository, paymentService)
+
+	output, err := capturePayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.ValidationError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, []string{"payment cannot be captured"}, w.Messages)
+}
+
+func TestCapturePaymentWithAcquirerError(t *testing.T) {
+	ctx := context.Background()
+	payment := createAuthorizedPayment(1000)
+
+	input := CapturePaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		StartCapture(ctx, payment.Id).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		CancelCapture(mock.Anything, payment.Id).
+		Return(nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		CaptureTransaction(ctx, payment, entity.NewMoney(1000, "BRL")).
+		Return(nil, core_errors.NewAcquirerError(422, "the transaction was voided")).
+		Once()
+
+	capturePayment := NewCapturePayment(paymentRepository, paymentService)
+
+	output, err := capturePayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+	assert.Equal(t, entity.PaymentStatusAuthorized, payment.Status)
+
+	var w *core_errors.AcquirerError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, 422, w.Code)
+}
+
+func TestCapturePaymentWithInvalidPaymentId(t *testing.T) {
+	ctx := context.Background()
+
+	input := CapturePaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     "an invalid id",
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentService := service.NewIPaymentServiceMock(t)
+	capturePayment := NewCapturePayment(paymentRepository, paymentService)
+
+	output, err := capturePayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.NotFoundError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, "payment id is invalid", w.Message)
+}
+
+func TestCapturePaymentOfAnotherStore(t *testing.T) {
+	ctx := context.Background()
+	payment := createAuthorizedPayment(1000)
+
+	input := CapturePaymentInput{
+		AllowedStores: []string{"e4b3f4c0-6d7c-4f5e-9b54-3b1c1a7d2f10"},
+		PaymentId:     payment.Id,
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+
+	// the payment is neither captured on the acquirer nor updated
+	capturePayment := NewCapturePayment(paymentRepository, service.NewIPaymentServiceMock(t))
+
+	output, err := capturePayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.NotFoundError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, "payment id is invalid", w.Message)
+}
+
+func createAuthorizedPayment(amount int64) *entity.Payment {
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+	purchase := entity.NewPurchase(entity.NewMoney(amount, "BRL"), []string{"Item 1", "Item 2"}, 2)
+	store := entity.NewStore("11222333000181", "Address", "01310100")
+	store.Id = testStoreId
+	acquirer := entity.NewAcquirer("Acquirer")
+
+	payment := entity.NewPayment(entity.NewTransaction(card, purchase, store, acquirer))
+	payment.Authorize(entity.NewAcquirerResponse("Acquirer Id", 200, "Acquirer Id"))
+	return payment
+}
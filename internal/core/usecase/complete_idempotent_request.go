@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type CompleteIdempotentRequestInput struct {
+	Caller       string
+	Key          string
+	RequestHash  string
+	ResponseCode int
+	ResponseBody []byte
+
+	Submitted bool
+}
+
+type ICompleteIdempotentRequest interface {
+	Execute(ctx context.Context, input *CompleteIdempotentRequestInput) error
+}
+
+type CompleteIdempotentRequest struct {
+	idempotencyRepository repository.IIdempotencyRepository
+}
+
+func NewCompleteIdempotentRequest(idempotencyRepository repository.IIdempotencyRepository) *CompleteIdempotentRequest {
+	return &CompleteIdempotentRequest{
+		idempotencyRepository: idempotencyRepository,
+	}
+}
+
+// Execute releases the key of a server error so the client is able to retry the request, unless
+// a transaction was sent to an acquirer, which a retry could charge again.
+func (c *CompleteIdempotentRequest) Execute(ctx context.Context, input *CompleteIdempotentRequestInput) error {
+	if input.ResponseCode >= 500 && !input.Submitted {
+		return c.idempotencyRepository.DeleteIdempotency(ctx, input.Caller, input.Key)
+	}
+
+	idempotency := entity.NewIdempotency(input.Caller, input.Key, input.RequestHash)
+	idempotency.Complete(input.ResponseCode, input.ResponseBody)
+
+	return c.idempotencyRepository.UpdateIdempotency(ctx, idempotency)
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCompleteIdempotentRequestWithSuccessfulResponse(t *testing.T) {
+	ctx := context.Background()
+
+	input := CompleteIdempotentRequestInput{
+		Caller:       "Caller",
+		Key:          "Key",
+		RequestHash:  "Hash",
+		ResponseCode: 200,
+		ResponseBody: []byte("Body"),
+	}
+
+	idempotencyRepository := repository.NewIIdempotencyRepositoryMock(t)
+	idempotencyRepository.
+		EXPECT().
+		UpdateIdempotency(ctx, mock.Anything).
+		Run(func(ctx context.Context, idempotency *entity.Idempotency) {
+			assert.Equal(t, input.Caller, idempotency.Caller)
+			assert.Equal(t, input.Key, idempotency.Key)
+			assert.Equal(t, entity.IdempotencyStatusCompleted, idempotency.Status)
+			assert.Equal(t, input.ResponseCode, idempotency.ResponseCode)
+			assert.Equal(t, input.ResponseBody, idempotency.ResponseBody)
+		}).
+		Return(nil).
+		Once()
+
+	completeIdempotentRequest := NewCompleteIdempotentRequest(idempotencyRepository)
+
+	err := completeIdempotentRequest.Execute(ctx, &input)
+	assert.Nil(t, err)
+}
+
+func TestCompleteIdempotentRequestWithServerError(t *testing.T) {
+	ctx := context.Background()
+
+	input := CompleteIdempotentRequestInput{
+		Caller:       "Caller",
+		Key:          "Key",
+		RequestHash:  "Hash",
+		ResponseCode: 500,
+		ResponseBody: []byte("Body"),
+	}
+
+	idempotencyRepository := repository.NewIIdempotencyRepositoryMock(t)
+	idempotencyRepository.
+		EXPECT().
+		DeleteIdempotency(ctx, input.Caller, input.Key).
+		Return(nil).
+		Once()
+
+	completeIdempotentRequest := NewCompleteIdempotentRequest(idempotencyRepository)
+
+	err := completeIdempotentRequest.Execute(ctx, &input)
+	assert.Nil(t, err)
+}
+
+func TestCompleteIdempotentRequestWithServerErrorAfterSubmission(t *testing.T) {
+	ctx := context.Background()
+
+	input := CompleteIdempotentRequestInput{
+		Caller:       "Caller",
+		Key:          "Key",
+		RequestHash:  "Hash",
+		ResponseCode: 504,
+		ResponseBody: []byte("Body"),
+		Submitted:    true,
+	}
+
+	idempotencyRepository := repository.NewIIdempotencyRepositoryMock(t)
+	idempotencyRepository.
+		EXPECT().
+		UpdateIdempotency(ctx, mock.Anything).
+		Run(func(ctx context.Context, idempotency *entity.Idempotency) {
+			assert.Equal(t, entity.IdempotencyStatusCompleted, idempotency.Status)
+			assert.Equal(t, input.ResponseCode, idempotency.ResponseCode)
+			assert.Equal(t, input.ResponseBody, idempotency.ResponseBody)
+		}).
+		Return(nil).
+		Once()
+
+	completeIdempotentRequest := NewCompleteIdempotentRequest(idempotencyRepository)
+
+	err := completeIdempotentRequest.Execute(ctx, &input)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type PaymentBatchTransactionInput struct {
+	CardToken            string
+	PurchaseAmount       int64
+	PurchaseCurrency     string
+	PurchaseItems        []string
+	PurchaseInstallments int
+	StoreId              string
+	AcquirerName         string
+	AuthorizeOnly        bool
+
+	// Invalid transactions are failed without being processed.
+	Invalid []string
+}
+
+type CreatePaymentBatchInput struct {
+	Caller        string
+	AllowedStores []string
+	Transactions  []*PaymentBatchTransactionInput
+}
+
+type CreatePaymentBatchOutput struct {
+	Batch *PaymentBatchOutput
+}
+
+type ICreatePaymentBatch interface {
+	Execute(ctx context.Context, input *CreatePaymentBatchInput) (*CreatePaymentBatchOutput, error)
+}
+
+type CreatePaymentBatch struct {
+	paymentBatchProcessor
+}
+
+func NewCreatePaymentBatch(
+	batchRepository repository.IPaymentBatchRepository,
+	processPayment *ProcessPayment,
+) *CreatePaymentBatch {
+	return &CreatePaymentBatch{
+		paymentBatchProcessor: paymentBatchProcessor{
+			batchRepository: batchRepository,
+			processPayment:  processPayment,
+		},
+	}
+}
+
+// Execute processes a batch of up to PaymentBatchSyncLimit transactions right away, and queues
+// a larger one to be processed in the background.
+func (c *CreatePaymentBatch) Execute(ctx context.Context, input *CreatePaymentBatchInput) (*CreatePaymentBatchOutput, error) {
+	if len(input.Transactions) == 0 {
+		return nil, core_errors.NewValidationError("batch transactions are required")
+	}
+
+	if len(input.Transactions) > PaymentBatchMaxItems {
+		return nil, core_errors.NewValidationError(fmt.Sprintf("batch transactions must be at most %d", PaymentBatchMaxItems))
+	}
+
+	now := time.Now().UTC()
+
+	items := make([]*entity.PaymentBatchItem, 0, len(input.Transactions))
+	for i, transaction := range input.Transactions {
+		item := entity.NewPaymentBatchItem(i, &entity.PaymentBatchTransaction{
+			CardToken:            transaction.CardToken,
+			PurchaseAmount:       transaction.PurchaseAmount,
+			PurchaseCurrency:     transaction.PurchaseCurrency,
+			PurchaseItems:        transaction.PurchaseItems,
+			PurchaseInstallments: transaction.PurchaseInstallments,
+			StoreId:              transaction.StoreId,
+			AcquirerName:         transaction.AcquirerName,
+			AuthorizeOnly:        transaction.AuthorizeOnly,
+		})
+
+		if len(transaction.Invalid) > 0 {
+			item.Fail(nil, entity.PaymentBatchErrorValidation, 0, transaction.Invalid, now)
+		}
+
+		items = append(items, item)
+	}
+
+	batch := entity.NewPaymentBatch(input.Caller, input.AllowedStores, items)
+
+	processNow := len(items) <= PaymentBatchSyncLimit
+	if processNow {
+		batch.Claim(now)
+	}
+
+	err := c.batchRepository.CreateBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	if processNow {
+		err = c.process(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &CreatePaymentBatchOutput{Batch: newPaymentBatchOutput(batch)}, nil
+}
@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePaymentBatchWithMixedTransactions(t *testing.T) {
+	ctx := context.Background()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := CreatePaymentBatchInput{
+		Caller:        "Caller",
+		AllowedStores: []string{testStoreId},
+		Transactions: []*PaymentBatchTransactionInput{
+			createPaymentBatchTransactionInput(card.Token, testStoreId),
+			{Invalid: []string{"transaction card_token is required"}},
+			createPaymentBatchTransactionInput(card.Token, "9e4c2f71-6a3d-4b8e-8f25-1d7a0c9b4e62"),
+		},
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, card.Token).
+		Return(card, nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, card.Token).
+		Return("4111111111111111", nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.Anything).
+		Return(entity.NewAcquirerResponse("id", 200, "id"), nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, "Caller", payment.Caller)
+		}).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	// the store of the third transaction is not allowed, the second one is invalid and the
+	// first one is started and then succeeds
+	batchRepository := repository.NewIPaymentBatchRepositoryMock(t)
+	batchRepository.
+		EXPECT().
+		CreateBatch(ctx, mock.Anything).
+		Run(func(ctx context.Context, batch *entity.PaymentBatch) {
+			assert.Equal(t, entity.PaymentBatchStatusProcessing, batch.Status)
+			assert.Equal(t, "Caller", batch.Caller)
+			assert.Equal(t, 3, len(batch.Items))
+			assert.Equal(t, entity.PaymentBatchItemStatusFailed, batch.Items[1].Status)
+		}).
+		Return(nil).
+		Once()
+	batchRepository.
+		EXPECT().
+		UpdateBatchItem(ctx, mock.Anything, mock.Anything).
+		Return(nil).
+		Times(3)
+	batchRepository.
+		EXPECT().
+		UpdateBatch(ctx, mock.Anything).
+		Run(func(ctx context.Context, batch *entity.PaymentBatch) {
+			assert.Equal(t, entity.PaymentBatchStatusDone, batch.Status)
+		}).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, service.NewIRoutingServiceMock(t))
+	createPaymentBatch := NewCreatePaymentBatch(batchRepository, processPayment)
+
+	output, err := createPaymentBatch.Execute(ctx, &input)
+	require.Nil(t, err)
+
+	batch := output.Batch
+	assert.NotEmpty(t, batch.BatchId)
+	assert.Equal(t, "done", batch.Status)
+	assert.Equal(t, 1, batch.Succeeded)
+	assert.Equal(t, 2, batch.Failed)
+	assert.Equal(t, 0, batch.Pending)
+	require.Equal(t, 3, len(batch.Items))
+
+	assert.Equal(t, "succeeded", batch.Items[0].Status)
+	assert.NotEmpty(t, batch.Items[0].PaymentId)
+	assert.Equal(t, "approved", batch.Items[0].PaymentStatus)
+
+	assert.Equal(t, "failed", batch.Items[1].Status)
+	assert.Equal(t, "validation", batch.Items[1].ErrorType)
+	assert.Equal(t, []string{"transaction card_token is required"}, batch.Items[1].ErrorMessages)
+
+	assert.Equal(t, "failed", batch.Items[2].Status)
+	assert.Empty(t, batch.Items[2].PaymentId)
+	assert.Equal(t, "forbidden", batch.Items[2].ErrorType)
+	assert.Equal(t, []string{"store is not allowed for this client"}, batch.Items[2].ErrorMessages)
+}
+
+func TestCreatePaymentBatchWithDeclinedTransaction(t *testing.T) {
+	ctx := context.Background()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := CreatePaymentBatchInput{
+		Caller:        "Caller",
+		AllowedStores: []string{testStoreId},
+		Transactions: []*PaymentBatchTransactionInput{
+			createPaymentBatchTransactionInput(card.Token, testStoreId),
+		},
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, card.Token).
+		Return(card, nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, card.Token).
+		Return("4111111111111111", nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.Anything).
+		Return(nil, core_errors.NewAcquirerError(422, "the maximum purchase value should not exceed 100")).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	batchRepository := repository.NewIPaymentBatchRepositoryMock(t)
+	batchRepository.
+		EXPECT().
+		CreateBatch(ctx, mock.Anything).
+		Return(nil).
+		Once()
+	batchRepository.
+		EXPECT().
+		UpdateBatchItem(ctx, mock.Anything, mock.Anything).
+		Return(nil).
+		Twice()
+	batchRepository.
+		EXPECT().
+		UpdateBatch(ctx, mock.Anything).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, service.NewIRoutingServiceMock(t))
+	createPaymentBatch := NewCreatePaymentBatch(batchRepository, processPayment)
+
+	output, err := createPaymentBatch.Execute(ctx, &input)
+	require.Nil(t, err)
+
+	item := output.Batch.Items[0]
+	assert.Equal(t, "failed", item.Status)
+	assert.NotEmpty(t, item.PaymentId)
+	assert.Equal(t, "declined", item.PaymentStatus)
+	assert.Equal(t, "acquirer", item.ErrorType)
+	assert.Equal(t, 422, item.ErrorCode)
+	assert.Equal(t, []string{"the maximum purchase value should not exceed 100"}, item.ErrorMessages)
+}
+
+func TestCreatePaymentBatchWithLargeBatch(t *testing.T) {
+	ctx := context.Background()
+
+	input := CreatePaymentBatchInput{
+		Caller:        "Caller",
+		AllowedStores: []string{testStoreId},
+	}
+	for i := 0; i <= PaymentBatchSyncLimit; i++ {
+		input.Transactions = append(input.Transactions, createPaymentBatchTransactionInput("Token", testStoreId))
+	}
+
+	// the batch is only queued, without processing its transactions
+	batchRepository := repository.NewIPaymentBatchRepositoryMock(t)
+	batchRepository.
+		EXPECT().
+		CreateBatch(ctx, mock.Anything).
+		Run(func(ctx context.Context, batch *entity.PaymentBatch) {
+			assert.Equal(t, entity.PaymentBatchStatusQueued, batch.Status)
+			assert.Equal(t, []string{testStoreId}, batch.AllowedStores)
+		}).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(repository.NewICardRepositoryMock(t), repository.NewIPaymentRepositoryMock(t), repository.NewIReversalRepositoryMock(t), repository.NewIStoreRepositoryMock(t), service.NewIPaymentServiceMock(t), service.NewIRoutingServiceMock(t))
+	createPaymentBatch := NewCreatePaymentBatch(batchRepository, processPayment)
+
+	output, err := createPaymentBatch.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, "queued", output.Batch.Status)
+	assert.Equal(t, PaymentBatchSyncLimit+1, output.Batch.Pending)
+}
+
+func TestCreatePaymentBatchWithInvalidSize(t *testing.T) {
+	ctx := context.Background()
+
+	processPayment := NewProcessPayment(repository.NewICardRepositoryMock(t), repository.NewIPaymentRepositoryMock(t), repository.NewIReversalRepositoryMock(t), repository.NewIStoreRepositoryMock(t), service.NewIPaymentServiceMock(t), service.NewIRoutingServiceMock(t))
+	createPaymentBatch := NewCreatePaymentBatch(repository.NewIPaymentBatchRepositoryMock(t), processPayment)
+
+	_, err := createPaymentBatch.Execute(ctx, &CreatePaymentBatchInput{Caller: "Caller"})
+	var validationErr *core_errors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []string{"batch transactions are required"}, validationErr.Messages)
+
+	input := CreatePaymentBatchInput{Caller: "Caller"}
+	for i := 0; i <= PaymentBatchMaxItems; i++ {
+		input.Transactions = append(input.Transactions, createPaymentBatchTransactionInput("Token", testStoreId))
+	}
+
+	_, err = createPaymentBatch.Execute(ctx, &input)
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []string{"batch transactions must be at most 500"}, validationErr.Messages)
+}
+
+func createPaymentBatchTransactionInput(cardToken string, storeId string) *PaymentBatchTransactionInput {
+	return &PaymentBatchTransactionInput{
+		CardToken:            cardToken,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              storeId,
+		AcquirerName:         "Acquirer",
+	}
+}
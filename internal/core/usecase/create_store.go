@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+type CreateStoreInput struct {
+	Identification string
+	Address        string
+	Cep            string
+	Street         string
+	Number         string
+	City           string
+	State          string
+}
+
+type CreateStoreOutput struct {
+	Store *StoreOutput
+}
+
+type ICreateStore interface {
+	Execute(ctx context.Context, input *CreateStoreInput) (*CreateStoreOutput, error)
+}
+
+type CreateStore struct {
+	storeRepository repository.IStoreRepository
+	addressLookup   service.IAddressLookup
+}
+
+func NewCreateStore(storeRepository repository.IStoreRepository, addressLookup service.IAddressLookup) *CreateStore {
+	return &CreateStore{
+		storeRepository: storeRepository,
+		addressLookup:   addressLookup,
+	}
+}
+
+func (c *CreateStore) Execute(ctx context.Context, input *CreateStoreInput) (*CreateStoreOutput, error) {
+	store := entity.NewRegisteredStore(input.Identification, input.Address, input.Cep)
+	store.SetLocation(input.Street, input.Number, input.City, input.State)
+
+	err := completeStoreAddress(ctx, c.addressLookup, store)
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.storeRepository.CreateStore(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateStoreOutput{Store: newStoreOutput(store)}, nil
+}
+
+// completeStoreAddress fills the structured address of the store not informed from its cep,
+// which is only looked up when valid.
+func completeStoreAddress(ctx context.Context, addressLookup service.IAddressLookup, store *entity.Store) error {
+	if !entity.ValidCep(store.Cep) {
+		return nil
+	}
+
+	address, err := addressLookup.LookupAddress(ctx, store.Cep)
+	if err != nil {
+		return err
+	}
+
+	store.ApplyAddress(address)
+	return nil
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStore(t *testing.T) {
+	ctx := context.Background()
+
+	input := CreateStoreInput{
+		Identification: "11.222.333/0001-81",
+		Address:        "Avenida Paulista, 1000",
+		Cep:            "01310-100",
+		Number:         "1000",
+	}
+
+	var storeId string
+	storeRepository := repository.NewIStoreRepositoryMock(t)
+	storeRepository.
+		EXPECT().
+		CreateStore(ctx, mock.Anything).
+		Run(func(ctx context.Context, store *entity.Store) {
+			storeId = store.Id
+			assert.Equal(t, "11222333000181", store.Identification)
+			assert.Equal(t, "01310100", store.Cep)
+			assert.Equal(t, "Avenida Paulista", store.Street)
+			assert.Equal(t, "1000", store.Number)
+			assert.Equal(t, "São Paulo", store.City)
+			assert.Equal(t, "SP", store.State)
+		}).
+		Return(nil).
+		Once()
+
+	createStore := NewCreateStore(storeRepository, createAddressLookup(t, ctx))
+
+	output, err := createStore.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.NotEmpty(t, output.Store.StoreId)
+	assert.Equal(t, storeId, output.Store.StoreId)
+	assert.Equal(t, "11222333000181", output.Store.Identification)
+	assert.Equal(t, "cnpj", output.Store.DocumentType)
+	assert.Equal(t, "Avenida Paulista", output.Store.Street)
+	assert.False(t, output.Store.CreatedAt.IsZero())
+}
+
+func TestCreateStoreWithInvalidData(t *testing.T) {
+	ctx := context.Background()
+
+	input := CreateStoreInput{
+		Identification: "11.222.333/0001-82",
+		Address:        "",
+		Cep:            "123",
+	}
+
+	createStore := NewCreateStore(repository.NewIStoreRepositoryMock(t), service.NewIAddressLookupMock(t))
+
+	output, err := createStore.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.ValidationError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, []string{
+		"store identification is not a valid cpf or cnpj",
+		"store address is required",
+		"store cep is invalid",
+	}, w.Messages)
+}
+
+func TestCreateStoreWithStateMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	input := CreateStoreInput{
+		Identification: "11.222.333/0001-81",
+		Address:        "Address",
+		Cep:            "01310-100",
+		State:          "rj",
+	}
+
+	createStore := NewCreateStore(repository.NewIStoreRepositoryMock(t), createAddressLookup(t, ctx))
+
+	output, err := createStore.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.ValidationError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, []string{"store state does not match the cep"}, w.Messages)
+}
+
+func TestCreateStoreWithRepositoryError(t *testing.T) {
+	ctx := context.Background()
+
+	input := CreateStoreInput{
+		Identification: "11.222.333/0001-81",
+		Address:        "Address",
+		Cep:            "01310-100",
+	}
+
+	storeRepository := repository.NewIStoreRepositoryMock(t)
+	storeRepository.
+		EXPECT().
+		CreateStore(ctx, mock.Anything).
+		Return(core_errors.NewInternalError(errors.New("database is unavailable"))).
+		Once()
+
+	createStore := NewCreateStore(storeRepository, createAddressLookup(t, ctx))
+
+	output, err := createStore.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.InternalError
+	require.ErrorAs(t, err, &w)
+}
+
+func createAddressLookup(t *testing.T, ctx context.Context) *service.IAddressLookupMock {
+	addressLookup := service.NewIAddressLookupMock(t)
+	addressLookup.
+		EXPECT().
+		LookupAddress(ctx, "01310100").
+		Return(&entity.Address{Cep: "01310100", Street: "Avenida Paulista", District: "Bela Vista", City: "São Paulo", State: "SP"}, nil).
+		Once()
+
+	return addressLookup
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type CreateWebhookInput struct {
+	Caller string
+	Url    string
+	Events []string
+	Secret string
+}
+
+type CreateWebhookOutput struct {
+	Webhook *WebhookOutput
+}
+
+type ICreateWebhook interface {
+	Execute(ctx context.Context, input *CreateWebhookInput) (*CreateWebhookOutput, error)
+}
+
+type CreateWebhook struct {
+	webhookRepository repository.IWebhookRepository
+}
+
+func NewCreateWebhook(webhookRepository repository.IWebhookRepository) *CreateWebhook {
+	return &CreateWebhook{
+		webhookRepository: webhookRepository,
+	}
+}
+
+// Execute subscribes the client to the events of its payments.
+func (c *CreateWebhook) Execute(ctx context.Context, input *CreateWebhookInput) (*CreateWebhookOutput, error) {
+	webhook := entity.NewWebhook(input.Caller, input.Url, input.Events, input.Secret)
+
+	err := webhook.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.webhookRepository.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateWebhookOutput{Webhook: newWebhookOutput(webhook)}, nil
+}
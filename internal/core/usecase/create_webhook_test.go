@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	ctx := context.Background()
+
+	input := CreateWebhookInput{
+		Caller: "Caller",
+		Url:    "https://example.com/webhooks",
+		Events: []string{"payment.approved", "payment.declined"},
+		Secret: "a-secret-of-16-chars",
+	}
+
+	var stored *entity.Webhook
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		CreateWebhook(ctx, mock.Anything).
+		Run(func(ctx context.Context, webhook *entity.Webhook) {
+			assert.Equal(t, "Caller", webhook.Caller)
+			assert.Equal(t, "a-secret-of-16-chars", webhook.Secret)
+			stored = webhook
+		}).
+		Return(nil).
+		Once()
+
+	createWebhook := NewCreateWebhook(webhookRepository)
+
+	output, err := createWebhook.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, stored.Id, output.Webhook.WebhookId)
+	assert.Equal(t, input.Url, output.Webhook.Url)
+	assert.Equal(t, input.Events, output.Webhook.Events)
+	assert.Equal(t, stored.CreatedAt, output.Webhook.CreatedAt)
+}
+
+func TestCreateWebhookWithInvalidData(t *testing.T) {
+	ctx := context.Background()
+
+	input := CreateWebhookInput{
+		Caller: "Caller",
+		Url:    "example.com",
+		Events: []string{"payment.created"},
+		Secret: "short",
+	}
+
+	createWebhook := NewCreateWebhook(repository.NewIWebhookRepositoryMock(t))
+
+	output, err := createWebhook.Execute(ctx, &input)
+	assert.Nil(t, output)
+	assert.Equal(t, core_errors.NewValidationError(
+		"webhook url is invalid",
+		"webhook event payment.created is invalid",
+		"webhook secret must have at least 16 characters",
+	), err)
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type DeleteCardInput struct {
+	CardToken string
+}
+
+type DeleteCardOutput struct{}
+
+type IDeleteCard interface {
+	Execute(ctx context.Context, input *DeleteCardInput) (*DeleteCardOutput, error)
+}
+
+type DeleteCard struct {
+	cardRepository repository.ICardRepository
+}
+
+func NewDeleteCard(cardRepository repository.ICardRepository) *DeleteCard {
+	return &DeleteCard{
+		cardRepository: cardRepository,
+	}
+}
+
+func (d *DeleteCard) Execute(ctx context.Context, input *DeleteCardInput) (*DeleteCardOutput, error) {
+	err := d.cardRepository.DeleteCard(ctx, input.CardToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteCardOutput{}, nil
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteCard(t *testing.T) {
+	ctx := context.Background()
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		DeleteCard(ctx, "Token").
+		Return(nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		DeleteCard(ctx, "Unknown").
+		Return(core_errors.NewNotFoundError("card token is invalid")).
+		Once()
+
+	deleteCard := NewDeleteCard(cardRepository)
+
+	output, err := deleteCard.Execute(ctx, &DeleteCardInput{CardToken: "Token"})
+	require.Nil(t, err)
+	assert.NotNil(t, output)
+
+	output, err = deleteCard.Execute(ctx, &DeleteCardInput{CardToken: "Unknown"})
+	assert.Nil(t, output)
+
+	var e *core_errors.NotFoundError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, "card token is invalid", e.Message)
+}
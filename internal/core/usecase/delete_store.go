@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+
+	"github.com/google/uuid"
+)
+
+type DeleteStoreInput struct {
+	StoreId string
+}
+
+type DeleteStoreOutput struct{}
+
+type IDeleteStore interface {
+	Execute(ctx context.Context, input *DeleteStoreInput) (*DeleteStoreOutput, error)
+}
+
+type DeleteStore struct {
+	storeRepository repository.IStoreRepository
+}
+
+func NewDeleteStore(storeRepository repository.IStoreRepository) *DeleteStore {
+	return &DeleteStore{
+		storeRepository: storeRepository,
+	}
+}
+
+func (d *DeleteStore) Execute(ctx context.Context, input *DeleteStoreInput) (*DeleteStoreOutput, error) {
+	if _, err := uuid.Parse(input.StoreId); err != nil {
+		return nil, core_errors.NewNotFoundError("store id is invalid")
+	}
+
+	err := d.storeRepository.DeleteStore(ctx, input.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteStoreOutput{}, nil
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteStore(t *testing.T) {
+	ctx := context.Background()
+	storeId := "5b0b8b3e-0f8e-4d52-9d8a-3c1f6e2a7b10"
+	unknownId := "9e4c2f71-6a3d-4b8e-8f25-1d7a0c9b4e62"
+
+	storeRepository := repository.NewIStoreRepositoryMock(t)
+	storeRepository.
+		EXPECT().
+		DeleteStore(ctx, storeId).
+		Return(nil).
+		Once()
+	storeRepository.
+		EXPECT().
+		DeleteStore(ctx, unknownId).
+		Return(core_errors.NewNotFoundError("store id is invalid")).
+		Once()
+
+	deleteStore := NewDeleteStore(storeRepository)
+
+	output, err := deleteStore.Execute(ctx, &DeleteStoreInput{StoreId: storeId})
+	require.Nil(t, err)
+	assert.NotNil(t, output)
+
+	for _, id := range []string{unknownId, "Invalid"} {
+		output, err = deleteStore.Execute(ctx, &DeleteStoreInput{StoreId: id})
+		assert.Nil(t, output)
+
+		var e *core_errors.NotFoundError
+		require.ErrorAs(t, err, &e)
+		assert.Equal(t, "store id is invalid", e.Message)
+	}
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type DeleteWebhookInput struct {
+	Caller    string
+	WebhookId string
+}
+
+type DeleteWebhookOutput struct{}
+
+type IDeleteWebhook interface {
+	Execute(ctx context.Context, input *DeleteWebhookInput) (*DeleteWebhookOutput, error)
+}
+
+type DeleteWebhook struct {
+	webhookRepository repository.IWebhookRepository
+}
+
+func NewDeleteWebhook(webhookRepository repository.IWebhookRepository) *DeleteWebhook {
+	return &DeleteWebhook{
+		webhookRepository: webhookRepository,
+	}
+}
+
+// Execute removes a webhook of the client along with its pending deliveries.
+func (d *DeleteWebhook) Execute(ctx context.Context, input *DeleteWebhookInput) (*DeleteWebhookOutput, error) {
+	webhook, err := findCallerWebhook(ctx, d.webhookRepository, input.Caller, input.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.webhookRepository.DeleteWebhook(ctx, webhook.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteWebhookOutput{}, nil
+}
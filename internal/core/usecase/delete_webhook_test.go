@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteWebhook(t *testing.T) {
+	ctx := context.Background()
+	webhook := createTestWebhook("Caller")
+	other := createTestWebhook("Another Caller")
+
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		FindWebhook(ctx, webhook.Id).
+		Return(webhook, nil).
+		Once()
+	webhookRepository.
+		EXPECT().
+		DeleteWebhook(ctx, webhook.Id).
+		Return(nil).
+		Once()
+	webhookRepository.
+		EXPECT().
+		FindWebhook(ctx, other.Id).
+		Return(other, nil).
+		Once()
+
+	deleteWebhook := NewDeleteWebhook(webhookRepository)
+
+	output, err := deleteWebhook.Execute(ctx, &DeleteWebhookInput{Caller: "Caller", WebhookId: webhook.Id})
+	require.Nil(t, err)
+	assert.NotNil(t, output)
+
+	for _, id := range []string{other.Id, "Invalid"} {
+		output, err = deleteWebhook.Execute(ctx, &DeleteWebhookInput{Caller: "Caller", WebhookId: id})
+		assert.Nil(t, output)
+
+		var e *core_errors.NotFoundError
+		require.ErrorAs(t, err, &e)
+		assert.Equal(t, "webhook id is invalid", e.Message)
+	}
+}
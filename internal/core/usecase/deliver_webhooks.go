@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+type DeliverWebhooksInput struct {
+	Limit int
+}
+
+type DeliverWebhooksOutput struct {
+	Delivered int
+	Pending   int
+	Failed    int
+}
+
+type IDeliverWebhooks interface {
+	Execute(ctx context.Context, input *DeliverWebhooksInput) (*DeliverWebhooksOutput, error)
+}
+
+type DeliverWebhooks struct {
+	webhookRepository  repository.IWebhookRepository
+	deliveryRepository repository.IWebhookDeliveryRepository
+	webhookSender      service.IWebhookSender
+}
+
+func NewDeliverWebhooks(
+	webhookRepository repository.IWebhookRepository,
+	deliveryRepository repository.IWebhookDeliveryRepository,
+	webhookSender service.IWebhookSender,
+) *DeliverWebhooks {
+	return &DeliverWebhooks{
+		webhookRepository:  webhookRepository,
+		deliveryRepository: deliveryRepository,
+		webhookSender:      webhookSender,
+	}
+}
+
+// Execute sends the due deliveries to their webhooks. A delivery is done when the webhook
+// answers with a 2xx status, and is retried later with a backoff otherwise, until it fails
+// after entity.WebhookMaxAttempts.
+func (d *DeliverWebhooks) Execute(ctx context.Context, input *DeliverWebhooksInput) (*DeliverWebhooksOutput, error) {
+	deliveries, err := d.deliveryRepository.FindDueDeliveries(ctx, time.Now().UTC(), input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &DeliverWebhooksOutput{}
+	webhooks := make(map[string]*entity.Webhook)
+
+	for _, delivery := range deliveries {
+		webhook, ok := webhooks[delivery.WebhookId]
+		if !ok {
+			webhook, err = d.webhookRepository.FindWebhook(ctx, delivery.WebhookId)
+			if err != nil {
+				return nil, err
+			}
+
+			webhooks[webhook.Id] = webhook
+		}
+
+		code, sendErr := d.webhookSender.Send(ctx, webhook, delivery)
+		if sendErr != nil {
+			slog.Error(sendErr.Error(), "delivery", delivery.Id, "webhook", webhook.Id)
+			delivery.Retry(code, sendErr.Error(), time.Now().UTC())
+		} else {
+			delivery.Deliver(code, time.Now().UTC())
+		}
+
+		err = d.deliveryRepository.UpdateDelivery(ctx, delivery)
+		if err != nil {
+			return nil, err
+		}
+
+		switch delivery.Status {
+		case entity.WebhookDeliveryStatusDelivered:
+			output.Delivered++
+		case entity.WebhookDeliveryStatusFailed:
+			output.Failed++
+		default:
+			output.Pending++
+		}
+	}
+
+	return output, nil
+}
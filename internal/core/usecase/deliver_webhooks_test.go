@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliverWebhooks(t *testing.T) {
+	ctx := context.Background()
+	webhook := createTestWebhook("Caller")
+
+	delivered := createTestDelivery(webhook)
+	delivered.Status = entity.WebhookDeliveryStatusPending
+	delivered.Attempts = 0
+
+	retried := createTestDelivery(webhook)
+	retried.Status = entity.WebhookDeliveryStatusPending
+	retried.Attempts = 1
+
+	failed := createTestDelivery(webhook)
+	failed.Status = entity.WebhookDeliveryStatusPending
+	failed.Attempts = entity.WebhookMaxAttempts - 1
+
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		FindWebhook(ctx, webhook.Id).
+		Return(webhook, nil).
+		Once()
+
+	deliveryRepository := repository.NewIWebhookDeliveryRepositoryMock(t)
+	deliveryRepository.
+		EXPECT().
+		FindDueDeliveries(ctx, mock.Anything, 10).
+		Return([]*entity.WebhookDelivery{delivered, retried, failed}, nil).
+		Once()
+	deliveryRepository.
+		EXPECT().
+		UpdateDelivery(ctx, delivered).
+		Run(func(ctx context.Context, delivery *entity.WebhookDelivery) {
+			assert.Equal(t, entity.WebhookDeliveryStatusDelivered, delivery.Status)
+			assert.Equal(t, 1, delivery.Attempts)
+			assert.Equal(t, 200, delivery.ResponseCode)
+		}).
+		Return(nil).
+		Once()
+	deliveryRepository.
+		EXPECT().
+		UpdateDelivery(ctx, retried).
+		Run(func(ctx context.Context, delivery *entity.WebhookDelivery) {
+			assert.Equal(t, entity.WebhookDeliveryStatusPending, delivery.Status)
+			assert.Equal(t, 2, delivery.Attempts)
+			assert.Equal(t, 500, delivery.ResponseCode)
+			assert.Equal(t, "webhook answered with status 500", delivery.ResponseMessage)
+		}).
+		Return(nil).
+		Once()
+	deliveryRepository.
+		EXPECT().
+		UpdateDelivery(ctx, failed).
+		Run(func(ctx context.Context, delivery *entity.WebhookDelivery) {
+			assert.Equal(t, entity.WebhookDeliveryStatusFailed, delivery.Status)
+			assert.Equal(t, "connection refused", delivery.ResponseMessage)
+		}).
+		Return(nil).
+		Once()
+
+	webhookSender := service.NewIWebhookSenderMock(t)
+	webhookSender.
+		EXPECT().
+		Send(ctx, webhook, delivered).
+		Return(200, nil).
+		Once()
+	webhookSender.
+		EXPECT().
+		Send(ctx, webhook, retried).
+		Return(500, errors.New("webhook answered with status 500")).
+		Once()
+	webhookSender.
+		EXPECT().
+		Send(ctx, webhook, failed).
+		Return(0, errors.New("connection refused")).
+		Once()
+
+	deliverWebhooks := NewDeliverWebhooks(webhookRepository, deliveryRepository, webhookSender)
+
+	output, err := deliverWebhooks.Execute(ctx, &DeliverWebhooksInput{Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 1, output.Delivered)
+	assert.Equal(t, 1, output.Pending)
+	assert.Equal(t, 1, output.Failed)
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+type FindAcquirerHealthInput struct{}
+
+type AcquirerHealthOutput struct {
+	AcquirerName string
+	State        string
+	Requests     int64
+	Failures     int64
+	SlowCalls    int64
+	Rejections   int64
+	ErrorRate    float64
+	SlowCallRate float64
+	OpenedAt     time.Time
+}
+
+type FindAcquirerHealthOutput struct {
+	Acquirers []*AcquirerHealthOutput
+}
+
+type IFindAcquirerHealth interface {
+	Execute(ctx context.Context, input *FindAcquirerHealthInput) (*FindAcquirerHealthOutput, error)
+}
+
+type FindAcquirerHealth struct {
+	healthService service.IAcquirerHealthService
+}
+
+func NewFindAcquirerHealth(healthService service.IAcquirerHealthService) *FindAcquirerHealth {
+	return &FindAcquirerHealth{
+		healthService: healthService,
+	}
+}
+
+func (f *FindAcquirerHealth) Execute(ctx context.Context, input *FindAcquirerHealthInput) (*FindAcquirerHealthOutput, error) {
+	health := f.healthService.AcquirerHealth(ctx)
+
+	acquirers := make([]*AcquirerHealthOutput, 0, len(health))
+	for _, h := range health {
+		acquirers = append(acquirers, &AcquirerHealthOutput{
+			AcquirerName: h.Acquirer,
+			State:        string(h.State),
+			Requests:     h.Requests,
+			Failures:     h.Failures,
+			SlowCalls:    h.SlowCalls,
+			Rejections:   h.Rejections,
+			ErrorRate:    h.ErrorRate,
+			SlowCallRate: h.SlowCallRate,
+			OpenedAt:     h.OpenedAt,
+		})
+	}
+
+	output := &FindAcquirerHealthOutput{
+		Acquirers: acquirers,
+	}
+
+	return output, nil
+}
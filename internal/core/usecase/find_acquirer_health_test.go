@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindAcquirerHealth(t *testing.T) {
+	ctx := context.Background()
+	openedAt := time.Now().UTC()
+
+	healthService := service.NewIAcquirerHealthServiceMock(t)
+	healthService.
+		EXPECT().
+		AcquirerHealth(ctx).
+		Return([]*entity.AcquirerHealth{
+			{Acquirer: "cielo", State: entity.CircuitStateClosed, Requests: 10, Failures: 1, ErrorRate: 0.1},
+			{Acquirer: "rede", State: entity.CircuitStateOpen, Requests: 5, Failures: 5, Rejections: 2, ErrorRate: 1, OpenedAt: openedAt},
+		}).
+		Once()
+
+	findAcquirerHealth := NewFindAcquirerHealth(healthService)
+
+	output, err := findAcquirerHealth.Execute(ctx, &FindAcquirerHealthInput{})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(output.Acquirers))
+
+	assert.Equal(t, "cielo", output.Acquirers[0].AcquirerName)
+	assert.Equal(t, "closed", output.Acquirers[0].State)
+	assert.Equal(t, int64(10), output.Acquirers[0].Requests)
+	assert.Equal(t, 0.1, output.Acquirers[0].ErrorRate)
+
+	assert.Equal(t, "rede", output.Acquirers[1].AcquirerName)
+	assert.Equal(t, "open", output.Acquirers[1].State)
+	assert.Equal(t, int64(2), output.Acquirers[1].Rejections)
+	assert.Equal(t, openedAt, output.Acquirers[1].OpenedAt)
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+
+	"github.com/google/uuid"
+)
+
+type FindPaymentInput struct {
+	// AllowedStores are the stores of the client, whose payments are the only ones found.
+	AllowedStores []string
+	PaymentId     string
+}
+
+type PaymentAttemptOutput struct {
+	AcquirerName    string
+	Status          string
+	AcquirerId      string
+	AcquirerCode    int
+	AcquirerMessage string
+	CreatedAt       time.Time
+}
+
+type FindPaymentOutput struct {
+	PaymentId            string
+	PaymentStatus        string
+	CardToken            string
+	CardBrand            string
+	PurchaseAmount       int64
+	PurchaseCurrency     string
+	PurchaseItems        []string
+	PurchaseInstallments int
+	StoreId              string
+	StoreIdentification  string
+	StoreDocumentType    string
+	StoreAddress         string
+	StoreCep             string
+	StoreStreet          string
+	StoreNumber          string
+	StoreCity            string
+	StoreState           string
+	AcquirerName         string
+	RouteRule            string
+	AcquirerId           string
+	AcquirerCode         int
+	AcquirerMessage      string
+	CapturedAmount       int64
+	RefundedAmount       int64
+	Attempts             []*PaymentAttemptOutput
+	Caller               string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+type IFindPayment interface {
+	Execute(ctx context.Context, input *FindPaymentInput) (*FindPaymentOutput, error)
+}
+
+type FindPayment struct {
+	paymentRepository repository.IPaymentRepository
+}
+
+func NewFindPayment(paymentRepository repository.IPaymentRepository) *FindPayment {
+	return &FindPayment{
+		paymentRepository: paymentRepository,
+	}
+}
+
+func (f *FindPayment) Execute(ctx context.Context, input *FindPaymentInput) (*FindPaymentOutput, error) {
+	payment, err := findStorePayment(ctx, f.paymentRepository, input.AllowedStores, input.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := payment.Transaction
+
+	var routeRule string
+	if transaction.Route != nil {
+		routeRule = transaction.Route.Rule
+	}
+
+	attempts := make([]*PaymentAttemptOutput, 0, len(payment.Attempts))
+	for _, attempt := range payment.Attempts {
+		attempts = append(attempts, &PaymentAttemptOutput{
+			AcquirerName:    attempt.Acquirer,
+			Status:          string(attempt.Status),
+			AcquirerId:      attempt.AcquirerId,
+			AcquirerCode:    attempt.AcquirerCode,
+			AcquirerMessage: attempt.AcquirerMessage,
+			CreatedAt:       attempt.CreatedAt,
+		})
+	}
+
+	output := &FindPaymentOutput{
+		PaymentId:            payment.Id,
+		PaymentStatus:        string(payment.Status),
+		CardToken:            transaction.Card.Token,
+		CardBrand:            transaction.Card.Brand,
+		PurchaseAmount:       transaction.Purchase.Value.Amount,
+		PurchaseCurrency:     transaction.Purchase.Value.Currency,
+		PurchaseItems:        transaction.Purchase.Items,
+		PurchaseInstallments: transaction.Purchase.Installments,
+		StoreId:              transaction.Store.Id,
+		StoreIdentification:  transaction.Store.Identification,
+		StoreDocumentType:    string(transaction.Store.DocumentType),
+		StoreAddress:         transaction.Store.Address,
+		StoreCep:             transaction.Store.Cep,
+		StoreStreet:          transaction.Store.Street,
+		StoreNumber:          transaction.Store.Number,
+		StoreCity:            transaction.Store.City,
+		StoreState:           transaction.Store.State,
+		AcquirerName:         transaction.Acquirer.Name,
+		RouteRule:            routeRule,
+		AcquirerId:           payment.AcquirerId,
+		AcquirerCode:         payment.AcquirerCode,
+		AcquirerMessage:      payment.AcquirerMessage,
+		CapturedAmount:       payment.CapturedValue.Amount,
+		RefundedAmount:       payment.RefundedValue.Amount,
+		Attempts:             attempts,
+		Caller:               payment.Caller,
+		CreatedAt:            payment.CreatedAt,
+		UpdatedAt:            payment.UpdatedAt,
+	}
+
+	return output, nil
+}
+
+// findStorePayment finds a payment of a store of the client. The payments of other stores are
+// reported as not found, so their ids are not disclosed.
+func findStorePayment(ctx context.Context, paymentRepository repository.IPaymentRepository, allowedStores []string, paymentId string) (*entity.Payment, error) {
+	if _, err := uuid.Parse(paymentId); err != nil {
+		return nil, core_errors.NewNotFoundError("payment id is invalid")
+	}
+
+	payment, err := paymentRepository.FindPayment(ctx, paymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(allowedStores, payment.Transaction.Store.Id) {
+		return nil, core_errors.NewNotFoundError("payment id is invalid")
+	}
+
+	return payment, nil
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+
+	"github.com/google/uuid"
+)
+
+type FindPaymentBatchInput struct {
+	Caller  string
+	BatchId string
+}
+
+type FindPaymentBatchOutput struct {
+	Batch *PaymentBatchOutput
+}
+
+type IFindPaymentBatch interface {
+	Execute(ctx context.Context, input *FindPaymentBatchInput) (*FindPaymentBatchOutput, error)
+}
+
+type FindPaymentBatch struct {
+	batchRepository repository.IPaymentBatchRepository
+}
+
+func NewFindPaymentBatch(batchRepository repository.IPaymentBatchRepository) *FindPaymentBatch {
+	return &FindPaymentBatch{
+		batchRepository: batchRepository,
+	}
+}
+
+// Execute finds a batch of the client with the results of its items. The batches of other
+// clients are reported as not found.
+func (f *FindPaymentBatch) Execute(ctx context.Context, input *FindPaymentBatchInput) (*FindPaymentBatchOutput, error) {
+	if _, err := uuid.Parse(input.BatchId); err != nil {
+		return nil, core_errors.NewNotFoundError("batch id is invalid")
+	}
+
+	batch, err := f.batchRepository.FindBatch(ctx, input.BatchId)
+	if err != nil {
+		return nil, err
+	}
+
+	if batch.Caller != input.Caller {
+		return nil, core_errors.NewNotFoundError("batch id is invalid")
+	}
+
+	return &FindPaymentBatchOutput{Batch: newPaymentBatchOutput(batch)}, nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindPaymentBatch(t *testing.T) {
+	ctx := context.Background()
+
+	payment := entity.NewPayment(nil)
+	payment.Status = entity.PaymentStatusApproved
+
+	succeeded := entity.NewPaymentBatchItem(0, &entity.PaymentBatchTransaction{})
+	succeeded.Succeed(payment, payment.CreatedAt)
+	batch := entity.NewPaymentBatch("Caller", []string{testStoreId}, []*entity.PaymentBatchItem{
+		succeeded,
+		entity.NewPaymentBatchItem(1, &entity.PaymentBatchTransaction{}),
+	})
+
+	batchRepository := repository.NewIPaymentBatchRepositoryMock(t)
+	batchRepository.
+		EXPECT().
+		FindBatch(ctx, batch.Id).
+		Return(batch, nil).
+		Twice()
+
+	findPaymentBatch := NewFindPaymentBatch(batchRepository)
+
+	output, err := findPaymentBatch.Execute(ctx, &FindPaymentBatchInput{Caller: "Caller", BatchId: batch.Id})
+	require.Nil(t, err)
+	assert.Equal(t, batch.Id, output.Batch.BatchId)
+	assert.Equal(t, "queued", output.Batch.Status)
+	assert.Equal(t, 1, output.Batch.Succeeded)
+	assert.Equal(t, 1, output.Batch.Pending)
+	assert.Equal(t, payment.Id, output.Batch.Items[0].PaymentId)
+	assert.Equal(t, "approved", output.Batch.Items[0].PaymentStatus)
+	assert.Equal(t, "pending", output.Batch.Items[1].Status)
+
+	// the batches of other clients are not found
+	output, err = findPaymentBatch.Execute(ctx, &FindPaymentBatchInput{Caller: "Another Caller", BatchId: batch.Id})
+	assert.Nil(t, output)
+
+	var e *core_errors.NotFoundError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, "batch id is invalid", e.Message)
+
+	_, err = findPaymentBatch.Execute(ctx, &FindPaymentBatchInput{Caller: "Caller", BatchId: "Invalid"})
+	require.ErrorAs(t, err, &e)
+}
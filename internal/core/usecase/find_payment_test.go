@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindPaymentWithExistentPayment(t *testing.T) {
+	ctx := context.Background()
+
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+	purchase := entity.NewPurchase(entity.NewMoney(499, "BRL"), []string{"Item 1", "Item 2"}, 2)
+	store := entity.NewStore("11222333000181", "Address", "01310100")
+	store.Id = testStoreId
+	acquirer := entity.NewAcquirer("Acquirer")
+	payment := entity.NewPayment(entity.NewTransaction(card, purchase, store, acquirer))
+	payment.Approve(entity.NewAcquirerResponse("Acquirer Id", 200, "Message"))
+	payment.Caller = "Caller"
+
+	input := FindPaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+
+	findPayment := NewFindPayment(paymentRepository)
+
+	output, err := findPayment.Execute(ctx, &input)
+	require.Nil(t, err)
+
+	assert.Equal(t, payment.Id, output.PaymentId)
+	assert.Equal(t, "approved", output.PaymentStatus)
+	assert.Equal(t, card.Token, output.CardToken)
+	assert.Equal(t, card.Brand, output.CardBrand)
+	assert.Equal(t, purchase.Value.Amount, output.PurchaseAmount)
+	assert.Equal(t, purchase.Value.Currency, output.PurchaseCurrency)
+	assert.Equal(t, purchase.Items, output.PurchaseItems)
+	assert.Equal(t, purchase.Installments, output.PurchaseInstallments)
+	assert.Equal(t, store.Identification, output.StoreIdentification)
+	assert.Equal(t, "cnpj", output.StoreDocumentType)
+	assert.Equal(t, store.Address, output.StoreAddress)
+	assert.Equal(t, store.Cep, output.StoreCep)
+	assert.Equal(t, acquirer.Name, output.AcquirerName)
+	assert.Equal(t, "Acquirer Id", output.AcquirerId)
+	assert.Equal(t, 200, output.AcquirerCode)
+	assert.Equal(t, "Message", output.AcquirerMessage)
+	assert.Equal(t, "Caller", output.Caller)
+	assert.Equal(t, payment.CreatedAt, output.CreatedAt)
+	assert.Equal(t, payment.UpdatedAt, output.UpdatedAt)
+}
+
+func TestFindPaymentWithInvalidPaymentId(t *testing.T) {
+	ctx := context.Background()
+
+	input := FindPaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     "an invalid id",
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	findPayment := NewFindPayment(paymentRepository)
+
+	output, err := findPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.NotFoundError
+	require.ErrorAs(t, err, &w)
+
+	assert.Equal(t, "payment id is invalid", w.Message)
+}
+
+func TestFindPaymentWithNonExistentPayment(t *testing.T) {
+	ctx := context.Background()
+
+	input := FindPaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     "e4b3f4c0-6d7c-4f5e-9b54-3b1c1a7d2f10",
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, input.PaymentId).
+		Return(nil, core_errors.NewNotFoundError("payment id is invalid")).
+		Once()
+
+	findPayment := NewFindPayment(paymentRepository)
+
+	output, err := findPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.NotFoundError
+	require.ErrorAs(t, err, &w)
+
+	assert.Equal(t, "payment id is invalid", w.Message)
+}
+
+func TestFindPaymentOfAnotherStore(t *testing.T) {
+	ctx := context.Background()
+	payment := createApprovedPayment(1000)
+
+	input := FindPaymentInput{
+		AllowedStores: []string{"e4b3f4c0-6d7c-4f5e-9b54-3b1c1a7d2f10"},
+		PaymentId:     payment.Id,
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+
+	findPayment := NewFindPayment(paymentRepository)
+
+	output, err := findPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.NotFoundError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, "payment id is invalid", w.Message)
+}
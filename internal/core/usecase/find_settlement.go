@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+
+	"github.com/google/uuid"
+)
+
+type FindSettlementInput struct {
+	SettlementId string
+}
+
+type FindSettlementOutput struct {
+	Settlement *SettlementOutput
+}
+
+type IFindSettlement interface {
+	Execute(ctx context.Context, input *FindSettlementInput) (*FindSettlementOutput, error)
+}
+
+type FindSettlement struct {
+	settlementRepository repository.ISettlementRepository
+}
+
+func NewFindSettlement(settlementRepository repository.ISettlementRepository) *FindSettlement {
+	return &FindSettlement{
+		settlementRepository: settlementRepository,
+	}
+}
+
+func (f *FindSettlement) Execute(ctx context.Context, input *FindSettlementInput) (*FindSettlementOutput, error) {
+	if _, err := uuid.Parse(input.SettlementId); err != nil {
+		return nil, core_errors.NewNotFoundError("settlement id is invalid")
+	}
+
+	settlement, err := f.settlementRepository.FindSettlement(ctx, input.SettlementId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FindSettlementOutput{Settlement: newSettlementOutput(settlement)}, nil
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindSettlement(t *testing.T) {
+	ctx := context.Background()
+
+	settlement := entity.NewSettlement("cielo", entity.SettlementFormatEdi, "cielo.txt", nil)
+
+	settlementRepository := repository.NewISettlementRepositoryMock(t)
+	settlementRepository.
+		EXPECT().
+		FindSettlement(ctx, settlement.Id).
+		Return(settlement, nil).
+		Once()
+
+	findSettlement := NewFindSettlement(settlementRepository)
+
+	output, err := findSettlement.Execute(ctx, &FindSettlementInput{SettlementId: settlement.Id})
+	require.Nil(t, err)
+	assert.Equal(t, settlement.Id, output.Settlement.SettlementId)
+	assert.Equal(t, "edi", output.Settlement.Format)
+	assert.Empty(t, output.Settlement.Discrepancies)
+
+	output, err = findSettlement.Execute(ctx, &FindSettlementInput{SettlementId: "Invalid"})
+	assert.Nil(t, output)
+
+	var e *core_errors.NotFoundError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, "settlement id is invalid", e.Message)
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+
+	"github.com/google/uuid"
+)
+
+type FindStoreInput struct {
+	StoreId string
+}
+
+type StoreOutput struct {
+	StoreId        string
+	Identification string
+	DocumentType   string
+	Address        string
+	Cep            string
+	Street         string
+	Number         string
+	City           string
+	State          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type FindStoreOutput struct {
+	Store *StoreOutput
+}
+
+type IFindStore interface {
+	Execute(ctx context.Context, input *FindStoreInput) (*FindStoreOutput, error)
+}
+
+type FindStore struct {
+	storeRepository repository.IStoreRepository
+}
+
+func NewFindStore(storeRepository repository.IStoreRepository) *FindStore {
+	return &FindStore{
+		storeRepository: storeRepository,
+	}
+}
+
+func (f *FindStore) Execute(ctx context.Context, input *FindStoreInput) (*FindStoreOutput, error) {
+	if _, err := uuid.Parse(input.StoreId); err != nil {
+		return nil, core_errors.NewNotFoundError("store id is invalid")
+	}
+
+	store, err := f.storeRepository.FindStore(ctx, input.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FindStoreOutput{Store: newStoreOutput(store)}, nil
+}
+
+func newStoreOutput(store *entity.Store) *StoreOutput {
+	return &StoreOutput{
+		StoreId:        store.Id,
+		Identification: store.Identification,
+		DocumentType:   string(store.DocumentType),
+		Address:        store.Address,
+		Cep:            store.Cep,
+		Street:         store.Street,
+		Number:         store.Number,
+		City:           store.City,
+		State:          store.State,
+		CreatedAt:      store.CreatedAt,
+		UpdatedAt:      store.UpdatedAt,
+	}
+}
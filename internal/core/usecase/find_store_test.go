@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindStore(t *testing.T) {
+	ctx := context.Background()
+
+	store := entity.NewRegisteredStore("11222333000181", "Address", "01310100")
+	store.SetLocation("Avenida Paulista", "1000", "São Paulo", "SP")
+
+	storeRepository := repository.NewIStoreRepositoryMock(t)
+	storeRepository.
+		EXPECT().
+		FindStore(ctx, store.Id).
+		Return(store, nil).
+		Once()
+
+	findStore := NewFindStore(storeRepository)
+
+	output, err := findStore.Execute(ctx, &FindStoreInput{StoreId: store.Id})
+	require.Nil(t, err)
+	assert.Equal(t, store.Id, output.Store.StoreId)
+	assert.Equal(t, store.Identification, output.Store.Identification)
+	assert.Equal(t, "cnpj", output.Store.DocumentType)
+	assert.Equal(t, store.Address, output.Store.Address)
+	assert.Equal(t, store.Cep, output.Store.Cep)
+	assert.Equal(t, store.Street, output.Store.Street)
+	assert.Equal(t, store.Number, output.Store.Number)
+	assert.Equal(t, store.City, output.Store.City)
+	assert.Equal(t, store.State, output.Store.State)
+	assert.Equal(t, store.CreatedAt, output.Store.CreatedAt)
+}
+
+func TestFindStoreWithInvalidId(t *testing.T) {
+	ctx := context.Background()
+
+	findStore := NewFindStore(repository.NewIStoreRepositoryMock(t))
+
+	output, err := findStore.Execute(ctx, &FindStoreInput{StoreId: "Invalid"})
+	assert.Nil(t, output)
+
+	var e *core_errors.NotFoundError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, "store id is invalid", e.Message)
+}
+
+func TestListStores(t *testing.T) {
+	ctx := context.Background()
+
+	stores := []*entity.Store{
+		entity.NewRegisteredStore("11222333000181", "Address", "01310100"),
+		entity.NewRegisteredStore("52998224725", "Address", "20011000"),
+	}
+
+	storeRepository := repository.NewIStoreRepositoryMock(t)
+	storeRepository.
+		EXPECT().
+		FindStores(ctx).
+		Return(stores, nil).
+		Once()
+
+	listStores := NewListStores(storeRepository)
+
+	output, err := listStores.Execute(ctx, &ListStoresInput{})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(output.Stores))
+	assert.Equal(t, stores[0].Id, output.Stores[0].StoreId)
+	assert.Equal(t, stores[1].Id, output.Stores[1].StoreId)
+	assert.Equal(t, "cpf", output.Stores[1].DocumentType)
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+
+	"github.com/google/uuid"
+)
+
+type FindWebhookInput struct {
+	Caller    string
+	WebhookId string
+}
+
+// WebhookOutput is a webhook without its secret, which is never returned.
+type WebhookOutput struct {
+	WebhookId string
+	Url       string
+	Events    []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type FindWebhookOutput struct {
+	Webhook *WebhookOutput
+}
+
+type IFindWebhook interface {
+	Execute(ctx context.Context, input *FindWebhookInput) (*FindWebhookOutput, error)
+}
+
+type FindWebhook struct {
+	webhookRepository repository.IWebhookRepository
+}
+
+func NewFindWebhook(webhookRepository repository.IWebhookRepository) *FindWebhook {
+	return &FindWebhook{
+		webhookRepository: webhookRepository,
+	}
+}
+
+func (f *FindWebhook) Execute(ctx context.Context, input *FindWebhookInput) (*FindWebhookOutput, error) {
+	webhook, err := findCallerWebhook(ctx, f.webhookRepository, input.Caller, input.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FindWebhookOutput{Webhook: newWebhookOutput(webhook)}, nil
+}
+
+// findCallerWebhook finds a webhook of the client. The webhooks of other clients are reported
+// as not found, so their ids are not disclosed.
+func findCallerWebhook(ctx context.Context, webhookRepository repository.IWebhookRepository, caller string, webhookId string) (*entity.Webhook, error) {
+	if _, err := uuid.Parse(webhookId); err != nil {
+		return nil, core_errors.NewNotFoundError("webhook id is invalid")
+	}
+
+	webhook, err := webhookRepository.FindWebhook(ctx, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook.Caller != caller {
+		return nil, core_errors.NewNotFoundError("webhook id is invalid")
+	}
+
+	return webhook, nil
+}
+
+func newWebhookOutput(webhook *entity.Webhook) *WebhookOutput {
+	events := make([]string, 0, len(webhook.Events))
+	for _, event := range webhook.Events {
+		events = append(events, string(event))
+	}
+
+	return &WebhookOutput{
+		WebhookId: webhook.Id,
+		Url:       webhook.Url,
+		Events:    events,
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+	}
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindWebhook(t *testing.T) {
+	ctx := context.Background()
+	webhook := createTestWebhook("Caller")
+
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		FindWebhook(ctx, webhook.Id).
+		Return(webhook, nil).
+		Twice()
+
+	findWebhook := NewFindWebhook(webhookRepository)
+
+	output, err := findWebhook.Execute(ctx, &FindWebhookInput{Caller: "Caller", WebhookId: webhook.Id})
+	require.Nil(t, err)
+	assert.Equal(t, webhook.Id, output.Webhook.WebhookId)
+	assert.Equal(t, webhook.Url, output.Webhook.Url)
+	assert.Equal(t, []string{"payment.approved"}, output.Webhook.Events)
+	assert.Equal(t, webhook.CreatedAt, output.Webhook.CreatedAt)
+	assert.Equal(t, webhook.UpdatedAt, output.Webhook.UpdatedAt)
+
+	// the webhooks of other clients are not found
+	output, err = findWebhook.Execute(ctx, &FindWebhookInput{Caller: "Another Caller", WebhookId: webhook.Id})
+	assert.Nil(t, output)
+
+	var e *core_errors.NotFoundError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, "webhook id is invalid", e.Message)
+}
+
+func TestFindWebhookWithInvalidId(t *testing.T) {
+	ctx := context.Background()
+	unknownId := uuid.NewString()
+
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		FindWebhook(ctx, unknownId).
+		Return(nil, core_errors.NewNotFoundError("webhook id is invalid")).
+		Once()
+
+	findWebhook := NewFindWebhook(webhookRepository)
+
+	for _, id := range []string{unknownId, "Invalid"} {
+		output, err := findWebhook.Execute(ctx, &FindWebhookInput{Caller: "Caller", WebhookId: id})
+		assert.Nil(t, output)
+
+		var e *core_errors.NotFoundError
+		require.ErrorAs(t, err, &e)
+		assert.Equal(t, "webhook id is invalid", e.Message)
+	}
+}
+
+func TestListWebhooks(t *testing.T) {
+	ctx := context.Background()
+
+	webhooks := []*entity.Webhook{
+		createTestWebhook("Caller"),
+		createTestWebhook("Caller"),
+	}
+
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		FindWebhooks(ctx, "Caller").
+		Return(webhooks, nil).
+		Once()
+
+	listWebhooks := NewListWebhooks(webhookRepository)
+
+	output, err := listWebhooks.Execute(ctx, &ListWebhooksInput{Caller: "Caller"})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(output.Webhooks))
+	assert.Equal(t, webhooks[0].Id, output.Webhooks[0].WebhookId)
+	assert.Equal(t, webhooks[1].Id, output.Webhooks[1].WebhookId)
+}
+
+func createTestWebhook(caller string) *entity.Webhook {
+	return entity.NewWebhook(caller, "https://example.com/webhooks", []string{"payment.approved"}, "a-secret-of-16-chars")
+}
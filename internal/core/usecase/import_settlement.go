@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+type ImportSettlementInput struct {
+	Acquirer string
+	Format   string
+	FileName string
+	File     io.Reader
+}
+
+type SettlementDiscrepancyOutput struct {
+	Type             string
+	Line             int
+	PaymentId        string
+	AcquirerId       string
+	ExpectedAmount   int64
+	ExpectedCurrency string
+	SettledAmount    int64
+	SettledCurrency  string
+	Date             time.Time
+}
+
+type SettlementOutput struct {
+	SettlementId     string
+	Acquirer         string
+	Format           string
+	FileName         string
+	Lines            int
+	Matched          int
+	Missing          int
+	Unexpected       int
+	AmountMismatches int
+	PeriodStart      time.Time
+	PeriodEnd        time.Time
+	Discrepancies    []*SettlementDiscrepancyOutput
+	CreatedAt        time.Time
+}
+
+type ImportSettlementOutput struct {
+	Settlement *SettlementOutput
+}
+
+type IImportSettlement interface {
+	Execute(ctx context.Context, input *ImportSettlementInput) (*ImportSettlementOutput, error)
+}
+
+type ImportSettlement struct {
+	settlementRepository repository.ISettlementRepository
+	settlementParser     service.ISettlementParser
+}
+
+func NewImportSettlement(
+	settlementRepository repository.ISettlementRepository,
+	settlementParser service.ISettlementParser,
+) *ImportSettlement {
+	return &ImportSettlement{
+		settlementRepository: settlementRepository,
+		settlementParser:     settlementParser,
+	}
+}
+
+// Execute reads a settlement file of the acquirer and reconciles it with the payments the
+// acquirer has ids of in the file or created in the days of the file, recording the report.
+func (i *ImportSettlement) Execute(ctx context.Context, input *ImportSettlementInput) (*ImportSettlementOutput, error) {
+	msgs := make([]string, 0)
+
+	if input.Acquirer == "" {
+		msgs = append(msgs, "acquirer name is required")
+	}
+
+	format := entity.SettlementFormat(input.Format)
+	if format != entity.SettlementFormatCsv && format != entity.SettlementFormatEdi {
+		msgs = append(msgs, "settlement format must be csv or edi")
+	}
+
+	if input.File == nil {
+		msgs = append(msgs, "settlement file is required")
+	}
+
+	if len(msgs) > 0 {
+		return nil, core_errors.NewValidationError(msgs...)
+	}
+
+	lines, err := i.settlementParser.Parse(format, input.Acquirer, input.File)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement := entity.NewSettlement(input.Acquirer, format, input.FileName, lines)
+
+	if len(lines) > 0 {
+		acquirerIds := make([]string, 0, len(lines))
+		for _, line := range lines {
+			if line.AcquirerId != "" {
+				acquirerIds = append(acquirerIds, line.AcquirerId)
+			}
+		}
+
+		payments, err := i.settlementRepository.FindSettlementPayments(
+			ctx,
+			input.Acquirer,
+			acquirerIds,
+			settlement.PeriodStart,
+			settlement.PeriodEnd.AddDate(0, 0, 1),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		settlement.Reconcile(lines, payments)
+	}
+
+	err = i.settlementRepository.CreateSettlement(ctx, settlement)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportSettlementOutput{Settlement: newSettlementOutput(settlement)}, nil
+}
+
+func newSettlementOutput(settlement *entity.Settlement) *SettlementOutput {
+	discrepancies := make([]*SettlementDiscrepancyOutput, 0, len(settlement.Discrepancies))
+	for _, discrepancy := range settlement.Discrepancies {
+		discrepancies = append(discrepancies, &SettlementDiscrepancyOutput{
+			Type:             string(discrepancy.Type),
+			Line:             discrepancy.Line,
+			PaymentId:        discrepancy.PaymentId,
+			AcquirerId:       discrepancy.AcquirerId,
+			ExpectedAmount:   discrepancy.ExpectedValue.Amount,
+			ExpectedCurrency: discrepancy.ExpectedValue.Currency,
+			SettledAmount:    discrepancy.SettledValue.Amount,
+			SettledCurrency:  discrepancy.SettledValue.Currency,
+			Date:             discrepancy.Date,
+		})
+	}
+
+	missing, unexpected, amountMismatches := settlement.Count()
+
+	return &SettlementOutput{
+		SettlementId:     settlement.Id,
+		Acquirer:         settlement.Acquirer,
+		Format:           string(settlement.Format),
+		FileName:         settlement.FileName,
+		Lines:            settlement.Lines,
+		Matched:          settlement.Matched,
+		Missing:          missing,
+		Unexpected:       unexpected,
+		AmountMismatches: amountMismatches,
+		PeriodStart:      settlement.PeriodStart,
+		PeriodEnd:        settlement.PeriodEnd,
+		Discrepancies:    discrepancies,
+		CreatedAt:        settlement.CreatedAt,
+	}
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportSettlement(t *testing.T) {
+	ctx := context.Background()
+	file := strings.NewReader("file")
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	lines := []*entity.SettlementLine{
+		entity.NewSettlementLine(2, "A1", entity.NewMoney(1000, "BRL"), day),
+		entity.NewSettlementLine(3, "", entity.NewMoney(2000, "BRL"), day.AddDate(0, 0, 1)),
+	}
+
+	matched := entity.NewPayment(nil)
+	matched.AcquirerId = "A1"
+	matched.Status = entity.PaymentStatusApproved
+	matched.CapturedValue = entity.NewMoney(1000, "BRL")
+	matched.CreatedAt = day.Add(time.Hour)
+
+	missing := entity.NewPayment(nil)
+	missing.AcquirerId = "A2"
+	missing.Status = entity.PaymentStatusApproved
+	missing.CapturedValue = entity.NewMoney(3000, "BRL")
+	missing.CreatedAt = day.AddDate(0, 0, 1).Add(time.Hour)
+
+	settlementParser := service.NewISettlementParserMock(t)
+	settlementParser.
+		EXPECT().
+		Parse(entity.SettlementFormatCsv, "cielo", file).
+		Return(lines, nil).
+		Once()
+
+	settlementRepository := repository.NewISettlementRepositoryMock(t)
+	settlementRepository.
+		EXPECT().
+		FindSettlementPayments(ctx, "cielo", []string{"A1"}, day, day.AddDate(0, 0, 2)).
+		Return([]*entity.Payment{matched, missing}, nil).
+		Once()
+	settlementRepository.
+		EXPECT().
+		CreateSettlement(ctx, mock.AnythingOfType("*entity.Settlement")).
+		Return(nil).
+		Once()
+
+	importSettlement := NewImportSettlement(settlementRepository, settlementParser)
+
+	output, err := importSettlement.Execute(ctx, &ImportSettlementInput{
+		Acquirer: "cielo",
+		Format:   "csv",
+		FileName: "cielo.csv",
+		File:     file,
+	})
+	require.Nil(t, err)
+
+	settlement := output.Settlement
+	assert.NotEmpty(t, settlement.SettlementId)
+	assert.Equal(t, "cielo", settlement.Acquirer)
+	assert.Equal(t, "csv", settlement.Format)
+	assert.Equal(t, "cielo.csv", settlement.FileName)
+	assert.Equal(t, 2, settlement.Lines)
+	assert.Equal(t, 1, settlement.Matched)
+	assert.Equal(t, 1, settlement.Missing)
+	assert.Equal(t, 1, settlement.Unexpected)
+	assert.Equal(t, 0, settlement.AmountMismatches)
+	assert.Equal(t, day, settlement.PeriodStart)
+	assert.Equal(t, day.AddDate(0, 0, 1), settlement.PeriodEnd)
+
+	require.Equal(t, 2, len(settlement.Discrepancies))
+	assert.Equal(t, "unexpected", settlement.Discrepancies[0].Type)
+	assert.Equal(t, 3, settlement.Discrepancies[0].Line)
+	assert.Equal(t, int64(2000), settlement.Discrepancies[0].SettledAmount)
+	assert.Equal(t, "missing", settlement.Discrepancies[1].Type)
+	assert.Equal(t, missing.Id, settlement.Discrepancies[1].PaymentId)
+	assert.Equal(t, int64(3000), settlement.Discrepancies[1].ExpectedAmount)
+}
+
+func TestImportSettlementWithInvalidFile(t *testing.T) {
+	ctx := context.Background()
+	file := strings.NewReader("file")
+
+	settlementParser := service.NewISettlementParserMock(t)
+	settlementParser.
+		EXPECT().
+		Parse(entity.SettlementFormatEdi, "cielo", file).
+		Return(nil, core_errors.NewValidationError("settlement file trailer is required")).
+		Once()
+
+	importSettlement := NewImportSettlement(repository.NewISettlementRepositoryMock(t), settlementParser)
+
+	output, err := importSettlement.Execute(ctx, &ImportSettlementInput{Acquirer: "cielo", Format: "edi", File: file})
+	assert.Nil(t, output)
+	assert.Equal(t, core_errors.NewValidationError("settlement file trailer is required"), err)
+
+	output, err = importSettlement.Execute(ctx, &ImportSettlementInput{Format: "xml"})
+	assert.Nil(t, output)
+	assert.Equal(t, core_errors.NewValidationError(
+		"acquirer name is required",
+		"settlement format must be csv or edi",
+		"settlement file is required",
+	), err)
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type ListStoresInput struct{}
+
+type ListStoresOutput struct {
+	Stores []*StoreOutput
+}
+
+type IListStores interface {
+	Execute(ctx context.Context, input *ListStoresInput) (*ListStoresOutput, error)
+}
+
+type ListStores struct {
+	storeRepository repository.IStoreRepository
+}
+
+func NewListStores(storeRepository repository.IStoreRepository) *ListStores {
+	return &ListStores{
+		storeRepository: storeRepository,
+	}
+}
+
+func (l *ListStores) Execute(ctx context.Context, input *ListStoresInput) (*ListStoresOutput, error) {
+	stores, err := l.storeRepository.FindStores(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListStoresOutput{
+		Stores: make([]*StoreOutput, 0, len(stores)),
+	}
+
+	for _, store := range stores {
+		output.Stores = append(output.Stores, newStoreOutput(store))
+	}
+
+	return output, nil
+}
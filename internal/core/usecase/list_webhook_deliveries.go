@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type ListWebhookDeliveriesInput struct {
+	Caller    string
+	WebhookId string
+	Limit     int
+}
+
+type WebhookDeliveryOutput struct {
+	DeliveryId      string
+	EventId         string
+	EventType       string
+	PaymentId       string
+	Payload         []byte
+	Status          string
+	Attempts        int
+	NextAttemptAt   time.Time
+	ResponseCode    int
+	ResponseMessage string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type ListWebhookDeliveriesOutput struct {
+	Deliveries []*WebhookDeliveryOutput
+}
+
+type IListWebhookDeliveries interface {
+	Execute(ctx context.Context, input *ListWebhookDeliveriesInput) (*ListWebhookDeliveriesOutput, error)
+}
+
+type ListWebhookDeliveries struct {
+	webhookRepository  repository.IWebhookRepository
+	deliveryRepository repository.IWebhookDeliveryRepository
+}
+
+func NewListWebhookDeliveries(
+	webhookRepository repository.IWebhookRepository,
+	deliveryRepository repository.IWebhookDeliveryRepository,
+) *ListWebhookDeliveries {
+	return &ListWebhookDeliveries{
+		webhookRepository:  webhookRepository,
+		deliveryRepository: deliveryRepository,
+	}
+}
+
+// Execute returns the last deliveries of a webhook of the client, newest first.
+func (l *ListWebhookDeliveries) Execute(ctx context.Context, input *ListWebhookDeliveriesInput) (*ListWebhookDeliveriesOutput, error) {
+	webhook, err := findCallerWebhook(ctx, l.webhookRepository, input.Caller, input.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := l.deliveryRepository.FindDeliveries(ctx, webhook.Id, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListWebhookDeliveriesOutput{
+		Deliveries: make([]*WebhookDeliveryOutput, 0, len(deliveries)),
+	}
+
+	for _, delivery := range deliveries {
+		output.Deliveries = append(output.Deliveries, newWebhookDeliveryOutput(delivery))
+	}
+
+	return output, nil
+}
+
+func newWebhookDeliveryOutput(delivery *entity.WebhookDelivery) *WebhookDeliveryOutput {
+	return &WebhookDeliveryOutput{
+		DeliveryId:      delivery.Id,
+		EventId:         delivery.EventId,
+		EventType:       string(delivery.EventType),
+		PaymentId:       delivery.PaymentId,
+		Payload:         delivery.Payload,
+		Status:          string(delivery.Status),
+		Attempts:        delivery.Attempts,
+		NextAttemptAt:   delivery.NextAttemptAt,
+		ResponseCode:    delivery.ResponseCode,
+		ResponseMessage: delivery.ResponseMessage,
+		CreatedAt:       delivery.CreatedAt,
+		UpdatedAt:       delivery.UpdatedAt,
+	}
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	webhook := createTestWebhook("Caller")
+	delivery := createTestDelivery(webhook)
+
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		FindWebhook(ctx, webhook.Id).
+		Return(webhook, nil).
+		Once()
+
+	deliveryRepository := repository.NewIWebhookDeliveryRepositoryMock(t)
+	deliveryRepository.
+		EXPECT().
+		FindDeliveries(ctx, webhook.Id, 100).
+		Return([]*entity.WebhookDelivery{delivery}, nil).
+		Once()
+
+	listDeliveries := NewListWebhookDeliveries(webhookRepository, deliveryRepository)
+
+	output, err := listDeliveries.Execute(ctx, &ListWebhookDeliveriesInput{Caller: "Caller", WebhookId: webhook.Id, Limit: 100})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(output.Deliveries))
+	assert.Equal(t, delivery.Id, output.Deliveries[0].DeliveryId)
+	assert.Equal(t, delivery.EventId, output.Deliveries[0].EventId)
+	assert.Equal(t, "payment.approved", output.Deliveries[0].EventType)
+	assert.Equal(t, delivery.PaymentId, output.Deliveries[0].PaymentId)
+	assert.Equal(t, delivery.Payload, output.Deliveries[0].Payload)
+	assert.Equal(t, "failed", output.Deliveries[0].Status)
+	assert.Equal(t, entity.WebhookMaxAttempts, output.Deliveries[0].Attempts)
+	assert.Equal(t, 500, output.Deliveries[0].ResponseCode)
+}
+
+func TestRedeliverWebhook(t *testing.T) {
+	ctx := context.Background()
+	webhook := createTestWebhook("Caller")
+	delivery := createTestDelivery(webhook)
+
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		FindWebhook(ctx, webhook.Id).
+		Return(webhook, nil).
+		Once()
+
+	deliveryRepository := repository.NewIWebhookDeliveryRepositoryMock(t)
+	deliveryRepository.
+		EXPECT().
+		FindDelivery(ctx, delivery.Id).
+		Return(delivery, nil).
+		Once()
+	deliveryRepository.
+		EXPECT().
+		UpdateDelivery(ctx, delivery).
+		Run(func(ctx context.Context, delivery *entity.WebhookDelivery) {
+			assert.Equal(t, entity.WebhookDeliveryStatusPending, delivery.Status)
+			assert.Equal(t, 0, delivery.Attempts)
+			assert.WithinDuration(t, time.Now(), delivery.NextAttemptAt, time.Second)
+		}).
+		Return(nil).
+		Once()
+
+	redeliverWebhook := NewRedeliverWebhook(webhookRepository, deliveryRepository)
+
+	output, err := redeliverWebhook.Execute(ctx, &RedeliverWebhookInput{Caller: "Caller", WebhookId: webhook.Id, DeliveryId: delivery.Id})
+	require.Nil(t, err)
+	assert.Equal(t, delivery.Id, output.Delivery.DeliveryId)
+	assert.Equal(t, "pending", output.Delivery.Status)
+}
+
+func TestRedeliverWebhookWithInvalidDelivery(t *testing.T) {
+	ctx := context.Background()
+	webhook := createTestWebhook("Caller")
+	otherDelivery := createTestDelivery(createTestWebhook("Caller"))
+
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		FindWebhook(ctx, webhook.Id).
+		Return(webhook, nil).
+		Twice()
+
+	deliveryRepository := repository.NewIWebhookDeliveryRepositoryMock(t)
+	deliveryRepository.
+		EXPECT().
+		FindDelivery(ctx, otherDelivery.Id).
+		Return(otherDelivery, nil).
+		Once()
+
+	redeliverWebhook := NewRedeliverWebhook(webhookRepository, deliveryRepository)
+
+	// the delivery must be one of the webhook
+	for _, id := range []string{otherDelivery.Id, "Invalid"} {
+		output, err := redeliverWebhook.Execute(ctx, &RedeliverWebhookInput{Caller: "Caller", WebhookId: webhook.Id, DeliveryId: id})
+		assert.Nil(t, output)
+
+		var e *core_errors.NotFoundError
+		require.ErrorAs(t, err, &e)
+		assert.Equal(t, "delivery id is invalid", e.Message)
+	}
+}
+
+func createTestDelivery(webhook *entity.Webhook) *entity.WebhookDelivery {
+	now := time.Now().UTC()
+
+	return &entity.WebhookDelivery{
+		Id:              uuid.NewString(),
+		WebhookId:       webhook.Id,
+		EventId:         uuid.NewString(),
+		EventType:       entity.WebhookEventPaymentApproved,
+		PaymentId:       uuid.NewString(),
+		Payload:         []byte(`{"type":"payment.approved"}`),
+		Status:          entity.WebhookDeliveryStatusFailed,
+		Attempts:        entity.WebhookMaxAttempts,
+		NextAttemptAt:   now,
+		ResponseCode:    500,
+		ResponseMessage: "webhook answered with status 500",
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
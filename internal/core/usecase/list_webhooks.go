@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type ListWebhooksInput struct {
+	Caller string
+}
+
+type ListWebhooksOutput struct {
+	Webhooks []*WebhookOutput
+}
+
+type IListWebhooks interface {
+	Execute(ctx context.Context, input *ListWebhooksInput) (*ListWebhooksOutput, error)
+}
+
+type ListWebhooks struct {
+	webhookRepository repository.IWebhookRepository
+}
+
+func NewListWebhooks(webhookRepository repository.IWebhookRepository) *ListWebhooks {
+	return &ListWebhooks{
+		webhookRepository: webhookRepository,
+	}
+}
+
+func (l *ListWebhooks) Execute(ctx context.Context, input *ListWebhooksInput) (*ListWebhooksOutput, error) {
+	webhooks, err := l.webhookRepository.FindWebhooks(ctx, input.Caller)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListWebhooksOutput{
+		Webhooks: make([]*WebhookOutput, 0, len(webhooks)),
+	}
+
+	for _, webhook := range webhooks {
+		output.Webhooks = append(output.Webhooks, newWebhookOutput(webhook))
+	}
+
+	return output, nil
+}
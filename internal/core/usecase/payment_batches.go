@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+const (
+	PaymentBatchMaxItems = 500
+
+	// PaymentBatchSyncLimit keeps a batch processed while the client waits to what is sent to
+	// an acquirer at once, so it takes about as long as a single payment.
+	PaymentBatchSyncLimit = PaymentBatchAcquirerConcurrency
+
+	PaymentBatchAcquirerConcurrency = 4
+)
+
+type PaymentBatchItemOutput struct {
+	Index         int
+	Status        string
+	PaymentId     string
+	PaymentStatus string
+	ErrorType     string
+	ErrorCode     int
+	ErrorMessages []string
+}
+
+type PaymentBatchOutput struct {
+	BatchId   string
+	Status    string
+	Succeeded int
+	Failed    int
+	Pending   int
+	Items     []*PaymentBatchItemOutput
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type paymentBatchProcessor struct {
+	batchRepository repository.IPaymentBatchRepository
+	processPayment  *ProcessPayment
+}
+
+// process does not send again the items left processing by an interrupted run, since the
+// acquirer may have charged them.
+func (b *paymentBatchProcessor) process(ctx context.Context, batch *entity.PaymentBatch) error {
+	p := *b.processPayment
+	p.paymentService = newAcquirerLimiter(p.paymentService, PaymentBatchAcquirerConcurrency)
+	semaphores := make(map[string]chan struct{})
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var processErr error
+
+	// save records an item, renewing the lease of the batch when it is due
+	save := func(item *entity.PaymentBatchItem) error {
+		err := b.batchRepository.UpdateBatchItem(ctx, batch.Id, item)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err == nil && batch.Renew(time.Now().UTC()) {
+			err = b.batchRepository.UpdateBatch(ctx, batch)
+		}
+
+		if err != nil && processErr == nil {
+			processErr = err
+		}
+
+		return err
+	}
+
+	for _, item := range batch.Items {
+		if item.Status == entity.PaymentBatchItemStatusProcessing {
+			err := b.resume(ctx, item)
+			if err != nil {
+				mu.Lock()
+				if processErr == nil {
+					processErr = err
+				}
+				mu.Unlock()
+				continue
+			}
+
+			save(item)
+			continue
+		}
+
+		if item.Status != entity.PaymentBatchItemStatusPending {
+			continue
+		}
+
+		payment, err := p.prepare(ctx, newPaymentBatchInput(batch, item.Transaction))
+		if err != nil {
+			failPaymentBatchItem(item, nil, err)
+			save(item)
+			continue
+		}
+
+		// the items are started as their acquirer has room for them
+		acquirer := payment.Transaction.Acquirer.Name
+		semaphore, ok := semaphores[acquirer]
+		if !ok {
+			semaphore = make(chan struct{}, PaymentBatchAcquirerConcurrency)
+			semaphores[acquirer] = semaphore
+		}
+
+		wg.Add(1)
+		go func(item *entity.PaymentBatchItem, payment *entity.Payment) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			item.Start(payment, time.Now().UTC())
+			if save(item) != nil {
+				return
+			}
+
+			err := p.submit(ctx, payment, false)
+			if err != nil {
+				failPaymentBatchItem(item, payment, err)
+			} else {
+				item.Succeed(payment, time.Now().UTC())
+			}
+
+			save(item)
+		}(item, payment)
+	}
+
+	wg.Wait()
+
+	if processErr != nil {
+		return processErr
+	}
+
+	batch.Complete(time.Now().UTC())
+	return b.batchRepository.UpdateBatch(ctx, batch)
+}
+
+// resume gives an interrupted item the outcome of its payment, settling a payment still
+// pending as the one of an interrupted job.
+func (b *paymentBatchProcessor) resume(ctx context.Context, item *entity.PaymentBatchItem) error {
+	p := b.processPayment
+	message := "payment batch processing was interrupted"
+	now := time.Now().UTC()
+
+	payment, err := p.paymentRepository.FindPayment(ctx, item.PaymentId)
+	if err != nil {
+		var notFoundErr *core_errors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			// the run stopped before recording the payment
+			item.Fail(nil, entity.PaymentBatchErrorInternal, 0, []string{message}, now)
+			return nil
+		}
+
+		return err
+	}
+
+	if payment.Status == entity.PaymentStatusPending {
+		sent, err := p.resume(ctx, payment, message)
+		if err != nil {
+			return err
+		}
+
+		if !sent {
+			payment.Fail(message)
+			err = p.record(ctx, payment)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	switch payment.Status {
+	case entity.PaymentStatusDeclined:
+		item.Fail(payment, entity.PaymentBatchErrorAcquirer, payment.AcquirerCode, []string{payment.AcquirerMessage}, now)
+	case entity.PaymentStatusFailed, entity.PaymentStatusUnknown, entity.PaymentStatusReversed:
+		item.Fail(payment, entity.PaymentBatchErrorInternal, 0, []string{message}, now)
+	default:
+		item.Succeed(payment, now)
+	}
+
+	return nil
+}
+
+// acquirerLimiter sends at most a given number of transactions to each acquirer at once.
+type acquirerLimiter struct {
+	service.IPaymentService
+	limit      int
+	mu         sync.Mutex
+	semaphores map[string]chan struct{}
+}
+
+func newAcquirerLimiter(paymentService service.IPaymentService, limit int) *acquirerLimiter {
+	return &acquirerLimiter{
+		IPaymentService: paymentService,
+		limit:           limit,
+		semaphores:      make(map[string]chan struct{}),
+	}
+}
+
+func (l *acquirerLimiter) ProcessTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.AcquirerResponse, error) {
+	l.mu.Lock()
+	semaphore, ok := l.semaphores[transaction.Acquirer.Name]
+	if !ok {
+		semaphore = make(chan struct{}, l.limit)
+		l.semaphores[transaction.Acquirer.Name] = semaphore
+	}
+	l.mu.Unlock()
+
+	semaphore <- struct{}{}
+	defer func() { <-semaphore }()
+
+	return l.IPaymentService.ProcessTransaction(ctx, transaction)
+}
+
+func newPaymentBatchInput(batch *entity.PaymentBatch, transaction *entity.PaymentBatchTransaction) *ProcessPaymentInput {
+	return &ProcessPaymentInput{
+		CardToken:            transaction.CardToken,
+		PurchaseAmount:       transaction.PurchaseAmount,
+		PurchaseCurrency:     transaction.PurchaseCurrency,
+		PurchaseItems:        transaction.PurchaseItems,
+		PurchaseInstallments: transaction.PurchaseInstallments,
+		StoreId:              transaction.StoreId,
+		AcquirerName:         transaction.AcquirerName,
+		AuthorizeOnly:        transaction.AuthorizeOnly,
+		Caller:               batch.Caller,
+		AllowedStores:        batch.AllowedStores,
+	}
+}
+
+// failPaymentBatchItem records the error of an item, which is only detailed for the errors
+// reported to the clients of ProcessPayment as well.
+func failPaymentBatchItem(item *entity.PaymentBatchItem, payment *entity.Payment, err error) {
+	now := time.Now().UTC()
+
+	var validationErr *core_errors.ValidationError
+	var notFoundErr *core_errors.NotFoundError
+	var forbiddenErr *core_errors.ForbiddenError
+	var acquirerErr *core_errors.AcquirerError
+	var timeoutErr *core_errors.TimeoutError
+
+	switch {
+	case errors.As(err, &validationErr):
+		item.Fail(payment, entity.PaymentBatchErrorValidation, 0, validationErr.Messages, now)
+	case errors.As(err, &notFoundErr):
+		item.Fail(payment, entity.PaymentBatchErrorNotFound, 0, []string{notFoundErr.Message}, now)
+	case errors.As(err, &forbiddenErr):
+		item.Fail(payment, entity.PaymentBatchErrorForbidden, 0, []string{forbiddenErr.Message}, now)
+	case errors.As(err, &acquirerErr):
+		item.Fail(payment, entity.PaymentBatchErrorAcquirer, acquirerErr.Code, []string{acquirerErr.Message}, now)
+	case errors.As(err, &timeoutErr):
+		item.Fail(payment, entity.PaymentBatchErrorTimeout, 0, []string{timeoutErr.Message}, now)
+	default:
+		slog.Error(err.Error(), "item", item.Index)
+		item.Fail(payment, entity.PaymentBatchErrorInternal, 0, []string{"internal server error"}, now)
+	}
+}
+
+func newPaymentBatchOutput(batch *entity.PaymentBatch) *PaymentBatchOutput {
+	items := make([]*PaymentBatchItemOutput, 0, len(batch.Items))
+	for _, item := range batch.Items {
+		items = append(items, &PaymentBatchItemOutput{
+			Index:         item.Index,
+			Status:        string(item.Status),
+			PaymentId:     item.PaymentId,
+			PaymentStatus: string(item.PaymentStatus),
+			ErrorType:     string(item.ErrorType),
+			ErrorCode:     item.ErrorCode,
+			ErrorMessages: item.ErrorMessages,
+		})
+	}
+
+	succeeded, failed, pending := batch.Count()
+
+	return &PaymentBatchOutput{
+		BatchId:   batch.Id,
+		Status:    string(batch.Status),
+		Succeeded: succeeded,
+		Failed:    failed,
+		Pending:   pending,
+		Items:     items,
+		CreatedAt: batch.CreatedAt,
+		UpdatedAt: batch.UpdatedAt,
+	}
+}
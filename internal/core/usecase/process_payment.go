@@ -2,25 +2,34 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
 	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
 	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+
+	"github.com/google/uuid"
 )
 
 type ProcessPaymentInput struct {
 	CardToken            string
-	PurchaseValue        float64
+	PurchaseAmount       int64
+	PurchaseCurrency     string
 	PurchaseItems        []string
 	PurchaseInstallments int
-	StoreIdentification  string
-	StoreAddress         string
-	StoreCep             string
+	StoreId              string
 	AcquirerName         string
+	AuthorizeOnly        bool
+	Caller               string
+	AllowedStores        []string
+	Async                bool
 }
 
 type ProcessPaymentOutput struct {
-	PaymentId string
+	PaymentId     string
+	PaymentStatus string
 }
 
 type IProcessPayment interface {
@@ -28,41 +37,261 @@ type IProcessPayment interface {
 }
 
 type ProcessPayment struct {
-	cardRepository repository.ICardRepository
-	paymentService service.IPaymentService
+	cardRepository     repository.ICardRepository
+	paymentRepository  repository.IPaymentRepository
+	reversalRepository repository.IReversalRepository
+	storeRepository    repository.IStoreRepository
+	paymentService     service.IPaymentService
+	routingService     service.IRoutingService
 }
 
-func NewProcessPayment(cardRepository repository.ICardRepository, paymentService service.IPaymentService) *ProcessPayment {
+func NewProcessPayment(
+	cardRepository repository.ICardRepository,
+	paymentRepository repository.IPaymentRepository,
+	reversalRepository repository.IReversalRepository,
+	storeRepository repository.IStoreRepository,
+	paymentService service.IPaymentService,
+	routingService service.IRoutingService,
+) *ProcessPayment {
 	return &ProcessPayment{
-		cardRepository: cardRepository,
-		paymentService: paymentService,
+		cardRepository:     cardRepository,
+		paymentRepository:  paymentRepository,
+		reversalRepository: reversalRepository,
+		storeRepository:    storeRepository,
+		paymentService:     paymentService,
+		routingService:     routingService,
 	}
 }
 
 func (p *ProcessPayment) Execute(ctx context.Context, input *ProcessPaymentInput) (*ProcessPaymentOutput, error) {
+	payment, err := p.prepare(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.submit(ctx, payment, input.Async)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ProcessPaymentOutput{
+		PaymentId:     payment.Id,
+		PaymentStatus: string(payment.Status),
+	}
+
+	return output, nil
+}
+
+func (p *ProcessPayment) prepare(ctx context.Context, input *ProcessPaymentInput) (*entity.Payment, error) {
+	if !slices.Contains(input.AllowedStores, input.StoreId) {
+		return nil, core_errors.NewForbiddenError("store is not allowed for this client")
+	}
+
+	if _, err := uuid.Parse(input.StoreId); err != nil {
+		return nil, core_errors.NewNotFoundError("store id is invalid")
+	}
+
 	card, err := p.cardRepository.FindCard(ctx, input.CardToken)
 	if err != nil {
 		return nil, err
 	}
 
-	purchase := entity.NewPurchase(input.PurchaseValue, input.PurchaseItems, input.PurchaseInstallments)
-	store := entity.NewStore(input.StoreIdentification, input.StoreAddress, input.StoreCep)
+	store, err := p.storeRepository.FindStore(ctx, input.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	value := entity.NewMoney(input.PurchaseAmount, input.PurchaseCurrency)
+	purchase := entity.NewPurchase(value, input.PurchaseItems, input.PurchaseInstallments)
 	acquirer := entity.NewAcquirer(input.AcquirerName)
 	transaction := entity.NewTransaction(card, purchase, store, acquirer)
+	transaction.AuthorizeOnly = input.AuthorizeOnly
+
+	if input.AcquirerName == "" {
+		route, err := p.routingService.Route(ctx, transaction)
+		if err != nil {
+			return nil, err
+		}
+
+		transaction.Acquirer = entity.NewAcquirer(route.Acquirer)
+		transaction.Route = route
+	}
 
 	err = transaction.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	payment, err := p.paymentService.ProcessTransaction(ctx, transaction)
+	payment := entity.NewPayment(transaction)
+	payment.Caller = input.Caller
+
+	return payment, nil
+}
+
+func (p *ProcessPayment) submit(ctx context.Context, payment *entity.Payment, async bool) error {
+	if async {
+		return p.paymentRepository.CreateQueuedPayment(ctx, payment)
+	}
+
+	err := p.paymentRepository.CreatePayment(ctx, payment)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	output := &ProcessPaymentOutput{
-		PaymentId: payment.Id,
+	processErr, attemptErr := p.charge(ctx, payment)
+
+	// the outcome is recorded even when the request expired meanwhile
+	err = p.record(context.WithoutCancel(ctx), payment)
+	if err != nil {
+		return err
 	}
 
-	return output, nil
+	if attemptErr != nil {
+		return attemptErr
+	}
+
+	return processErr
+}
+
+// charge returns the error of an attempt that could not be recorded apart, since it does not
+// change the outcome of the payment.
+func (p *ProcessPayment) charge(ctx context.Context, payment *entity.Payment) (processErr error, attemptErr error) {
+	transaction := payment.Transaction
+
+	result, processErr, attemptErr := p.process(ctx, payment)
+	if processErr != nil {
+		var acquirerErr *core_errors.AcquirerError
+		if errors.As(processErr, &acquirerErr) {
+			payment.Decline(acquirerErr.Code, acquirerErr.Message)
+		} else if n := len(payment.Attempts); n > 0 && payment.Attempts[n-1].Unresolved() {
+			payment.Unknown(processErr.Error())
+		} else {
+			payment.Fail(processErr.Error())
+		}
+	} else if transaction.AuthorizeOnly {
+		payment.Authorize(result)
+	} else {
+		payment.Approve(result)
+	}
+
+	return processErr, attemptErr
+}
+
+func (p *ProcessPayment) record(ctx context.Context, payment *entity.Payment) error {
+	return p.paymentRepository.UpdatePayment(ctx, payment)
+}
+
+// process sends the transaction to the next fallback of its route only while it fails before
+// reaching the acquirer, since the acquirer may have processed it otherwise. Every attempt is
+// recorded before it is sent, and the ones that may have charged the card get a reversal. A
+// transaction whose attempt could not be recorded is not sent to the next fallback.
+func (p *ProcessPayment) process(ctx context.Context, payment *entity.Payment) (result *entity.AcquirerResponse, processErr error, attemptErr error) {
+	transaction := payment.Transaction
+
+	number, err := p.cardRepository.FindCardNumber(ctx, transaction.Card.Token)
+	if err != nil {
+		return nil, err, nil
+	}
+
+	transaction.Card.Number = number
+	defer func() {
+		transaction.Card.Number = ""
+	}()
+	acquirers := append([]string{transaction.Acquirer.Name}, transaction.Fallbacks()...)
+
+	for _, acquirer := range acquirers {
+		transaction.Acquirer = entity.NewAcquirer(acquirer)
+		attempt := payment.AddAttempt(acquirer)
+		transaction.Reference = attempt.Id
+
+		err := p.paymentRepository.CreatePaymentAttempt(ctx, attempt)
+		if err != nil {
+			payment.Attempts = payment.Attempts[:len(payment.Attempts)-1]
+			return nil, err, nil
+		}
+
+		submission := service.WithSubmission(ctx)
+		result, processErr = p.paymentService.ProcessTransaction(submission, transaction)
+		if processErr != nil {
+			var acquirerErr *core_errors.AcquirerError
+			var timeoutErr *core_errors.TimeoutError
+			if errors.As(processErr, &acquirerErr) {
+				attempt.Decline(acquirerErr.Code, acquirerErr.Message)
+			} else if errors.As(processErr, &timeoutErr) {
+				attempt.TimeOut(timeoutErr.Message)
+			} else if service.Submitted(submission) {
+				attempt.Unknown(processErr.Error())
+			} else {
+				attempt.Fail(processErr.Error())
+			}
+		} else {
+			attempt.Succeed(result)
+		}
+
+		recordCtx := context.WithoutCancel(ctx)
+
+		err = p.paymentRepository.UpdatePaymentAttempt(recordCtx, attempt)
+		if err != nil {
+			return result, processErr, err
+		}
+
+		if attempt.Unresolved() {
+			err = p.reversalRepository.CreateReversal(recordCtx, entity.NewReversal(payment, attempt))
+			if err != nil {
+				return result, processErr, err
+			}
+		}
+
+		if !core_errors.IsTemporary(processErr) {
+			break
+		}
+	}
+
+	return result, processErr, nil
+}
+
+// resume settles a pending payment whose processing was interrupted, reporting whether it was
+// sent to an acquirer. A payment that was sent is not sent again: it becomes unknown until the
+// reversals of its attempts are resolved.
+func (p *ProcessPayment) resume(ctx context.Context, payment *entity.Payment, message string) (bool, error) {
+	if len(payment.Attempts) == 0 {
+		return false, nil
+	}
+
+	reversals, err := p.reversalRepository.FindReversals(ctx, payment.Id)
+	if err != nil {
+		return true, err
+	}
+
+	reversed := make(map[string]bool)
+	for _, reversal := range reversals {
+		reversed[reversal.AttemptId] = true
+	}
+
+	payment.Fail(message)
+
+	for _, attempt := range payment.Attempts {
+		if !attempt.MayHaveCharged() {
+			continue
+		}
+
+		if attempt.Status == entity.PaymentAttemptStatusSent {
+			attempt.Unknown(message)
+			err = p.paymentRepository.UpdatePaymentAttempt(ctx, attempt)
+			if err != nil {
+				return true, err
+			}
+		}
+
+		if !reversed[attempt.Id] {
+			err = p.reversalRepository.CreateReversal(ctx, entity.NewReversal(payment, attempt))
+			if err != nil {
+				return true, err
+			}
+		}
+
+		payment.Unknown(message)
+	}
+
+	return true, p.record(ctx, payment)
 }
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type ProcessPaymentBatchesInput struct {
+	Limit int
+}
+
+type ProcessPaymentBatchesOutput struct {
+	Processed int
+	Failed    int
+}
+
+type IProcessPaymentBatches interface {
+	Execute(ctx context.Context, input *ProcessPaymentBatchesInput) (*ProcessPaymentBatchesOutput, error)
+}
+
+type ProcessPaymentBatches struct {
+	paymentBatchProcessor
+}
+
+func NewProcessPaymentBatches(
+	batchRepository repository.IPaymentBatchRepository,
+	processPayment *ProcessPayment,
+) *ProcessPaymentBatches {
+	return &ProcessPaymentBatches{
+		paymentBatchProcessor: paymentBatchProcessor{
+			batchRepository: batchRepository,
+			processPayment:  processPayment,
+		},
+	}
+}
+
+// Execute claims the queued batches and processes them. A batch that could not be recorded
+// is left to be claimed again once its lease expires.
+func (p *ProcessPaymentBatches) Execute(ctx context.Context, input *ProcessPaymentBatchesInput) (*ProcessPaymentBatchesOutput, error) {
+	batches, err := p.batchRepository.ClaimBatches(ctx, time.Now().UTC(), input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ProcessPaymentBatchesOutput{}
+
+	for _, batch := range batches {
+		err = p.process(ctx, batch)
+		if err != nil {
+			slog.Error(err.Error(), "batch", batch.Id)
+			output.Failed++
+			continue
+		}
+
+		output.Processed++
+	}
+
+	return output, nil
+}
@@ -0,0 +1,307 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessPaymentBatchesWithInterruptedBatch(t *testing.T) {
+	ctx := context.Background()
+	payment, _ := createQueuedPayment()
+	attempt := payment.AddAttempt("Acquirer")
+	unrecorded := entity.NewPayment(nil)
+
+	// the first two items were left processing, the payment of the second one before it was
+	// recorded, and the store of the third one is not allowed
+	interrupted := entity.NewPaymentBatchItem(0, &entity.PaymentBatchTransaction{StoreId: testStoreId})
+	interrupted.Start(payment, payment.CreatedAt)
+	unsent := entity.NewPaymentBatchItem(1, &entity.PaymentBatchTransaction{StoreId: testStoreId})
+	unsent.Start(unrecorded, payment.CreatedAt)
+	forbidden := entity.NewPaymentBatchItem(2, &entity.PaymentBatchTransaction{StoreId: "Store"})
+	finished := entity.NewPaymentBatchItem(3, &entity.PaymentBatchTransaction{StoreId: testStoreId})
+	finished.Succeed(payment, payment.CreatedAt)
+
+	batch := entity.NewPaymentBatch("Caller", []string{testStoreId}, []*entity.PaymentBatchItem{interrupted, unsent, forbidden, finished})
+	batch.Claim(batch.CreatedAt)
+	batch.Claim(batch.CreatedAt)
+
+	batchRepository := repository.NewIPaymentBatchRepositoryMock(t)
+	batchRepository.
+		EXPECT().
+		ClaimBatches(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentBatch{batch}, nil).
+		Once()
+	batchRepository.
+		EXPECT().
+		UpdateBatchItem(ctx, batch.Id, interrupted).
+		Run(func(ctx context.Context, batchId string, item *entity.PaymentBatchItem) {
+			assert.Equal(t, entity.PaymentBatchItemStatusFailed, item.Status)
+			assert.Equal(t, payment.Id, item.PaymentId)
+			assert.Equal(t, entity.PaymentStatusUnknown, item.PaymentStatus)
+			assert.Equal(t, []string{"payment batch processing was interrupted"}, item.ErrorMessages)
+		}).
+		Return(nil).
+		Once()
+	batchRepository.
+		EXPECT().
+		UpdateBatchItem(ctx, batch.Id, unsent).
+		Run(func(ctx context.Context, batchId string, item *entity.PaymentBatchItem) {
+			assert.Equal(t, entity.PaymentBatchItemStatusFailed, item.Status)
+			assert.Equal(t, entity.PaymentBatchErrorInternal, item.ErrorType)
+		}).
+		Return(nil).
+		Once()
+	batchRepository.
+		EXPECT().
+		UpdateBatchItem(ctx, batch.Id, forbidden).
+		Run(func(ctx context.Context, batchId string, item *entity.PaymentBatchItem) {
+			assert.Equal(t, entity.PaymentBatchItemStatusFailed, item.Status)
+			assert.Equal(t, entity.PaymentBatchErrorForbidden, item.ErrorType)
+		}).
+		Return(nil).
+		Once()
+	batchRepository.
+		EXPECT().
+		UpdateBatch(ctx, batch).
+		Run(func(ctx context.Context, batch *entity.PaymentBatch) {
+			assert.Equal(t, entity.PaymentBatchStatusDone, batch.Status)
+		}).
+		Return(nil).
+		Once()
+
+	// the payments are not sent to the acquirer again, and the attempt is reversed
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, unrecorded.Id).
+		Return(nil, core_errors.NewNotFoundError("payment id is invalid")).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(ctx, attempt).
+		Run(func(ctx context.Context, attempt *entity.PaymentAttempt) {
+			assert.Equal(t, entity.PaymentAttemptStatusUnknown, attempt.Status)
+		}).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(ctx, payment).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusUnknown, payment.Status)
+		}).
+		Return(nil).
+		Once()
+
+	reversalRepository := repository.NewIReversalRepositoryMock(t)
+	reversalRepository.
+		EXPECT().
+		FindReversals(ctx, payment.Id).
+		Return([]*entity.Reversal{}, nil).
+		Once()
+	reversalRepository.
+		EXPECT().
+		CreateReversal(ctx, mock.Anything).
+		Run(func(ctx context.Context, reversal *entity.Reversal) {
+			assert.Equal(t, attempt.Id, reversal.AttemptId)
+		}).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(repository.NewICardRepositoryMock(t), paymentRepository, reversalRepository, repository.NewIStoreRepositoryMock(t), service.NewIPaymentServiceMock(t), service.NewIRoutingServiceMock(t))
+	processPaymentBatches := NewProcessPaymentBatches(batchRepository, processPayment)
+
+	output, err := processPaymentBatches.Execute(ctx, &ProcessPaymentBatchesInput{Limit: 1})
+	require.Nil(t, err)
+	assert.Equal(t, 1, output.Processed)
+	assert.Equal(t, 0, output.Failed)
+}
+
+func TestProcessPaymentBatchesWithAcquirerConcurrency(t *testing.T) {
+	ctx := context.Background()
+	transactions := 4 * PaymentBatchAcquirerConcurrency
+
+	items := make([]*entity.PaymentBatchItem, 0, transactions)
+	for i := 0; i < transactions; i++ {
+		items = append(items, entity.NewPaymentBatchItem(i, &entity.PaymentBatchTransaction{
+			CardToken:            "Token",
+			PurchaseAmount:       499,
+			PurchaseCurrency:     "BRL",
+			PurchaseItems:        []string{"Item 1", "Item 2"},
+			PurchaseInstallments: 2,
+			StoreId:              testStoreId,
+		}))
+	}
+
+	batch := entity.NewPaymentBatch("Caller", []string{testStoreId}, items)
+	batch.Claim(batch.CreatedAt)
+
+	batchRepository := repository.NewIPaymentBatchRepositoryMock(t)
+	batchRepository.
+		EXPECT().
+		ClaimBatches(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentBatch{batch}, nil).
+		Once()
+	batchRepository.
+		EXPECT().
+		UpdateBatchItem(ctx, batch.Id, mock.Anything).
+		Return(nil).
+		Times(2 * transactions)
+	batchRepository.
+		EXPECT().
+		UpdateBatch(ctx, batch).
+		Return(nil).
+		Once()
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, "Token").
+		RunAndReturn(func(ctx context.Context, token string) (*entity.Card, error) {
+			return entity.NewCard(token, "Holder", "12/2099", "Brand"), nil
+		}).
+		Times(transactions)
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, "Token").
+		Return("4111111111111111", nil).
+		Times(transactions)
+
+	storeRepository := repository.NewIStoreRepositoryMock(t)
+	storeRepository.
+		EXPECT().
+		FindStore(ctx, testStoreId).
+		Return(entity.NewStore("11222333000181", "Address", "01310100"), nil).
+		Times(transactions)
+
+	// half of the transactions are routed to rede, and the other half fall back to it
+	routed := 0
+	routingService := service.NewIRoutingServiceMock(t)
+	routingService.
+		EXPECT().
+		Route(ctx, mock.Anything).
+		RunAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.Route, error) {
+			routed++
+			if routed%2 == 0 {
+				return entity.NewRoute("rede", "Rule", nil), nil
+			}
+
+			route := entity.NewRoute("cielo", "Rule", nil)
+			route.Fallbacks = []string{"rede"}
+			return route, nil
+		}).
+		Times(transactions)
+
+	var mu sync.Mutex
+	inflight, maxInflight := 0, 0
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.MatchedBy(func(transaction *entity.Transaction) bool {
+			return transaction.Acquirer.Name == "cielo"
+		})).
+		Return(nil, core_errors.NewUnavailableError(errors.New("connection refused"))).
+		Times(transactions / 2)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.MatchedBy(func(transaction *entity.Transaction) bool {
+			return transaction.Acquirer.Name == "rede"
+		})).
+		RunAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.AcquirerResponse, error) {
+			mu.Lock()
+			inflight++
+			maxInflight = max(maxInflight, inflight)
+			mu.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+
+			mu.Lock()
+			inflight--
+			mu.Unlock()
+
+			return entity.NewAcquirerResponse("id", 200, "id"), nil
+		}).
+		Times(transactions)
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Return(nil).
+		Times(transactions)
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Times(transactions + transactions/2)
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Times(transactions + transactions/2)
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Return(nil).
+		Times(transactions)
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), storeRepository, paymentService, routingService)
+	processPaymentBatches := NewProcessPaymentBatches(batchRepository, processPayment)
+
+	output, err := processPaymentBatches.Execute(ctx, &ProcessPaymentBatchesInput{Limit: 1})
+	require.Nil(t, err)
+	assert.Equal(t, 1, output.Processed)
+
+	succeeded, _, _ := batch.Count()
+	assert.Equal(t, transactions, succeeded)
+	assert.Greater(t, maxInflight, 1)
+	assert.LessOrEqual(t, maxInflight, PaymentBatchAcquirerConcurrency)
+}
+
+func TestProcessPaymentBatchesWithUpdateError(t *testing.T) {
+	ctx := context.Background()
+
+	batch := entity.NewPaymentBatch("Caller", []string{testStoreId}, []*entity.PaymentBatchItem{
+		entity.NewPaymentBatchItem(0, &entity.PaymentBatchTransaction{StoreId: "Store"}),
+	})
+	batch.Claim(batch.CreatedAt)
+
+	// the batch is not completed, so it is claimed again once its lease expires
+	batchRepository := repository.NewIPaymentBatchRepositoryMock(t)
+	batchRepository.
+		EXPECT().
+		ClaimBatches(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentBatch{batch}, nil).
+		Once()
+	batchRepository.
+		EXPECT().
+		UpdateBatchItem(ctx, batch.Id, mock.Anything).
+		Return(errors.New("database error")).
+		Once()
+
+	processPayment := NewProcessPayment(repository.NewICardRepositoryMock(t), repository.NewIPaymentRepositoryMock(t), repository.NewIReversalRepositoryMock(t), repository.NewIStoreRepositoryMock(t), service.NewIPaymentServiceMock(t), service.NewIRoutingServiceMock(t))
+	processPaymentBatches := NewProcessPaymentBatches(batchRepository, processPayment)
+
+	output, err := processPaymentBatches.Execute(ctx, &ProcessPaymentBatchesInput{Limit: 1})
+	require.Nil(t, err)
+	assert.Equal(t, 0, output.Processed)
+	assert.Equal(t, 1, output.Failed)
+	assert.Equal(t, entity.PaymentBatchStatusProcessing, batch.Status)
+}
@@ -2,10 +2,12 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
 	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	iservice "github.com/sesaquecruz/go-payment-processor/internal/core/service"
 	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
 	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
 
@@ -16,17 +18,18 @@ import (
 
 func TestProcessPaymentWithValidTransaction(t *testing.T) {
 	ctx := context.Background()
-	card := entity.NewCard("Token", "Holder", "Expiration", "Brand")
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
 
 	input := ProcessPaymentInput{
 		CardToken:            card.Token,
-		PurchaseValue:        4.99,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
 		PurchaseItems:        []string{"Item 1", "Item 2"},
 		PurchaseInstallments: 2,
-		StoreIdentification:  "Identification",
-		StoreAddress:         "Address",
-		StoreCep:             "Cep",
+		StoreId:              testStoreId,
 		AcquirerName:         "Acquirer",
+		Caller:               "Caller",
+		AllowedStores:        []string{testStoreId},
 	}
 
 	cardRepository := repository.NewICardRepositoryMock(t)
@@ -35,28 +38,148 @@ func TestProcessPaymentWithValidTransaction(t *testing.T) {
 		FindCard(ctx, input.CardToken).
 		Return(card, nil).
 		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, input.CardToken).
+		Return("4111111111111111", nil).
+		Once()
 
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
 	paymentService := service.NewIPaymentServiceMock(t)
 	paymentService.
 		EXPECT().
-		ProcessTransaction(ctx, mock.Anything).
+		ProcessTransaction(mock.Anything, mock.Anything).
 		Run(func(ctx context.Context, transaction *entity.Transaction) {
 			assert.Equal(t, card, transaction.Card)
-			assert.Equal(t, input.PurchaseValue, transaction.Purchase.Value)
+			assert.Equal(t, "4111111111111111", transaction.Card.Number)
+			assert.Equal(t, entity.NewMoney(input.PurchaseAmount, input.PurchaseCurrency), transaction.Purchase.Value)
 			assert.EqualValues(t, input.PurchaseItems, transaction.Purchase.Items)
 			assert.Equal(t, input.PurchaseInstallments, transaction.Purchase.Installments)
-			assert.Equal(t, input.StoreIdentification, transaction.Store.Identification)
-			assert.Equal(t, input.StoreAddress, transaction.Store.Address)
-			assert.Equal(t, input.StoreCep, transaction.Store.Cep)
+			assert.Equal(t, "11222333000181", transaction.Store.Identification)
+			assert.Equal(t, entity.DocumentTypeCnpj, transaction.Store.DocumentType)
+			assert.Equal(t, testStoreId, transaction.Store.Id)
+			assert.Equal(t, "Address", transaction.Store.Address)
+			assert.Equal(t, "01310100", transaction.Store.Cep)
+			assert.Equal(t, "Avenida Paulista", transaction.Store.Street)
+			assert.Equal(t, "1000", transaction.Store.Number)
+			assert.Equal(t, "São Paulo", transaction.Store.City)
+			assert.Equal(t, "SP", transaction.Store.State)
+		}).
+		Return(entity.NewAcquirerResponse("id", 200, "id"), nil).
+		Once()
+
+	var paymentId string
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			paymentId = payment.Id
+			assert.Equal(t, entity.PaymentStatusPending, payment.Status)
+			assert.Equal(t, "Caller", payment.Caller)
+		}).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, paymentId, payment.Id)
+			assert.Equal(t, entity.PaymentStatusApproved, payment.Status)
+			assert.Equal(t, "id", payment.AcquirerId)
+			assert.Empty(t, payment.Transaction.Card.Number)
+		}).
+		Return(nil).
+		Once()
+
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, service.NewIRoutingServiceMock(t))
+
+	output, err := processPayment.Execute(ctx, &input)
+	assert.Nil(t, err)
+	assert.Equal(t, paymentId, output.PaymentId)
+	assert.Equal(t, "approved", output.PaymentStatus)
+}
+
+func TestProcessPaymentWithAuthorizeOnly(t *testing.T) {
+	ctx := context.Background()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := ProcessPaymentInput{
+		CardToken:            card.Token,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              testStoreId,
+		AcquirerName:         "Acquirer",
+		AuthorizeOnly:        true,
+		AllowedStores:        []string{testStoreId},
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, input.CardToken).
+		Return(card, nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, input.CardToken).
+		Return("", nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, transaction *entity.Transaction) {
+			assert.True(t, transaction.AuthorizeOnly)
+		}).
+		Return(entity.NewAcquirerResponse("id", 200, "id"), nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusAuthorized, payment.Status)
+			assert.Equal(t, int64(0), payment.CapturedValue.Amount)
 		}).
-		Return(entity.NewPayment("id"), nil).
+		Return(nil).
+		Once()
+
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
 		Once()
 
-	processPayment := NewProcessPayment(cardRepository, paymentService)
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, service.NewIRoutingServiceMock(t))
 
 	output, err := processPayment.Execute(ctx, &input)
 	assert.Nil(t, err)
-	assert.NotEmpty(t, output.PaymentId)
+	assert.Equal(t, "authorized", output.PaymentStatus)
 }
 
 func TestProcessPaymentWithInvalidCardToken(t *testing.T) {
@@ -64,13 +187,13 @@ func TestProcessPaymentWithInvalidCardToken(t *testing.T) {
 
 	input := ProcessPaymentInput{
 		CardToken:            "",
-		PurchaseValue:        4.99,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
 		PurchaseItems:        []string{"Item 1", "Item 2"},
 		PurchaseInstallments: 2,
-		StoreIdentification:  "Identification",
-		StoreAddress:         "Address",
-		StoreCep:             "Cep",
+		StoreId:              testStoreId,
 		AcquirerName:         "Acquirer",
+		AllowedStores:        []string{testStoreId},
 	}
 
 	cardRepository := repository.NewICardRepositoryMock(t)
@@ -80,8 +203,9 @@ func TestProcessPaymentWithInvalidCardToken(t *testing.T) {
 		Return(nil, core_errors.NewNotFoundError("card not found")).
 		Once()
 
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
 	paymentService := service.NewIPaymentServiceMock(t)
-	processPayment := NewProcessPayment(cardRepository, paymentService)
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), repository.NewIStoreRepositoryMock(t), paymentService, service.NewIRoutingServiceMock(t))
 
 	output, err := processPayment.Execute(ctx, &input)
 	assert.Nil(t, output)
@@ -94,17 +218,17 @@ func TestProcessPaymentWithInvalidCardToken(t *testing.T) {
 
 func TestProcessPaymentWithInvalidPurchaseData(t *testing.T) {
 	ctx := context.Background()
-	card := entity.NewCard("Token", "Holder", "Expiration", "Brand")
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
 
 	input := ProcessPaymentInput{
 		CardToken:            card.Token,
-		PurchaseValue:        0,
+		PurchaseAmount:       0,
+		PurchaseCurrency:     "BRL",
 		PurchaseItems:        []string{""},
 		PurchaseInstallments: 0,
-		StoreIdentification:  "Identification",
-		StoreAddress:         "Address",
-		StoreCep:             "Cep",
+		StoreId:              testStoreId,
 		AcquirerName:         "Acquirer",
+		AllowedStores:        []string{testStoreId},
 	}
 
 	cardRepository := repository.NewICardRepositoryMock(t)
@@ -114,8 +238,9 @@ func TestProcessPaymentWithInvalidPurchaseData(t *testing.T) {
 		Return(card, nil).
 		Once()
 
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
 	paymentService := service.NewIPaymentServiceMock(t)
-	processPayment := NewProcessPayment(cardRepository, paymentService)
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, service.NewIRoutingServiceMock(t))
 
 	output, err := processPayment.Execute(ctx, &input)
 	assert.Nil(t, output)
@@ -135,19 +260,20 @@ func TestProcessPaymentWithInvalidPurchaseData(t *testing.T) {
 	}
 }
 
-func TestProcessPaymentWithInvalidStoreData(t *testing.T) {
+func TestProcessPaymentWithUnregisteredStore(t *testing.T) {
 	ctx := context.Background()
-	card := entity.NewCard("Token", "Holder", "Expiration", "Brand")
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+	storeId := "9e4c2f71-6a3d-4b8e-8f25-1d7a0c9b4e62"
 
 	input := ProcessPaymentInput{
 		CardToken:            card.Token,
-		PurchaseValue:        4.99,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
 		PurchaseItems:        []string{"Item 1", "Item 2"},
 		PurchaseInstallments: 2,
-		StoreIdentification:  "",
-		StoreAddress:         "",
-		StoreCep:             "",
+		StoreId:              storeId,
 		AcquirerName:         "Acquirer",
+		AllowedStores:        []string{storeId},
 	}
 
 	cardRepository := repository.NewICardRepositoryMock(t)
@@ -157,40 +283,161 @@ func TestProcessPaymentWithInvalidStoreData(t *testing.T) {
 		Return(card, nil).
 		Once()
 
+	storeRepository := repository.NewIStoreRepositoryMock(t)
+	storeRepository.
+		EXPECT().
+		FindStore(ctx, storeId).
+		Return(nil, core_errors.NewNotFoundError("store id is invalid")).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
 	paymentService := service.NewIPaymentServiceMock(t)
-	processPayment := NewProcessPayment(cardRepository, paymentService)
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), storeRepository, paymentService, service.NewIRoutingServiceMock(t))
 
 	output, err := processPayment.Execute(ctx, &input)
 	assert.Nil(t, output)
 
-	var w *core_errors.ValidationError
+	var w *core_errors.NotFoundError
 	require.ErrorAs(t, err, &w)
+	assert.Equal(t, "store id is invalid", w.Message)
+}
 
-	msgs := w.Messages
-	assert.Equal(t, 3, len(msgs))
+func TestProcessPaymentWithStoreNotAllowed(t *testing.T) {
+	ctx := context.Background()
 
-	for i, msg := range []string{
-		"store identification is required",
-		"store address is required",
-		"store cep is required",
-	} {
-		assert.Equal(t, msg, msgs[i])
+	testCases := []struct {
+		Test          string
+		StoreId       string
+		AllowedStores []string
+	}{
+		{"store of another client", testStoreId, []string{"9e4c2f71-6a3d-4b8e-8f25-1d7a0c9b4e62"}},
+		{"client without stores", testStoreId, nil},
+		{"empty store id", "", []string{testStoreId}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Test, func(t *testing.T) {
+			input := ProcessPaymentInput{
+				CardToken:            "Token",
+				PurchaseAmount:       499,
+				PurchaseCurrency:     "BRL",
+				PurchaseItems:        []string{"Item 1", "Item 2"},
+				PurchaseInstallments: 2,
+				StoreId:              tc.StoreId,
+				AcquirerName:         "Acquirer",
+				AllowedStores:        tc.AllowedStores,
+			}
+
+			processPayment := NewProcessPayment(
+				repository.NewICardRepositoryMock(t),
+				repository.NewIPaymentRepositoryMock(t),
+				repository.NewIReversalRepositoryMock(t),
+				repository.NewIStoreRepositoryMock(t),
+				service.NewIPaymentServiceMock(t),
+				service.NewIRoutingServiceMock(t),
+			)
+
+			output, err := processPayment.Execute(ctx, &input)
+			assert.Nil(t, output)
+
+			var w *core_errors.ForbiddenError
+			require.ErrorAs(t, err, &w)
+			assert.Equal(t, "store is not allowed for this client", w.Message)
+		})
+	}
+}
+
+func TestProcessPaymentWithRoutedAcquirer(t *testing.T) {
+	ctx := context.Background()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := ProcessPaymentInput{
+		CardToken:            card.Token,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              testStoreId,
+		AcquirerName:         "",
+		AllowedStores:        []string{testStoreId},
 	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, input.CardToken).
+		Return(card, nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, input.CardToken).
+		Return("", nil).
+		Once()
+
+	route := entity.NewRoute("cielo", "cielo-up-to-100", nil)
+	routingService := service.NewIRoutingServiceMock(t)
+	routingService.
+		EXPECT().
+		Route(ctx, mock.Anything).
+		Return(route, nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, transaction *entity.Transaction) {
+			assert.Equal(t, "cielo", transaction.Acquirer.Name)
+		}).
+		Return(entity.NewAcquirerResponse("id", 200, "id"), nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, route, payment.Transaction.Route)
+		}).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, routingService)
+
+	output, err := processPayment.Execute(ctx, &input)
+	assert.Nil(t, err)
+	assert.Equal(t, "approved", output.PaymentStatus)
 }
 
-func TestProcessPaymentWithInvalidAcquirer(t *testing.T) {
+func TestProcessPaymentWithoutAcquirerRoute(t *testing.T) {
 	ctx := context.Background()
-	card := entity.NewCard("Token", "Holder", "Expiration", "Brand")
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
 
 	input := ProcessPaymentInput{
 		CardToken:            card.Token,
-		PurchaseValue:        4.99,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
 		PurchaseItems:        []string{"Item 1", "Item 2"},
 		PurchaseInstallments: 2,
-		StoreIdentification:  "Identification",
-		StoreAddress:         "Address",
-		StoreCep:             "Cep",
+		StoreId:              testStoreId,
 		AcquirerName:         "",
+		AllowedStores:        []string{testStoreId},
 	}
 
 	cardRepository := repository.NewICardRepositoryMock(t)
@@ -200,8 +447,16 @@ func TestProcessPaymentWithInvalidAcquirer(t *testing.T) {
 		Return(card, nil).
 		Once()
 
+	routingService := service.NewIRoutingServiceMock(t)
+	routingService.
+		EXPECT().
+		Route(ctx, mock.Anything).
+		Return(nil, core_errors.NewValidationError("no acquirer route matches the transaction")).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
 	paymentService := service.NewIPaymentServiceMock(t)
-	processPayment := NewProcessPayment(cardRepository, paymentService)
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, routingService)
 
 	output, err := processPayment.Execute(ctx, &input)
 	assert.Nil(t, output)
@@ -209,29 +464,22 @@ func TestProcessPaymentWithInvalidAcquirer(t *testing.T) {
 	var w *core_errors.ValidationError
 	require.ErrorAs(t, err, &w)
 
-	msgs := w.Messages
-	assert.Equal(t, 1, len(msgs))
-
-	for i, msg := range []string{
-		"acquirer name is required",
-	} {
-		assert.Equal(t, msg, msgs[i])
-	}
+	assert.Equal(t, []string{"no acquirer route matches the transaction"}, w.Messages)
 }
 
 func TestProcessPaymentWithAcquirerError(t *testing.T) {
 	ctx := context.Background()
-	card := entity.NewCard("Token", "Holder", "Expiration", "Brand")
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
 
 	input := ProcessPaymentInput{
 		CardToken:            card.Token,
-		PurchaseValue:        4.99,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
 		PurchaseItems:        []string{"Item 1", "Item 2"},
 		PurchaseInstallments: 2,
-		StoreIdentification:  "Identification",
-		StoreAddress:         "Address",
-		StoreCep:             "Cep",
+		StoreId:              testStoreId,
 		AcquirerName:         "Acquirer",
+		AllowedStores:        []string{testStoreId},
 	}
 
 	cardRepository := repository.NewICardRepositoryMock(t)
@@ -240,15 +488,48 @@ func TestProcessPaymentWithAcquirerError(t *testing.T) {
 		FindCard(ctx, input.CardToken).
 		Return(card, nil).
 		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, input.CardToken).
+		Return("", nil).
+		Once()
 
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
 	paymentService := service.NewIPaymentServiceMock(t)
 	paymentService.
 		EXPECT().
-		ProcessTransaction(ctx, mock.Anything).
+		ProcessTransaction(mock.Anything, mock.Anything).
 		Return(nil, core_errors.NewAcquirerError(503, "acquirer is unavailable")).
 		Once()
 
-	processPayment := NewProcessPayment(cardRepository, paymentService)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusDeclined, payment.Status)
+			assert.Equal(t, 503, payment.AcquirerCode)
+			assert.Equal(t, "acquirer is unavailable", payment.AcquirerMessage)
+		}).
+		Return(nil).
+		Once()
+
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, service.NewIRoutingServiceMock(t))
 
 	output, err := processPayment.Execute(ctx, &input)
 	assert.Nil(t, output)
@@ -259,3 +540,790 @@ func TestProcessPaymentWithAcquirerError(t *testing.T) {
 	assert.Equal(t, 503, w.Code)
 	assert.Equal(t, "acquirer is unavailable", w.Message)
 }
+
+func TestProcessPaymentWithInternalError(t *testing.T) {
+	ctx := context.Background()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := ProcessPaymentInput{
+		CardToken:            card.Token,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              testStoreId,
+		AcquirerName:         "Acquirer",
+		AllowedStores:        []string{testStoreId},
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, input.CardToken).
+		Return(card, nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, input.CardToken).
+		Return("", nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusFailed, payment.Status)
+			assert.Equal(t, "connection refused", payment.AcquirerMessage)
+		}).
+		Return(nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.Anything).
+		Return(nil, core_errors.NewInternalError(errors.New("connection refused"))).
+		Once()
+
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, service.NewIRoutingServiceMock(t))
+
+	output, err := processPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.InternalError
+	require.ErrorAs(t, err, &w)
+}
+
+func TestProcessPaymentWithRepositoryError(t *testing.T) {
+	ctx := context.Background()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := ProcessPaymentInput{
+		CardToken:            card.Token,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              testStoreId,
+		AcquirerName:         "Acquirer",
+		AllowedStores:        []string{testStoreId},
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, input.CardToken).
+		Return(card, nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Return(core_errors.NewInternalError(errors.New("database is unavailable"))).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, service.NewIRoutingServiceMock(t))
+
+	output, err := processPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.InternalError
+	require.ErrorAs(t, err, &w)
+}
+
+func TestProcessPaymentWithAttemptRepositoryError(t *testing.T) {
+	ctx := context.Background()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := ProcessPaymentInput{
+		CardToken:            card.Token,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              testStoreId,
+		AcquirerName:         "Acquirer",
+		AllowedStores:        []string{testStoreId},
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, input.CardToken).
+		Return(card, nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, input.CardToken).
+		Return("", nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.Anything).
+		Return(entity.NewAcquirerResponse("id", 200, "id"), nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(core_errors.NewInternalError(errors.New("database is unavailable"))).
+		Once()
+
+	// the card was charged, so the payment keeps the approval of the acquirer
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusApproved, payment.Status)
+			assert.Equal(t, "id", payment.AcquirerId)
+		}).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, service.NewIRoutingServiceMock(t))
+
+	output, err := processPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.InternalError
+	require.ErrorAs(t, err, &w)
+}
+
+func TestProcessPaymentWithUnrecordedAttempt(t *testing.T) {
+	ctx := context.Background()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := ProcessPaymentInput{
+		CardToken:            card.Token,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              testStoreId,
+		AcquirerName:         "Acquirer",
+		AllowedStores:        []string{testStoreId},
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, input.CardToken).
+		Return(card, nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, input.CardToken).
+		Return("", nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(ctx, mock.Anything).
+		Return(core_errors.NewInternalError(errors.New("database is unavailable"))).
+		Once()
+
+	// the payment is not sent to the acquirer without its attempt
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusFailed, payment.Status)
+			assert.Empty(t, payment.Attempts)
+		}).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), service.NewIPaymentServiceMock(t), service.NewIRoutingServiceMock(t))
+
+	output, err := processPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.InternalError
+	require.ErrorAs(t, err, &w)
+}
+
+func TestProcessPaymentWithFailover(t *testing.T) {
+	ctx := context.Background()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := ProcessPaymentInput{
+		CardToken:            card.Token,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              testStoreId,
+		AllowedStores:        []string{testStoreId},
+	}
+
+	route := entity.NewRoute("cielo", "Rule", nil)
+	route.Fallbacks = []string{"rede", "stone"}
+
+	t.Run("retries technical failures on the next acquirer", func(t *testing.T) {
+		cardRepository := repository.NewICardRepositoryMock(t)
+		cardRepository.
+			EXPECT().
+			FindCard(ctx, input.CardToken).
+			Return(card, nil).
+			Once()
+		cardRepository.
+			EXPECT().
+			FindCardNumber(ctx, input.CardToken).
+			Return("", nil).
+			Once()
+
+		routingService := service.NewIRoutingServiceMock(t)
+		routingService.
+			EXPECT().
+			Route(ctx, mock.Anything).
+			Return(route, nil).
+			Once()
+
+		acquirers := make([]string, 0)
+		paymentService := service.NewIPaymentServiceMock(t)
+		paymentService.
+			EXPECT().
+			ProcessTransaction(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, transaction *entity.Transaction) {
+				acquirers = append(acquirers, transaction.Acquirer.Name)
+			}).
+			Return(nil, core_errors.NewUnavailableError(errors.New("connection refused"))).
+			Once()
+		paymentService.
+			EXPECT().
+			ProcessTransaction(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, transaction *entity.Transaction) {
+				acquirers = append(acquirers, transaction.Acquirer.Name)
+			}).
+			Return(nil, core_errors.NewAcquirerError(503, "service unavailable")).
+			Once()
+		paymentService.
+			EXPECT().
+			ProcessTransaction(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, transaction *entity.Transaction) {
+				acquirers = append(acquirers, transaction.Acquirer.Name)
+			}).
+			Return(entity.NewAcquirerResponse("id", 200, "id"), nil).
+			Once()
+
+		attempts := make([]*entity.PaymentAttempt, 0)
+		paymentRepository := repository.NewIPaymentRepositoryMock(t)
+		paymentRepository.
+			EXPECT().
+			CreatePayment(ctx, mock.Anything).
+			Return(nil).
+			Once()
+		paymentRepository.
+			EXPECT().
+			CreatePaymentAttempt(mock.Anything, mock.Anything).
+			Return(nil).
+			Times(3)
+		paymentRepository.
+			EXPECT().
+			UpdatePaymentAttempt(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, attempt *entity.PaymentAttempt) {
+				attempts = append(attempts, attempt)
+			}).
+			Return(nil).
+			Times(3)
+		paymentRepository.
+			EXPECT().
+			UpdatePayment(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, payment *entity.Payment) {
+				assert.Equal(t, entity.PaymentStatusApproved, payment.Status)
+				assert.Equal(t, "stone", payment.Transaction.Acquirer.Name)
+				assert.Equal(t, 3, len(payment.Attempts))
+			}).
+			Return(nil).
+			Once()
+
+		processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, routingService)
+
+		output, err := processPayment.Execute(ctx, &input)
+		require.Nil(t, err)
+		assert.Equal(t, "approved", output.PaymentStatus)
+		assert.Equal(t, []string{"cielo", "rede", "stone"}, acquirers)
+
+		require.Equal(t, 3, len(attempts))
+		assert.Equal(t, entity.PaymentAttemptStatusFailed, attempts[0].Status)
+		assert.Equal(t, "connection refused", attempts[0].AcquirerMessage)
+		assert.Equal(t, entity.PaymentAttemptStatusDeclined, attempts[1].Status)
+		assert.Equal(t, 503, attempts[1].AcquirerCode)
+		assert.Equal(t, entity.PaymentAttemptStatusSucceeded, attempts[2].Status)
+	})
+
+	t.Run("does not retry declines", func(t *testing.T) {
+		cardRepository := repository.NewICardRepositoryMock(t)
+		cardRepository.
+			EXPECT().
+			FindCard(ctx, input.CardToken).
+			Return(card, nil).
+			Once()
+		cardRepository.
+			EXPECT().
+			FindCardNumber(ctx, input.CardToken).
+			Return("", nil).
+			Once()
+
+		routingService := service.NewIRoutingServiceMock(t)
+		routingService.
+			EXPECT().
+			Route(ctx, mock.Anything).
+			Return(route, nil).
+			Once()
+
+		paymentService := service.NewIPaymentServiceMock(t)
+		paymentService.
+			EXPECT().
+			ProcessTransaction(mock.Anything, mock.Anything).
+			Return(nil, core_errors.NewAcquirerError(422, "the maximum purchase value should not exceed 100")).
+			Once()
+
+		paymentRepository := repository.NewIPaymentRepositoryMock(t)
+		paymentRepository.
+			EXPECT().
+			CreatePayment(ctx, mock.Anything).
+			Return(nil).
+			Once()
+		paymentRepository.
+			EXPECT().
+			CreatePaymentAttempt(mock.Anything, mock.Anything).
+			Return(nil).
+			Once()
+		paymentRepository.
+			EXPECT().
+			UpdatePaymentAttempt(mock.Anything, mock.Anything).
+			Return(nil).
+			Once()
+		paymentRepository.
+			EXPECT().
+			UpdatePayment(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, payment *entity.Payment) {
+				assert.Equal(t, entity.PaymentStatusDeclined, payment.Status)
+				assert.Equal(t, "cielo", payment.Transaction.Acquirer.Name)
+				assert.Equal(t, 1, len(payment.Attempts))
+			}).
+			Return(nil).
+			Once()
+
+		processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, routingService)
+
+		output, err := processPayment.Execute(ctx, &input)
+		assert.Nil(t, output)
+
+		var w *core_errors.AcquirerError
+		require.ErrorAs(t, err, &w)
+		assert.Equal(t, 422, w.Code)
+	})
+
+	t.Run("fails when every acquirer fails", func(t *testing.T) {
+		cardRepository := repository.NewICardRepositoryMock(t)
+		cardRepository.
+			EXPECT().
+			FindCard(ctx, input.CardToken).
+			Return(card, nil).
+			Once()
+		cardRepository.
+			EXPECT().
+			FindCardNumber(ctx, input.CardToken).
+			Return("", nil).
+			Once()
+
+		routingService := service.NewIRoutingServiceMock(t)
+		routingService.
+			EXPECT().
+			Route(ctx, mock.Anything).
+			Return(route, nil).
+			Once()
+
+		paymentService := service.NewIPaymentServiceMock(t)
+		paymentService.
+			EXPECT().
+			ProcessTransaction(mock.Anything, mock.Anything).
+			Return(nil, core_errors.NewUnavailableError(errors.New("connection refused"))).
+			Times(3)
+
+		paymentRepository := repository.NewIPaymentRepositoryMock(t)
+		paymentRepository.
+			EXPECT().
+			CreatePayment(ctx, mock.Anything).
+			Return(nil).
+			Once()
+		paymentRepository.
+			EXPECT().
+			CreatePaymentAttempt(mock.Anything, mock.Anything).
+			Return(nil).
+			Times(3)
+		paymentRepository.
+			EXPECT().
+			UpdatePaymentAttempt(mock.Anything, mock.Anything).
+			Return(nil).
+			Times(3)
+		paymentRepository.
+			EXPECT().
+			UpdatePayment(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, payment *entity.Payment) {
+				assert.Equal(t, entity.PaymentStatusFailed, payment.Status)
+				assert.Equal(t, "connection refused", payment.AcquirerMessage)
+			}).
+			Return(nil).
+			Once()
+
+		processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, routingService)
+
+		output, err := processPayment.Execute(ctx, &input)
+		assert.Nil(t, output)
+
+		var w *core_errors.UnavailableError
+		require.ErrorAs(t, err, &w)
+	})
+
+	t.Run("does not retry failures after reaching the acquirer", func(t *testing.T) {
+		cardRepository := repository.NewICardRepositoryMock(t)
+		cardRepository.
+			EXPECT().
+			FindCard(ctx, input.CardToken).
+			Return(card, nil).
+			Once()
+		cardRepository.
+			EXPECT().
+			FindCardNumber(ctx, input.CardToken).
+			Return("", nil).
+			Once()
+
+		routingService := service.NewIRoutingServiceMock(t)
+		routingService.
+			EXPECT().
+			Route(ctx, mock.Anything).
+			Return(route, nil).
+			Once()
+
+		paymentService := service.NewIPaymentServiceMock(t)
+		paymentService.
+			EXPECT().
+			ProcessTransaction(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, transaction *entity.Transaction) {
+				iservice.MarkSubmitted(ctx)
+			}).
+			Return(nil, core_errors.NewInternalError(errors.New("unexpected EOF"))).
+			Once()
+
+		paymentRepository := repository.NewIPaymentRepositoryMock(t)
+		paymentRepository.
+			EXPECT().
+			CreatePayment(ctx, mock.Anything).
+			Return(nil).
+			Once()
+		paymentRepository.
+			EXPECT().
+			CreatePaymentAttempt(mock.Anything, mock.Anything).
+			Return(nil).
+			Once()
+		paymentRepository.
+			EXPECT().
+			UpdatePaymentAttempt(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, attempt *entity.PaymentAttempt) {
+				assert.Equal(t, entity.PaymentAttemptStatusUnknown, attempt.Status)
+				assert.Equal(t, "unexpected EOF", attempt.AcquirerMessage)
+			}).
+			Return(nil).
+			Once()
+		paymentRepository.
+			EXPECT().
+			UpdatePayment(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, payment *entity.Payment) {
+				assert.Equal(t, entity.PaymentStatusUnknown, payment.Status)
+				assert.Equal(t, "cielo", payment.Transaction.Acquirer.Name)
+				assert.Equal(t, 1, len(payment.Attempts))
+			}).
+			Return(nil).
+			Once()
+
+		reversalRepository := repository.NewIReversalRepositoryMock(t)
+		reversalRepository.
+			EXPECT().
+			CreateReversal(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, reversal *entity.Reversal) {
+				assert.Equal(t, "cielo", reversal.Acquirer)
+			}).
+			Return(nil).
+			Once()
+
+		processPayment := NewProcessPayment(cardRepository, paymentRepository, reversalRepository, createStoreRepository(t, ctx), paymentService, routingService)
+
+		output, err := processPayment.Execute(ctx, &input)
+		assert.Nil(t, output)
+
+		var w *core_errors.InternalError
+		require.ErrorAs(t, err, &w)
+	})
+}
+
+func TestProcessPaymentWithTimeout(t *testing.T) {
+	ctx := context.Background()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := ProcessPaymentInput{
+		CardToken:            card.Token,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              testStoreId,
+		AcquirerName:         "Acquirer",
+		AllowedStores:        []string{testStoreId},
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, input.CardToken).
+		Return(card, nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, input.CardToken).
+		Return("", nil).
+		Once()
+
+	var reference string
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, transaction *entity.Transaction) {
+			reference = transaction.Reference
+		}).
+		Return(nil, core_errors.NewTimeoutError("acquirer timed out")).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, attempt *entity.PaymentAttempt) {
+			assert.Equal(t, entity.PaymentAttemptStatusTimedOut, attempt.Status)
+			assert.Equal(t, "acquirer timed out", attempt.AcquirerMessage)
+			assert.Equal(t, reference, attempt.Id)
+		}).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusUnknown, payment.Status)
+		}).
+		Return(nil).
+		Once()
+
+	reversalRepository := repository.NewIReversalRepositoryMock(t)
+	reversalRepository.
+		EXPECT().
+		CreateReversal(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, reversal *entity.Reversal) {
+			assert.Equal(t, reference, reversal.AttemptId)
+			assert.Equal(t, "Acquirer", reversal.Acquirer)
+			assert.Equal(t, entity.NewMoney(499, "BRL"), reversal.Value)
+			assert.Equal(t, entity.ReversalStatusPending, reversal.Status)
+		}).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, reversalRepository, createStoreRepository(t, ctx), paymentService, service.NewIRoutingServiceMock(t))
+
+	output, err := processPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.TimeoutError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, "acquirer timed out", w.Message)
+}
+
+func TestProcessPaymentWithExpiredRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := ProcessPaymentInput{
+		CardToken:            card.Token,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              testStoreId,
+		AcquirerName:         "Acquirer",
+		AllowedStores:        []string{testStoreId},
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, input.CardToken).
+		Return(card, nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, input.CardToken).
+		Return("", nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, transaction *entity.Transaction) {
+			cancel()
+		}).
+		Return(entity.NewAcquirerResponse("id", 200, "id"), nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreatePayment(ctx, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, attempt *entity.PaymentAttempt) {
+			assert.Nil(t, ctx.Err())
+		}).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Nil(t, ctx.Err())
+			assert.Equal(t, entity.PaymentStatusApproved, payment.Status)
+		}).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), paymentService, service.NewIRoutingServiceMock(t))
+
+	output, err := processPayment.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, "approved", output.PaymentStatus)
+}
+
+const testStoreId = "5b0b8b3e-0f8e-4d52-9d8a-3c1f6e2a7b10"
+
+func TestProcessPaymentWithAsync(t *testing.T) {
+	ctx := context.Background()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	input := ProcessPaymentInput{
+		CardToken:            card.Token,
+		PurchaseAmount:       499,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              testStoreId,
+		AcquirerName:         "Acquirer",
+		AuthorizeOnly:        true,
+		Caller:               "Caller",
+		AllowedStores:        []string{testStoreId},
+		Async:                true,
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, input.CardToken).
+		Return(card, nil).
+		Once()
+
+	// the payment is only queued, without reaching the acquirer
+	var paymentId string
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		CreateQueuedPayment(ctx, mock.Anything).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			paymentId = payment.Id
+			assert.Equal(t, entity.PaymentStatusPending, payment.Status)
+			assert.True(t, payment.Transaction.AuthorizeOnly)
+			assert.Equal(t, "Caller", payment.Caller)
+		}).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), createStoreRepository(t, ctx), service.NewIPaymentServiceMock(t), service.NewIRoutingServiceMock(t))
+
+	output, err := processPayment.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, paymentId, output.PaymentId)
+	assert.Equal(t, "pending", output.PaymentStatus)
+}
+
+func createStoreRepository(t *testing.T, ctx context.Context) *repository.IStoreRepositoryMock {
+	store := entity.NewStore("11.222.333/0001-81", "Address", "01310-100")
+	store.Id = testStoreId
+	store.SetLocation("Avenida Paulista", "1000", "São Paulo", "SP")
+
+	storeRepository := repository.NewIStoreRepositoryMock(t)
+	storeRepository.
+		EXPECT().
+		FindStore(ctx, testStoreId).
+		Return(store, nil).
+		Once()
+
+	return storeRepository
+}
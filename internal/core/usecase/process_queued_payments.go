@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type ProcessQueuedPaymentsInput struct {
+	Limit int
+}
+
+type ProcessQueuedPaymentsOutput struct {
+	Processed int
+	Failed    int
+}
+
+type IProcessQueuedPayments interface {
+	Execute(ctx context.Context, input *ProcessQueuedPaymentsInput) (*ProcessQueuedPaymentsOutput, error)
+}
+
+type ProcessQueuedPayments struct {
+	paymentJobRepository repository.IPaymentJobRepository
+	processPayment       *ProcessPayment
+}
+
+func NewProcessQueuedPayments(
+	paymentJobRepository repository.IPaymentJobRepository,
+	processPayment *ProcessPayment,
+) *ProcessQueuedPayments {
+	return &ProcessQueuedPayments{
+		paymentJobRepository: paymentJobRepository,
+		processPayment:       processPayment,
+	}
+}
+
+// Execute claims the jobs one at a time, so a job is never left waiting under a lease that
+// expires while the previous ones are processed.
+func (q *ProcessQueuedPayments) Execute(ctx context.Context, input *ProcessQueuedPaymentsInput) (*ProcessQueuedPaymentsOutput, error) {
+	output := &ProcessQueuedPaymentsOutput{}
+
+	for i := 0; i < input.Limit; i++ {
+		jobs, err := q.paymentJobRepository.ClaimJobs(ctx, time.Now().UTC(), 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(jobs) == 0 {
+			break
+		}
+
+		job := jobs[0]
+		jobErr := q.processJob(ctx, job)
+		if jobErr != nil {
+			slog.Error(jobErr.Error(), "payment", job.PaymentId)
+			job.Fail(jobErr.Error(), time.Now().UTC())
+			output.Failed++
+		} else {
+			job.Complete(time.Now().UTC())
+			output.Processed++
+		}
+
+		err = q.paymentJobRepository.UpdateJob(ctx, job)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// processJob only sends the payment of an interrupted job when the previous worker stopped
+// before sending it, since the acquirer may have charged it otherwise.
+func (q *ProcessQueuedPayments) processJob(ctx context.Context, job *entity.PaymentJob) error {
+	p := q.processPayment
+
+	payment, err := p.paymentRepository.FindPayment(ctx, job.PaymentId)
+	if err != nil {
+		return err
+	}
+
+	if payment.Status != entity.PaymentStatusPending {
+		return nil
+	}
+
+	if job.Interrupted() {
+		sent, err := p.resume(ctx, payment, "payment processing was interrupted")
+		if sent || err != nil {
+			return err
+		}
+	}
+
+	transaction := payment.Transaction
+	transaction.AuthorizeOnly = job.AuthorizeOnly
+	if transaction.Route != nil {
+		transaction.Route.Fallbacks = job.Fallbacks
+	}
+
+	card, err := p.cardRepository.FindCard(ctx, transaction.Card.Token)
+	if err != nil {
+		payment.Fail(err.Error())
+		return p.record(ctx, payment)
+	}
+
+	transaction.Card = card
+
+	// the error of the acquirer is recorded on the payment
+	_, attemptErr := p.charge(ctx, payment)
+
+	err = p.record(context.WithoutCancel(ctx), payment)
+	if err != nil {
+		return err
+	}
+
+	return attemptErr
+}
@@ -0,0 +1,357 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessQueuedPaymentsWithApprovedPayment(t *testing.T) {
+	ctx := context.Background()
+	payment, job := createQueuedPayment()
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	jobRepository := repository.NewIPaymentJobRepositoryMock(t)
+	jobRepository.
+		EXPECT().
+		ClaimJobs(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentJob{job}, nil).
+		Once()
+	jobRepository.
+		EXPECT().
+		ClaimJobs(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentJob{}, nil).
+		Once()
+	jobRepository.
+		EXPECT().
+		UpdateJob(ctx, job).
+		Run(func(ctx context.Context, job *entity.PaymentJob) {
+			assert.Equal(t, entity.PaymentJobStatusDone, job.Status)
+		}).
+		Return(nil).
+		Once()
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, card.Token).
+		Return(card, nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, card.Token).
+		Return("4111111111111111", nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, transaction *entity.Transaction) {
+			assert.Equal(t, "4111111111111111", transaction.Card.Number)
+			assert.Equal(t, "Acquirer", transaction.Acquirer.Name)
+		}).
+		Return(entity.NewAcquirerResponse("id", 200, "id"), nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, payment).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusApproved, payment.Status)
+			assert.Empty(t, payment.Transaction.Card.Number)
+		}).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), repository.NewIStoreRepositoryMock(t), paymentService, service.NewIRoutingServiceMock(t))
+	processQueuedPayments := NewProcessQueuedPayments(jobRepository, processPayment)
+
+	output, err := processQueuedPayments.Execute(ctx, &ProcessQueuedPaymentsInput{Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 1, output.Processed)
+	assert.Equal(t, 0, output.Failed)
+}
+
+func TestProcessQueuedPaymentsWithInterruptedJob(t *testing.T) {
+	ctx := context.Background()
+	payment, job := createQueuedPayment()
+	job.Attempts = 2
+	attempt := payment.AddAttempt("Acquirer")
+
+	jobRepository := repository.NewIPaymentJobRepositoryMock(t)
+	jobRepository.
+		EXPECT().
+		ClaimJobs(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentJob{job}, nil).
+		Once()
+	jobRepository.
+		EXPECT().
+		ClaimJobs(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentJob{}, nil).
+		Once()
+	jobRepository.
+		EXPECT().
+		UpdateJob(ctx, job).
+		Run(func(ctx context.Context, job *entity.PaymentJob) {
+			assert.Equal(t, entity.PaymentJobStatusDone, job.Status)
+		}).
+		Return(nil).
+		Once()
+
+	// the payment is not sent to the acquirer again, and its attempt is reversed
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(ctx, attempt).
+		Run(func(ctx context.Context, attempt *entity.PaymentAttempt) {
+			assert.Equal(t, entity.PaymentAttemptStatusUnknown, attempt.Status)
+		}).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, payment).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusUnknown, payment.Status)
+		}).
+		Return(nil).
+		Once()
+
+	reversalRepository := repository.NewIReversalRepositoryMock(t)
+	reversalRepository.
+		EXPECT().
+		FindReversals(ctx, payment.Id).
+		Return([]*entity.Reversal{}, nil).
+		Once()
+	reversalRepository.
+		EXPECT().
+		CreateReversal(ctx, mock.Anything).
+		Run(func(ctx context.Context, reversal *entity.Reversal) {
+			assert.Equal(t, attempt.Id, reversal.AttemptId)
+			assert.Equal(t, "Acquirer", reversal.Acquirer)
+		}).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(repository.NewICardRepositoryMock(t), paymentRepository, reversalRepository, repository.NewIStoreRepositoryMock(t), service.NewIPaymentServiceMock(t), service.NewIRoutingServiceMock(t))
+	processQueuedPayments := NewProcessQueuedPayments(jobRepository, processPayment)
+
+	output, err := processQueuedPayments.Execute(ctx, &ProcessQueuedPaymentsInput{Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 1, output.Processed)
+}
+
+func TestProcessQueuedPaymentsWithJobInterruptedBeforeSending(t *testing.T) {
+	ctx := context.Background()
+	payment, job := createQueuedPayment()
+	job.Attempts = 2
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+
+	jobRepository := repository.NewIPaymentJobRepositoryMock(t)
+	jobRepository.
+		EXPECT().
+		ClaimJobs(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentJob{job}, nil).
+		Once()
+	jobRepository.
+		EXPECT().
+		UpdateJob(ctx, job).
+		Return(nil).
+		Once()
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindCard(ctx, card.Token).
+		Return(card, nil).
+		Once()
+	cardRepository.
+		EXPECT().
+		FindCardNumber(ctx, card.Token).
+		Return("4111111111111111", nil).
+		Once()
+
+	// the payment was never sent, so it is sent now
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ProcessTransaction(mock.Anything, mock.Anything).
+		Return(entity.NewAcquirerResponse("id", 200, "id"), nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		CreatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentAttempt(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, payment).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusApproved, payment.Status)
+		}).
+		Return(nil).
+		Once()
+
+	processPayment := NewProcessPayment(cardRepository, paymentRepository, repository.NewIReversalRepositoryMock(t), repository.NewIStoreRepositoryMock(t), paymentService, service.NewIRoutingServiceMock(t))
+	processQueuedPayments := NewProcessQueuedPayments(jobRepository, processPayment)
+
+	output, err := processQueuedPayments.Execute(ctx, &ProcessQueuedPaymentsInput{Limit: 1})
+	require.Nil(t, err)
+	assert.Equal(t, 1, output.Processed)
+}
+
+func TestProcessQueuedPaymentsWithProcessedPayment(t *testing.T) {
+	ctx := context.Background()
+	payment, job := createQueuedPayment()
+	payment.Approve(entity.NewAcquirerResponse("id", 200, "id"))
+
+	jobRepository := repository.NewIPaymentJobRepositoryMock(t)
+	jobRepository.
+		EXPECT().
+		ClaimJobs(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentJob{job}, nil).
+		Once()
+	jobRepository.
+		EXPECT().
+		ClaimJobs(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentJob{}, nil).
+		Once()
+	jobRepository.
+		EXPECT().
+		UpdateJob(ctx, job).
+		Run(func(ctx context.Context, job *entity.PaymentJob) {
+			assert.Equal(t, entity.PaymentJobStatusDone, job.Status)
+		}).
+		Return(nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+
+	processPayment := NewProcessPayment(repository.NewICardRepositoryMock(t), paymentRepository, repository.NewIReversalRepositoryMock(t), repository.NewIStoreRepositoryMock(t), service.NewIPaymentServiceMock(t), service.NewIRoutingServiceMock(t))
+	processQueuedPayments := NewProcessQueuedPayments(jobRepository, processPayment)
+
+	output, err := processQueuedPayments.Execute(ctx, &ProcessQueuedPaymentsInput{Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 1, output.Processed)
+}
+
+func TestProcessQueuedPaymentsWithUpdatePaymentError(t *testing.T) {
+	ctx := context.Background()
+	payment, job := createQueuedPayment()
+	job.Attempts = 2
+	payment.AddAttempt("Acquirer").Decline(422, "declined")
+
+	jobRepository := repository.NewIPaymentJobRepositoryMock(t)
+	jobRepository.
+		EXPECT().
+		ClaimJobs(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentJob{job}, nil).
+		Once()
+	jobRepository.
+		EXPECT().
+		ClaimJobs(ctx, mock.Anything, 1).
+		Return([]*entity.PaymentJob{}, nil).
+		Once()
+	jobRepository.
+		EXPECT().
+		UpdateJob(ctx, job).
+		Run(func(ctx context.Context, job *entity.PaymentJob) {
+			assert.Equal(t, entity.PaymentJobStatusFailed, job.Status)
+			assert.Equal(t, "database error", job.LastError)
+		}).
+		Return(nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(mock.Anything, payment).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusFailed, payment.Status)
+		}).
+		Return(errors.New("database error")).
+		Once()
+
+	reversalRepository := repository.NewIReversalRepositoryMock(t)
+	reversalRepository.
+		EXPECT().
+		FindReversals(ctx, payment.Id).
+		Return([]*entity.Reversal{}, nil).
+		Once()
+
+	processPayment := NewProcessPayment(repository.NewICardRepositoryMock(t), paymentRepository, reversalRepository, repository.NewIStoreRepositoryMock(t), service.NewIPaymentServiceMock(t), service.NewIRoutingServiceMock(t))
+	processQueuedPayments := NewProcessQueuedPayments(jobRepository, processPayment)
+
+	output, err := processQueuedPayments.Execute(ctx, &ProcessQueuedPaymentsInput{Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 0, output.Processed)
+	assert.Equal(t, 1, output.Failed)
+}
+
+func createQueuedPayment() (*entity.Payment, *entity.PaymentJob) {
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+	purchase := entity.NewPurchase(entity.NewMoney(1000, "BRL"), []string{"Item 1", "Item 2"}, 2)
+	store := entity.NewStore("11222333000181", "Address", "01310100")
+	acquirer := entity.NewAcquirer("Acquirer")
+
+	payment := entity.NewPayment(entity.NewTransaction(card, purchase, store, acquirer))
+	payment.Caller = "Caller"
+
+	job := entity.NewPaymentJob(payment)
+	job.Status = entity.PaymentJobStatusProcessing
+	job.Attempts = 1
+
+	return payment, job
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+type PublishEventsInput struct {
+	Limit int
+}
+
+type PublishEventsOutput struct {
+	Published int
+	Pending   int
+}
+
+type IPublishEvents interface {
+	Execute(ctx context.Context, input *PublishEventsInput) (*PublishEventsOutput, error)
+}
+
+type PublishEvents struct {
+	outboxRepository   repository.IOutboxRepository
+	deliveryRepository repository.IWebhookDeliveryRepository
+	eventPublisher     service.IEventPublisher
+}
+
+func NewPublishEvents(
+	outboxRepository repository.IOutboxRepository,
+	deliveryRepository repository.IWebhookDeliveryRepository,
+	eventPublisher service.IEventPublisher,
+) *PublishEvents {
+	return &PublishEvents{
+		outboxRepository:   outboxRepository,
+		deliveryRepository: deliveryRepository,
+		eventPublisher:     eventPublisher,
+	}
+}
+
+// Execute relays the pending events of the outbox to the webhooks of the client that requested
+// the payment and to the publisher, in the order of their sequence. A single relay runs at a
+// time; the others return without relaying. When an event fails, the later events of its
+// payment are held back until it is published, so the events of a payment are never published
+// out of order. An event whose publishing could not be recorded is relayed again on the next
+// relay, and its deliveries are not queued twice.
+func (p *PublishEvents) Execute(ctx context.Context, input *PublishEventsInput) (*PublishEventsOutput, error) {
+	unlock, err := p.outboxRepository.LockOutbox(ctx)
+	if err != nil {
+		var conflictErr *core_errors.ConflictError
+		if errors.As(err, &conflictErr) {
+			return &PublishEventsOutput{}, nil
+		}
+
+		return nil, err
+	}
+	defer unlock()
+
+	events, err := p.outboxRepository.FindPendingEvents(ctx, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &PublishEventsOutput{}
+	held := make(map[string]bool)
+
+	for _, event := range events {
+		if held[event.PaymentId] {
+			output.Pending++
+			continue
+		}
+
+		publishErr := p.relay(ctx, event)
+		if publishErr != nil {
+			slog.Error(publishErr.Error(), "event", event.Id, "payment", event.PaymentId)
+			event.Fail(publishErr.Error())
+			held[event.PaymentId] = true
+			output.Pending++
+		} else {
+			event.Publish(time.Now().UTC())
+			output.Published++
+		}
+
+		err = p.outboxRepository.UpdateEvent(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func (p *PublishEvents) relay(ctx context.Context, event *entity.DomainEvent) error {
+	webhookEvent, err := entity.NewWebhookEvent(event)
+	if err != nil {
+		return err
+	}
+
+	if webhookEvent != nil {
+		err = p.deliveryRepository.CreateDeliveries(ctx, webhookEvent)
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.eventPublisher.Publish(ctx, event)
+}
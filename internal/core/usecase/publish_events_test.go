@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishEvents(t *testing.T) {
+	ctx := context.Background()
+
+	failedPayment := uuid.NewString()
+	failed := createTestDomainEvent(1, failedPayment, "payment.created")
+	held := createTestDomainEvent(3, failedPayment, "payment.approved")
+
+	payment := createApprovedPayment(1000)
+	payment.Caller = "Caller"
+	published, err := entity.NewPaymentChangedEvent(payment)
+	require.Nil(t, err)
+	published.Sequence = 2
+
+	unlocked := false
+
+	outboxRepository := repository.NewIOutboxRepositoryMock(t)
+	outboxRepository.
+		EXPECT().
+		LockOutbox(ctx).
+		Return(func() { unlocked = true }, nil).
+		Once()
+	outboxRepository.
+		EXPECT().
+		FindPendingEvents(ctx, 10).
+		Return([]*entity.DomainEvent{failed, published, held}, nil).
+		Once()
+	outboxRepository.
+		EXPECT().
+		UpdateEvent(ctx, failed).
+		Run(func(ctx context.Context, event *entity.DomainEvent) {
+			assert.False(t, event.Published())
+			assert.Equal(t, 1, event.Attempts)
+			assert.Equal(t, "connection refused", event.LastError)
+		}).
+		Return(nil).
+		Once()
+	outboxRepository.
+		EXPECT().
+		UpdateEvent(ctx, published).
+		Run(func(ctx context.Context, event *entity.DomainEvent) {
+			assert.True(t, event.Published())
+		}).
+		Return(nil).
+		Once()
+
+	// the deliveries are queued for the events of the payments requested by a client
+	deliveryRepository := repository.NewIWebhookDeliveryRepositoryMock(t)
+	deliveryRepository.
+		EXPECT().
+		CreateDeliveries(ctx, mock.Anything).
+		Run(func(ctx context.Context, event *entity.WebhookEvent) {
+			assert.Equal(t, published.Id, event.Id)
+			assert.Equal(t, entity.WebhookEventPaymentApproved, event.Type)
+			assert.Equal(t, "Caller", event.Caller)
+		}).
+		Return(nil).
+		Once()
+
+	// the later event of the failed payment is held back
+	eventPublisher := service.NewIEventPublisherMock(t)
+	eventPublisher.
+		EXPECT().
+		Publish(ctx, failed).
+		Return(errors.New("connection refused")).
+		Once()
+	eventPublisher.
+		EXPECT().
+		Publish(ctx, published).
+		Return(nil).
+		Once()
+
+	publishEvents := NewPublishEvents(outboxRepository, deliveryRepository, eventPublisher)
+
+	output, err := publishEvents.Execute(ctx, &PublishEventsInput{Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 1, output.Published)
+	assert.Equal(t, 2, output.Pending)
+	assert.True(t, unlocked)
+}
+
+func TestPublishEventsWithLockedOutbox(t *testing.T) {
+	ctx := context.Background()
+
+	// another relay is publishing the events
+	outboxRepository := repository.NewIOutboxRepositoryMock(t)
+	outboxRepository.
+		EXPECT().
+		LockOutbox(ctx).
+		Return(nil, core_errors.NewConflictError("outbox is locked by another relay")).
+		Once()
+
+	publishEvents := NewPublishEvents(outboxRepository, repository.NewIWebhookDeliveryRepositoryMock(t), service.NewIEventPublisherMock(t))
+
+	output, err := publishEvents.Execute(ctx, &PublishEventsInput{Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 0, output.Published)
+	assert.Equal(t, 0, output.Pending)
+}
+
+func TestPublishEventsWithRepositoryError(t *testing.T) {
+	ctx := context.Background()
+	event := createTestDomainEvent(1, uuid.NewString(), "payment.created")
+
+	outboxRepository := repository.NewIOutboxRepositoryMock(t)
+	outboxRepository.
+		EXPECT().
+		LockOutbox(ctx).
+		Return(func() {}, nil).
+		Once()
+	outboxRepository.
+		EXPECT().
+		FindPendingEvents(ctx, 10).
+		Return([]*entity.DomainEvent{event}, nil).
+		Once()
+	outboxRepository.
+		EXPECT().
+		UpdateEvent(ctx, event).
+		Return(core_errors.NewInternalError(errors.New("connection refused"))).
+		Once()
+
+	eventPublisher := service.NewIEventPublisherMock(t)
+	eventPublisher.
+		EXPECT().
+		Publish(ctx, event).
+		Return(nil).
+		Once()
+
+	publishEvents := NewPublishEvents(outboxRepository, repository.NewIWebhookDeliveryRepositoryMock(t), eventPublisher)
+
+	output, err := publishEvents.Execute(ctx, &PublishEventsInput{Limit: 10})
+	assert.Nil(t, output)
+
+	var e *core_errors.InternalError
+	require.ErrorAs(t, err, &e)
+}
+
+func createTestDomainEvent(sequence int64, paymentId string, eventType string) *entity.DomainEvent {
+	return &entity.DomainEvent{
+		Id:        uuid.NewString(),
+		Sequence:  sequence,
+		Type:      eventType,
+		PaymentId: paymentId,
+		Payload:   []byte(`{}`),
+	}
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+
+	"github.com/google/uuid"
+)
+
+type RedeliverWebhookInput struct {
+	Caller     string
+	WebhookId  string
+	DeliveryId string
+}
+
+type RedeliverWebhookOutput struct {
+	Delivery *WebhookDeliveryOutput
+}
+
+type IRedeliverWebhook interface {
+	Execute(ctx context.Context, input *RedeliverWebhookInput) (*RedeliverWebhookOutput, error)
+}
+
+type RedeliverWebhook struct {
+	webhookRepository  repository.IWebhookRepository
+	deliveryRepository repository.IWebhookDeliveryRepository
+}
+
+func NewRedeliverWebhook(
+	webhookRepository repository.IWebhookRepository,
+	deliveryRepository repository.IWebhookDeliveryRepository,
+) *RedeliverWebhook {
+	return &RedeliverWebhook{
+		webhookRepository:  webhookRepository,
+		deliveryRepository: deliveryRepository,
+	}
+}
+
+// Execute queues a delivery of a webhook of the client to be sent again right away, whatever
+// its status, with a new round of attempts. The event keeps its id.
+func (r *RedeliverWebhook) Execute(ctx context.Context, input *RedeliverWebhookInput) (*RedeliverWebhookOutput, error) {
+	webhook, err := findCallerWebhook(ctx, r.webhookRepository, input.Caller, input.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(input.DeliveryId); err != nil {
+		return nil, core_errors.NewNotFoundError("delivery id is invalid")
+	}
+
+	delivery, err := r.deliveryRepository.FindDelivery(ctx, input.DeliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery.WebhookId != webhook.Id {
+		return nil, core_errors.NewNotFoundError("delivery id is invalid")
+	}
+
+	delivery.Redeliver(time.Now().UTC())
+
+	err = r.deliveryRepository.UpdateDelivery(ctx, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedeliverWebhookOutput{Delivery: newWebhookDeliveryOutput(delivery)}, nil
+}
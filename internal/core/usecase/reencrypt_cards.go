@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+type ReencryptCardsInput struct {
+	// Rotate creates a new key before re-encrypting the cards.
+	Rotate    bool
+	BatchSize int
+}
+
+type ReencryptCardsOutput struct {
+	KeyVersion int
+	Cards      int
+}
+
+type IReencryptCards interface {
+	Execute(ctx context.Context, input *ReencryptCardsInput) (*ReencryptCardsOutput, error)
+}
+
+type ReencryptCards struct {
+	cardRepository repository.ICardRepository
+	keyManager     service.IKeyManager
+}
+
+func NewReencryptCards(cardRepository repository.ICardRepository, keyManager service.IKeyManager) *ReencryptCards {
+	return &ReencryptCards{
+		cardRepository: cardRepository,
+		keyManager:     keyManager,
+	}
+}
+
+// Execute re-encrypts the card numbers in batches until none is left under an older key.
+func (r *ReencryptCards) Execute(ctx context.Context, input *ReencryptCardsInput) (*ReencryptCardsOutput, error) {
+	if input.Rotate {
+		_, err := r.keyManager.Rotate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	output := &ReencryptCardsOutput{KeyVersion: r.keyManager.CurrentVersion()}
+
+	for {
+		cards, err := r.cardRepository.ReencryptCards(ctx, input.BatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		output.Cards += cards
+
+		if cards < input.BatchSize {
+			return output, nil
+		}
+	}
+}
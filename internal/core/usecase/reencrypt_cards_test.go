@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReencryptCards(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rotate the key and re-encrypt all batches", func(t *testing.T) {
+		keyManager := service.NewIKeyManagerMock(t)
+		keyManager.
+			EXPECT().
+			Rotate().
+			Return(2, nil).
+			Once()
+		keyManager.
+			EXPECT().
+			CurrentVersion().
+			Return(2).
+			Once()
+
+		cardRepository := repository.NewICardRepositoryMock(t)
+		cardRepository.
+			EXPECT().
+			ReencryptCards(ctx, 10).
+			Return(10, nil).
+			Once()
+		cardRepository.
+			EXPECT().
+			ReencryptCards(ctx, 10).
+			Return(3, nil).
+			Once()
+
+		output, err := NewReencryptCards(cardRepository, keyManager).Execute(ctx, &ReencryptCardsInput{
+			Rotate:    true,
+			BatchSize: 10,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, 2, output.KeyVersion)
+		assert.Equal(t, 13, output.Cards)
+	})
+
+	t.Run("re-encrypt without rotating the key", func(t *testing.T) {
+		keyManager := service.NewIKeyManagerMock(t)
+		keyManager.
+			EXPECT().
+			CurrentVersion().
+			Return(1).
+			Once()
+
+		cardRepository := repository.NewICardRepositoryMock(t)
+		cardRepository.
+			EXPECT().
+			ReencryptCards(ctx, 10).
+			Return(0, nil).
+			Once()
+
+		output, err := NewReencryptCards(cardRepository, keyManager).Execute(ctx, &ReencryptCardsInput{BatchSize: 10})
+		require.Nil(t, err)
+		assert.Equal(t, 1, output.KeyVersion)
+		assert.Equal(t, 0, output.Cards)
+	})
+
+	t.Run("rotation error", func(t *testing.T) {
+		keyManager := service.NewIKeyManagerMock(t)
+		keyManager.
+			EXPECT().
+			Rotate().
+			Return(0, errors.New("keystore is read-only")).
+			Once()
+
+		cardRepository := repository.NewICardRepositoryMock(t)
+
+		output, err := NewReencryptCards(cardRepository, keyManager).Execute(ctx, &ReencryptCardsInput{
+			Rotate:    true,
+			BatchSize: 10,
+		})
+		assert.Nil(t, output)
+		assert.EqualError(t, err, "keystore is read-only")
+	})
+}
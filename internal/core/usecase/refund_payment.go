@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+type RefundPaymentInput struct {
+	AllowedStores []string
+	PaymentId     string
+	RefundType    string
+	RefundAmount  int64
+
+	// An empty currency accepts any, and the amount is in the currency of the payment.
+	Currency string
+}
+
+type RefundPaymentOutput struct {
+	RefundId       string
+	RefundType     string
+	RefundAmount   int64
+	RefundCurrency string
+	RefundStatus   string
+	PaymentId      string
+	PaymentStatus  string
+}
+
+type IRefundPayment interface {
+	Execute(ctx context.Context, input *RefundPaymentInput) (*RefundPaymentOutput, error)
+}
+
+type RefundPayment struct {
+	paymentRepository repository.IPaymentRepository
+	refundRepository  repository.IRefundRepository
+	paymentService    service.IPaymentService
+}
+
+func NewRefundPayment(
+	paymentRepository repository.IPaymentRepository,
+	refundRepository repository.IRefundRepository,
+	paymentService service.IPaymentService,
+) *RefundPayment {
+	return &RefundPayment{
+		paymentRepository: paymentRepository,
+		refundRepository:  refundRepository,
+		paymentService:    paymentService,
+	}
+}
+
+func (r *RefundPayment) Execute(ctx context.Context, input *RefundPaymentInput) (*RefundPaymentOutput, error) {
+	payment, err := findStorePayment(ctx, r.paymentRepository, input.AllowedStores, input.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Currency != "" && input.Currency != payment.Transaction.Purchase.Value.Currency {
+		return nil, core_errors.NewValidationError(fmt.Sprintf("payment currency must be %s", input.Currency))
+	}
+
+	refundType := entity.RefundType(input.RefundType)
+	refundValue := entity.NewMoney(input.RefundAmount, payment.Transaction.Purchase.Value.Currency)
+
+	switch refundType {
+	case entity.RefundTypeVoid:
+		err = payment.CanVoid(time.Now().UTC())
+		refundValue = payment.VoidValue()
+	case entity.RefundTypeRefund:
+		if refundValue.Amount == 0 {
+			refundValue = payment.RefundableValue()
+		}
+		err = payment.CanRefund(refundValue)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	refund := entity.NewRefund(payment.Id, refundType, refundValue)
+
+	err = refund.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.refundRepository.CreateRefund(ctx, refund)
+	if err != nil {
+		return nil, err
+	}
+
+	// a refund that may have reached the acquirer keeps its value reserved, so it is not
+	// refunded twice when the client retries
+	submission := service.WithSubmission(ctx)
+	result, refundErr := r.paymentService.RefundTransaction(submission, payment, refund)
+	if refundErr != nil {
+		var acquirerErr *core_errors.AcquirerError
+		var timeoutErr *core_errors.TimeoutError
+		if errors.As(refundErr, &acquirerErr) {
+			refund.Decline(acquirerErr.Code, acquirerErr.Message)
+		} else if errors.As(refundErr, &timeoutErr) || service.Submitted(submission) {
+			refund.Unknown(refundErr.Error())
+		} else {
+			refund.Fail(refundErr.Error())
+		}
+	} else {
+		refund.Approve(result)
+	}
+
+	// the outcome is recorded even when the request expired meanwhile
+	recordCtx := context.WithoutCancel(ctx)
+
+	err = r.refundRepository.UpdateRefund(recordCtx, refund)
+	if err != nil {
+		return nil, err
+	}
+
+	if refundErr != nil {
+		return nil, refundErr
+	}
+
+	payment, err = r.applyRefunds(recordCtx, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &RefundPaymentOutput{
+		RefundId:       refund.Id,
+		RefundType:     string(refund.Type),
+		RefundAmount:   refund.Value.Amount,
+		RefundCurrency: refund.Value.Currency,
+		RefundStatus:   string(refund.Status),
+		PaymentId:      payment.Id,
+		PaymentStatus:  string(payment.Status),
+	}
+
+	return output, nil
+}
+
+// applyRefunds reads the payment again when a concurrent refund changed it first.
+func (r *RefundPayment) applyRefunds(ctx context.Context, payment *entity.Payment) (*entity.Payment, error) {
+	for {
+		status := payment.Status
+
+		refunds, err := r.refundRepository.FindRefunds(ctx, payment.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		payment.ApplyRefunds(refunds)
+
+		err = r.paymentRepository.UpdatePaymentFrom(ctx, payment, status)
+
+		var conflictErr *core_errors.ConflictError
+		if !errors.As(err, &conflictErr) {
+			return payment, err
+		}
+
+		payment, err = r.paymentRepository.FindPayment(ctx, payment.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,514 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	iservice "github.com/sesaquecruz/go-payment-processor/internal/core/service"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefundPaymentWithFullRefund(t *testing.T) {
+	ctx := context.Background()
+	payment := createApprovedPayment(1000)
+	payment.Caller = "Caller"
+
+	input := RefundPaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+		RefundType:    "refund",
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentFrom(mock.Anything, payment, entity.PaymentStatusApproved).
+		Run(func(ctx context.Context, payment *entity.Payment, fromStatus entity.PaymentStatus) {
+			assert.Equal(t, entity.PaymentStatusRefunded, payment.Status)
+			assert.Equal(t, entity.NewMoney(1000, "BRL"), payment.RefundedValue)
+		}).
+		Return(nil).
+		Once()
+
+	var stored *entity.Refund
+	refundRepository := repository.NewIRefundRepositoryMock(t)
+	refundRepository.
+		EXPECT().
+		CreateRefund(ctx, mock.Anything).
+		Run(func(ctx context.Context, refund *entity.Refund) {
+			assert.Equal(t, payment.Id, refund.PaymentId)
+			assert.Equal(t, entity.RefundTypeRefund, refund.Type)
+			assert.Equal(t, entity.NewMoney(1000, "BRL"), refund.Value)
+			assert.Equal(t, entity.RefundStatusPending, refund.Status)
+			stored = refund
+		}).
+		Return(nil).
+		Once()
+	refundRepository.
+		EXPECT().
+		UpdateRefund(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, refund *entity.Refund) {
+			assert.Equal(t, entity.RefundStatusApproved, refund.Status)
+		}).
+		Return(nil).
+		Once()
+	refundRepository.
+		EXPECT().
+		FindRefunds(mock.Anything, payment.Id).
+		RunAndReturn(func(ctx context.Context, paymentId string) ([]*entity.Refund, error) {
+			return []*entity.Refund{stored}, nil
+		}).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		RefundTransaction(mock.Anything, payment, mock.Anything).
+		Return(entity.NewAcquirerResponse("Refund Id", 200, "Refund Id"), nil).
+		Once()
+
+	// a failure to queue the webhooks does not fail the processed refund
+
+	refundPayment := NewRefundPayment(paymentRepository, refundRepository, paymentService)
+
+	output, err := refundPayment.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.NotEmpty(t, output.RefundId)
+	assert.Equal(t, "refund", output.RefundType)
+	assert.Equal(t, int64(1000), output.RefundAmount)
+	assert.Equal(t, "BRL", output.RefundCurrency)
+	assert.Equal(t, "approved", output.RefundStatus)
+	assert.Equal(t, payment.Id, output.PaymentId)
+	assert.Equal(t, "refunded", output.PaymentStatus)
+}
+
+func TestRefundPaymentWithConcurrentRefund(t *testing.T) {
+	ctx := context.Background()
+	payment := createApprovedPayment(1000)
+
+	// another refund of 300 changed the payment while this one was sent to the acquirer
+	refunded := *payment
+	refunded.Status = entity.PaymentStatusPartiallyRefunded
+	refunded.RefundedValue = entity.NewMoney(300, "BRL")
+	other := entity.NewRefund(payment.Id, entity.RefundTypeRefund, entity.NewMoney(300, "BRL"))
+	other.Approve(entity.NewAcquirerResponse("Refund Id", 200, "Refund Id"))
+
+	input := RefundPaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+		RefundType:    "refund",
+		RefundAmount:  500,
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentFrom(mock.Anything, payment, entity.PaymentStatusApproved).
+		Return(core_errors.NewConflictError("payment status has changed")).
+		Once()
+	paymentRepository.
+		EXPECT().
+		FindPayment(mock.Anything, payment.Id).
+		Return(&refunded, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentFrom(mock.Anything, &refunded, entity.PaymentStatusPartiallyRefunded).
+		Run(func(ctx context.Context, payment *entity.Payment, fromStatus entity.PaymentStatus) {
+			assert.Equal(t, entity.PaymentStatusPartiallyRefunded, payment.Status)
+			assert.Equal(t, entity.NewMoney(800, "BRL"), payment.RefundedValue)
+		}).
+		Return(nil).
+		Once()
+
+	var stored *entity.Refund
+	refundRepository := repository.NewIRefundRepositoryMock(t)
+	refundRepository.
+		EXPECT().
+		CreateRefund(ctx, mock.Anything).
+		Run(func(ctx context.Context, refund *entity.Refund) {
+			stored = refund
+		}).
+		Return(nil).
+		Once()
+	refundRepository.
+		EXPECT().
+		UpdateRefund(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	refundRepository.
+		EXPECT().
+		FindRefunds(mock.Anything, payment.Id).
+		RunAndReturn(func(ctx context.Context, paymentId string) ([]*entity.Refund, error) {
+			return []*entity.Refund{other, stored}, nil
+		}).
+		Twice()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		RefundTransaction(mock.Anything, payment, mock.Anything).
+		Return(entity.NewAcquirerResponse("Refund Id", 200, "Refund Id"), nil).
+		Once()
+
+	refundPayment := NewRefundPayment(paymentRepository, refundRepository, paymentService)
+
+	output, err := refundPayment.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, "partially_refunded", output.PaymentStatus)
+}
+
+func TestRefundPaymentWithValueAboveRefundable(t *testing.T) {
+	ctx := context.Background()
+	payment := createApprovedPayment(1000)
+	payment.RefundedValue = entity.NewMoney(600, "BRL")
+
+	input := RefundPaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+		RefundType:    "refund",
+		RefundAmount:  500,
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+
+	refundRepository := repository.NewIRefundRepositoryMock(t)
+	paymentService := service.NewIPaymentServiceMock(t)
+	refundPayment := NewRefundPayment(paymentRepository, refundRepository, paymentService)
+
+	output, err := refundPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.ValidationError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, []string{"refund value exceeds the refundable value"}, w.Messages)
+}
+
+func TestRefundPaymentWithAnotherCurrency(t *testing.T) {
+	ctx := context.Background()
+	payment := createApprovedPayment(1000)
+	payment.Transaction.Purchase.Value = entity.NewMoney(1000, "USD")
+	payment.CapturedValue = entity.NewMoney(1000, "USD")
+
+	input := RefundPaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+		RefundType:    "refund",
+		RefundAmount:  500,
+		Currency:      "BRL",
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+
+	refundPayment := NewRefundPayment(paymentRepository, repository.NewIRefundRepositoryMock(t), service.NewIPaymentServiceMock(t))
+
+	output, err := refundPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.ValidationError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, []string{"payment currency must be BRL"}, w.Messages)
+}
+
+func TestRefundPaymentWithVoidOnAnotherDay(t *testing.T) {
+	ctx := context.Background()
+	payment := createApprovedPayment(1000)
+	payment.CreatedAt = payment.CreatedAt.AddDate(0, 0, -1)
+
+	input := RefundPaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+		RefundType:    "void",
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+
+	refundRepository := repository.NewIRefundRepositoryMock(t)
+	paymentService := service.NewIPaymentServiceMock(t)
+	refundPayment := NewRefundPayment(paymentRepository, refundRepository, paymentService)
+
+	output, err := refundPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.ValidationError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, []string{"payment can only be voided on the day it was processed"}, w.Messages)
+}
+
+func TestRefundPaymentWithVoidOfAuthorization(t *testing.T) {
+	ctx := context.Background()
+	payment := createAuthorizedPayment(1000)
+	payment.CreatedAt = payment.CreatedAt.AddDate(0, 0, -3)
+
+	input := RefundPaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+		RefundType:    "void",
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePaymentFrom(mock.Anything, payment, entity.PaymentStatusAuthorized).
+		Return(nil).
+		Once()
+
+	var stored *entity.Refund
+	refundRepository := repository.NewIRefundRepositoryMock(t)
+	refundRepository.
+		EXPECT().
+		CreateRefund(ctx, mock.Anything).
+		Run(func(ctx context.Context, refund *entity.Refund) {
+			assert.Equal(t, entity.NewMoney(1000, "BRL"), refund.Value)
+			stored = refund
+		}).
+		Return(nil).
+		Once()
+	refundRepository.
+		EXPECT().
+		UpdateRefund(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+	refundRepository.
+		EXPECT().
+		FindRefunds(mock.Anything, payment.Id).
+		RunAndReturn(func(ctx context.Context, paymentId string) ([]*entity.Refund, error) {
+			return []*entity.Refund{stored}, nil
+		}).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		RefundTransaction(mock.Anything, payment, mock.Anything).
+		Return(entity.NewAcquirerResponse("Void Id", 200, "Void Id"), nil).
+		Once()
+
+	refundPayment := NewRefundPayment(paymentRepository, refundRepository, paymentService)
+
+	output, err := refundPayment.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, "void", output.RefundType)
+	assert.Equal(t, "voided", output.PaymentStatus)
+}
+
+func TestRefundPaymentWithAcquirerError(t *testing.T) {
+	ctx := context.Background()
+	payment := createApprovedPayment(1000)
+
+	input := RefundPaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     payment.Id,
+		RefundType:    "void",
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+
+	refundRepository := repository.NewIRefundRepositoryMock(t)
+	refundRepository.
+		EXPECT().
+		CreateRefund(ctx, mock.Anything).
+		Return(nil).
+		Once()
+	refundRepository.
+		EXPECT().
+		UpdateRefund(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, refund *entity.Refund) {
+			assert.Equal(t, entity.RefundTypeVoid, refund.Type)
+			assert.Equal(t, entity.RefundStatusDeclined, refund.Status)
+			assert.Equal(t, 422, refund.AcquirerCode)
+		}).
+		Return(nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		RefundTransaction(mock.Anything, payment, mock.Anything).
+		Return(nil, core_errors.NewAcquirerError(422, "the transaction cannot be voided")).
+		Once()
+
+	refundPayment := NewRefundPayment(paymentRepository, refundRepository, paymentService)
+
+	output, err := refundPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.AcquirerError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, 422, w.Code)
+}
+
+func TestRefundPaymentWithUnansweredRefund(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		err    error
+		sent   bool
+		status entity.RefundStatus
+	}{
+		{"keeps the value of a refund that timed out", core_errors.NewTimeoutError("acquirer timeout"), true, entity.RefundStatusUnknown},
+		{"keeps the value of a refund that failed after being sent", core_errors.NewInternalError(errors.New("unexpected EOF")), true, entity.RefundStatusUnknown},
+		{"releases the value of a refund that was not sent", core_errors.NewUnavailableError(errors.New("connection refused")), false, entity.RefundStatusFailed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payment := createApprovedPayment(1000)
+
+			input := RefundPaymentInput{
+				AllowedStores: []string{testStoreId},
+				PaymentId:     payment.Id,
+				RefundType:    "refund",
+			}
+
+			paymentRepository := repository.NewIPaymentRepositoryMock(t)
+			paymentRepository.
+				EXPECT().
+				FindPayment(ctx, payment.Id).
+				Return(payment, nil).
+				Once()
+
+			refundRepository := repository.NewIRefundRepositoryMock(t)
+			refundRepository.
+				EXPECT().
+				CreateRefund(ctx, mock.Anything).
+				Return(nil).
+				Once()
+			refundRepository.
+				EXPECT().
+				UpdateRefund(mock.Anything, mock.Anything).
+				Run(func(ctx context.Context, refund *entity.Refund) {
+					assert.Equal(t, test.status, refund.Status)
+				}).
+				Return(nil).
+				Once()
+
+			paymentService := service.NewIPaymentServiceMock(t)
+			paymentService.
+				EXPECT().
+				RefundTransaction(mock.Anything, payment, mock.Anything).
+				Run(func(ctx context.Context, payment *entity.Payment, refund *entity.Refund) {
+					if test.sent {
+						iservice.MarkSubmitted(ctx)
+					}
+				}).
+				Return(nil, test.err).
+				Once()
+
+			refundPayment := NewRefundPayment(paymentRepository, refundRepository, paymentService)
+
+			output, err := refundPayment.Execute(ctx, &input)
+			assert.Nil(t, output)
+			assert.Equal(t, test.err, err)
+			assert.Equal(t, entity.PaymentStatusApproved, payment.Status)
+		})
+	}
+}
+
+func TestRefundPaymentWithInvalidPaymentId(t *testing.T) {
+	ctx := context.Background()
+
+	input := RefundPaymentInput{
+		AllowedStores: []string{testStoreId},
+		PaymentId:     "an invalid id",
+		RefundType:    "refund",
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	refundRepository := repository.NewIRefundRepositoryMock(t)
+	paymentService := service.NewIPaymentServiceMock(t)
+	refundPayment := NewRefundPayment(paymentRepository, refundRepository, paymentService)
+
+	output, err := refundPayment.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.NotFoundError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, "payment id is invalid", w.Message)
+}
+
+func TestRefundPaymentOfAnotherStore(t *testing.T) {
+	ctx := context.Background()
+	payment := createApprovedPayment(1000)
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Twice()
+
+	// the payment is neither refunded nor voided
+	refundPayment := NewRefundPayment(paymentRepository, repository.NewIRefundRepositoryMock(t), service.NewIPaymentServiceMock(t))
+
+	for _, refundType := range []string{"refund", "void"} {
+		input := RefundPaymentInput{
+			AllowedStores: []string{"e4b3f4c0-6d7c-4f5e-9b54-3b1c1a7d2f10"},
+			PaymentId:     payment.Id,
+			RefundType:    refundType,
+		}
+
+		output, err := refundPayment.Execute(ctx, &input)
+		assert.Nil(t, output)
+
+		var w *core_errors.NotFoundError
+		require.ErrorAs(t, err, &w)
+		assert.Equal(t, "payment id is invalid", w.Message)
+	}
+}
+
+func createApprovedPayment(amount int64) *entity.Payment {
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+	purchase := entity.NewPurchase(entity.NewMoney(amount, "BRL"), []string{"Item 1", "Item 2"}, 2)
+	store := entity.NewStore("11222333000181", "Address", "01310100")
+	store.Id = testStoreId
+	acquirer := entity.NewAcquirer("Acquirer")
+
+	payment := entity.NewPayment(entity.NewTransaction(card, purchase, store, acquirer))
+	payment.Approve(entity.NewAcquirerResponse("Acquirer Id", 200, "Acquirer Id"))
+	return payment
+}
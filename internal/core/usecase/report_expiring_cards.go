@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type ReportExpiringCardsInput struct {
+	Days int
+}
+
+type ExpiringCard struct {
+	CardToken      string
+	CardBrand      string
+	CardLast4      string
+	CardExpiration string
+}
+
+type ReportExpiringCardsOutput struct {
+	From  time.Time
+	Until time.Time
+	Cards []*ExpiringCard
+}
+
+type IReportExpiringCards interface {
+	Execute(ctx context.Context, input *ReportExpiringCardsInput) (*ReportExpiringCardsOutput, error)
+}
+
+type ReportExpiringCards struct {
+	cardRepository repository.ICardRepository
+}
+
+func NewReportExpiringCards(cardRepository repository.ICardRepository) *ReportExpiringCards {
+	return &ReportExpiringCards{
+		cardRepository: cardRepository,
+	}
+}
+
+// Execute lists the vaulted cards that are still valid but expire within the given days.
+func (r *ReportExpiringCards) Execute(ctx context.Context, input *ReportExpiringCardsInput) (*ReportExpiringCardsOutput, error) {
+	from := time.Now().UTC()
+	until := from.AddDate(0, 0, input.Days)
+
+	cards, err := r.cardRepository.FindExpiringCards(ctx, from, until)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ReportExpiringCardsOutput{
+		From:  from,
+		Until: until,
+		Cards: make([]*ExpiringCard, 0, len(cards)),
+	}
+
+	for _, card := range cards {
+		output.Cards = append(output.Cards, &ExpiringCard{
+			CardToken:      card.Token,
+			CardBrand:      card.Brand,
+			CardLast4:      card.Last4,
+			CardExpiration: card.Expiration,
+		})
+	}
+
+	return output, nil
+}
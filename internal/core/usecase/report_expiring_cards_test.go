@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportExpiringCards(t *testing.T) {
+	ctx := context.Background()
+
+	card := entity.NewCard("Token", "Holder", "12/2099", "VISA")
+	card.Last4 = "1111"
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindExpiringCards(ctx, mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, from time.Time, until time.Time) {
+			assert.WithinDuration(t, time.Now(), from, time.Minute)
+			assert.Equal(t, from.AddDate(0, 0, 30), until)
+		}).
+		Return([]*entity.Card{card}, nil).
+		Once()
+
+	output, err := NewReportExpiringCards(cardRepository).Execute(ctx, &ReportExpiringCardsInput{Days: 30})
+	require.Nil(t, err)
+	assert.Equal(t, output.From.AddDate(0, 0, 30), output.Until)
+	assert.Equal(t, []*ExpiringCard{{CardToken: "Token", CardBrand: "VISA", CardLast4: "1111", CardExpiration: "12/2099"}}, output.Cards)
+}
+
+func TestReportExpiringCardsWithRepositoryError(t *testing.T) {
+	ctx := context.Background()
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		FindExpiringCards(ctx, mock.Anything, mock.Anything).
+		Return(nil, core_errors.NewInternalError(errors.New("connection refused"))).
+		Once()
+
+	output, err := NewReportExpiringCards(cardRepository).Execute(ctx, &ReportExpiringCardsInput{Days: 30})
+	assert.Nil(t, output)
+
+	var e *core_errors.InternalError
+	assert.ErrorAs(t, err, &e)
+}
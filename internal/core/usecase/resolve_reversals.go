@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+type ResolveReversalsInput struct {
+	Limit int
+}
+
+type ResolveReversalsOutput struct {
+	Resolved int
+	Pending  int
+}
+
+type IResolveReversals interface {
+	Execute(ctx context.Context, input *ResolveReversalsInput) (*ResolveReversalsOutput, error)
+}
+
+type ResolveReversals struct {
+	paymentRepository  repository.IPaymentRepository
+	reversalRepository repository.IReversalRepository
+	paymentService     service.IPaymentService
+}
+
+func NewResolveReversals(
+	paymentRepository repository.IPaymentRepository,
+	reversalRepository repository.IReversalRepository,
+	paymentService service.IPaymentService,
+) *ResolveReversals {
+	return &ResolveReversals{
+		paymentRepository:  paymentRepository,
+		reversalRepository: reversalRepository,
+		paymentService:     paymentService,
+	}
+}
+
+// Execute sends the due reversals to their acquirers. A reversal is resolved when the acquirer
+// reverses the transaction or answers that it was not found, and is retried later otherwise.
+// Once all reversals of a payment with an unknown outcome are resolved, the payment is too.
+func (r *ResolveReversals) Execute(ctx context.Context, input *ResolveReversalsInput) (*ResolveReversalsOutput, error) {
+	reversals, err := r.reversalRepository.FindDueReversals(ctx, time.Now().UTC(), input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ResolveReversalsOutput{}
+
+	for _, reversal := range reversals {
+		result, reverseErr := r.paymentService.ReverseTransaction(ctx, reversal)
+
+		var acquirerErr *core_errors.AcquirerError
+		if reverseErr == nil {
+			reversal.Reverse(result)
+		} else if errors.As(reverseErr, &acquirerErr) && acquirerErr.Code == http.StatusNotFound {
+			reversal.NotFound(acquirerErr.Code, acquirerErr.Message)
+		} else {
+			slog.Error(reverseErr.Error(), "reversal", reversal.Id)
+			reversal.Retry(reverseErr.Error(), time.Now().UTC())
+		}
+
+		err = r.reversalRepository.UpdateReversal(ctx, reversal)
+		if err != nil {
+			return nil, err
+		}
+
+		if !reversal.Resolved() {
+			output.Pending++
+			continue
+		}
+
+		output.Resolved++
+
+		err = r.resolvePayment(ctx, reversal.PaymentId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func (r *ResolveReversals) resolvePayment(ctx context.Context, paymentId string) error {
+	payment, err := r.paymentRepository.FindPayment(ctx, paymentId)
+	if err != nil {
+		return err
+	}
+
+	if payment.Status != entity.PaymentStatusUnknown {
+		return nil
+	}
+
+	reversals, err := r.reversalRepository.FindReversals(ctx, paymentId)
+	if err != nil {
+		return err
+	}
+
+	payment.ApplyReversals(reversals)
+	if payment.Status == entity.PaymentStatusUnknown {
+		return nil
+	}
+
+	return r.paymentRepository.UpdatePayment(ctx, payment)
+}
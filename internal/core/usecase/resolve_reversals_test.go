@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveReversalsWithReversedTransaction(t *testing.T) {
+	ctx := context.Background()
+	payment, reversal := createUnknownPayment()
+	payment.Caller = "Caller"
+
+	reversalRepository := repository.NewIReversalRepositoryMock(t)
+	reversalRepository.
+		EXPECT().
+		FindDueReversals(ctx, mock.Anything, 10).
+		Return([]*entity.Reversal{reversal}, nil).
+		Once()
+	reversalRepository.
+		EXPECT().
+		UpdateReversal(ctx, reversal).
+		Run(func(ctx context.Context, reversal *entity.Reversal) {
+			assert.Equal(t, entity.ReversalStatusReversed, reversal.Status)
+			assert.Equal(t, "Reversal Id", reversal.AcquirerId)
+		}).
+		Return(nil).
+		Once()
+	reversalRepository.
+		EXPECT().
+		FindReversals(ctx, payment.Id).
+		Return([]*entity.Reversal{reversal}, nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ReverseTransaction(ctx, reversal).
+		Return(entity.NewAcquirerResponse("Reversal Id", 200, "Reversal Id"), nil).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(ctx, payment).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusReversed, payment.Status)
+		}).
+		Return(nil).
+		Once()
+
+	resolveReversals := NewResolveReversals(paymentRepository, reversalRepository, paymentService)
+
+	output, err := resolveReversals.Execute(ctx, &ResolveReversalsInput{Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 1, output.Resolved)
+	assert.Equal(t, 0, output.Pending)
+}
+
+func TestResolveReversalsWithUnknownTransaction(t *testing.T) {
+	ctx := context.Background()
+	payment, reversal := createUnknownPayment()
+
+	reversalRepository := repository.NewIReversalRepositoryMock(t)
+	reversalRepository.
+		EXPECT().
+		FindDueReversals(ctx, mock.Anything, 10).
+		Return([]*entity.Reversal{reversal}, nil).
+		Once()
+	reversalRepository.
+		EXPECT().
+		UpdateReversal(ctx, reversal).
+		Run(func(ctx context.Context, reversal *entity.Reversal) {
+			assert.Equal(t, entity.ReversalStatusNotFound, reversal.Status)
+			assert.Equal(t, 404, reversal.AcquirerCode)
+		}).
+		Return(nil).
+		Once()
+	reversalRepository.
+		EXPECT().
+		FindReversals(ctx, payment.Id).
+		Return([]*entity.Reversal{reversal}, nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ReverseTransaction(ctx, reversal).
+		Return(nil, core_errors.NewAcquirerError(404, "the transaction was not found")).
+		Once()
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		FindPayment(ctx, payment.Id).
+		Return(payment, nil).
+		Once()
+	paymentRepository.
+		EXPECT().
+		UpdatePayment(ctx, payment).
+		Run(func(ctx context.Context, payment *entity.Payment) {
+			assert.Equal(t, entity.PaymentStatusFailed, payment.Status)
+		}).
+		Return(nil).
+		Once()
+
+	resolveReversals := NewResolveReversals(paymentRepository, reversalRepository, paymentService)
+
+	output, err := resolveReversals.Execute(ctx, &ResolveReversalsInput{Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 1, output.Resolved)
+}
+
+func TestResolveReversalsWithAcquirerFailure(t *testing.T) {
+	ctx := context.Background()
+	_, reversal := createUnknownPayment()
+
+	reversalRepository := repository.NewIReversalRepositoryMock(t)
+	reversalRepository.
+		EXPECT().
+		FindDueReversals(ctx, mock.Anything, 10).
+		Return([]*entity.Reversal{reversal}, nil).
+		Once()
+	reversalRepository.
+		EXPECT().
+		UpdateReversal(ctx, reversal).
+		Run(func(ctx context.Context, reversal *entity.Reversal) {
+			assert.Equal(t, entity.ReversalStatusPending, reversal.Status)
+			assert.Equal(t, 1, reversal.Retries)
+			assert.True(t, reversal.NextRetryAt.After(reversal.CreatedAt))
+			assert.Equal(t, "acquirer timed out", reversal.AcquirerMessage)
+		}).
+		Return(nil).
+		Once()
+
+	paymentService := service.NewIPaymentServiceMock(t)
+	paymentService.
+		EXPECT().
+		ReverseTransaction(ctx, reversal).
+		Return(nil, core_errors.NewTimeoutError("acquirer timed out")).
+		Once()
+
+	resolveReversals := NewResolveReversals(repository.NewIPaymentRepositoryMock(t), reversalRepository, paymentService)
+
+	output, err := resolveReversals.Execute(ctx, &ResolveReversalsInput{Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 0, output.Resolved)
+	assert.Equal(t, 1, output.Pending)
+}
+
+func TestResolveReversalsWithRepositoryError(t *testing.T) {
+	ctx := context.Background()
+
+	reversalRepository := repository.NewIReversalRepositoryMock(t)
+	reversalRepository.
+		EXPECT().
+		FindDueReversals(ctx, mock.Anything, 10).
+		Return(nil, core_errors.NewInternalError(errors.New("connection refused"))).
+		Once()
+
+	resolveReversals := NewResolveReversals(repository.NewIPaymentRepositoryMock(t), reversalRepository, service.NewIPaymentServiceMock(t))
+
+	output, err := resolveReversals.Execute(ctx, &ResolveReversalsInput{Limit: 10})
+	assert.Nil(t, output)
+
+	var e *core_errors.InternalError
+	assert.ErrorAs(t, err, &e)
+}
+
+func createUnknownPayment() (*entity.Payment, *entity.Reversal) {
+	card := entity.NewCard("Token", "Holder", "12/2099", "Brand")
+	purchase := entity.NewPurchase(entity.NewMoney(1000, "BRL"), []string{"Item 1", "Item 2"}, 2)
+	store := entity.NewStore("11222333000181", "Address", "01310100")
+	acquirer := entity.NewAcquirer("Acquirer")
+
+	payment := entity.NewPayment(entity.NewTransaction(card, purchase, store, acquirer))
+	attempt := payment.AddAttempt("Acquirer")
+	attempt.TimeOut("acquirer timed out")
+	payment.Unknown("acquirer timed out")
+
+	return payment, entity.NewReversal(payment, attempt)
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+
+	"github.com/google/uuid"
+)
+
+type RouteTransactionInput struct {
+	CardBrand            string
+	PurchaseAmount       int64
+	PurchaseCurrency     string
+	PurchaseInstallments int
+	StoreId              string
+	AllowedStores        []string
+}
+
+type RouteEvaluationOutput struct {
+	Rule    string
+	Matched bool
+	Reason  string
+}
+
+type RouteTransactionOutput struct {
+	AcquirerName string
+	RouteRule    string
+	Fallbacks    []string
+	Evaluations  []*RouteEvaluationOutput
+}
+
+type IRouteTransaction interface {
+	Execute(ctx context.Context, input *RouteTransactionInput) (*RouteTransactionOutput, error)
+}
+
+// RouteTransaction explains which acquirer would process a transaction without processing it.
+type RouteTransaction struct {
+	storeRepository repository.IStoreRepository
+	routingService  service.IRoutingService
+}
+
+func NewRouteTransaction(storeRepository repository.IStoreRepository, routingService service.IRoutingService) *RouteTransaction {
+	return &RouteTransaction{
+		storeRepository: storeRepository,
+		routingService:  routingService,
+	}
+}
+
+// Execute routes the transaction of a registered store as a payment of it would be routed,
+// so the store must be one of the stores the caller is allowed to charge for.
+func (r *RouteTransaction) Execute(ctx context.Context, input *RouteTransactionInput) (*RouteTransactionOutput, error) {
+	if !slices.Contains(input.AllowedStores, input.StoreId) {
+		return nil, core_errors.NewForbiddenError("store is not allowed for this client")
+	}
+
+	if _, err := uuid.Parse(input.StoreId); err != nil {
+		return nil, core_errors.NewNotFoundError("store id is invalid")
+	}
+
+	store, err := r.storeRepository.FindStore(ctx, input.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	value := entity.NewMoney(input.PurchaseAmount, input.PurchaseCurrency)
+	purchase := entity.NewPurchase(value, nil, input.PurchaseInstallments)
+	card := entity.NewCard("", "", "", input.CardBrand)
+	transaction := entity.NewTransaction(card, purchase, store, nil)
+
+	route := r.routingService.Explain(ctx, transaction)
+
+	evaluations := make([]*RouteEvaluationOutput, 0, len(route.Evaluations))
+	for _, evaluation := range route.Evaluations {
+		evaluations = append(evaluations, &RouteEvaluationOutput{
+			Rule:    evaluation.Rule,
+			Matched: evaluation.Matched,
+			Reason:  evaluation.Reason,
+		})
+	}
+
+	output := &RouteTransactionOutput{
+		AcquirerName: route.Acquirer,
+		RouteRule:    route.Rule,
+		Fallbacks:    route.Fallbacks,
+		Evaluations:  evaluations,
+	}
+
+	return output, nil
+}
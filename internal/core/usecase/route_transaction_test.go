@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	store := entity.NewStore("11.222.333/0001-81", "Address", "01310100")
+	store.Id = testStoreId
+
+	input := RouteTransactionInput{
+		CardBrand:            "visa",
+		PurchaseAmount:       20000,
+		PurchaseCurrency:     "BRL",
+		PurchaseInstallments: 3,
+		StoreId:              testStoreId,
+		AllowedStores:        []string{testStoreId},
+	}
+
+	storeRepository := repository.NewIStoreRepositoryMock(t)
+	storeRepository.
+		EXPECT().
+		FindStore(ctx, testStoreId).
+		Return(store, nil).
+		Once()
+
+	evaluations := []*entity.RouteEvaluation{
+		{Rule: "cielo-up-to-100", Matched: false, Reason: "amount is above 10000"},
+		{Rule: "rede-up-to-500", Matched: true},
+	}
+
+	routingService := service.NewIRoutingServiceMock(t)
+	routingService.
+		EXPECT().
+		Explain(ctx, mock.Anything).
+		Run(func(ctx context.Context, transaction *entity.Transaction) {
+			assert.Equal(t, input.CardBrand, transaction.Card.Brand)
+			assert.Equal(t, entity.NewMoney(input.PurchaseAmount, input.PurchaseCurrency), transaction.Purchase.Value)
+			assert.Equal(t, input.PurchaseInstallments, transaction.Purchase.Installments)
+			assert.Equal(t, store, transaction.Store)
+		}).
+		Return(entity.NewRoute("rede", "rede-up-to-500", evaluations)).
+		Once()
+
+	routeTransaction := NewRouteTransaction(storeRepository, routingService)
+
+	output, err := routeTransaction.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, "rede", output.AcquirerName)
+	assert.Equal(t, "rede-up-to-500", output.RouteRule)
+	require.Equal(t, 2, len(output.Evaluations))
+	assert.Equal(t, "cielo-up-to-100", output.Evaluations[0].Rule)
+	assert.False(t, output.Evaluations[0].Matched)
+	assert.Equal(t, "amount is above 10000", output.Evaluations[0].Reason)
+	assert.True(t, output.Evaluations[1].Matched)
+}
+
+func TestRouteTransactionWithStoreNotAllowed(t *testing.T) {
+	ctx := context.Background()
+
+	routeTransaction := NewRouteTransaction(repository.NewIStoreRepositoryMock(t), service.NewIRoutingServiceMock(t))
+
+	output, err := routeTransaction.Execute(ctx, &RouteTransactionInput{
+		CardBrand:            "visa",
+		PurchaseAmount:       20000,
+		PurchaseCurrency:     "BRL",
+		PurchaseInstallments: 3,
+		StoreId:              testStoreId,
+		AllowedStores:        []string{uuid.NewString()},
+	})
+	assert.Nil(t, output)
+
+	var e *core_errors.ForbiddenError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, "store is not allowed for this client", e.Message)
+}
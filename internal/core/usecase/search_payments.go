@@ -0,0 +1,290 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PaymentSearchDefaultLimit = 20
+	PaymentSearchMaxLimit     = 100
+)
+
+var paymentSearchSorts = map[string]repository.PaymentSort{
+	"created_at":  repository.PaymentSortCreatedAt,
+	"-created_at": repository.PaymentSortCreatedAt,
+	"amount":      repository.PaymentSortAmount,
+	"-amount":     repository.PaymentSortAmount,
+}
+
+var paymentStatuses = []entity.PaymentStatus{
+	entity.PaymentStatusPending,
+	entity.PaymentStatusAuthorized,
+	entity.PaymentStatusApproved,
+	entity.PaymentStatusDeclined,
+	entity.PaymentStatusFailed,
+	entity.PaymentStatusCapturing,
+	entity.PaymentStatusUnknown,
+	entity.PaymentStatusReversed,
+	entity.PaymentStatusPartiallyRefunded,
+	entity.PaymentStatusRefunded,
+	entity.PaymentStatusVoided,
+}
+
+var cardLast4Regexp = regexp.MustCompile(`^[0-9]{4}$`)
+
+type SearchPaymentsInput struct {
+	AllowedStores []string
+	StoreId       string
+	AcquirerName  string
+	Statuses      []string
+	CardLast4     string
+
+	MinAmount int64
+	MaxAmount int64
+
+	// CreatedFrom is inclusive and CreatedTo exclusive.
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+
+	Sort   string
+	Cursor string
+	Limit  int
+}
+
+type PaymentSummaryOutput struct {
+	PaymentId        string
+	PaymentStatus    string
+	CardBrand        string
+	CardLast4        string
+	PurchaseAmount   int64
+	PurchaseCurrency string
+	StoreId          string
+	AcquirerName     string
+	AcquirerId       string
+	CapturedAmount   int64
+	RefundedAmount   int64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type SearchPaymentsOutput struct {
+	Payments   []*PaymentSummaryOutput
+	NextCursor string
+}
+
+type ISearchPayments interface {
+	Execute(ctx context.Context, input *SearchPaymentsInput) (*SearchPaymentsOutput, error)
+}
+
+type SearchPayments struct {
+	paymentRepository repository.IPaymentRepository
+}
+
+func NewSearchPayments(paymentRepository repository.IPaymentRepository) *SearchPayments {
+	return &SearchPayments{
+		paymentRepository: paymentRepository,
+	}
+}
+
+// paymentCursor is the position of the last payment of a page, which is encoded as base64
+// json so the clients handle it as an opaque value.
+type paymentCursor struct {
+	Sort      string    `json:"s"`
+	Id        string    `json:"i"`
+	CreatedAt time.Time `json:"c,omitempty"`
+	Amount    int64     `json:"a,omitempty"`
+}
+
+// Execute fetches one payment more than the limit, which tells whether there is a next page.
+func (s *SearchPayments) Execute(ctx context.Context, input *SearchPaymentsInput) (*SearchPaymentsOutput, error) {
+	search, err := newPaymentSearch(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &SearchPaymentsOutput{
+		Payments: make([]*PaymentSummaryOutput, 0),
+	}
+
+	if len(search.StoreIds) == 0 {
+		return output, nil
+	}
+
+	limit := search.Limit
+	search.Limit++
+
+	payments, err := s.paymentRepository.SearchPayments(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(payments) > limit {
+		payments = payments[:limit]
+
+		last := payments[limit-1]
+		cursor := paymentCursor{Sort: input.Sort, Id: last.Id}
+		if search.Sort == repository.PaymentSortAmount {
+			cursor.Amount = last.Transaction.Purchase.Value.Amount
+		} else {
+			cursor.CreatedAt = last.CreatedAt
+		}
+
+		output.NextCursor = encodePaymentCursor(&cursor)
+	}
+
+	for _, payment := range payments {
+		output.Payments = append(output.Payments, newPaymentSummaryOutput(payment))
+	}
+
+	return output, nil
+}
+
+func newPaymentSearch(input *SearchPaymentsInput) (*repository.PaymentSearch, error) {
+	stores := input.AllowedStores
+	if input.StoreId != "" {
+		if !slices.Contains(input.AllowedStores, input.StoreId) {
+			return nil, core_errors.NewForbiddenError("store is not allowed for this client")
+		}
+		stores = []string{input.StoreId}
+	}
+
+	// the stores that are not in the registry have no payments to search
+	storeIds := make([]string, 0, len(stores))
+	for _, store := range stores {
+		if _, err := uuid.Parse(store); err == nil {
+			storeIds = append(storeIds, store)
+		}
+	}
+
+	if input.Sort == "" {
+		input.Sort = "-created_at"
+	}
+
+	if input.Limit == 0 {
+		input.Limit = PaymentSearchDefaultLimit
+	}
+
+	msgs := make([]string, 0)
+
+	statuses := make([]entity.PaymentStatus, 0, len(input.Statuses))
+	for _, status := range input.Statuses {
+		if !slices.Contains(paymentStatuses, entity.PaymentStatus(status)) {
+			msgs = append(msgs, fmt.Sprintf("payment status %s is invalid", status))
+			continue
+		}
+		statuses = append(statuses, entity.PaymentStatus(status))
+	}
+
+	if input.CardLast4 != "" && !cardLast4Regexp.MatchString(input.CardLast4) {
+		msgs = append(msgs, "card last4 must have 4 digits")
+	}
+
+	if input.MinAmount < 0 || input.MaxAmount < 0 {
+		msgs = append(msgs, "amounts must not be negative")
+	} else if input.MaxAmount > 0 && input.MinAmount > input.MaxAmount {
+		msgs = append(msgs, "min amount must not be greater than max amount")
+	}
+
+	if !input.CreatedFrom.IsZero() && !input.CreatedTo.IsZero() && input.CreatedFrom.After(input.CreatedTo) {
+		msgs = append(msgs, "created from must not be after created to")
+	}
+
+	sort, ok := paymentSearchSorts[input.Sort]
+	if !ok {
+		msgs = append(msgs, "sort must be created_at, -created_at, amount or -amount")
+	}
+
+	if input.Limit < 1 || input.Limit > PaymentSearchMaxLimit {
+		msgs = append(msgs, fmt.Sprintf("limit must be between 1 and %d", PaymentSearchMaxLimit))
+	}
+
+	var cursor *paymentCursor
+	if input.Cursor != "" && ok {
+		cursor = decodePaymentCursor(input.Cursor)
+		if cursor == nil || cursor.Sort != input.Sort {
+			msgs = append(msgs, "cursor is invalid for this sort")
+		}
+	}
+
+	if len(msgs) > 0 {
+		return nil, core_errors.NewValidationError(msgs...)
+	}
+
+	search := &repository.PaymentSearch{
+		StoreIds:     storeIds,
+		AcquirerName: input.AcquirerName,
+		Statuses:     statuses,
+		CardLast4:    input.CardLast4,
+		MinAmount:    input.MinAmount,
+		MaxAmount:    input.MaxAmount,
+		CreatedFrom:  input.CreatedFrom,
+		CreatedTo:    input.CreatedTo,
+		Sort:         sort,
+		Descending:   input.Sort[0] == '-',
+		Limit:        input.Limit,
+	}
+
+	if cursor != nil {
+		search.After = cursor.Id
+		search.AfterCreatedAt = cursor.CreatedAt
+		search.AfterAmount = cursor.Amount
+	}
+
+	return search, nil
+}
+
+func encodePaymentCursor(cursor *paymentCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePaymentCursor returns nil when the cursor was not encoded by encodePaymentCursor.
+func decodePaymentCursor(value string) *paymentCursor {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil
+	}
+
+	var cursor paymentCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil
+	}
+
+	if _, err := uuid.Parse(cursor.Id); err != nil {
+		return nil
+	}
+
+	return &cursor
+}
+
+func newPaymentSummaryOutput(payment *entity.Payment) *PaymentSummaryOutput {
+	transaction := payment.Transaction
+
+	return &PaymentSummaryOutput{
+		PaymentId:        payment.Id,
+		PaymentStatus:    string(payment.Status),
+		CardBrand:        transaction.Card.Brand,
+		CardLast4:        transaction.Card.Last4,
+		PurchaseAmount:   transaction.Purchase.Value.Amount,
+		PurchaseCurrency: transaction.Purchase.Value.Currency,
+		StoreId:          transaction.Store.Id,
+		AcquirerName:     transaction.Acquirer.Name,
+		AcquirerId:       payment.AcquirerId,
+		CapturedAmount:   payment.CapturedValue.Amount,
+		RefundedAmount:   payment.RefundedValue.Amount,
+		CreatedAt:        payment.CreatedAt,
+		UpdatedAt:        payment.UpdatedAt,
+	}
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	irepository "github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchPayments(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	payments := make([]*entity.Payment, 0)
+	for i := 0; i < 3; i++ {
+		payment := entity.NewPayment(entity.NewTransaction(
+			entity.NewCard("Token", "Holder", "01/2030", "VISA"),
+			entity.NewPurchase(entity.NewMoney(int64(1000*(i+1)), "BRL"), []string{"Item"}, 1),
+			entity.NewStore("11222333000181", "Address", "01310100"),
+			entity.NewAcquirer("cielo"),
+		))
+		payment.Transaction.Store.Id = testStoreId
+		payment.Transaction.Card.Last4 = "1111"
+		payment.CreatedAt = now.Add(-time.Duration(i) * time.Minute)
+		payments = append(payments, payment)
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		SearchPayments(ctx, &irepository.PaymentSearch{
+			StoreIds:   []string{testStoreId},
+			Statuses:   []entity.PaymentStatus{},
+			Sort:       irepository.PaymentSortCreatedAt,
+			Descending: true,
+			Limit:      3,
+		}).
+		Return(payments, nil).
+		Once()
+
+	searchPayments := NewSearchPayments(paymentRepository)
+
+	output, err := searchPayments.Execute(ctx, &SearchPaymentsInput{AllowedStores: []string{testStoreId}, Limit: 2})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(output.Payments))
+	assert.Equal(t, payments[0].Id, output.Payments[0].PaymentId)
+	assert.Equal(t, "1111", output.Payments[0].CardLast4)
+	assert.Equal(t, int64(1000), output.Payments[0].PurchaseAmount)
+	assert.Equal(t, testStoreId, output.Payments[0].StoreId)
+	assert.NotEmpty(t, output.NextCursor)
+
+	// the next page starts after the last payment of the page
+	paymentRepository.
+		EXPECT().
+		SearchPayments(ctx, &irepository.PaymentSearch{
+			StoreIds:       []string{testStoreId},
+			AcquirerName:   "cielo",
+			Statuses:       []entity.PaymentStatus{entity.PaymentStatusPending},
+			CardLast4:      "1111",
+			MinAmount:      100,
+			MaxAmount:      5000,
+			Sort:           irepository.PaymentSortCreatedAt,
+			Descending:     true,
+			After:          payments[1].Id,
+			AfterCreatedAt: payments[1].CreatedAt,
+			Limit:          3,
+		}).
+		Return(payments[2:], nil).
+		Once()
+
+	output, err = searchPayments.Execute(ctx, &SearchPaymentsInput{
+		AllowedStores: []string{testStoreId},
+		StoreId:       testStoreId,
+		AcquirerName:  "cielo",
+		Statuses:      []string{"pending"},
+		CardLast4:     "1111",
+		MinAmount:     100,
+		MaxAmount:     5000,
+		Cursor:        output.NextCursor,
+		Limit:         2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(output.Payments))
+	assert.Equal(t, payments[2].Id, output.Payments[0].PaymentId)
+	assert.Empty(t, output.NextCursor)
+
+	// the clients without stores have no payments
+	output, err = searchPayments.Execute(ctx, &SearchPaymentsInput{})
+	require.Nil(t, err)
+	assert.Empty(t, output.Payments)
+}
+
+func TestSearchPaymentsByAmount(t *testing.T) {
+	ctx := context.Background()
+
+	payments := make([]*entity.Payment, 0)
+	for _, amount := range []int64{500, 1000} {
+		payment := entity.NewPayment(entity.NewTransaction(
+			entity.NewCard("Token", "Holder", "01/2030", "VISA"),
+			entity.NewPurchase(entity.NewMoney(amount, "BRL"), []string{"Item"}, 1),
+			entity.NewStore("11222333000181", "Address", "01310100"),
+			entity.NewAcquirer("cielo"),
+		))
+		payments = append(payments, payment)
+	}
+
+	paymentRepository := repository.NewIPaymentRepositoryMock(t)
+	paymentRepository.
+		EXPECT().
+		SearchPayments(ctx, &irepository.PaymentSearch{
+			StoreIds: []string{testStoreId},
+			Statuses: []entity.PaymentStatus{},
+			Sort:     irepository.PaymentSortAmount,
+			Limit:    2,
+		}).
+		Return(payments, nil).
+		Once()
+
+	paymentRepository.
+		EXPECT().
+		SearchPayments(ctx, &irepository.PaymentSearch{
+			StoreIds:    []string{testStoreId},
+			Statuses:    []entity.PaymentStatus{},
+			Sort:        irepository.PaymentSortAmount,
+			After:       payments[0].Id,
+			AfterAmount: 500,
+			Limit:       2,
+		}).
+		Return(payments[1:], nil).
+		Once()
+
+	searchPayments := NewSearchPayments(paymentRepository)
+
+	output, err := searchPayments.Execute(ctx, &SearchPaymentsInput{AllowedStores: []string{testStoreId}, Sort: "amount", Limit: 1})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(output.Payments))
+
+	cursor := output.NextCursor
+	output, err = searchPayments.Execute(ctx, &SearchPaymentsInput{AllowedStores: []string{testStoreId}, Sort: "amount", Cursor: cursor, Limit: 1})
+	require.Nil(t, err)
+	assert.Equal(t, payments[1].Id, output.Payments[0].PaymentId)
+	assert.Empty(t, output.NextCursor)
+
+	// a cursor continues the sort it was created for
+	_, err = searchPayments.Execute(ctx, &SearchPaymentsInput{AllowedStores: []string{testStoreId}, Sort: "-amount", Cursor: cursor})
+	assert.Equal(t, core_errors.NewValidationError("cursor is invalid for this sort"), err)
+}
+
+func TestSearchPaymentsValidation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	searchPayments := NewSearchPayments(repository.NewIPaymentRepositoryMock(t))
+
+	_, err := searchPayments.Execute(ctx, &SearchPaymentsInput{AllowedStores: []string{testStoreId}, StoreId: "Another Store"})
+	var e *core_errors.ForbiddenError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, "store is not allowed for this client", e.Message)
+
+	_, err = searchPayments.Execute(ctx, &SearchPaymentsInput{
+		AllowedStores: []string{testStoreId},
+		Statuses:      []string{"approved", "settled"},
+		CardLast4:     "11a1",
+		MinAmount:     2000,
+		MaxAmount:     1000,
+		CreatedFrom:   now,
+		CreatedTo:     now.Add(-time.Hour),
+		Sort:          "status",
+		Cursor:        "Invalid",
+		Limit:         PaymentSearchMaxLimit + 1,
+	})
+	assert.Equal(t, core_errors.NewValidationError(
+		"payment status settled is invalid",
+		"card last4 must have 4 digits",
+		"min amount must not be greater than max amount",
+		"created from must not be after created to",
+		"sort must be created_at, -created_at, amount or -amount",
+		"limit must be between 1 and 100",
+	), err)
+
+	_, err = searchPayments.Execute(ctx, &SearchPaymentsInput{AllowedStores: []string{testStoreId}, MinAmount: -1, Cursor: "Invalid"})
+	assert.Equal(t, core_errors.NewValidationError("amounts must not be negative", "cursor is invalid for this sort"), err)
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type StartIdempotentRequestInput struct {
+	Caller      string
+	Key         string
+	RequestHash string
+}
+
+type StartIdempotentRequestOutput struct {
+	Replay       bool
+	ResponseCode int
+	ResponseBody []byte
+}
+
+type IStartIdempotentRequest interface {
+	Execute(ctx context.Context, input *StartIdempotentRequestInput) (*StartIdempotentRequestOutput, error)
+}
+
+type StartIdempotentRequest struct {
+	idempotencyRepository repository.IIdempotencyRepository
+}
+
+func NewStartIdempotentRequest(idempotencyRepository repository.IIdempotencyRepository) *StartIdempotentRequest {
+	return &StartIdempotentRequest{
+		idempotencyRepository: idempotencyRepository,
+	}
+}
+
+// Execute does not take a key abandoned in processing again, since its request may have
+// charged the card before its server stopped.
+func (s *StartIdempotentRequest) Execute(ctx context.Context, input *StartIdempotentRequestInput) (*StartIdempotentRequestOutput, error) {
+	idempotency := entity.NewIdempotency(input.Caller, input.Key, input.RequestHash)
+
+	err := idempotency.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.idempotencyRepository.CreateIdempotency(ctx, idempotency)
+	if err == nil {
+		return &StartIdempotentRequestOutput{Replay: false}, nil
+	}
+
+	var conflictErr *core_errors.ConflictError
+	if !errors.As(err, &conflictErr) {
+		return nil, err
+	}
+
+	stored, err := s.idempotencyRepository.FindIdempotency(ctx, input.Caller, input.Key)
+	if err != nil {
+		var notFoundErr *core_errors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, core_errors.NewConflictError("a request with this idempotency key is in progress")
+		}
+
+		return nil, err
+	}
+
+	if stored.RequestHash != input.RequestHash {
+		return nil, core_errors.NewConflictError("idempotency key was already used with a different request")
+	}
+
+	if stored.Abandoned() {
+		return nil, core_errors.NewConflictError("a request with this idempotency key was interrupted and may have been processed")
+	}
+
+	if stored.Status != entity.IdempotencyStatusCompleted {
+		return nil, core_errors.NewConflictError("a request with this idempotency key is in progress")
+	}
+
+	output := &StartIdempotentRequestOutput{
+		Replay:       true,
+		ResponseCode: stored.ResponseCode,
+		ResponseBody: stored.ResponseBody,
+	}
+
+	return output, nil
+}
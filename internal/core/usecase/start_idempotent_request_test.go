@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartIdempotentRequestWithNewKey(t *testing.T) {
+	ctx := context.Background()
+
+	input := StartIdempotentRequestInput{
+		Caller:      "Caller",
+		Key:         "Key",
+		RequestHash: "Hash",
+	}
+
+	idempotencyRepository := repository.NewIIdempotencyRepositoryMock(t)
+	idempotencyRepository.
+		EXPECT().
+		CreateIdempotency(ctx, mock.Anything).
+		Run(func(ctx context.Context, idempotency *entity.Idempotency) {
+			assert.Equal(t, input.Caller, idempotency.Caller)
+			assert.Equal(t, input.Key, idempotency.Key)
+			assert.Equal(t, input.RequestHash, idempotency.RequestHash)
+			assert.Equal(t, entity.IdempotencyStatusProcessing, idempotency.Status)
+		}).
+		Return(nil).
+		Once()
+
+	startIdempotentRequest := NewStartIdempotentRequest(idempotencyRepository)
+
+	output, err := startIdempotentRequest.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.False(t, output.Replay)
+}
+
+func TestStartIdempotentRequestWithCompletedKey(t *testing.T) {
+	ctx := context.Background()
+
+	input := StartIdempotentRequestInput{
+		Caller:      "Caller",
+		Key:         "Key",
+		RequestHash: "Hash",
+	}
+
+	stored := entity.NewIdempotency(input.Caller, input.Key, input.RequestHash)
+	stored.Complete(200, []byte("Body"))
+
+	idempotencyRepository := repository.NewIIdempotencyRepositoryMock(t)
+	idempotencyRepository.
+		EXPECT().
+		CreateIdempotency(ctx, mock.Anything).
+		Return(core_errors.NewConflictError("idempotency key already exists")).
+		Once()
+	idempotencyRepository.
+		EXPECT().
+		FindIdempotency(ctx, input.Caller, input.Key).
+		Return(stored, nil).
+		Once()
+
+	startIdempotentRequest := NewStartIdempotentRequest(idempotencyRepository)
+
+	output, err := startIdempotentRequest.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.True(t, output.Replay)
+	assert.Equal(t, 200, output.ResponseCode)
+	assert.Equal(t, []byte("Body"), output.ResponseBody)
+}
+
+func TestStartIdempotentRequestWithDifferentRequest(t *testing.T) {
+	ctx := context.Background()
+
+	input := StartIdempotentRequestInput{
+		Caller:      "Caller",
+		Key:         "Key",
+		RequestHash: "Hash",
+	}
+
+	stored := entity.NewIdempotency(input.Caller, input.Key, "Another Hash")
+	stored.Complete(200, []byte("Body"))
+
+	idempotencyRepository := repository.NewIIdempotencyRepositoryMock(t)
+	idempotencyRepository.
+		EXPECT().
+		CreateIdempotency(ctx, mock.Anything).
+		Return(core_errors.NewConflictError("idempotency key already exists")).
+		Once()
+	idempotencyRepository.
+		EXPECT().
+		FindIdempotency(ctx, input.Caller, input.Key).
+		Return(stored, nil).
+		Once()
+
+	startIdempotentRequest := NewStartIdempotentRequest(idempotencyRepository)
+
+	output, err := startIdempotentRequest.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.ConflictError
+	require.ErrorAs(t, err, &w)
+
+	assert.Equal(t, "idempotency key was already used with a different request", w.Message)
+}
+
+func TestStartIdempotentRequestWithKeyInProgress(t *testing.T) {
+	ctx := context.Background()
+
+	input := StartIdempotentRequestInput{
+		Caller:      "Caller",
+		Key:         "Key",
+		RequestHash: "Hash",
+	}
+
+	idempotencyRepository := repository.NewIIdempotencyRepositoryMock(t)
+	idempotencyRepository.
+		EXPECT().
+		CreateIdempotency(ctx, mock.Anything).
+		Return(core_errors.NewConflictError("idempotency key already exists")).
+		Once()
+	idempotencyRepository.
+		EXPECT().
+		FindIdempotency(ctx, input.Caller, input.Key).
+		Return(entity.NewIdempotency(input.Caller, input.Key, input.RequestHash), nil).
+		Once()
+
+	startIdempotentRequest := NewStartIdempotentRequest(idempotencyRepository)
+
+	output, err := startIdempotentRequest.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.ConflictError
+	require.ErrorAs(t, err, &w)
+
+	assert.Equal(t, "a request with this idempotency key is in progress", w.Message)
+}
+
+func TestStartIdempotentRequestWithAbandonedKey(t *testing.T) {
+	ctx := context.Background()
+
+	input := StartIdempotentRequestInput{
+		Caller:      "Caller",
+		Key:         "Key",
+		RequestHash: "Hash",
+	}
+
+	stored := entity.NewIdempotency(input.Caller, input.Key, input.RequestHash)
+	stored.UpdatedAt = stored.UpdatedAt.Add(-entity.IdempotencyProcessingTimeout - time.Minute)
+
+	idempotencyRepository := repository.NewIIdempotencyRepositoryMock(t)
+	idempotencyRepository.
+		EXPECT().
+		CreateIdempotency(ctx, mock.Anything).
+		Return(core_errors.NewConflictError("idempotency key already exists")).
+		Once()
+	idempotencyRepository.
+		EXPECT().
+		FindIdempotency(ctx, input.Caller, input.Key).
+		Return(stored, nil).
+		Once()
+
+	// the key is kept, since its request may have been processed
+	startIdempotentRequest := NewStartIdempotentRequest(idempotencyRepository)
+
+	output, err := startIdempotentRequest.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.ConflictError
+	require.ErrorAs(t, err, &w)
+	assert.Equal(t, "a request with this idempotency key was interrupted and may have been processed", w.Message)
+}
+
+func TestStartIdempotentRequestWithInvalidKey(t *testing.T) {
+	ctx := context.Background()
+
+	input := StartIdempotentRequestInput{
+		Caller:      "Caller",
+		Key:         "",
+		RequestHash: "Hash",
+	}
+
+	idempotencyRepository := repository.NewIIdempotencyRepositoryMock(t)
+	startIdempotentRequest := NewStartIdempotentRequest(idempotencyRepository)
+
+	output, err := startIdempotentRequest.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var w *core_errors.ValidationError
+	require.ErrorAs(t, err, &w)
+
+	assert.Equal(t, []string{"idempotency key is required"}, w.Messages)
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+type TokenizeCardInput struct {
+	CardNumber       string
+	CardHolder       string
+	CardExpiration   string
+	CardBrand        string
+	CardSecurityCode string
+}
+
+type TokenizeCardOutput struct {
+	CardToken       string
+	CardBrand       string
+	CardBin         string
+	CardLast4       string
+	CardFundingType string
+	CardCountry     string
+}
+
+type ITokenizeCard interface {
+	Execute(ctx context.Context, input *TokenizeCardInput) (*TokenizeCardOutput, error)
+}
+
+type TokenizeCard struct {
+	cardRepository repository.ICardRepository
+	binService     service.IBinService
+}
+
+func NewTokenizeCard(cardRepository repository.ICardRepository, binService service.IBinService) *TokenizeCard {
+	return &TokenizeCard{
+		cardRepository: cardRepository,
+		binService:     binService,
+	}
+}
+
+// Execute stores the card in the vault and returns its token. The brand is inferred from the
+// bin when it is not declared, and the security code is validated and then discarded.
+func (t *TokenizeCard) Execute(ctx context.Context, input *TokenizeCardInput) (*TokenizeCardOutput, error) {
+	card := entity.NewVaultCard(input.CardNumber, input.CardHolder, input.CardExpiration, input.CardBrand)
+
+	err := card.ValidateData(input.CardSecurityCode)
+	if err != nil {
+		return nil, err
+	}
+
+	binRange, err := t.binService.FindBin(ctx, card.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	err = card.ApplyBin(binRange)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.cardRepository.CreateCard(ctx, card)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &TokenizeCardOutput{
+		CardToken:       card.Token,
+		CardBrand:       card.Brand,
+		CardBin:         card.Bin,
+		CardLast4:       card.Last4,
+		CardFundingType: string(card.FundingType),
+		CardCountry:     card.Country,
+	}
+
+	return output, nil
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenizeCardWithValidData(t *testing.T) {
+	ctx := context.Background()
+
+	input := TokenizeCardInput{
+		CardNumber:       "4111111111111111",
+		CardHolder:       "Holder",
+		CardExpiration:   "01/2030",
+		CardBrand:        "VISA",
+		CardSecurityCode: "123",
+	}
+
+	var token string
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		CreateCard(ctx, mock.Anything).
+		Run(func(ctx context.Context, card *entity.Card) {
+			token = card.Token
+			assert.Equal(t, input.CardNumber, card.Number)
+			assert.Equal(t, input.CardHolder, card.Holder)
+			assert.Equal(t, input.CardExpiration, card.Expiration)
+			assert.Equal(t, input.CardBrand, card.Brand)
+			assert.Equal(t, entity.FundingTypeCredit, card.FundingType)
+			assert.Equal(t, "US", card.Country)
+		}).
+		Return(nil).
+		Once()
+
+	binService := service.NewIBinServiceMock(t)
+	binService.
+		EXPECT().
+		FindBin(ctx, input.CardNumber).
+		Return(&entity.BinRange{Start: "411111", End: "411111", Brand: "VISA", FundingType: entity.FundingTypeCredit, Country: "US"}, nil).
+		Once()
+
+	tokenizeCard := NewTokenizeCard(cardRepository, binService)
+
+	output, err := tokenizeCard.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, token, output.CardToken)
+	assert.Equal(t, "VISA", output.CardBrand)
+	assert.Equal(t, "411111", output.CardBin)
+	assert.Equal(t, "1111", output.CardLast4)
+	assert.Equal(t, "credit", output.CardFundingType)
+	assert.Equal(t, "US", output.CardCountry)
+}
+
+func TestTokenizeCardWithInferredBrand(t *testing.T) {
+	ctx := context.Background()
+
+	input := TokenizeCardInput{
+		CardNumber:       "5066991111111118",
+		CardHolder:       "Holder",
+		CardExpiration:   "01/2030",
+		CardSecurityCode: "123",
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		CreateCard(ctx, mock.Anything).
+		Return(nil).
+		Once()
+
+	binService := service.NewIBinServiceMock(t)
+	binService.
+		EXPECT().
+		FindBin(ctx, input.CardNumber).
+		Return(&entity.BinRange{Start: "506699", End: "506778", Brand: "ELO", Country: "BR"}, nil).
+		Once()
+
+	output, err := NewTokenizeCard(cardRepository, binService).Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, "ELO", output.CardBrand)
+	assert.Equal(t, "BR", output.CardCountry)
+}
+
+func TestTokenizeCardWithBrandMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	input := TokenizeCardInput{
+		CardNumber:       "5555555555554444",
+		CardHolder:       "Holder",
+		CardExpiration:   "01/2030",
+		CardBrand:        "VISA",
+		CardSecurityCode: "123",
+	}
+
+	binService := service.NewIBinServiceMock(t)
+	binService.
+		EXPECT().
+		FindBin(ctx, input.CardNumber).
+		Return(&entity.BinRange{Start: "51", End: "55", Brand: "MASTERCARD"}, nil).
+		Once()
+
+	output, err := NewTokenizeCard(repository.NewICardRepositoryMock(t), binService).Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var e *core_errors.ValidationError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, []string{"card brand does not match the card number"}, e.Messages)
+}
+
+func TestTokenizeCardWithInvalidData(t *testing.T) {
+	ctx := context.Background()
+
+	input := TokenizeCardInput{
+		CardNumber:       "4111 1111 1111 1111",
+		CardHolder:       "Holder",
+		CardExpiration:   "01/2030",
+		CardBrand:        "VISA",
+		CardSecurityCode: "12",
+	}
+
+	tokenizeCard := NewTokenizeCard(repository.NewICardRepositoryMock(t), service.NewIBinServiceMock(t))
+
+	output, err := tokenizeCard.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var e *core_errors.ValidationError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, []string{"card number is invalid", "card security code is invalid"}, e.Messages)
+}
+
+func TestTokenizeCardWithRepositoryError(t *testing.T) {
+	ctx := context.Background()
+
+	input := TokenizeCardInput{
+		CardNumber:       "4111111111111111",
+		CardHolder:       "Holder",
+		CardExpiration:   "01/2030",
+		CardBrand:        "VISA",
+		CardSecurityCode: "123",
+	}
+
+	cardRepository := repository.NewICardRepositoryMock(t)
+	cardRepository.
+		EXPECT().
+		CreateCard(ctx, mock.Anything).
+		Return(core_errors.NewInternalError(errors.New("connection refused"))).
+		Once()
+
+	binService := service.NewIBinServiceMock(t)
+	binService.
+		EXPECT().
+		FindBin(ctx, input.CardNumber).
+		Return(nil, nil).
+		Once()
+
+	tokenizeCard := NewTokenizeCard(cardRepository, binService)
+
+	output, err := tokenizeCard.Execute(ctx, &input)
+	assert.Nil(t, output)
+
+	var e *core_errors.InternalError
+	assert.ErrorAs(t, err, &e)
+}
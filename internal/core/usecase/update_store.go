@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+
+	"github.com/google/uuid"
+)
+
+type UpdateStoreInput struct {
+	StoreId        string
+	Identification string
+	Address        string
+	Cep            string
+	Street         string
+	Number         string
+	City           string
+	State          string
+}
+
+type UpdateStoreOutput struct {
+	Store *StoreOutput
+}
+
+type IUpdateStore interface {
+	Execute(ctx context.Context, input *UpdateStoreInput) (*UpdateStoreOutput, error)
+}
+
+type UpdateStore struct {
+	storeRepository repository.IStoreRepository
+	addressLookup   service.IAddressLookup
+}
+
+func NewUpdateStore(storeRepository repository.IStoreRepository, addressLookup service.IAddressLookup) *UpdateStore {
+	return &UpdateStore{
+		storeRepository: storeRepository,
+		addressLookup:   addressLookup,
+	}
+}
+
+// Execute replaces the registered data of the store. The payments already processed keep the
+// data they were sent to the acquirer with.
+func (u *UpdateStore) Execute(ctx context.Context, input *UpdateStoreInput) (*UpdateStoreOutput, error) {
+	if _, err := uuid.Parse(input.StoreId); err != nil {
+		return nil, core_errors.NewNotFoundError("store id is invalid")
+	}
+
+	registered, err := u.storeRepository.FindStore(ctx, input.StoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	store := entity.NewStore(input.Identification, input.Address, input.Cep)
+	store.SetLocation(input.Street, input.Number, input.City, input.State)
+	store.Id = registered.Id
+	store.CreatedAt = registered.CreatedAt
+	store.UpdatedAt = time.Now().UTC()
+
+	err = completeStoreAddress(ctx, u.addressLookup, store)
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.storeRepository.UpdateStore(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateStoreOutput{Store: newStoreOutput(store)}, nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateStore(t *testing.T) {
+	ctx := context.Background()
+
+	registered := entity.NewRegisteredStore("52998224725", "Old Address", "20011000")
+	registered.CreatedAt = time.Now().UTC().Add(-time.Hour)
+
+	input := UpdateStoreInput{
+		StoreId:        registered.Id,
+		Identification: "11.222.333/0001-81",
+		Address:        "Avenida Paulista, 1000",
+		Cep:            "01310-100",
+	}
+
+	storeRepository := repository.NewIStoreRepositoryMock(t)
+	storeRepository.
+		EXPECT().
+		FindStore(ctx, registered.Id).
+		Return(registered, nil).
+		Once()
+	storeRepository.
+		EXPECT().
+		UpdateStore(ctx, mock.Anything).
+		Run(func(ctx context.Context, store *entity.Store) {
+			assert.Equal(t, registered.Id, store.Id)
+			assert.Equal(t, "11222333000181", store.Identification)
+			assert.Equal(t, "Avenida Paulista", store.Street)
+			assert.Equal(t, "SP", store.State)
+			assert.Equal(t, registered.CreatedAt, store.CreatedAt)
+			assert.True(t, store.UpdatedAt.After(store.CreatedAt))
+		}).
+		Return(nil).
+		Once()
+
+	updateStore := NewUpdateStore(storeRepository, createAddressLookup(t, ctx))
+
+	output, err := updateStore.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, registered.Id, output.Store.StoreId)
+	assert.Equal(t, "Avenida Paulista, 1000", output.Store.Address)
+}
+
+func TestUpdateStoreNotFound(t *testing.T) {
+	ctx := context.Background()
+	storeId := "5b0b8b3e-0f8e-4d52-9d8a-3c1f6e2a7b10"
+
+	storeRepository := repository.NewIStoreRepositoryMock(t)
+	storeRepository.
+		EXPECT().
+		FindStore(ctx, storeId).
+		Return(nil, core_errors.NewNotFoundError("store id is invalid")).
+		Once()
+
+	updateStore := NewUpdateStore(storeRepository, service.NewIAddressLookupMock(t))
+
+	for _, id := range []string{storeId, "Invalid"} {
+		output, err := updateStore.Execute(ctx, &UpdateStoreInput{StoreId: id})
+		assert.Nil(t, output)
+
+		var e *core_errors.NotFoundError
+		require.ErrorAs(t, err, &e)
+		assert.Equal(t, "store id is invalid", e.Message)
+	}
+}
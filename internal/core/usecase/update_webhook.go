@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+type UpdateWebhookInput struct {
+	Caller    string
+	WebhookId string
+	Url       string
+	Events    []string
+
+	// Secret replaces the secret of the webhook when informed.
+	Secret string
+}
+
+type UpdateWebhookOutput struct {
+	Webhook *WebhookOutput
+}
+
+type IUpdateWebhook interface {
+	Execute(ctx context.Context, input *UpdateWebhookInput) (*UpdateWebhookOutput, error)
+}
+
+type UpdateWebhook struct {
+	webhookRepository repository.IWebhookRepository
+}
+
+func NewUpdateWebhook(webhookRepository repository.IWebhookRepository) *UpdateWebhook {
+	return &UpdateWebhook{
+		webhookRepository: webhookRepository,
+	}
+}
+
+// Execute replaces the url and events of a webhook of the client. The deliveries already
+// queued keep being sent to the new url.
+func (u *UpdateWebhook) Execute(ctx context.Context, input *UpdateWebhookInput) (*UpdateWebhookOutput, error) {
+	webhook, err := findCallerWebhook(ctx, u.webhookRepository, input.Caller, input.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.Update(input.Url, input.Events, input.Secret, time.Now().UTC())
+
+	err = webhook.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.webhookRepository.UpdateWebhook(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateWebhookOutput{Webhook: newWebhookOutput(webhook)}, nil
+}
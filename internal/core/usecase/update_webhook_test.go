@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateWebhook(t *testing.T) {
+	ctx := context.Background()
+	webhook := createTestWebhook("Caller")
+	createdAt := webhook.CreatedAt
+
+	input := UpdateWebhookInput{
+		Caller:    "Caller",
+		WebhookId: webhook.Id,
+		Url:       "https://example.com/payments",
+		Events:    []string{"payment.refunded", "payment.voided"},
+	}
+
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		FindWebhook(ctx, webhook.Id).
+		Return(webhook, nil).
+		Once()
+	webhookRepository.
+		EXPECT().
+		UpdateWebhook(ctx, webhook).
+		Run(func(ctx context.Context, webhook *entity.Webhook) {
+			assert.Equal(t, "https://example.com/payments", webhook.Url)
+			assert.Equal(t, "a-secret-of-16-chars", webhook.Secret)
+		}).
+		Return(nil).
+		Once()
+
+	updateWebhook := NewUpdateWebhook(webhookRepository)
+
+	output, err := updateWebhook.Execute(ctx, &input)
+	require.Nil(t, err)
+	assert.Equal(t, webhook.Id, output.Webhook.WebhookId)
+	assert.Equal(t, input.Url, output.Webhook.Url)
+	assert.Equal(t, input.Events, output.Webhook.Events)
+	assert.Equal(t, createdAt, output.Webhook.CreatedAt)
+	assert.False(t, output.Webhook.UpdatedAt.Before(createdAt))
+}
+
+func TestUpdateWebhookWithInvalidData(t *testing.T) {
+	ctx := context.Background()
+	webhook := createTestWebhook("Caller")
+
+	input := UpdateWebhookInput{
+		Caller:    "Caller",
+		WebhookId: webhook.Id,
+		Url:       "https://example.com/payments",
+		Events:    []string{},
+		Secret:    "short",
+	}
+
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		FindWebhook(ctx, webhook.Id).
+		Return(webhook, nil).
+		Once()
+
+	updateWebhook := NewUpdateWebhook(webhookRepository)
+
+	output, err := updateWebhook.Execute(ctx, &input)
+	assert.Nil(t, output)
+	assert.Equal(t, core_errors.NewValidationError(
+		"webhook events are required",
+		"webhook secret must have at least 16 characters",
+	), err)
+}
+
+func TestUpdateWebhookOfAnotherCaller(t *testing.T) {
+	ctx := context.Background()
+	webhook := createTestWebhook("Another Caller")
+
+	webhookRepository := repository.NewIWebhookRepositoryMock(t)
+	webhookRepository.
+		EXPECT().
+		FindWebhook(ctx, webhook.Id).
+		Return(webhook, nil).
+		Once()
+
+	updateWebhook := NewUpdateWebhook(webhookRepository)
+
+	output, err := updateWebhook.Execute(ctx, &UpdateWebhookInput{
+		Caller:    "Caller",
+		WebhookId: webhook.Id,
+		Url:       "https://example.com/payments",
+		Events:    []string{"payment.approved"},
+	})
+	assert.Nil(t, output)
+
+	var e *core_errors.NotFoundError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, "webhook id is invalid", e.Message)
+}
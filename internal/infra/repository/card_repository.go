@@ -5,23 +5,96 @@ import (
 	"database/sql"
 	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
 	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
 )
 
 type CardRepository struct {
-	db *sql.DB
+	db         *sql.DB
+	keyManager service.IKeyManager
 }
 
-func NewCardRepository(db *sql.DB) *CardRepository {
+func NewCardRepository(db *sql.DB, keyManager service.IKeyManager) *CardRepository {
 	return &CardRepository{
-		db: db,
+		db:         db,
+		keyManager: keyManager,
 	}
 }
 
+func (r *CardRepository) CreateCard(ctx context.Context, card *entity.Card) error {
+	number, keyVersion, err := r.keyManager.Encrypt([]byte(card.Number))
+	if err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	stmt, err := r.db.PrepareContext(ctx, `
+		INSERT INTO cards (token, holder, expiration, brand, bin, last4, funding_type, country, expires_at, number_encrypted, key_version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		card.Token,
+		card.Holder,
+		card.Expiration,
+		card.Brand,
+		card.Bin,
+		card.Last4,
+		card.FundingType,
+		card.Country,
+		sql.NullTime{Time: card.ExpiresAt(), Valid: !card.ExpiresAt().IsZero()},
+		number,
+		keyVersion,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *CardRepository) DeleteCard(ctx context.Context, cardToken string) error {
+	stmt, err := r.db.PrepareContext(ctx, "DELETE FROM cards WHERE token = $1")
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, cardToken)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("card token is invalid")
+	}
+
+	return nil
+}
+
 func (r *CardRepository) FindCard(ctx context.Context, cardToken string) (*entity.Card, error) {
-	stmt, err := r.db.PrepareContext(ctx, "SELECT token, holder, expiration, brand FROM cards WHERE token = $1")
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT token, holder, expiration, brand, bin, last4, funding_type, country
+		FROM cards
+		WHERE token = $1
+	`)
 	if err != nil {
 		slog.Error(err.Error())
 		return nil, err
@@ -34,6 +107,10 @@ func (r *CardRepository) FindCard(ctx context.Context, cardToken string) (*entit
 		&card.Holder,
 		&card.Expiration,
 		&card.Brand,
+		&card.Bin,
+		&card.Last4,
+		&card.FundingType,
+		&card.Country,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -46,3 +123,168 @@ func (r *CardRepository) FindCard(ctx context.Context, cardToken string) (*entit
 
 	return &card, nil
 }
+
+func (r *CardRepository) FindCardNumber(ctx context.Context, cardToken string) (string, error) {
+	stmt, err := r.db.PrepareContext(ctx, "SELECT number_encrypted, key_version FROM cards WHERE token = $1")
+	if err != nil {
+		slog.Error(err.Error())
+		return "", core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	var number []byte
+	var keyVersion int
+	err = stmt.QueryRowContext(ctx, cardToken).Scan(&number, &keyVersion)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", core_errors.NewNotFoundError("card token is invalid")
+		}
+
+		slog.Error(err.Error())
+		return "", core_errors.NewInternalError(err)
+	}
+
+	if number == nil {
+		return "", nil
+	}
+
+	plaintext, err := r.keyManager.Decrypt(number, keyVersion)
+	if err != nil {
+		slog.Error(err.Error())
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+func (r *CardRepository) FindExpiringCards(ctx context.Context, from time.Time, until time.Time) ([]*entity.Card, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT token, holder, expiration, brand, bin, last4, funding_type, country
+		FROM cards
+		WHERE expires_at > $1 AND expires_at <= $2
+		ORDER BY expires_at, token
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, from, until)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	cards := make([]*entity.Card, 0)
+	for rows.Next() {
+		var card entity.Card
+		err = rows.Scan(
+			&card.Token,
+			&card.Holder,
+			&card.Expiration,
+			&card.Brand,
+			&card.Bin,
+			&card.Last4,
+			&card.FundingType,
+			&card.Country,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		cards = append(cards, &card)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return cards, nil
+}
+
+// ReencryptCards skips the rows locked by others, so it runs online alongside the payments and
+// other re-encryptions.
+func (r *CardRepository) ReencryptCards(ctx context.Context, limit int) (int, error) {
+	keyVersion := r.keyManager.CurrentVersion()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		slog.Error(err.Error())
+		return 0, core_errors.NewInternalError(err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT token, number_encrypted, key_version
+		FROM cards
+		WHERE number_encrypted IS NOT NULL AND key_version <> $1
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`, keyVersion, limit)
+	if err != nil {
+		slog.Error(err.Error())
+		return 0, core_errors.NewInternalError(err)
+	}
+
+	type encryptedCard struct {
+		token      string
+		number     []byte
+		keyVersion int
+	}
+
+	cards := make([]*encryptedCard, 0)
+	for rows.Next() {
+		var card encryptedCard
+		err = rows.Scan(&card.token, &card.number, &card.keyVersion)
+		if err != nil {
+			rows.Close()
+			slog.Error(err.Error())
+			return 0, core_errors.NewInternalError(err)
+		}
+
+		cards = append(cards, &card)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return 0, core_errors.NewInternalError(err)
+	}
+
+	for _, card := range cards {
+		plaintext, err := r.keyManager.Decrypt(card.number, card.keyVersion)
+		if err != nil {
+			slog.Error(err.Error())
+			return 0, err
+		}
+
+		number, version, err := r.keyManager.Encrypt(plaintext)
+		if err != nil {
+			slog.Error(err.Error())
+			return 0, err
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"UPDATE cards SET number_encrypted = $2, key_version = $3 WHERE token = $1",
+			card.token,
+			number,
+			version,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return 0, core_errors.NewInternalError(err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		slog.Error(err.Error())
+		return 0, core_errors.NewInternalError(err)
+	}
+
+	return len(cards), nil
+}
@@ -1,13 +1,17 @@
 package repository
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
 	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/service"
 	"github.com/sesaquecruz/go-payment-processor/test/testcontainers"
 
 	"github.com/stretchr/testify/suite"
@@ -18,6 +22,7 @@ type CardRepositoryTestSuite struct {
 	ctx            context.Context
 	db             *sql.DB
 	pgContainer    *testcontainers.PostgresContainer
+	keyManager     *service.LocalKeyManager
 	cardRepository *CardRepository
 }
 
@@ -31,10 +36,14 @@ func (s *CardRepositoryTestSuite) SetupSuite() {
 	db, err := connection.DBConnection(pgContainer.DSN)
 	s.Require().Nil(err)
 
+	keyManager, err := service.NewLocalKeyManager(filepath.Join(s.T().TempDir(), "keystore.json"), bytes.Repeat([]byte{1}, 32))
+	s.Require().Nil(err)
+
 	s.ctx = ctx
 	s.db = db
 	s.pgContainer = pgContainer
-	s.cardRepository = NewCardRepository(db)
+	s.keyManager = keyManager
+	s.cardRepository = NewCardRepository(db, keyManager)
 }
 
 func (s *CardRepositoryTestSuite) TestFindCards() {
@@ -130,6 +139,106 @@ func (s *CardRepositoryTestSuite) TestFindCards() {
 	}
 }
 
+func (s *CardRepositoryTestSuite) TestVaultCards() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	err = saveTestCardData(s.db)
+	s.Require().Nil(err)
+
+	card := entity.NewVaultCard("4111111111111111", "Holder", "01/2030", "VISA")
+
+	s.T().Run("create a card with an encrypted number", func(t *testing.T) {
+		err := s.cardRepository.CreateCard(s.ctx, card)
+		s.Require().Nil(err)
+
+		var number []byte
+		err = s.db.QueryRow("SELECT number_encrypted FROM cards WHERE token = $1", card.Token).Scan(&number)
+		s.Require().Nil(err)
+		s.NotContains(string(number), card.Number)
+	})
+
+	s.T().Run("find the card without its number", func(t *testing.T) {
+		found, err := s.cardRepository.FindCard(s.ctx, card.Token)
+		s.Require().Nil(err)
+		s.Equal("411111", found.Bin)
+		s.Equal("1111", found.Last4)
+		s.Empty(found.Number)
+	})
+
+	s.T().Run("find the card number", func(t *testing.T) {
+		number, err := s.cardRepository.FindCardNumber(s.ctx, card.Token)
+		s.Require().Nil(err)
+		s.Equal("4111111111111111", number)
+
+		number, err = s.cardRepository.FindCardNumber(s.ctx, "461c9432d4d7eca7ba32b783aa22ca5c89e4f396288de5128b73b461c42d4f40")
+		s.Require().Nil(err)
+		s.Empty(number)
+	})
+
+	s.T().Run("re-encrypt the card with a new key", func(t *testing.T) {
+		version, err := s.keyManager.Rotate()
+		s.Require().Nil(err)
+
+		cards, err := s.cardRepository.ReencryptCards(s.ctx, 10)
+		s.Require().Nil(err)
+		s.Equal(1, cards)
+
+		var keyVersion int
+		err = s.db.QueryRow("SELECT key_version FROM cards WHERE token = $1", card.Token).Scan(&keyVersion)
+		s.Require().Nil(err)
+		s.Equal(version, keyVersion)
+
+		cards, err = s.cardRepository.ReencryptCards(s.ctx, 10)
+		s.Require().Nil(err)
+		s.Equal(0, cards)
+
+		number, err := s.cardRepository.FindCardNumber(s.ctx, card.Token)
+		s.Require().Nil(err)
+		s.Equal("4111111111111111", number)
+	})
+
+	s.T().Run("delete the card", func(t *testing.T) {
+		err := s.cardRepository.DeleteCard(s.ctx, card.Token)
+		s.Require().Nil(err)
+
+		_, err = s.cardRepository.FindCard(s.ctx, card.Token)
+		var e *errors.NotFoundError
+		s.Require().ErrorAs(err, &e)
+
+		err = s.cardRepository.DeleteCard(s.ctx, card.Token)
+		s.Require().ErrorAs(err, &e)
+	})
+}
+
+func (s *CardRepositoryTestSuite) TestFindExpiringCards() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	soon := entity.NewVaultCard("4111111111111111", "Soon", "03/2030", "VISA")
+	later := entity.NewVaultCard("5555555555554444", "Later", "05/30", "MASTERCARD")
+	expired := entity.NewVaultCard("4111111111111111", "Expired", "01/2030", "VISA")
+
+	for _, card := range []*entity.Card{later, soon, expired} {
+		err = s.cardRepository.CreateCard(s.ctx, card)
+		s.Require().Nil(err)
+	}
+
+	from := time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	cards, err := s.cardRepository.FindExpiringCards(s.ctx, from, from.AddDate(0, 0, 120))
+	s.Require().Nil(err)
+	s.Require().Len(cards, 2)
+	s.Equal(soon.Token, cards[0].Token)
+	s.Equal(later.Token, cards[1].Token)
+	s.Equal("1111", cards[0].Last4)
+	s.Empty(cards[0].Number)
+
+	cards, err = s.cardRepository.FindExpiringCards(s.ctx, from, from.AddDate(0, 0, 30))
+	s.Require().Nil(err)
+	s.Require().Len(cards, 0)
+}
+
 func (s *CardRepositoryTestSuite) TearDownSuite() {
 	err := s.pgContainer.TerminateContainer()
 	s.Require().Nil(err)
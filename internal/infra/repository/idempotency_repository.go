@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+type IdempotencyRepository struct {
+	db *sql.DB
+}
+
+func NewIdempotencyRepository(db *sql.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		db: db,
+	}
+}
+
+// CreateIdempotency stores a new key, or returns a ConflictError when the key is already
+// taken, which is what guards concurrent requests carrying the same key.
+func (r *IdempotencyRepository) CreateIdempotency(ctx context.Context, idempotency *entity.Idempotency) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		INSERT INTO idempotency_keys (caller, key, request_hash, status, response_code, response_body, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (caller, key) DO NOTHING
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		idempotency.Caller,
+		idempotency.Key,
+		idempotency.RequestHash,
+		idempotency.Status,
+		idempotency.ResponseCode,
+		idempotency.ResponseBody,
+		idempotency.CreatedAt,
+		idempotency.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewConflictError("idempotency key already exists")
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) UpdateIdempotency(ctx context.Context, idempotency *entity.Idempotency) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE idempotency_keys
+		SET status = $3, response_code = $4, response_body = $5, updated_at = $6
+		WHERE caller = $1 AND key = $2
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		idempotency.Caller,
+		idempotency.Key,
+		idempotency.Status,
+		idempotency.ResponseCode,
+		idempotency.ResponseBody,
+		idempotency.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("idempotency key is invalid")
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteIdempotency(ctx context.Context, caller string, key string) error {
+	stmt, err := r.db.PrepareContext(ctx, "DELETE FROM idempotency_keys WHERE caller = $1 AND key = $2")
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, caller, key)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) FindIdempotency(ctx context.Context, caller string, key string) (*entity.Idempotency, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT caller, key, request_hash, status, response_code, response_body, created_at, updated_at
+		FROM idempotency_keys
+		WHERE caller = $1 AND key = $2
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	var idempotency entity.Idempotency
+	err = stmt.QueryRowContext(ctx, caller, key).Scan(
+		&idempotency.Caller,
+		&idempotency.Key,
+		&idempotency.RequestHash,
+		&idempotency.Status,
+		&idempotency.ResponseCode,
+		&idempotency.ResponseBody,
+		&idempotency.CreatedAt,
+		&idempotency.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, core_errors.NewNotFoundError("idempotency key is invalid")
+		}
+
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return &idempotency, nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/test/testcontainers"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type IdempotencyRepositoryTestSuite struct {
+	suite.Suite
+	ctx                   context.Context
+	db                    *sql.DB
+	pgContainer           *testcontainers.PostgresContainer
+	idempotencyRepository *IdempotencyRepository
+}
+
+func (s *IdempotencyRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	migrationsPath := "../../../migrations"
+
+	pgContainer, err := testcontainers.NewPostgresContainer(ctx, migrationsPath)
+	s.Require().Nil(err)
+
+	db, err := connection.DBConnection(pgContainer.DSN)
+	s.Require().Nil(err)
+
+	s.ctx = ctx
+	s.db = db
+	s.pgContainer = pgContainer
+	s.idempotencyRepository = NewIdempotencyRepository(db)
+}
+
+func (s *IdempotencyRepositoryTestSuite) TestIdempotencyLifecycle() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	idempotency := entity.NewIdempotency("Caller", "Key", "Hash")
+
+	s.T().Run("create a new key", func(t *testing.T) {
+		err := s.idempotencyRepository.CreateIdempotency(s.ctx, idempotency)
+		s.Require().Nil(err)
+	})
+
+	s.T().Run("create a duplicated key", func(t *testing.T) {
+		err := s.idempotencyRepository.CreateIdempotency(s.ctx, entity.NewIdempotency("Caller", "Key", "Hash"))
+
+		var e *errors.ConflictError
+		s.Require().ErrorAs(err, &e)
+		s.Equal("idempotency key already exists", e.Message)
+	})
+
+	s.T().Run("create the key for another caller", func(t *testing.T) {
+		err := s.idempotencyRepository.CreateIdempotency(s.ctx, entity.NewIdempotency("Another Caller", "Key", "Hash"))
+		s.Require().Nil(err)
+	})
+
+	s.T().Run("complete the key", func(t *testing.T) {
+		idempotency.Complete(200, []byte("Body"))
+
+		err := s.idempotencyRepository.UpdateIdempotency(s.ctx, idempotency)
+		s.Require().Nil(err)
+
+		found, err := s.idempotencyRepository.FindIdempotency(s.ctx, "Caller", "Key")
+		s.Require().Nil(err)
+		s.Equal(entity.IdempotencyStatusCompleted, found.Status)
+		s.Equal(200, found.ResponseCode)
+		s.Equal([]byte("Body"), found.ResponseBody)
+	})
+
+	s.T().Run("delete the key", func(t *testing.T) {
+		err := s.idempotencyRepository.DeleteIdempotency(s.ctx, "Caller", "Key")
+		s.Require().Nil(err)
+
+		_, err = s.idempotencyRepository.FindIdempotency(s.ctx, "Caller", "Key")
+
+		var e *errors.NotFoundError
+		s.Require().ErrorAs(err, &e)
+		s.Equal("idempotency key is invalid", e.Message)
+	})
+}
+
+func (s *IdempotencyRepositoryTestSuite) TearDownSuite() {
+	err := s.pgContainer.TerminateContainer()
+	s.Require().Nil(err)
+}
+
+func TestIdempotencyRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyRepositoryTestSuite))
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"log/slog"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) *OutboxRepository {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+// outboxLockKey is the key of the advisory lock held by the relay of the outbox.
+const outboxLockKey = 7311
+
+// LockOutbox takes the lock of the outbox on a connection of its own and returns the function
+// that releases it. It returns a ConflictError when another relay holds the lock, so a single
+// relay publishes the events at a time and keeps them in the order of their sequence.
+func (r *OutboxRepository) LockOutbox(ctx context.Context) (func(), error) {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	var locked bool
+
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", outboxLockKey).Scan(&locked)
+	if err != nil {
+		conn.Close()
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	if !locked {
+		conn.Close()
+		return nil, core_errors.NewConflictError("outbox is locked by another relay")
+	}
+
+	unlock := func() {
+		_, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", outboxLockKey)
+		if err != nil {
+			// the connection is discarded instead of returned to the pool holding the lock
+			slog.Error(err.Error())
+			conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+
+		conn.Close()
+	}
+
+	return unlock, nil
+}
+
+// FindPendingEvents returns the events not published yet in the order of their sequence.
+func (r *OutboxRepository) FindPendingEvents(ctx context.Context, limit int) ([]*entity.DomainEvent, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT sequence, id, type, payment_id, payload, attempts, last_error, created_at
+		FROM outbox_events
+		WHERE published_at IS NULL
+		ORDER BY sequence
+		LIMIT $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, limit)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	events := make([]*entity.DomainEvent, 0)
+	for rows.Next() {
+		var event entity.DomainEvent
+		err = rows.Scan(
+			&event.Sequence,
+			&event.Id,
+			&event.Type,
+			&event.PaymentId,
+			&event.Payload,
+			&event.Attempts,
+			&event.LastError,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		events = append(events, &event)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return events, nil
+}
+
+func (r *OutboxRepository) UpdateEvent(ctx context.Context, event *entity.DomainEvent) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE outbox_events
+		SET attempts = $2, last_error = $3, published_at = $4
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		event.Id,
+		event.Attempts,
+		event.LastError,
+		sql.NullTime{Time: event.PublishedAt, Valid: event.Published()},
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("event id is invalid")
+	}
+
+	return nil
+}
+
+// createDomainEvent records the event in the outbox within the transaction of the change it
+// describes, so the event exists if and only if the change was committed.
+func createDomainEvent(ctx context.Context, tx *sql.Tx, event *entity.DomainEvent) error {
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO outbox_events (id, type, payment_id, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING sequence
+	`,
+		event.Id,
+		event.Type,
+		event.PaymentId,
+		string(event.Payload),
+		event.CreatedAt,
+	).Scan(&event.Sequence)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/test/testcontainers"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type OutboxRepositoryTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	db                *sql.DB
+	pgContainer       *testcontainers.PostgresContainer
+	paymentRepository *PaymentRepository
+	outboxRepository  *OutboxRepository
+}
+
+func (s *OutboxRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	migrationsPath := "../../../migrations"
+
+	pgContainer, err := testcontainers.NewPostgresContainer(ctx, migrationsPath)
+	s.Require().Nil(err)
+
+	db, err := connection.DBConnection(pgContainer.DSN)
+	s.Require().Nil(err)
+
+	s.ctx = ctx
+	s.db = db
+	s.pgContainer = pgContainer
+	s.paymentRepository = NewPaymentRepository(db)
+	s.outboxRepository = NewOutboxRepository(db)
+}
+
+func (s *OutboxRepositoryTestSuite) TestPaymentEvents() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	payment.Approve(entity.NewAcquirerResponse("Acquirer Id", 200, "Approved"))
+	err = s.paymentRepository.UpdatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	// a change that is not committed has no event
+	missing := createTestPayment()
+	err = s.paymentRepository.UpdatePayment(s.ctx, missing)
+
+	var e *errors.NotFoundError
+	s.Require().ErrorAs(err, &e)
+
+	events, err := s.outboxRepository.FindPendingEvents(s.ctx, 10)
+	s.Require().Nil(err)
+	s.Require().Equal(2, len(events))
+	s.Equal("payment.created", events[0].Type)
+	s.Equal("payment.approved", events[1].Type)
+	s.Less(events[0].Sequence, events[1].Sequence)
+
+	for _, event := range events {
+		s.Equal(payment.Id, event.PaymentId)
+	}
+
+	var snapshot entity.PaymentSnapshot
+	err = json.Unmarshal(events[1].Payload, &snapshot)
+	s.Require().Nil(err)
+	s.Equal("approved", snapshot.Status)
+	s.Equal("Acquirer Id", snapshot.AcquirerId)
+
+	events[0].Fail("connection refused")
+	err = s.outboxRepository.UpdateEvent(s.ctx, events[0])
+	s.Require().Nil(err)
+
+	events[1].Publish(time.Now().UTC())
+	err = s.outboxRepository.UpdateEvent(s.ctx, events[1])
+	s.Require().Nil(err)
+
+	pending, err := s.outboxRepository.FindPendingEvents(s.ctx, 10)
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(pending))
+	s.Equal(events[0].Id, pending[0].Id)
+	s.Equal(1, pending[0].Attempts)
+	s.Equal("connection refused", pending[0].LastError)
+}
+
+func (s *OutboxRepositoryTestSuite) TestLockOutbox() {
+	unlock, err := s.outboxRepository.LockOutbox(s.ctx)
+	s.Require().Nil(err)
+
+	// a single relay holds the lock at a time
+	_, err = s.outboxRepository.LockOutbox(s.ctx)
+	var e *errors.ConflictError
+	s.Require().ErrorAs(err, &e)
+	s.Equal("outbox is locked by another relay", e.Message)
+
+	unlock()
+
+	unlock, err = s.outboxRepository.LockOutbox(s.ctx)
+	s.Require().Nil(err)
+	unlock()
+}
+
+func (s *OutboxRepositoryTestSuite) TearDownSuite() {
+	err := s.pgContainer.TerminateContainer()
+	s.Require().Nil(err)
+}
+
+func TestOutboxRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxRepositoryTestSuite))
+}
@@ -0,0 +1,347 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/lib/pq"
+)
+
+type PaymentBatchRepository struct {
+	db *sql.DB
+}
+
+func NewPaymentBatchRepository(db *sql.DB) *PaymentBatchRepository {
+	return &PaymentBatchRepository{
+		db: db,
+	}
+}
+
+func (r *PaymentBatchRepository) CreateBatch(ctx context.Context, batch *entity.PaymentBatch) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO payment_batches (id, caller, allowed_stores, status, attempts, locked_until, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`,
+		batch.Id,
+		batch.Caller,
+		pq.Array(batch.AllowedStores),
+		batch.Status,
+		batch.Attempts,
+		sql.NullTime{Time: batch.LockedUntil, Valid: !batch.LockedUntil.IsZero()},
+		batch.CreatedAt,
+		batch.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO payment_batch_items (batch_id, item_index, transaction, status, payment_id, payment_status,
+			error_type, error_code, error_messages, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	for _, item := range batch.Items {
+		transaction, err := json.Marshal(item.Transaction)
+		if err != nil {
+			slog.Error(err.Error())
+			return core_errors.NewInternalError(err)
+		}
+
+		_, err = stmt.ExecContext(ctx,
+			batch.Id,
+			item.Index,
+			string(transaction),
+			item.Status,
+			sql.NullString{String: item.PaymentId, Valid: item.PaymentId != ""},
+			item.PaymentStatus,
+			item.ErrorType,
+			item.ErrorCode,
+			pq.Array(item.ErrorMessages),
+			item.UpdatedAt,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return core_errors.NewInternalError(err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *PaymentBatchRepository) FindBatch(ctx context.Context, batchId string) (*entity.PaymentBatch, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT id, caller, allowed_stores, status, attempts, locked_until, created_at, updated_at
+		FROM payment_batches
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	batch, err := scanPaymentBatch(stmt.QueryRowContext(ctx, batchId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, core_errors.NewNotFoundError("batch id is invalid")
+		}
+
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	batch.Items, err = r.findBatchItems(ctx, batch.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// ClaimBatches claims the batches in a single statement, like ClaimJobs.
+func (r *PaymentBatchRepository) ClaimBatches(ctx context.Context, now time.Time, limit int) ([]*entity.PaymentBatch, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE payment_batches
+		SET status = $1, attempts = attempts + 1, locked_until = $2, updated_at = $3
+		WHERE id IN (
+			SELECT id
+			FROM payment_batches
+			WHERE status = $4 OR (status = $1 AND locked_until <= $3)
+			ORDER BY created_at
+			LIMIT $5
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, caller, allowed_stores, status, attempts, locked_until, created_at, updated_at
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx,
+		entity.PaymentBatchStatusProcessing,
+		now.Add(entity.PaymentBatchLease),
+		now,
+		entity.PaymentBatchStatusQueued,
+		limit,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	batches := make([]*entity.PaymentBatch, 0)
+	for rows.Next() {
+		batch, err := scanPaymentBatch(rows)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		batches = append(batches, batch)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	for _, batch := range batches {
+		batch.Items, err = r.findBatchItems(ctx, batch.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return batches, nil
+}
+
+func (r *PaymentBatchRepository) UpdateBatch(ctx context.Context, batch *entity.PaymentBatch) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE payment_batches
+		SET status = $2, locked_until = $3, updated_at = $4
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		batch.Id,
+		batch.Status,
+		sql.NullTime{Time: batch.LockedUntil, Valid: !batch.LockedUntil.IsZero()},
+		batch.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("batch id is invalid")
+	}
+
+	return nil
+}
+
+func (r *PaymentBatchRepository) UpdateBatchItem(ctx context.Context, batchId string, item *entity.PaymentBatchItem) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE payment_batch_items
+		SET status = $3, payment_id = $4, payment_status = $5, error_type = $6, error_code = $7,
+			error_messages = $8, updated_at = $9
+		WHERE batch_id = $1 AND item_index = $2
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		batchId,
+		item.Index,
+		item.Status,
+		sql.NullString{String: item.PaymentId, Valid: item.PaymentId != ""},
+		item.PaymentStatus,
+		item.ErrorType,
+		item.ErrorCode,
+		pq.Array(item.ErrorMessages),
+		item.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("batch item is invalid")
+	}
+
+	return nil
+}
+
+func (r *PaymentBatchRepository) findBatchItems(ctx context.Context, batchId string) ([]*entity.PaymentBatchItem, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT item_index, transaction, status, payment_id, payment_status, error_type, error_code,
+			error_messages, updated_at
+		FROM payment_batch_items
+		WHERE batch_id = $1
+		ORDER BY item_index
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, batchId)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	items := make([]*entity.PaymentBatchItem, 0)
+	for rows.Next() {
+		var item entity.PaymentBatchItem
+		var transaction []byte
+		var paymentId sql.NullString
+
+		err = rows.Scan(
+			&item.Index,
+			&transaction,
+			&item.Status,
+			&paymentId,
+			&item.PaymentStatus,
+			&item.ErrorType,
+			&item.ErrorCode,
+			pq.Array(&item.ErrorMessages),
+			&item.UpdatedAt,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		err = json.Unmarshal(transaction, &item.Transaction)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		item.PaymentId = paymentId.String
+		items = append(items, &item)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return items, nil
+}
+
+func scanPaymentBatch(row interface{ Scan(dest ...any) error }) (*entity.PaymentBatch, error) {
+	var batch entity.PaymentBatch
+	var lockedUntil sql.NullTime
+
+	err := row.Scan(
+		&batch.Id,
+		&batch.Caller,
+		pq.Array(&batch.AllowedStores),
+		&batch.Status,
+		&batch.Attempts,
+		&lockedUntil,
+		&batch.CreatedAt,
+		&batch.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	batch.LockedUntil = lockedUntil.Time
+	return &batch, nil
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/test/testcontainers"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentBatchRepositoryTestSuite struct {
+	suite.Suite
+	ctx                    context.Context
+	db                     *sql.DB
+	pgContainer            *testcontainers.PostgresContainer
+	paymentRepository      *PaymentRepository
+	paymentBatchRepository *PaymentBatchRepository
+}
+
+func (s *PaymentBatchRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	migrationsPath := "../../../migrations"
+
+	pgContainer, err := testcontainers.NewPostgresContainer(ctx, migrationsPath)
+	s.Require().Nil(err)
+
+	db, err := connection.DBConnection(pgContainer.DSN)
+	s.Require().Nil(err)
+
+	s.ctx = ctx
+	s.db = db
+	s.pgContainer = pgContainer
+	s.paymentRepository = NewPaymentRepository(db)
+	s.paymentBatchRepository = NewPaymentBatchRepository(db)
+}
+
+func (s *PaymentBatchRepositoryTestSuite) TestCreateAndFindBatch() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	batch := createTestPaymentBatch()
+	batch.Items[1].Fail(nil, entity.PaymentBatchErrorValidation, 0, []string{"card_token is required"}, batch.CreatedAt)
+
+	err = s.paymentBatchRepository.CreateBatch(s.ctx, batch)
+	s.Require().Nil(err)
+
+	found, err := s.paymentBatchRepository.FindBatch(s.ctx, batch.Id)
+	s.Require().Nil(err)
+	s.Equal(batch.Id, found.Id)
+	s.Equal("Caller", found.Caller)
+	s.Equal(batch.AllowedStores, found.AllowedStores)
+	s.Equal(entity.PaymentBatchStatusQueued, found.Status)
+	s.True(found.LockedUntil.IsZero())
+	s.Require().Equal(2, len(found.Items))
+	s.Equal(batch.Items[0].Transaction, found.Items[0].Transaction)
+	s.Equal(entity.PaymentBatchItemStatusPending, found.Items[0].Status)
+	s.Equal(entity.PaymentBatchItemStatusFailed, found.Items[1].Status)
+	s.Equal(entity.PaymentBatchErrorValidation, found.Items[1].ErrorType)
+	s.Equal([]string{"card_token is required"}, found.Items[1].ErrorMessages)
+
+	_, err = s.paymentBatchRepository.FindBatch(s.ctx, uuid.NewString())
+	s.NotNil(err)
+}
+
+func (s *PaymentBatchRepositoryTestSuite) TestClaimAndUpdateBatches() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	first := createTestPaymentBatch()
+	second := createTestPaymentBatch()
+	second.CreatedAt = first.CreatedAt.Add(time.Second)
+
+	for _, batch := range []*entity.PaymentBatch{first, second} {
+		err = s.paymentBatchRepository.CreateBatch(s.ctx, batch)
+		s.Require().Nil(err)
+	}
+
+	now := time.Now().UTC()
+
+	batches, err := s.paymentBatchRepository.ClaimBatches(s.ctx, now, 1)
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(batches))
+	s.Equal(first.Id, batches[0].Id)
+	s.Equal(entity.PaymentBatchStatusProcessing, batches[0].Status)
+	s.Equal(1, batches[0].Attempts)
+	s.WithinDuration(now.Add(entity.PaymentBatchLease), batches[0].LockedUntil, time.Millisecond)
+	s.Equal(2, len(batches[0].Items))
+
+	// a claimed batch is not claimed again while leased
+	batches, err = s.paymentBatchRepository.ClaimBatches(s.ctx, now, 10)
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(batches))
+	s.Equal(second.Id, batches[0].Id)
+
+	payment := createTestPayment()
+	payment.Approve(entity.NewAcquirerResponse("Id", 200, "Approved"))
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	item := batches[0].Items[0]
+	item.Succeed(payment, now)
+	err = s.paymentBatchRepository.UpdateBatchItem(s.ctx, second.Id, item)
+	s.Require().Nil(err)
+
+	batches[0].Complete(now)
+	err = s.paymentBatchRepository.UpdateBatch(s.ctx, batches[0])
+	s.Require().Nil(err)
+
+	found, err := s.paymentBatchRepository.FindBatch(s.ctx, second.Id)
+	s.Require().Nil(err)
+	s.Equal(entity.PaymentBatchStatusDone, found.Status)
+	s.Equal(entity.PaymentBatchItemStatusSucceeded, found.Items[0].Status)
+	s.Equal(payment.Id, found.Items[0].PaymentId)
+	s.Equal(entity.PaymentStatusApproved, found.Items[0].PaymentStatus)
+
+	// an expired lease is claimed again
+	batches, err = s.paymentBatchRepository.ClaimBatches(s.ctx, now.Add(entity.PaymentBatchLease), 10)
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(batches))
+	s.Equal(first.Id, batches[0].Id)
+	s.Equal(2, batches[0].Attempts)
+}
+
+func (s *PaymentBatchRepositoryTestSuite) TearDownSuite() {
+	err := s.pgContainer.TerminateContainer()
+	s.Require().Nil(err)
+}
+
+func TestPaymentBatchRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PaymentBatchRepositoryTestSuite))
+}
+
+func createTestPaymentBatch() *entity.PaymentBatch {
+	transaction := &entity.PaymentBatchTransaction{
+		CardToken:            "Token",
+		PurchaseAmount:       999,
+		PurchaseCurrency:     "BRL",
+		PurchaseItems:        []string{"Item 1", "Item 2"},
+		PurchaseInstallments: 2,
+		StoreId:              uuid.NewString(),
+		AcquirerName:         "cielo",
+	}
+
+	items := []*entity.PaymentBatchItem{
+		entity.NewPaymentBatchItem(0, transaction),
+		entity.NewPaymentBatchItem(1, &entity.PaymentBatchTransaction{}),
+	}
+
+	return entity.NewPaymentBatch("Caller", []string{transaction.StoreId}, items)
+}
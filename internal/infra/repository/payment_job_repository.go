@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/lib/pq"
+)
+
+type PaymentJobRepository struct {
+	db *sql.DB
+}
+
+func NewPaymentJobRepository(db *sql.DB) *PaymentJobRepository {
+	return &PaymentJobRepository{
+		db: db,
+	}
+}
+
+// ClaimJobs claims the oldest claimable jobs in a single statement. The rows locked by a
+// concurrent claim are skipped, so the workers of a pool never claim the same job.
+func (r *PaymentJobRepository) ClaimJobs(ctx context.Context, now time.Time, limit int) ([]*entity.PaymentJob, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE payment_jobs
+		SET status = $1, attempts = attempts + 1, locked_until = $2, updated_at = $3
+		WHERE payment_id IN (
+			SELECT payment_id
+			FROM payment_jobs
+			WHERE status = $4 OR (status = $1 AND locked_until <= $3)
+			ORDER BY created_at
+			LIMIT $5
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING payment_id, authorize_only, fallbacks, status, attempts, locked_until, last_error, created_at, updated_at
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx,
+		entity.PaymentJobStatusProcessing,
+		now.Add(entity.PaymentJobLease),
+		now,
+		entity.PaymentJobStatusQueued,
+		limit,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	jobs := make([]*entity.PaymentJob, 0)
+	for rows.Next() {
+		var job entity.PaymentJob
+		err = rows.Scan(
+			&job.PaymentId,
+			&job.AuthorizeOnly,
+			pq.Array(&job.Fallbacks),
+			&job.Status,
+			&job.Attempts,
+			&job.LockedUntil,
+			&job.LastError,
+			&job.CreatedAt,
+			&job.UpdatedAt,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		jobs = append(jobs, &job)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return jobs, nil
+}
+
+func (r *PaymentJobRepository) UpdateJob(ctx context.Context, job *entity.PaymentJob) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE payment_jobs
+		SET status = $2, last_error = $3, updated_at = $4
+		WHERE payment_id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		job.PaymentId,
+		job.Status,
+		job.LastError,
+		job.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("payment id is invalid")
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/test/testcontainers"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentJobRepositoryTestSuite struct {
+	suite.Suite
+	ctx                  context.Context
+	db                   *sql.DB
+	pgContainer          *testcontainers.PostgresContainer
+	paymentRepository    *PaymentRepository
+	paymentJobRepository *PaymentJobRepository
+}
+
+func (s *PaymentJobRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	migrationsPath := "../../../migrations"
+
+	pgContainer, err := testcontainers.NewPostgresContainer(ctx, migrationsPath)
+	s.Require().Nil(err)
+
+	db, err := connection.DBConnection(pgContainer.DSN)
+	s.Require().Nil(err)
+
+	s.ctx = ctx
+	s.db = db
+	s.pgContainer = pgContainer
+	s.paymentRepository = NewPaymentRepository(db)
+	s.paymentJobRepository = NewPaymentJobRepository(db)
+}
+
+func (s *PaymentJobRepositoryTestSuite) TestClaimJobs() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	first := createTestPayment()
+	first.Transaction.AuthorizeOnly = true
+	first.Transaction.Route = entity.NewRoute(first.Transaction.Acquirer.Name, "Rule", nil)
+	first.Transaction.Route.Fallbacks = []string{"Rede"}
+
+	second := createTestPayment()
+	second.CreatedAt = first.CreatedAt.Add(time.Second)
+
+	for _, payment := range []*entity.Payment{first, second} {
+		err = s.paymentRepository.CreateQueuedPayment(s.ctx, payment)
+		s.Require().Nil(err)
+	}
+
+	found, err := s.paymentRepository.FindPayment(s.ctx, first.Id)
+	s.Require().Nil(err)
+	s.Equal(entity.PaymentStatusPending, found.Status)
+
+	now := time.Now().UTC()
+
+	jobs, err := s.paymentJobRepository.ClaimJobs(s.ctx, now, 1)
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(jobs))
+	s.Equal(first.Id, jobs[0].PaymentId)
+	s.True(jobs[0].AuthorizeOnly)
+	s.Equal([]string{"Rede"}, jobs[0].Fallbacks)
+	s.Equal(entity.PaymentJobStatusProcessing, jobs[0].Status)
+	s.Equal(1, jobs[0].Attempts)
+	s.WithinDuration(now.Add(entity.PaymentJobLease), jobs[0].LockedUntil, time.Millisecond)
+
+	// a claimed job is not claimed again while leased
+	jobs, err = s.paymentJobRepository.ClaimJobs(s.ctx, now, 10)
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(jobs))
+	s.Equal(second.Id, jobs[0].PaymentId)
+
+	jobs[0].Complete(now)
+	err = s.paymentJobRepository.UpdateJob(s.ctx, jobs[0])
+	s.Require().Nil(err)
+
+	// an expired lease is claimed again as interrupted
+	jobs, err = s.paymentJobRepository.ClaimJobs(s.ctx, now.Add(entity.PaymentJobLease), 10)
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(jobs))
+	s.Equal(first.Id, jobs[0].PaymentId)
+	s.True(jobs[0].Interrupted())
+}
+
+func (s *PaymentJobRepositoryTestSuite) TearDownSuite() {
+	err := s.pgContainer.TerminateContainer()
+	s.Require().Nil(err)
+}
+
+func TestPaymentJobRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PaymentJobRepositoryTestSuite))
+}
@@ -0,0 +1,612 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	irepository "github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+
+	"github.com/lib/pq"
+)
+
+type PaymentRepository struct {
+	db *sql.DB
+}
+
+func NewPaymentRepository(db *sql.DB) *PaymentRepository {
+	return &PaymentRepository{
+		db: db,
+	}
+}
+
+func (r *PaymentRepository) CreatePayment(ctx context.Context, payment *entity.Payment) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer tx.Rollback()
+
+	err = createPayment(ctx, tx, payment)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *PaymentRepository) CreateQueuedPayment(ctx context.Context, payment *entity.Payment) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer tx.Rollback()
+
+	err = createPayment(ctx, tx, payment)
+	if err != nil {
+		return err
+	}
+
+	job := entity.NewPaymentJob(payment)
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO payment_jobs (payment_id, authorize_only, fallbacks, status, attempts, last_error, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`,
+		job.PaymentId,
+		job.AuthorizeOnly,
+		pq.Array(job.Fallbacks),
+		job.Status,
+		job.Attempts,
+		job.LastError,
+		job.CreatedAt,
+		job.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func createPayment(ctx context.Context, tx *sql.Tx, payment *entity.Payment) error {
+	event, err := entity.NewPaymentCreatedEvent(payment)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	transaction := payment.Transaction
+
+	var routeRule string
+	if transaction.Route != nil {
+		routeRule = transaction.Route.Rule
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO payments (
+			id, card_token, card_brand, card_last4, purchase_amount, currency, purchase_items, purchase_installments,
+			store_id, store_identification, store_document_type, store_address, store_cep,
+			store_street, store_number, store_city, store_state, acquirer_name, route_rule,
+			status, acquirer_id, acquirer_code, acquirer_message, captured_amount, refunded_amount, caller, created_at, updated_at
+		)
+		VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14,
+			$15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28
+		)
+	`,
+		payment.Id,
+		transaction.Card.Token,
+		transaction.Card.Brand,
+		transaction.Card.Last4,
+		transaction.Purchase.Value.Amount,
+		transaction.Purchase.Value.Currency,
+		pq.Array(transaction.Purchase.Items),
+		transaction.Purchase.Installments,
+		sql.NullString{String: transaction.Store.Id, Valid: transaction.Store.Id != ""},
+		transaction.Store.Identification,
+		transaction.Store.DocumentType,
+		transaction.Store.Address,
+		transaction.Store.Cep,
+		transaction.Store.Street,
+		transaction.Store.Number,
+		transaction.Store.City,
+		transaction.Store.State,
+		transaction.Acquirer.Name,
+		routeRule,
+		payment.Status,
+		payment.AcquirerId,
+		payment.AcquirerCode,
+		payment.AcquirerMessage,
+		payment.CapturedValue.Amount,
+		payment.RefundedValue.Amount,
+		payment.Caller,
+		payment.CreatedAt,
+		payment.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return createDomainEvent(ctx, tx, event)
+}
+
+// UpdatePayment locks the payment row before the event takes its sequence, so the events of a
+// payment are sequenced in the order they are committed.
+func (r *PaymentRepository) UpdatePayment(ctx context.Context, payment *entity.Payment) error {
+	return r.updatePayment(ctx, payment, "")
+}
+
+func (r *PaymentRepository) UpdatePaymentFrom(ctx context.Context, payment *entity.Payment, fromStatus entity.PaymentStatus) error {
+	return r.updatePayment(ctx, payment, fromStatus)
+}
+
+// StartCapture locks the payment as CreateRefund does, so a void created meanwhile is seen.
+// No event is recorded, since the payment is either captured or authorized again.
+func (r *PaymentRepository) StartCapture(ctx context.Context, paymentId string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer tx.Rollback()
+
+	var status entity.PaymentStatus
+	err = tx.QueryRowContext(ctx, "SELECT status FROM payments WHERE id = $1 FOR UPDATE", paymentId).Scan(&status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return core_errors.NewNotFoundError("payment id is invalid")
+		}
+
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if status != entity.PaymentStatusAuthorized {
+		return core_errors.NewConflictError("payment status has changed")
+	}
+
+	var voided bool
+	err = tx.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM refunds WHERE payment_id = $1 AND status IN ($2, $3, $4))",
+		paymentId,
+		entity.RefundStatusPending,
+		entity.RefundStatusApproved,
+		entity.RefundStatusUnknown,
+	).Scan(&voided)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if voided {
+		return core_errors.NewConflictError("payment is being voided")
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE payments SET status = $2 WHERE id = $1", paymentId, entity.PaymentStatusCapturing)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *PaymentRepository) CancelCapture(ctx context.Context, paymentId string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE payments SET status = $2 WHERE id = $1 AND status = $3",
+		paymentId,
+		entity.PaymentStatusAuthorized,
+		entity.PaymentStatusCapturing,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *PaymentRepository) updatePayment(ctx context.Context, payment *entity.Payment, fromStatus entity.PaymentStatus) error {
+	event, err := entity.NewPaymentChangedEvent(payment)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE payments
+		SET status = $2, acquirer_id = $3, acquirer_code = $4, acquirer_message = $5,
+			captured_amount = $6, refunded_amount = $7, updated_at = $8, acquirer_name = $9
+		WHERE id = $1 AND ($10 = '' OR status = $10)
+	`,
+		payment.Id,
+		payment.Status,
+		payment.AcquirerId,
+		payment.AcquirerCode,
+		payment.AcquirerMessage,
+		payment.CapturedValue.Amount,
+		payment.RefundedValue.Amount,
+		payment.UpdatedAt,
+		payment.Transaction.Acquirer.Name,
+		fromStatus,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		if fromStatus != "" {
+			return core_errors.NewConflictError("payment status has changed")
+		}
+
+		return core_errors.NewNotFoundError("payment id is invalid")
+	}
+
+	err = createDomainEvent(ctx, tx, event)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *PaymentRepository) FindPayment(ctx context.Context, paymentId string) (*entity.Payment, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT `+paymentColumns+`
+		FROM payments
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	payment, err := scanPayment(stmt.QueryRowContext(ctx, paymentId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, core_errors.NewNotFoundError("payment id is invalid")
+		}
+
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	payment.Attempts, err = r.findPaymentAttempts(ctx, payment.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// SearchPayments pages by keyset, comparing the sort column and the id with the ones of the
+// last payment of the previous page.
+func (r *PaymentRepository) SearchPayments(ctx context.Context, search *irepository.PaymentSearch) ([]*entity.Payment, error) {
+	conditions := []string{"store_id = ANY($1)"}
+	args := []any{pq.Array(search.StoreIds)}
+
+	where := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if search.AcquirerName != "" {
+		where("acquirer_name = $%d", search.AcquirerName)
+	}
+
+	if len(search.Statuses) > 0 {
+		statuses := make([]string, 0, len(search.Statuses))
+		for _, status := range search.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		where("status = ANY($%d)", pq.Array(statuses))
+	}
+
+	if search.CardLast4 != "" {
+		where("card_last4 = $%d", search.CardLast4)
+	}
+
+	if search.MinAmount > 0 {
+		where("purchase_amount >= $%d", search.MinAmount)
+	}
+
+	if search.MaxAmount > 0 {
+		where("purchase_amount <= $%d", search.MaxAmount)
+	}
+
+	if !search.CreatedFrom.IsZero() {
+		where("created_at >= $%d", search.CreatedFrom)
+	}
+
+	if !search.CreatedTo.IsZero() {
+		where("created_at < $%d", search.CreatedTo)
+	}
+
+	column := "created_at"
+	var after any = search.AfterCreatedAt
+	if search.Sort == irepository.PaymentSortAmount {
+		column = "purchase_amount"
+		after = search.AfterAmount
+	}
+
+	order := "ASC"
+	comparison := ">"
+	if search.Descending {
+		order = "DESC"
+		comparison = "<"
+	}
+
+	if search.After != "" {
+		args = append(args, after, search.After)
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s ($%d, $%d)", column, comparison, len(args)-1, len(args)))
+	}
+
+	args = append(args, search.Limit)
+
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT `+paymentColumns+`
+		FROM payments
+		WHERE `+strings.Join(conditions, " AND ")+`
+		ORDER BY `+column+` `+order+`, id `+order+`
+		LIMIT $`+strconv.Itoa(len(args)))
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	payments := make([]*entity.Payment, 0)
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		payments = append(payments, payment)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return payments, nil
+}
+
+func (r *PaymentRepository) CreatePaymentAttempt(ctx context.Context, attempt *entity.PaymentAttempt) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		INSERT INTO payment_attempts (
+			id, payment_id, acquirer_name, status, acquirer_id, acquirer_code, acquirer_message, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		attempt.Id,
+		attempt.PaymentId,
+		attempt.Acquirer,
+		attempt.Status,
+		attempt.AcquirerId,
+		attempt.AcquirerCode,
+		attempt.AcquirerMessage,
+		attempt.CreatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *PaymentRepository) UpdatePaymentAttempt(ctx context.Context, attempt *entity.PaymentAttempt) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE payment_attempts
+		SET status = $2, acquirer_id = $3, acquirer_code = $4, acquirer_message = $5
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		attempt.Id,
+		attempt.Status,
+		attempt.AcquirerId,
+		attempt.AcquirerCode,
+		attempt.AcquirerMessage,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("payment attempt id is invalid")
+	}
+
+	return nil
+}
+
+func (r *PaymentRepository) findPaymentAttempts(ctx context.Context, paymentId string) ([]*entity.PaymentAttempt, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT id, payment_id, acquirer_name, status, acquirer_id, acquirer_code, acquirer_message, created_at
+		FROM payment_attempts
+		WHERE payment_id = $1
+		ORDER BY created_at, id
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, paymentId)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	attempts := make([]*entity.PaymentAttempt, 0)
+	for rows.Next() {
+		var attempt entity.PaymentAttempt
+
+		err = rows.Scan(
+			&attempt.Id,
+			&attempt.PaymentId,
+			&attempt.Acquirer,
+			&attempt.Status,
+			&attempt.AcquirerId,
+			&attempt.AcquirerCode,
+			&attempt.AcquirerMessage,
+			&attempt.CreatedAt,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		attempts = append(attempts, &attempt)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return attempts, nil
+}
+
+const paymentColumns = `
+	id, card_token, card_brand, card_last4, purchase_amount, currency, purchase_items, purchase_installments,
+	store_id, store_identification, store_document_type, store_address, store_cep,
+	store_street, store_number, store_city, store_state, acquirer_name, route_rule,
+	status, acquirer_id, acquirer_code, acquirer_message, captured_amount, refunded_amount, caller, created_at, updated_at
+`
+
+func scanPayment(row interface{ Scan(dest ...any) error }) (*entity.Payment, error) {
+	var card entity.Card
+	var purchase entity.Purchase
+	var store entity.Store
+	var storeId sql.NullString
+	var acquirer entity.Acquirer
+	var routeRule string
+	var payment entity.Payment
+
+	err := row.Scan(
+		&payment.Id,
+		&card.Token,
+		&card.Brand,
+		&card.Last4,
+		&purchase.Value.Amount,
+		&purchase.Value.Currency,
+		pq.Array(&purchase.Items),
+		&purchase.Installments,
+		&storeId,
+		&store.Identification,
+		&store.DocumentType,
+		&store.Address,
+		&store.Cep,
+		&store.Street,
+		&store.Number,
+		&store.City,
+		&store.State,
+		&acquirer.Name,
+		&routeRule,
+		&payment.Status,
+		&payment.AcquirerId,
+		&payment.AcquirerCode,
+		&payment.AcquirerMessage,
+		&payment.CapturedValue.Amount,
+		&payment.RefundedValue.Amount,
+		&payment.Caller,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	store.Id = storeId.String
+	payment.CapturedValue.Currency = purchase.Value.Currency
+	payment.RefundedValue.Currency = purchase.Value.Currency
+	payment.Transaction = entity.NewTransaction(&card, &purchase, &store, &acquirer)
+	if routeRule != "" {
+		payment.Transaction.Route = entity.NewRoute(acquirer.Name, routeRule, nil)
+	}
+
+	return &payment, nil
+}
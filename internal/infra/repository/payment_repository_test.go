@@ -0,0 +1,374 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	irepository "github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/test/testcontainers"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentRepositoryTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	db                *sql.DB
+	pgContainer       *testcontainers.PostgresContainer
+	paymentRepository *PaymentRepository
+}
+
+func (s *PaymentRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	migrationsPath := "../../../migrations"
+
+	pgContainer, err := testcontainers.NewPostgresContainer(ctx, migrationsPath)
+	s.Require().Nil(err)
+
+	db, err := connection.DBConnection(pgContainer.DSN)
+	s.Require().Nil(err)
+
+	s.ctx = ctx
+	s.db = db
+	s.pgContainer = pgContainer
+	s.paymentRepository = NewPaymentRepository(db)
+}
+
+func (s *PaymentRepositoryTestSuite) TestCreateAndFindPayment() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+	payment.Caller = "Caller"
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	found, err := s.paymentRepository.FindPayment(s.ctx, payment.Id)
+	s.Require().Nil(err)
+
+	s.Equal(payment.Id, found.Id)
+	s.Equal("Caller", found.Caller)
+	s.Equal(entity.PaymentStatusPending, found.Status)
+	s.Equal(payment.Transaction.Card.Token, found.Transaction.Card.Token)
+	s.Equal(payment.Transaction.Card.Brand, found.Transaction.Card.Brand)
+	s.Equal(payment.Transaction.Purchase.Value, found.Transaction.Purchase.Value)
+	s.Equal(payment.Transaction.Purchase.Items, found.Transaction.Purchase.Items)
+	s.Equal(payment.Transaction.Purchase.Installments, found.Transaction.Purchase.Installments)
+	s.Equal(payment.Transaction.Store, found.Transaction.Store)
+	s.Equal(payment.Transaction.Acquirer, found.Transaction.Acquirer)
+	s.Nil(found.Transaction.Route)
+	s.WithinDuration(payment.CreatedAt, found.CreatedAt, time.Millisecond)
+}
+
+func (s *PaymentRepositoryTestSuite) TestCreateAndFindRoutedPayment() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+	payment.Transaction.Route = entity.NewRoute(payment.Transaction.Acquirer.Name, "Rule", nil)
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	found, err := s.paymentRepository.FindPayment(s.ctx, payment.Id)
+	s.Require().Nil(err)
+
+	s.Require().NotNil(found.Transaction.Route)
+	s.Equal("Rule", found.Transaction.Route.Rule)
+	s.Equal(payment.Transaction.Acquirer.Name, found.Transaction.Route.Acquirer)
+}
+
+func (s *PaymentRepositoryTestSuite) TestCreateAndFindPaymentOfRegisteredStore() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	store := entity.NewRegisteredStore("11222333000181", "Address", "01310100")
+	err = NewStoreRepository(s.db).CreateStore(s.ctx, store)
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+	payment.Transaction.Store.Id = store.Id
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	found, err := s.paymentRepository.FindPayment(s.ctx, payment.Id)
+	s.Require().Nil(err)
+	s.Equal(store.Id, found.Transaction.Store.Id)
+
+	err = NewStoreRepository(s.db).DeleteStore(s.ctx, store.Id)
+	s.Require().Nil(err)
+
+	found, err = s.paymentRepository.FindPayment(s.ctx, payment.Id)
+	s.Require().Nil(err)
+	s.Empty(found.Transaction.Store.Id)
+	s.Equal(payment.Transaction.Store.Identification, found.Transaction.Store.Identification)
+}
+
+func (s *PaymentRepositoryTestSuite) TestUpdatePayment() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	payment.Approve(entity.NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+	err = s.paymentRepository.UpdatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	found, err := s.paymentRepository.FindPayment(s.ctx, payment.Id)
+	s.Require().Nil(err)
+
+	s.Equal(entity.PaymentStatusApproved, found.Status)
+	s.Equal("Acquirer Id", found.AcquirerId)
+	s.Equal(200, found.AcquirerCode)
+	s.Equal("Message", found.AcquirerMessage)
+	s.Equal(payment.Transaction.Purchase.Value, found.CapturedValue)
+	s.Equal(entity.NewMoney(0, "BRL"), found.RefundedValue)
+}
+
+func (s *PaymentRepositoryTestSuite) TestCapturePayment() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	payment.Authorize(entity.NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+	err = s.paymentRepository.UpdatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	var e *errors.ConflictError
+
+	s.T().Run("cancel a capture", func(t *testing.T) {
+		err := s.paymentRepository.StartCapture(s.ctx, payment.Id)
+		s.Require().Nil(err)
+
+		err = s.paymentRepository.CancelCapture(s.ctx, payment.Id)
+		s.Require().Nil(err)
+
+		found, err := s.paymentRepository.FindPayment(s.ctx, payment.Id)
+		s.Require().Nil(err)
+		s.Equal(entity.PaymentStatusAuthorized, found.Status)
+	})
+
+	s.T().Run("capture a payment once", func(t *testing.T) {
+		err := s.paymentRepository.StartCapture(s.ctx, payment.Id)
+		s.Require().Nil(err)
+
+		// a concurrent capture is rejected before being sent
+		err = s.paymentRepository.StartCapture(s.ctx, payment.Id)
+		s.Require().ErrorAs(err, &e)
+		s.Equal("payment status has changed", e.Message)
+
+		payment.Capture(payment.Transaction.Purchase.Value)
+
+		err = s.paymentRepository.UpdatePaymentFrom(s.ctx, payment, entity.PaymentStatusCapturing)
+		s.Require().Nil(err)
+
+		found, err := s.paymentRepository.FindPayment(s.ctx, payment.Id)
+		s.Require().Nil(err)
+		s.Equal(entity.PaymentStatusApproved, found.Status)
+		s.Equal(payment.Transaction.Purchase.Value, found.CapturedValue)
+	})
+
+	s.T().Run("update a payment whose status changed", func(t *testing.T) {
+		err := s.paymentRepository.UpdatePaymentFrom(s.ctx, payment, entity.PaymentStatusCapturing)
+		s.Require().ErrorAs(err, &e)
+		s.Equal("payment status has changed", e.Message)
+	})
+}
+
+func (s *PaymentRepositoryTestSuite) TestCreateAndFindPaymentAttempts() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	failed := payment.AddAttempt("cielo")
+	err = s.paymentRepository.CreatePaymentAttempt(s.ctx, failed)
+	s.Require().Nil(err)
+
+	failed.Fail("timeout")
+	err = s.paymentRepository.UpdatePaymentAttempt(s.ctx, failed)
+	s.Require().Nil(err)
+
+	succeeded := payment.AddAttempt("rede")
+	succeeded.Succeed(entity.NewAcquirerResponse("Acquirer Id", 200, "Message"))
+	err = s.paymentRepository.CreatePaymentAttempt(s.ctx, succeeded)
+	s.Require().Nil(err)
+
+	payment.Transaction.Acquirer = entity.NewAcquirer("rede")
+	payment.Approve(entity.NewAcquirerResponse("Acquirer Id", 200, "Message"))
+	err = s.paymentRepository.UpdatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	found, err := s.paymentRepository.FindPayment(s.ctx, payment.Id)
+	s.Require().Nil(err)
+
+	s.Equal("rede", found.Transaction.Acquirer.Name)
+	s.Require().Equal(2, len(found.Attempts))
+	s.Equal(failed.Id, found.Attempts[0].Id)
+	s.Equal("cielo", found.Attempts[0].Acquirer)
+	s.Equal(entity.PaymentAttemptStatusFailed, found.Attempts[0].Status)
+	s.Equal("timeout", found.Attempts[0].AcquirerMessage)
+	s.Equal(succeeded.Id, found.Attempts[1].Id)
+	s.Equal(entity.PaymentAttemptStatusSucceeded, found.Attempts[1].Status)
+	s.Equal("Acquirer Id", found.Attempts[1].AcquirerId)
+
+	err = s.paymentRepository.UpdatePaymentAttempt(s.ctx, entity.NewPaymentAttempt(payment.Id, "cielo"))
+	var notFoundErr *errors.NotFoundError
+	s.ErrorAs(err, &notFoundErr)
+}
+
+func (s *PaymentRepositoryTestSuite) TestSearchPayments() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	store := entity.NewRegisteredStore("11222333000181", "Address", "01310100")
+	err = NewStoreRepository(s.db).CreateStore(s.ctx, store)
+	s.Require().Nil(err)
+
+	other := entity.NewRegisteredStore("11444777000161", "Address", "01310100")
+	err = NewStoreRepository(s.db).CreateStore(s.ctx, other)
+	s.Require().Nil(err)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	payments := make([]*entity.Payment, 0)
+	for i := 0; i < 4; i++ {
+		payment := createTestPayment()
+		payment.Transaction.Store.Id = store.Id
+		payment.Transaction.Card.Last4 = "1111"
+		payment.Transaction.Purchase.Value.Amount = int64(1000 * (4 - i))
+		payment.CreatedAt = now.Add(time.Duration(i) * time.Minute)
+		payments = append(payments, payment)
+	}
+
+	payments[1].Transaction.Acquirer.Name = "rede"
+	payments[2].Approve(entity.NewAcquirerResponse("A1", 200, "Approved"))
+	payments[3].Transaction.Card.Last4 = "2222"
+
+	otherStore := createTestPayment()
+	otherStore.Transaction.Store.Id = other.Id
+
+	for _, payment := range append(payments, otherStore) {
+		err = s.paymentRepository.CreatePayment(s.ctx, payment)
+		s.Require().Nil(err)
+	}
+
+	ids := func(payments []*entity.Payment) []string {
+		found := make([]string, 0, len(payments))
+		for _, payment := range payments {
+			found = append(found, payment.Id)
+		}
+		return found
+	}
+
+	found, err := s.paymentRepository.SearchPayments(s.ctx, &irepository.PaymentSearch{
+		StoreIds: []string{store.Id},
+		Sort:     irepository.PaymentSortCreatedAt,
+		Limit:    10,
+	})
+	s.Require().Nil(err)
+	s.Equal(ids(payments), ids(found))
+	s.Equal("1111", found[0].Transaction.Card.Last4)
+
+	found, err = s.paymentRepository.SearchPayments(s.ctx, &irepository.PaymentSearch{
+		StoreIds:       []string{store.Id},
+		Sort:           irepository.PaymentSortCreatedAt,
+		Descending:     true,
+		After:          payments[2].Id,
+		AfterCreatedAt: payments[2].CreatedAt,
+		Limit:          10,
+	})
+	s.Require().Nil(err)
+	s.Equal([]string{payments[1].Id, payments[0].Id}, ids(found))
+
+	found, err = s.paymentRepository.SearchPayments(s.ctx, &irepository.PaymentSearch{
+		StoreIds:    []string{store.Id},
+		Sort:        irepository.PaymentSortAmount,
+		After:       payments[3].Id,
+		AfterAmount: payments[3].Transaction.Purchase.Value.Amount,
+		Limit:       2,
+	})
+	s.Require().Nil(err)
+	s.Equal([]string{payments[2].Id, payments[1].Id}, ids(found))
+
+	found, err = s.paymentRepository.SearchPayments(s.ctx, &irepository.PaymentSearch{
+		StoreIds:     []string{store.Id, other.Id},
+		AcquirerName: "cielo",
+		Statuses:     []entity.PaymentStatus{entity.PaymentStatusPending},
+		CardLast4:    "1111",
+		MinAmount:    1000,
+		MaxAmount:    4000,
+		CreatedFrom:  now,
+		CreatedTo:    now.Add(time.Hour),
+		Sort:         irepository.PaymentSortCreatedAt,
+		Limit:        10,
+	})
+	s.Require().Nil(err)
+	s.Equal([]string{payments[0].Id}, ids(found))
+
+	found, err = s.paymentRepository.SearchPayments(s.ctx, &irepository.PaymentSearch{
+		StoreIds: []string{},
+		Sort:     irepository.PaymentSortCreatedAt,
+		Limit:    10,
+	})
+	s.Require().Nil(err)
+	s.Empty(found)
+}
+
+func (s *PaymentRepositoryTestSuite) TestPaymentNotFound() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	s.T().Run("find non-existent payment", func(t *testing.T) {
+		_, err := s.paymentRepository.FindPayment(s.ctx, uuid.NewString())
+
+		var e *errors.NotFoundError
+		s.Require().ErrorAs(err, &e)
+		s.Equal("payment id is invalid", e.Message)
+	})
+
+	s.T().Run("update non-existent payment", func(t *testing.T) {
+		err := s.paymentRepository.UpdatePayment(s.ctx, createTestPayment())
+
+		var e *errors.NotFoundError
+		s.Require().ErrorAs(err, &e)
+		s.Equal("payment id is invalid", e.Message)
+	})
+}
+
+func (s *PaymentRepositoryTestSuite) TearDownSuite() {
+	err := s.pgContainer.TerminateContainer()
+	s.Require().Nil(err)
+}
+
+func TestPaymentRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PaymentRepositoryTestSuite))
+}
+
+func createTestPayment() *entity.Payment {
+	return entity.NewPayment(entity.NewTransaction(
+		entity.NewCard("Token", "Holder", "01/2030", "VISA"),
+		entity.NewPurchase(entity.NewMoney(999, "BRL"), []string{"Item 1", "Item 2"}, 2),
+		entity.NewStore("11222333000181", "Address", "01310100"),
+		entity.NewAcquirer("cielo"),
+	))
+}
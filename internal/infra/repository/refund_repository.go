@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+type RefundRepository struct {
+	db *sql.DB
+}
+
+func NewRefundRepository(db *sql.DB) *RefundRepository {
+	return &RefundRepository{
+		db: db,
+	}
+}
+
+// CreateRefund locks the refunded payment so concurrent refunds cannot overdraw it.
+func (r *RefundRepository) CreateRefund(ctx context.Context, refund *entity.Refund) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer tx.Rollback()
+
+	var status entity.PaymentStatus
+	var refundableAmount int64
+	err = tx.QueryRowContext(ctx,
+		"SELECT status, CASE WHEN status = $2 THEN purchase_amount ELSE captured_amount END FROM payments WHERE id = $1 FOR UPDATE",
+		refund.PaymentId,
+		entity.PaymentStatusAuthorized,
+	).Scan(&status, &refundableAmount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return core_errors.NewNotFoundError("payment id is invalid")
+		}
+
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if status == entity.PaymentStatusCapturing {
+		return core_errors.NewConflictError("payment is being captured")
+	}
+
+	var exceeds bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(amount), 0) + $2 > $3
+		FROM refunds
+		WHERE payment_id = $1 AND status IN ($4, $5, $6)
+	`,
+		refund.PaymentId,
+		refund.Value.Amount,
+		refundableAmount,
+		entity.RefundStatusPending,
+		entity.RefundStatusApproved,
+		entity.RefundStatusUnknown,
+	).Scan(&exceeds)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if exceeds {
+		return core_errors.NewValidationError("refund value exceeds the refundable value")
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO refunds (
+			id, payment_id, type, amount, currency, status, acquirer_id, acquirer_code, acquirer_message, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`,
+		refund.Id,
+		refund.PaymentId,
+		refund.Type,
+		refund.Value.Amount,
+		refund.Value.Currency,
+		refund.Status,
+		refund.AcquirerId,
+		refund.AcquirerCode,
+		refund.AcquirerMessage,
+		refund.CreatedAt,
+		refund.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *RefundRepository) UpdateRefund(ctx context.Context, refund *entity.Refund) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE refunds
+		SET status = $2, acquirer_id = $3, acquirer_code = $4, acquirer_message = $5, updated_at = $6
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		refund.Id,
+		refund.Status,
+		refund.AcquirerId,
+		refund.AcquirerCode,
+		refund.AcquirerMessage,
+		refund.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("refund id is invalid")
+	}
+
+	return nil
+}
+
+func (r *RefundRepository) FindRefunds(ctx context.Context, paymentId string) ([]*entity.Refund, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT id, payment_id, type, amount, currency, status, acquirer_id, acquirer_code, acquirer_message, created_at, updated_at
+		FROM refunds
+		WHERE payment_id = $1
+		ORDER BY created_at
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, paymentId)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	refunds := make([]*entity.Refund, 0)
+	for rows.Next() {
+		var refund entity.Refund
+		err = rows.Scan(
+			&refund.Id,
+			&refund.PaymentId,
+			&refund.Type,
+			&refund.Value.Amount,
+			&refund.Value.Currency,
+			&refund.Status,
+			&refund.AcquirerId,
+			&refund.AcquirerCode,
+			&refund.AcquirerMessage,
+			&refund.CreatedAt,
+			&refund.UpdatedAt,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		refunds = append(refunds, &refund)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return refunds, nil
+}
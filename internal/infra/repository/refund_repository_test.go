@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/test/testcontainers"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RefundRepositoryTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	db                *sql.DB
+	pgContainer       *testcontainers.PostgresContainer
+	paymentRepository *PaymentRepository
+	refundRepository  *RefundRepository
+}
+
+func (s *RefundRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	migrationsPath := "../../../migrations"
+
+	pgContainer, err := testcontainers.NewPostgresContainer(ctx, migrationsPath)
+	s.Require().Nil(err)
+
+	db, err := connection.DBConnection(pgContainer.DSN)
+	s.Require().Nil(err)
+
+	s.ctx = ctx
+	s.db = db
+	s.pgContainer = pgContainer
+	s.paymentRepository = NewPaymentRepository(db)
+	s.refundRepository = NewRefundRepository(db)
+}
+
+func (s *RefundRepositoryTestSuite) TestRefundLifecycle() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+	payment.Approve(entity.NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	first := entity.NewRefund(payment.Id, entity.RefundTypeRefund, entity.NewMoney(500, "BRL"))
+
+	s.T().Run("create a refund within the purchase value", func(t *testing.T) {
+		err := s.refundRepository.CreateRefund(s.ctx, first)
+		s.Require().Nil(err)
+	})
+
+	s.T().Run("create a refund above the refundable value", func(t *testing.T) {
+		err := s.refundRepository.CreateRefund(s.ctx, entity.NewRefund(payment.Id, entity.RefundTypeRefund, entity.NewMoney(500, "BRL")))
+
+		var e *errors.ValidationError
+		s.Require().ErrorAs(err, &e)
+		s.Equal([]string{"refund value exceeds the refundable value"}, e.Messages)
+	})
+
+	s.T().Run("declined refunds release the refundable value", func(t *testing.T) {
+		first.Decline(422, "Message")
+
+		err := s.refundRepository.UpdateRefund(s.ctx, first)
+		s.Require().Nil(err)
+
+		err = s.refundRepository.CreateRefund(s.ctx, entity.NewRefund(payment.Id, entity.RefundTypeRefund, entity.NewMoney(999, "BRL")))
+		s.Require().Nil(err)
+	})
+
+	s.T().Run("find the payment refunds", func(t *testing.T) {
+		refunds, err := s.refundRepository.FindRefunds(s.ctx, payment.Id)
+		s.Require().Nil(err)
+		s.Require().Len(refunds, 2)
+		s.Equal(first.Id, refunds[0].Id)
+		s.Equal(entity.RefundStatusDeclined, refunds[0].Status)
+		s.Equal(entity.NewMoney(999, "BRL"), refunds[1].Value)
+	})
+}
+
+func (s *RefundRepositoryTestSuite) TestUnknownRefund() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+	payment.Approve(entity.NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	refund := entity.NewRefund(payment.Id, entity.RefundTypeRefund, payment.CapturedValue)
+
+	err = s.refundRepository.CreateRefund(s.ctx, refund)
+	s.Require().Nil(err)
+
+	refund.Unknown("acquirer timeout")
+
+	err = s.refundRepository.UpdateRefund(s.ctx, refund)
+	s.Require().Nil(err)
+
+	// the acquirer may have refunded it, so its value is not refunded again
+	err = s.refundRepository.CreateRefund(s.ctx, entity.NewRefund(payment.Id, entity.RefundTypeRefund, payment.CapturedValue))
+
+	var e *errors.ValidationError
+	s.Require().ErrorAs(err, &e)
+	s.Equal([]string{"refund value exceeds the refundable value"}, e.Messages)
+}
+
+func (s *RefundRepositoryTestSuite) TestVoidUncapturedPayment() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+	payment.Authorize(entity.NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	err = s.refundRepository.CreateRefund(s.ctx, entity.NewRefund(payment.Id, entity.RefundTypeVoid, payment.VoidValue()))
+	s.Require().Nil(err)
+
+	// the void is pending, so the payment cannot be captured meanwhile
+	err = s.paymentRepository.StartCapture(s.ctx, payment.Id)
+
+	var e *errors.ConflictError
+	s.Require().ErrorAs(err, &e)
+	s.Equal("payment is being voided", e.Message)
+}
+
+func (s *RefundRepositoryTestSuite) TestVoidPaymentBeingCaptured() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+	payment.Authorize(entity.NewAcquirerResponse("Acquirer Id", 200, "Message"))
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	err = s.paymentRepository.StartCapture(s.ctx, payment.Id)
+	s.Require().Nil(err)
+
+	err = s.refundRepository.CreateRefund(s.ctx, entity.NewRefund(payment.Id, entity.RefundTypeVoid, payment.VoidValue()))
+
+	var e *errors.ConflictError
+	s.Require().ErrorAs(err, &e)
+	s.Equal("payment is being captured", e.Message)
+}
+
+func (s *RefundRepositoryTestSuite) TearDownSuite() {
+	err := s.pgContainer.TerminateContainer()
+	s.Require().Nil(err)
+}
+
+func TestRefundRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RefundRepositoryTestSuite))
+}
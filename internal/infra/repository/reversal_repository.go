@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+type ReversalRepository struct {
+	db *sql.DB
+}
+
+func NewReversalRepository(db *sql.DB) *ReversalRepository {
+	return &ReversalRepository{
+		db: db,
+	}
+}
+
+func (r *ReversalRepository) CreateReversal(ctx context.Context, reversal *entity.Reversal) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		INSERT INTO reversals (
+			id, payment_id, attempt_id, acquirer_name, amount, currency, status, retries, next_retry_at,
+			acquirer_id, acquirer_code, acquirer_message, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		reversal.Id,
+		reversal.PaymentId,
+		reversal.AttemptId,
+		reversal.Acquirer,
+		reversal.Value.Amount,
+		reversal.Value.Currency,
+		reversal.Status,
+		reversal.Retries,
+		reversal.NextRetryAt,
+		reversal.AcquirerId,
+		reversal.AcquirerCode,
+		reversal.AcquirerMessage,
+		reversal.CreatedAt,
+		reversal.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *ReversalRepository) UpdateReversal(ctx context.Context, reversal *entity.Reversal) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE reversals
+		SET status = $2, retries = $3, next_retry_at = $4, acquirer_id = $5, acquirer_code = $6, acquirer_message = $7, updated_at = $8
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		reversal.Id,
+		reversal.Status,
+		reversal.Retries,
+		reversal.NextRetryAt,
+		reversal.AcquirerId,
+		reversal.AcquirerCode,
+		reversal.AcquirerMessage,
+		reversal.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("reversal id is invalid")
+	}
+
+	return nil
+}
+
+func (r *ReversalRepository) FindReversals(ctx context.Context, paymentId string) ([]*entity.Reversal, error) {
+	return r.findReversals(ctx, `
+		SELECT id, payment_id, attempt_id, acquirer_name, amount, currency, status, retries, next_retry_at,
+			acquirer_id, acquirer_code, acquirer_message, created_at, updated_at
+		FROM reversals
+		WHERE payment_id = $1
+		ORDER BY created_at, id
+	`, paymentId)
+}
+
+// FindDueReversals returns the pending reversals whose next retry is due, oldest first.
+func (r *ReversalRepository) FindDueReversals(ctx context.Context, now time.Time, limit int) ([]*entity.Reversal, error) {
+	return r.findReversals(ctx, `
+		SELECT id, payment_id, attempt_id, acquirer_name, amount, currency, status, retries, next_retry_at,
+			acquirer_id, acquirer_code, acquirer_message, created_at, updated_at
+		FROM reversals
+		WHERE status = $1 AND next_retry_at <= $2
+		ORDER BY next_retry_at, id
+		LIMIT $3
+	`, entity.ReversalStatusPending, now, limit)
+}
+
+func (r *ReversalRepository) findReversals(ctx context.Context, query string, args ...any) ([]*entity.Reversal, error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	reversals := make([]*entity.Reversal, 0)
+	for rows.Next() {
+		var reversal entity.Reversal
+		err = rows.Scan(
+			&reversal.Id,
+			&reversal.PaymentId,
+			&reversal.AttemptId,
+			&reversal.Acquirer,
+			&reversal.Value.Amount,
+			&reversal.Value.Currency,
+			&reversal.Status,
+			&reversal.Retries,
+			&reversal.NextRetryAt,
+			&reversal.AcquirerId,
+			&reversal.AcquirerCode,
+			&reversal.AcquirerMessage,
+			&reversal.CreatedAt,
+			&reversal.UpdatedAt,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		reversals = append(reversals, &reversal)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return reversals, nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/test/testcontainers"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ReversalRepositoryTestSuite struct {
+	suite.Suite
+	ctx                context.Context
+	db                 *sql.DB
+	pgContainer        *testcontainers.PostgresContainer
+	paymentRepository  *PaymentRepository
+	reversalRepository *ReversalRepository
+}
+
+func (s *ReversalRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	migrationsPath := "../../../migrations"
+
+	pgContainer, err := testcontainers.NewPostgresContainer(ctx, migrationsPath)
+	s.Require().Nil(err)
+
+	db, err := connection.DBConnection(pgContainer.DSN)
+	s.Require().Nil(err)
+
+	s.ctx = ctx
+	s.db = db
+	s.pgContainer = pgContainer
+	s.paymentRepository = NewPaymentRepository(db)
+	s.reversalRepository = NewReversalRepository(db)
+}
+
+func (s *ReversalRepositoryTestSuite) TestReversalLifecycle() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+	payment.Unknown("acquirer timed out")
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	first := payment.AddAttempt("cielo")
+	first.TimeOut("acquirer timed out")
+	second := payment.AddAttempt("rede")
+	second.TimeOut("acquirer timed out")
+
+	for _, attempt := range payment.Attempts {
+		err = s.paymentRepository.CreatePaymentAttempt(s.ctx, attempt)
+		s.Require().Nil(err)
+	}
+
+	retried := entity.NewReversal(payment, first)
+	due := entity.NewReversal(payment, second)
+
+	s.T().Run("create the reversals", func(t *testing.T) {
+		s.Require().Nil(s.reversalRepository.CreateReversal(s.ctx, retried))
+		s.Require().Nil(s.reversalRepository.CreateReversal(s.ctx, due))
+	})
+
+	s.T().Run("find the due reversals", func(t *testing.T) {
+		now := time.Now().UTC()
+		retried.Retry("acquirer timed out", now)
+
+		err := s.reversalRepository.UpdateReversal(s.ctx, retried)
+		s.Require().Nil(err)
+
+		reversals, err := s.reversalRepository.FindDueReversals(s.ctx, now, 10)
+		s.Require().Nil(err)
+		s.Require().Len(reversals, 1)
+		s.Equal(due.Id, reversals[0].Id)
+		s.Equal(second.Id, reversals[0].AttemptId)
+		s.Equal("rede", reversals[0].Acquirer)
+		s.Equal(entity.NewMoney(999, "BRL"), reversals[0].Value)
+
+		reversals, err = s.reversalRepository.FindDueReversals(s.ctx, now.Add(entity.ReversalRetryDelay), 10)
+		s.Require().Nil(err)
+		s.Len(reversals, 2)
+	})
+
+	s.T().Run("resolved reversals are not due", func(t *testing.T) {
+		due.NotFound(404, "the transaction was not found")
+
+		err := s.reversalRepository.UpdateReversal(s.ctx, due)
+		s.Require().Nil(err)
+
+		reversals, err := s.reversalRepository.FindDueReversals(s.ctx, time.Now().UTC().Add(time.Hour), 10)
+		s.Require().Nil(err)
+		s.Require().Len(reversals, 1)
+		s.Equal(retried.Id, reversals[0].Id)
+		s.Equal(1, reversals[0].Retries)
+	})
+
+	s.T().Run("find the payment reversals", func(t *testing.T) {
+		reversals, err := s.reversalRepository.FindReversals(s.ctx, payment.Id)
+		s.Require().Nil(err)
+		s.Require().Len(reversals, 2)
+	})
+}
+
+func (s *ReversalRepositoryTestSuite) TearDownSuite() {
+	err := s.pgContainer.TerminateContainer()
+	s.Require().Nil(err)
+}
+
+func TestReversalRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ReversalRepositoryTestSuite))
+}
@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/lib/pq"
+)
+
+type SettlementRepository struct {
+	db *sql.DB
+}
+
+func NewSettlementRepository(db *sql.DB) *SettlementRepository {
+	return &SettlementRepository{
+		db: db,
+	}
+}
+
+func (r *SettlementRepository) CreateSettlement(ctx context.Context, settlement *entity.Settlement) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO settlements (id, acquirer, format, file_name, lines, matched, period_start, period_end, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`,
+		settlement.Id,
+		settlement.Acquirer,
+		settlement.Format,
+		settlement.FileName,
+		settlement.Lines,
+		settlement.Matched,
+		sql.NullTime{Time: settlement.PeriodStart, Valid: !settlement.PeriodStart.IsZero()},
+		sql.NullTime{Time: settlement.PeriodEnd, Valid: !settlement.PeriodEnd.IsZero()},
+		settlement.CreatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO settlement_discrepancies (settlement_id, position, type, line, payment_id, acquirer_id,
+			expected_amount, expected_currency, settled_amount, settled_currency, date)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	for i, discrepancy := range settlement.Discrepancies {
+		_, err = stmt.ExecContext(ctx,
+			settlement.Id,
+			i,
+			discrepancy.Type,
+			discrepancy.Line,
+			sql.NullString{String: discrepancy.PaymentId, Valid: discrepancy.PaymentId != ""},
+			discrepancy.AcquirerId,
+			discrepancy.ExpectedValue.Amount,
+			discrepancy.ExpectedValue.Currency,
+			discrepancy.SettledValue.Amount,
+			discrepancy.SettledValue.Currency,
+			discrepancy.Date,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return core_errors.NewInternalError(err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *SettlementRepository) FindSettlement(ctx context.Context, settlementId string) (*entity.Settlement, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT id, acquirer, format, file_name, lines, matched, period_start, period_end, created_at
+		FROM settlements
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	var settlement entity.Settlement
+	var periodStart sql.NullTime
+	var periodEnd sql.NullTime
+
+	err = stmt.QueryRowContext(ctx, settlementId).Scan(
+		&settlement.Id,
+		&settlement.Acquirer,
+		&settlement.Format,
+		&settlement.FileName,
+		&settlement.Lines,
+		&settlement.Matched,
+		&periodStart,
+		&periodEnd,
+		&settlement.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, core_errors.NewNotFoundError("settlement id is invalid")
+		}
+
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	settlement.PeriodStart = periodStart.Time
+	settlement.PeriodEnd = periodEnd.Time
+
+	settlement.Discrepancies, err = r.findDiscrepancies(ctx, settlement.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &settlement, nil
+}
+
+// FindSettlementPayments uses the payments_acquirer_id_idx and payments_acquirer_created_at_idx
+// indexes.
+func (r *SettlementRepository) FindSettlementPayments(
+	ctx context.Context,
+	acquirer string,
+	acquirerIds []string,
+	from time.Time,
+	to time.Time,
+) ([]*entity.Payment, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT `+paymentColumns+`
+		FROM payments
+		WHERE acquirer_name = $1 AND acquirer_id = ANY($2)
+		UNION
+		SELECT `+paymentColumns+`
+		FROM payments
+		WHERE acquirer_name = $1 AND created_at >= $3 AND created_at < $4
+		ORDER BY created_at, id
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, acquirer, pq.Array(acquirerIds), from, to)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	payments := make([]*entity.Payment, 0)
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		payments = append(payments, payment)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return payments, nil
+}
+
+func (r *SettlementRepository) findDiscrepancies(ctx context.Context, settlementId string) ([]*entity.SettlementDiscrepancy, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT type, line, payment_id, acquirer_id, expected_amount, expected_currency, settled_amount,
+			settled_currency, date
+		FROM settlement_discrepancies
+		WHERE settlement_id = $1
+		ORDER BY position
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, settlementId)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	discrepancies := make([]*entity.SettlementDiscrepancy, 0)
+	for rows.Next() {
+		var discrepancy entity.SettlementDiscrepancy
+		var paymentId sql.NullString
+
+		err = rows.Scan(
+			&discrepancy.Type,
+			&discrepancy.Line,
+			&paymentId,
+			&discrepancy.AcquirerId,
+			&discrepancy.ExpectedValue.Amount,
+			&discrepancy.ExpectedValue.Currency,
+			&discrepancy.SettledValue.Amount,
+			&discrepancy.SettledValue.Currency,
+			&discrepancy.Date,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		discrepancy.PaymentId = paymentId.String
+		discrepancies = append(discrepancies, &discrepancy)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return discrepancies, nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/test/testcontainers"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type SettlementRepositoryTestSuite struct {
+	suite.Suite
+	ctx                  context.Context
+	db                   *sql.DB
+	pgContainer          *testcontainers.PostgresContainer
+	paymentRepository    *PaymentRepository
+	settlementRepository *SettlementRepository
+}
+
+func (s *SettlementRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	migrationsPath := "../../../migrations"
+
+	pgContainer, err := testcontainers.NewPostgresContainer(ctx, migrationsPath)
+	s.Require().Nil(err)
+
+	db, err := connection.DBConnection(pgContainer.DSN)
+	s.Require().Nil(err)
+
+	s.ctx = ctx
+	s.db = db
+	s.pgContainer = pgContainer
+	s.paymentRepository = NewPaymentRepository(db)
+	s.settlementRepository = NewSettlementRepository(db)
+}
+
+func (s *SettlementRepositoryTestSuite) TestCreateAndFindSettlement() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	payment := createTestPayment()
+	payment.Approve(entity.NewAcquirerResponse("A1", 200, "Approved"))
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	lines := []*entity.SettlementLine{
+		entity.NewSettlementLine(2, "A1", entity.NewMoney(1000, "BRL"), day),
+		entity.NewSettlementLine(3, "A9", entity.NewMoney(500, "BRL"), day.AddDate(0, 0, 1)),
+	}
+
+	settlement := entity.NewSettlement("cielo", entity.SettlementFormatCsv, "cielo.csv", lines)
+	settlement.Reconcile(lines, []*entity.Payment{payment})
+
+	err = s.settlementRepository.CreateSettlement(s.ctx, settlement)
+	s.Require().Nil(err)
+
+	found, err := s.settlementRepository.FindSettlement(s.ctx, settlement.Id)
+	s.Require().Nil(err)
+	s.Equal(settlement.Id, found.Id)
+	s.Equal("cielo", found.Acquirer)
+	s.Equal(entity.SettlementFormatCsv, found.Format)
+	s.Equal("cielo.csv", found.FileName)
+	s.Equal(2, found.Lines)
+	s.Equal(day, found.PeriodStart.UTC())
+	s.Equal(day.AddDate(0, 0, 1), found.PeriodEnd.UTC())
+	s.Require().Equal(2, len(found.Discrepancies))
+	s.Equal(entity.SettlementDiscrepancyAmountMismatch, found.Discrepancies[0].Type)
+	s.Equal(payment.Id, found.Discrepancies[0].PaymentId)
+	s.Equal(entity.NewMoney(999, "BRL"), found.Discrepancies[0].ExpectedValue)
+	s.Equal(entity.NewMoney(1000, "BRL"), found.Discrepancies[0].SettledValue)
+	s.Equal(entity.SettlementDiscrepancyUnexpected, found.Discrepancies[1].Type)
+	s.Equal(3, found.Discrepancies[1].Line)
+	s.Empty(found.Discrepancies[1].PaymentId)
+
+	_, err = s.settlementRepository.FindSettlement(s.ctx, uuid.NewString())
+	s.NotNil(err)
+}
+
+func (s *SettlementRepositoryTestSuite) TestFindSettlementPayments() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	now := time.Now().UTC()
+
+	byId := createTestPayment()
+	byId.Approve(entity.NewAcquirerResponse("A1", 200, "Approved"))
+	byId.CreatedAt = now.AddDate(0, 0, -10)
+
+	byDate := createTestPayment()
+	byDate.Approve(entity.NewAcquirerResponse("A2", 200, "Approved"))
+
+	otherAcquirer := createTestPayment()
+	otherAcquirer.Transaction.Acquirer.Name = "rede"
+	otherAcquirer.Approve(entity.NewAcquirerResponse("A1", 200, "Approved"))
+
+	outside := createTestPayment()
+	outside.CreatedAt = now.AddDate(0, 0, -5)
+
+	for _, payment := range []*entity.Payment{byId, byDate, otherAcquirer, outside} {
+		err = s.paymentRepository.CreatePayment(s.ctx, payment)
+		s.Require().Nil(err)
+	}
+
+	payments, err := s.settlementRepository.FindSettlementPayments(s.ctx, "cielo", []string{"A1"}, now.Add(-time.Hour), now.Add(time.Hour))
+	s.Require().Nil(err)
+	s.Require().Equal(2, len(payments))
+	s.Equal(byId.Id, payments[0].Id)
+	s.Equal(entity.NewMoney(999, "BRL"), payments[0].CapturedValue)
+	s.Equal(byDate.Id, payments[1].Id)
+}
+
+func (s *SettlementRepositoryTestSuite) TearDownSuite() {
+	err := s.pgContainer.TerminateContainer()
+	s.Require().Nil(err)
+}
+
+func TestSettlementRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(SettlementRepositoryTestSuite))
+}
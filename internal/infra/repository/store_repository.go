@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+type StoreRepository struct {
+	db *sql.DB
+}
+
+func NewStoreRepository(db *sql.DB) *StoreRepository {
+	return &StoreRepository{
+		db: db,
+	}
+}
+
+func (r *StoreRepository) CreateStore(ctx context.Context, store *entity.Store) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		INSERT INTO stores (
+			id, identification, document_type, address, cep, street, number, city, state, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		store.Id,
+		store.Identification,
+		store.DocumentType,
+		store.Address,
+		store.Cep,
+		store.Street,
+		store.Number,
+		store.City,
+		store.State,
+		store.CreatedAt,
+		store.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *StoreRepository) UpdateStore(ctx context.Context, store *entity.Store) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE stores
+		SET identification = $2, document_type = $3, address = $4, cep = $5,
+			street = $6, number = $7, city = $8, state = $9, updated_at = $10
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		store.Id,
+		store.Identification,
+		store.DocumentType,
+		store.Address,
+		store.Cep,
+		store.Street,
+		store.Number,
+		store.City,
+		store.State,
+		store.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("store id is invalid")
+	}
+
+	return nil
+}
+
+// DeleteStore removes the store from the registry. Its payments keep the store data they were
+// processed with.
+func (r *StoreRepository) DeleteStore(ctx context.Context, storeId string) error {
+	stmt, err := r.db.PrepareContext(ctx, "DELETE FROM stores WHERE id = $1")
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, storeId)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("store id is invalid")
+	}
+
+	return nil
+}
+
+func (r *StoreRepository) FindStore(ctx context.Context, storeId string) (*entity.Store, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT id, identification, document_type, address, cep, street, number, city, state, created_at, updated_at
+		FROM stores
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	store, err := scanStore(stmt.QueryRowContext(ctx, storeId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, core_errors.NewNotFoundError("store id is invalid")
+		}
+
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return store, nil
+}
+
+// FindStores returns every registered store, oldest first.
+func (r *StoreRepository) FindStores(ctx context.Context) ([]*entity.Store, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT id, identification, document_type, address, cep, street, number, city, state, created_at, updated_at
+		FROM stores
+		ORDER BY created_at, id
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	stores := make([]*entity.Store, 0)
+	for rows.Next() {
+		store, err := scanStore(rows)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		stores = append(stores, store)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return stores, nil
+}
+
+func scanStore(row interface{ Scan(dest ...any) error }) (*entity.Store, error) {
+	var store entity.Store
+
+	err := row.Scan(
+		&store.Id,
+		&store.Identification,
+		&store.DocumentType,
+		&store.Address,
+		&store.Cep,
+		&store.Street,
+		&store.Number,
+		&store.City,
+		&store.State,
+		&store.CreatedAt,
+		&store.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &store, nil
+}
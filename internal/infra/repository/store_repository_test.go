@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/test/testcontainers"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type StoreRepositoryTestSuite struct {
+	suite.Suite
+	ctx             context.Context
+	db              *sql.DB
+	pgContainer     *testcontainers.PostgresContainer
+	storeRepository *StoreRepository
+}
+
+func (s *StoreRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	migrationsPath := "../../../migrations"
+
+	pgContainer, err := testcontainers.NewPostgresContainer(ctx, migrationsPath)
+	s.Require().Nil(err)
+
+	db, err := connection.DBConnection(pgContainer.DSN)
+	s.Require().Nil(err)
+
+	s.ctx = ctx
+	s.db = db
+	s.pgContainer = pgContainer
+	s.storeRepository = NewStoreRepository(db)
+}
+
+func (s *StoreRepositoryTestSuite) TestCreateAndFindStore() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	store := createTestStore()
+
+	err = s.storeRepository.CreateStore(s.ctx, store)
+	s.Require().Nil(err)
+
+	found, err := s.storeRepository.FindStore(s.ctx, store.Id)
+	s.Require().Nil(err)
+
+	s.Equal(store.Id, found.Id)
+	s.Equal(store.Identification, found.Identification)
+	s.Equal(store.DocumentType, found.DocumentType)
+	s.Equal(store.Address, found.Address)
+	s.Equal(store.Cep, found.Cep)
+	s.Equal(store.Street, found.Street)
+	s.Equal(store.Number, found.Number)
+	s.Equal(store.City, found.City)
+	s.Equal(store.State, found.State)
+	s.WithinDuration(store.CreatedAt, found.CreatedAt, time.Millisecond)
+}
+
+func (s *StoreRepositoryTestSuite) TestFindStores() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	first := createTestStore()
+	err = s.storeRepository.CreateStore(s.ctx, first)
+	s.Require().Nil(err)
+
+	second := createTestStore()
+	second.CreatedAt = first.CreatedAt.Add(time.Second)
+	err = s.storeRepository.CreateStore(s.ctx, second)
+	s.Require().Nil(err)
+
+	stores, err := s.storeRepository.FindStores(s.ctx)
+	s.Require().Nil(err)
+	s.Require().Equal(2, len(stores))
+	s.Equal(first.Id, stores[0].Id)
+	s.Equal(second.Id, stores[1].Id)
+}
+
+func (s *StoreRepositoryTestSuite) TestUpdateStore() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	store := createTestStore()
+	err = s.storeRepository.CreateStore(s.ctx, store)
+	s.Require().Nil(err)
+
+	store.Address = "Another Address"
+	store.SetLocation("Rua Augusta", "500", "São Paulo", "SP")
+	store.UpdatedAt = store.UpdatedAt.Add(time.Minute)
+
+	err = s.storeRepository.UpdateStore(s.ctx, store)
+	s.Require().Nil(err)
+
+	found, err := s.storeRepository.FindStore(s.ctx, store.Id)
+	s.Require().Nil(err)
+	s.Equal("Another Address", found.Address)
+	s.Equal("Rua Augusta", found.Street)
+	s.Equal("500", found.Number)
+	s.WithinDuration(store.UpdatedAt, found.UpdatedAt, time.Millisecond)
+}
+
+func (s *StoreRepositoryTestSuite) TestDeleteStore() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	store := createTestStore()
+	err = s.storeRepository.CreateStore(s.ctx, store)
+	s.Require().Nil(err)
+
+	err = s.storeRepository.DeleteStore(s.ctx, store.Id)
+	s.Require().Nil(err)
+
+	_, err = s.storeRepository.FindStore(s.ctx, store.Id)
+
+	var e *errors.NotFoundError
+	s.Require().ErrorAs(err, &e)
+	s.Equal("store id is invalid", e.Message)
+}
+
+func (s *StoreRepositoryTestSuite) TestStoreNotFound() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	s.T().Run("update non-existent store", func(t *testing.T) {
+		err := s.storeRepository.UpdateStore(s.ctx, createTestStore())
+
+		var e *errors.NotFoundError
+		s.Require().ErrorAs(err, &e)
+		s.Equal("store id is invalid", e.Message)
+	})
+
+	s.T().Run("delete non-existent store", func(t *testing.T) {
+		err := s.storeRepository.DeleteStore(s.ctx, uuid.NewString())
+
+		var e *errors.NotFoundError
+		s.Require().ErrorAs(err, &e)
+		s.Equal("store id is invalid", e.Message)
+	})
+}
+
+func (s *StoreRepositoryTestSuite) TearDownSuite() {
+	err := s.pgContainer.TerminateContainer()
+	s.Require().Nil(err)
+}
+
+func TestStoreRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreRepositoryTestSuite))
+}
+
+func createTestStore() *entity.Store {
+	store := entity.NewRegisteredStore("11222333000181", "Avenida Paulista, 1000", "01310100")
+	store.SetLocation("Avenida Paulista", "1000", "São Paulo", "SP")
+
+	return store
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+type WebhookDeliveryRepository struct {
+	db *sql.DB
+}
+
+func NewWebhookDeliveryRepository(db *sql.DB) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		db: db,
+	}
+}
+
+// CreateDeliveries queues an event once per webhook.
+func (r *WebhookDeliveryRepository) CreateDeliveries(ctx context.Context, event *entity.WebhookEvent) error {
+	payload, err := event.Payload()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	stmt, err := r.db.PrepareContext(ctx, `
+		INSERT INTO webhook_deliveries (
+			id, webhook_id, event_id, event_type, payment_id, payload, status, attempts, next_attempt_at,
+			response_code, response_message, created_at, updated_at
+		)
+		SELECT gen_random_uuid(), id, $1, $2, $3, $4, $5, 0, $6, 0, '', $6, $6
+		FROM webhooks
+		WHERE caller = $7 AND $8 = ANY (events)
+		ON CONFLICT (webhook_id, event_id) DO NOTHING
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		event.Id,
+		event.Type,
+		event.Payment.PaymentId,
+		string(payload),
+		entity.WebhookDeliveryStatusPending,
+		event.CreatedAt,
+		event.Caller,
+		string(event.Type),
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *WebhookDeliveryRepository) UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, response_code = $5, response_message = $6, updated_at = $7
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		delivery.Id,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.ResponseCode,
+		delivery.ResponseMessage,
+		delivery.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("delivery id is invalid")
+	}
+
+	return nil
+}
+
+func (r *WebhookDeliveryRepository) FindDelivery(ctx context.Context, deliveryId string) (*entity.WebhookDelivery, error) {
+	deliveries, err := r.findDeliveries(ctx, `
+		SELECT id, webhook_id, event_id, event_type, payment_id, payload, status, attempts, next_attempt_at,
+			response_code, response_message, created_at, updated_at
+		FROM webhook_deliveries
+		WHERE id = $1
+	`, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(deliveries) == 0 {
+		return nil, core_errors.NewNotFoundError("delivery id is invalid")
+	}
+
+	return deliveries[0], nil
+}
+
+func (r *WebhookDeliveryRepository) FindDeliveries(ctx context.Context, webhookId string, limit int) ([]*entity.WebhookDelivery, error) {
+	return r.findDeliveries(ctx, `
+		SELECT id, webhook_id, event_id, event_type, payment_id, payload, status, attempts, next_attempt_at,
+			response_code, response_message, created_at, updated_at
+		FROM webhook_deliveries
+		WHERE webhook_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2
+	`, webhookId, limit)
+}
+
+func (r *WebhookDeliveryRepository) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	return r.findDeliveries(ctx, `
+		SELECT id, webhook_id, event_id, event_type, payment_id, payload, status, attempts, next_attempt_at,
+			response_code, response_message, created_at, updated_at
+		FROM webhook_deliveries
+		WHERE status = $1 AND next_attempt_at <= $2
+		ORDER BY next_attempt_at, id
+		LIMIT $3
+	`, entity.WebhookDeliveryStatusPending, now, limit)
+}
+
+func (r *WebhookDeliveryRepository) findDeliveries(ctx context.Context, query string, args ...any) ([]*entity.WebhookDelivery, error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*entity.WebhookDelivery, 0)
+	for rows.Next() {
+		var delivery entity.WebhookDelivery
+		err = rows.Scan(
+			&delivery.Id,
+			&delivery.WebhookId,
+			&delivery.EventId,
+			&delivery.EventType,
+			&delivery.PaymentId,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&delivery.ResponseCode,
+			&delivery.ResponseMessage,
+			&delivery.CreatedAt,
+			&delivery.UpdatedAt,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return deliveries, nil
+}
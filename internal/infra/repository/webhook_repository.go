@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/lib/pq"
+)
+
+type WebhookRepository struct {
+	db *sql.DB
+}
+
+func NewWebhookRepository(db *sql.DB) *WebhookRepository {
+	return &WebhookRepository{
+		db: db,
+	}
+}
+
+func (r *WebhookRepository) CreateWebhook(ctx context.Context, webhook *entity.Webhook) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		INSERT INTO webhooks (id, caller, url, events, secret, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		webhook.Id,
+		webhook.Caller,
+		webhook.Url,
+		pq.Array(webhookEvents(webhook)),
+		webhook.Secret,
+		webhook.CreatedAt,
+		webhook.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) UpdateWebhook(ctx context.Context, webhook *entity.Webhook) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE webhooks
+		SET url = $2, events = $3, secret = $4, updated_at = $5
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		webhook.Id,
+		webhook.Url,
+		pq.Array(webhookEvents(webhook)),
+		webhook.Secret,
+		webhook.UpdatedAt,
+	)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("webhook id is invalid")
+	}
+
+	return nil
+}
+
+// DeleteWebhook removes the webhook along with its deliveries.
+func (r *WebhookRepository) DeleteWebhook(ctx context.Context, webhookId string) error {
+	stmt, err := r.db.PrepareContext(ctx, "DELETE FROM webhooks WHERE id = $1")
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, webhookId)
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		slog.Error(err.Error())
+		return core_errors.NewInternalError(err)
+	}
+
+	if rows == 0 {
+		return core_errors.NewNotFoundError("webhook id is invalid")
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) FindWebhook(ctx context.Context, webhookId string) (*entity.Webhook, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT id, caller, url, events, secret, created_at, updated_at
+		FROM webhooks
+		WHERE id = $1
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	webhook, err := scanWebhook(stmt.QueryRowContext(ctx, webhookId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, core_errors.NewNotFoundError("webhook id is invalid")
+		}
+
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return webhook, nil
+}
+
+// FindWebhooks returns the webhooks of the client, oldest first.
+func (r *WebhookRepository) FindWebhooks(ctx context.Context, caller string) ([]*entity.Webhook, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		SELECT id, caller, url, events, secret, created_at, updated_at
+		FROM webhooks
+		WHERE caller = $1
+		ORDER BY created_at, id
+	`)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, caller)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+	defer rows.Close()
+
+	webhooks := make([]*entity.Webhook, 0)
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, core_errors.NewInternalError(err)
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err = rows.Err(); err != nil {
+		slog.Error(err.Error())
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return webhooks, nil
+}
+
+func scanWebhook(row interface{ Scan(dest ...any) error }) (*entity.Webhook, error) {
+	var webhook entity.Webhook
+	var events []string
+
+	err := row.Scan(
+		&webhook.Id,
+		&webhook.Caller,
+		&webhook.Url,
+		pq.Array(&events),
+		&webhook.Secret,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.Events = make([]entity.WebhookEventType, 0, len(events))
+	for _, event := range events {
+		webhook.Events = append(webhook.Events, entity.WebhookEventType(event))
+	}
+
+	return &webhook, nil
+}
+
+func webhookEvents(webhook *entity.Webhook) []string {
+	events := make([]string, 0, len(webhook.Events))
+	for _, event := range webhook.Events {
+		events = append(events, string(event))
+	}
+
+	return events
+}
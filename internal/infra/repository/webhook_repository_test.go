@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/connection"
+	"github.com/sesaquecruz/go-payment-processor/test/testcontainers"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookRepositoryTestSuite struct {
+	suite.Suite
+	ctx                context.Context
+	db                 *sql.DB
+	pgContainer        *testcontainers.PostgresContainer
+	paymentRepository  *PaymentRepository
+	webhookRepository  *WebhookRepository
+	deliveryRepository *WebhookDeliveryRepository
+}
+
+func (s *WebhookRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	migrationsPath := "../../../migrations"
+
+	pgContainer, err := testcontainers.NewPostgresContainer(ctx, migrationsPath)
+	s.Require().Nil(err)
+
+	db, err := connection.DBConnection(pgContainer.DSN)
+	s.Require().Nil(err)
+
+	s.ctx = ctx
+	s.db = db
+	s.pgContainer = pgContainer
+	s.paymentRepository = NewPaymentRepository(db)
+	s.webhookRepository = NewWebhookRepository(db)
+	s.deliveryRepository = NewWebhookDeliveryRepository(db)
+}
+
+func (s *WebhookRepositoryTestSuite) TestWebhookLifecycle() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	webhook := createTestWebhook("Caller", "payment.approved", "payment.declined")
+	err = s.webhookRepository.CreateWebhook(s.ctx, webhook)
+	s.Require().Nil(err)
+
+	other := createTestWebhook("Another Caller", "payment.approved")
+	err = s.webhookRepository.CreateWebhook(s.ctx, other)
+	s.Require().Nil(err)
+
+	found, err := s.webhookRepository.FindWebhook(s.ctx, webhook.Id)
+	s.Require().Nil(err)
+	s.Equal(webhook.Caller, found.Caller)
+	s.Equal(webhook.Url, found.Url)
+	s.Equal(webhook.Events, found.Events)
+	s.Equal(webhook.Secret, found.Secret)
+	s.WithinDuration(webhook.CreatedAt, found.CreatedAt, time.Millisecond)
+
+	webhook.Update("https://example.com/payments", []string{"payment.refunded"}, "another-secret-of-16-chars", webhook.UpdatedAt.Add(time.Minute))
+	err = s.webhookRepository.UpdateWebhook(s.ctx, webhook)
+	s.Require().Nil(err)
+
+	webhooks, err := s.webhookRepository.FindWebhooks(s.ctx, "Caller")
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(webhooks))
+	s.Equal("https://example.com/payments", webhooks[0].Url)
+	s.Equal([]entity.WebhookEventType{entity.WebhookEventPaymentRefunded}, webhooks[0].Events)
+	s.Equal("another-secret-of-16-chars", webhooks[0].Secret)
+
+	err = s.webhookRepository.DeleteWebhook(s.ctx, webhook.Id)
+	s.Require().Nil(err)
+
+	_, err = s.webhookRepository.FindWebhook(s.ctx, webhook.Id)
+
+	var e *errors.NotFoundError
+	s.Require().ErrorAs(err, &e)
+	s.Equal("webhook id is invalid", e.Message)
+
+	err = s.webhookRepository.UpdateWebhook(s.ctx, webhook)
+	s.Require().ErrorAs(err, &e)
+
+	err = s.webhookRepository.DeleteWebhook(s.ctx, uuid.NewString())
+	s.Require().ErrorAs(err, &e)
+}
+
+func (s *WebhookRepositoryTestSuite) TestDeliveryLifecycle() {
+	err := s.pgContainer.ClearDB()
+	s.Require().Nil(err)
+
+	subscribed := createTestWebhook("Caller", "payment.approved")
+	notSubscribed := createTestWebhook("Caller", "payment.declined")
+	otherCaller := createTestWebhook("Another Caller", "payment.approved")
+
+	for _, webhook := range []*entity.Webhook{subscribed, notSubscribed, otherCaller} {
+		err = s.webhookRepository.CreateWebhook(s.ctx, webhook)
+		s.Require().Nil(err)
+	}
+
+	payment := createTestPayment()
+	payment.Caller = "Caller"
+	payment.Approve(entity.NewAcquirerResponse("Acquirer Id", 200, "Approved"))
+
+	err = s.paymentRepository.CreatePayment(s.ctx, payment)
+	s.Require().Nil(err)
+
+	domainEvent, err := entity.NewPaymentChangedEvent(payment)
+	s.Require().Nil(err)
+
+	event, err := entity.NewWebhookEvent(domainEvent)
+	s.Require().Nil(err)
+
+	// events are queued once per webhook
+	for i := 0; i < 2; i++ {
+		err = s.deliveryRepository.CreateDeliveries(s.ctx, event)
+		s.Require().Nil(err)
+	}
+
+	deliveries, err := s.deliveryRepository.FindDeliveries(s.ctx, subscribed.Id, 10)
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(deliveries))
+
+	delivery := deliveries[0]
+	s.Equal(subscribed.Id, delivery.WebhookId)
+	s.Equal(event.Id, delivery.EventId)
+	s.Equal(entity.WebhookEventPaymentApproved, delivery.EventType)
+	s.Equal(payment.Id, delivery.PaymentId)
+	s.Equal(entity.WebhookDeliveryStatusPending, delivery.Status)
+
+	var payload entity.WebhookEvent
+	err = json.Unmarshal(delivery.Payload, &payload)
+	s.Require().Nil(err)
+	s.Equal(event.Id, payload.Id)
+	s.Equal(payment.Id, payload.Payment.PaymentId)
+
+	for _, webhook := range []*entity.Webhook{notSubscribed, otherCaller} {
+		deliveries, err = s.deliveryRepository.FindDeliveries(s.ctx, webhook.Id, 10)
+		s.Require().Nil(err)
+		s.Empty(deliveries)
+	}
+
+	due, err := s.deliveryRepository.FindDueDeliveries(s.ctx, time.Now().UTC(), 10)
+	s.Require().Nil(err)
+	s.Require().Equal(1, len(due))
+	s.Equal(delivery.Id, due[0].Id)
+
+	delivery.Retry(500, "webhook answered with status 500", time.Now().UTC())
+	err = s.deliveryRepository.UpdateDelivery(s.ctx, delivery)
+	s.Require().Nil(err)
+
+	due, err = s.deliveryRepository.FindDueDeliveries(s.ctx, time.Now().UTC(), 10)
+	s.Require().Nil(err)
+	s.Empty(due)
+
+	found, err := s.deliveryRepository.FindDelivery(s.ctx, delivery.Id)
+	s.Require().Nil(err)
+	s.Equal(1, found.Attempts)
+	s.Equal(500, found.ResponseCode)
+	s.Equal("webhook answered with status 500", found.ResponseMessage)
+	s.WithinDuration(delivery.NextAttemptAt, found.NextAttemptAt, time.Millisecond)
+
+	_, err = s.deliveryRepository.FindDelivery(s.ctx, uuid.NewString())
+
+	var e *errors.NotFoundError
+	s.Require().ErrorAs(err, &e)
+	s.Equal("delivery id is invalid", e.Message)
+
+	// deliveries are removed with their webhook
+	err = s.webhookRepository.DeleteWebhook(s.ctx, subscribed.Id)
+	s.Require().Nil(err)
+
+	_, err = s.deliveryRepository.FindDelivery(s.ctx, delivery.Id)
+	s.Require().ErrorAs(err, &e)
+}
+
+func (s *WebhookRepositoryTestSuite) TearDownSuite() {
+	err := s.pgContainer.TerminateContainer()
+	s.Require().Nil(err)
+}
+
+func TestWebhookRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookRepositoryTestSuite))
+}
+
+func createTestWebhook(caller string, events ...string) *entity.Webhook {
+	return entity.NewWebhook(caller, "https://example.com/webhooks", events, "a-secret-of-16-chars")
+}
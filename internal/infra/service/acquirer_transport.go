@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+)
+
+// TransportConfig has ResponseTimeout bound waiting for the response headers and RequestTimeout
+// the whole call, including reading the body.
+type TransportConfig struct {
+	ConnectTimeout  time.Duration
+	ResponseTimeout time.Duration
+	RequestTimeout  time.Duration
+	MaxIdleConns    int
+	MaxConns        int
+	IdleConnTimeout time.Duration
+}
+
+func DefaultTransportConfig() TransportConfig {
+	return TransportConfig{
+		ConnectTimeout:  2 * time.Second,
+		ResponseTimeout: 10 * time.Second,
+		RequestTimeout:  15 * time.Second,
+		MaxIdleConns:    10,
+		MaxConns:        50,
+		IdleConnTimeout: 90 * time.Second,
+	}
+}
+
+// NewHttpClient has its own connection pool, so a slow acquirer cannot exhaust the connections
+// of the others.
+func NewHttpClient(config TransportConfig) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   config.ConnectTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   config.ConnectTimeout,
+		ResponseHeaderTimeout: config.ResponseTimeout,
+		MaxIdleConns:          config.MaxIdleConns,
+		MaxIdleConnsPerHost:   config.MaxIdleConns,
+		MaxConnsPerHost:       config.MaxConns,
+		IdleConnTimeout:       config.IdleConnTimeout,
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   config.RequestTimeout,
+	}
+}
+
+// LoadTransportConfigs reads durations in milliseconds and keeps the defaults of omitted fields.
+func LoadTransportConfigs(path string) (map[string]TransportConfig, error) {
+	type fileConfig struct {
+		ConnectTimeoutMs  *int64 `json:"connect_timeout_ms"`
+		ResponseTimeoutMs *int64 `json:"response_timeout_ms"`
+		RequestTimeoutMs  *int64 `json:"request_timeout_ms"`
+		MaxIdleConns      *int   `json:"max_idle_conns"`
+		MaxConns          *int   `json:"max_conns"`
+		IdleConnTimeoutMs *int64 `json:"idle_conn_timeout_ms"`
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transport configs: %w", err)
+	}
+
+	var files map[string]*fileConfig
+	err = json.Unmarshal(data, &files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode transport configs: %w", err)
+	}
+
+	milliseconds := func(ms int64) time.Duration {
+		return time.Duration(ms) * time.Millisecond
+	}
+
+	configs := make(map[string]TransportConfig, len(files))
+	for acquirer, file := range files {
+		config := DefaultTransportConfig()
+
+		if file.ConnectTimeoutMs != nil {
+			config.ConnectTimeout = milliseconds(*file.ConnectTimeoutMs)
+		}
+		if file.ResponseTimeoutMs != nil {
+			config.ResponseTimeout = milliseconds(*file.ResponseTimeoutMs)
+		}
+		if file.RequestTimeoutMs != nil {
+			config.RequestTimeout = milliseconds(*file.RequestTimeoutMs)
+		}
+		if file.MaxIdleConns != nil {
+			config.MaxIdleConns = *file.MaxIdleConns
+		}
+		if file.MaxConns != nil {
+			config.MaxConns = *file.MaxConns
+		}
+		if file.IdleConnTimeoutMs != nil {
+			config.IdleConnTimeout = milliseconds(*file.IdleConnTimeoutMs)
+		}
+
+		if config.ConnectTimeout < 0 || config.ResponseTimeout < 0 || config.RequestTimeout < 0 ||
+			config.MaxIdleConns < 0 || config.MaxConns < 0 || config.IdleConnTimeout < 0 {
+			return nil, fmt.Errorf("transport config of %s is invalid", acquirer)
+		}
+
+		configs[acquirer] = config
+	}
+
+	return configs, nil
+}
+
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isDialError includes the dial timeouts, since the request was never sent either.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
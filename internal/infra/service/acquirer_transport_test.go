@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/acquirer"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	iservice "github.com/sesaquecruz/go-payment-processor/internal/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentServiceTimeouts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(500 * time.Millisecond):
+		case <-r.Context().Done():
+		}
+		w.Write([]byte(`{"code": 200, "message": "id"}`))
+	}))
+	defer server.Close()
+
+	config := DefaultTransportConfig()
+	config.ResponseTimeout = 50 * time.Millisecond
+
+	paymentService := NewPaymentService(
+		PaymentWithAcquirer(acquirer.NewCielo(server.URL+"/cielo", "cielo-api-key")),
+		PaymentWithAcquirer(acquirer.NewRede(server.URL+"/rede", "rede-api-key")),
+		PaymentWithTransport("cielo", config),
+	)
+
+	t.Run("times out waiting for the acquirer response", func(t *testing.T) {
+		ctx := iservice.WithSubmission(context.Background())
+		_, err := paymentService.ProcessTransaction(ctx, createTransaction("cielo", 1000))
+
+		var timeoutErr *errors.TimeoutError
+		require.ErrorAs(t, err, &timeoutErr)
+		assert.Equal(t, "acquirer timed out", timeoutErr.Message)
+		assert.True(t, errors.IsTemporary(err))
+		assert.True(t, iservice.Submitted(ctx))
+	})
+
+	t.Run("times out when the request context expires", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := paymentService.ProcessTransaction(ctx, createTransaction("rede", 1000))
+
+		var timeoutErr *errors.TimeoutError
+		require.ErrorAs(t, err, &timeoutErr)
+	})
+
+	t.Run("stops when the request context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		before := paymentService.breakers["rede"].Health("rede").Requests
+		_, err := paymentService.ProcessTransaction(ctx, createTransaction("rede", 1000))
+
+		var internalErr *errors.InternalError
+		require.ErrorAs(t, err, &internalErr)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.False(t, errors.IsTemporary(err))
+		assert.Equal(t, before, paymentService.breakers["rede"].Health("rede").Requests)
+	})
+
+	t.Run("fails without reaching the acquirer when the connection is not established", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.Nil(t, err)
+		address := listener.Addr().String()
+		listener.Close()
+
+		paymentService := NewPaymentService(
+			PaymentWithAcquirer(acquirer.NewCielo("http://"+address+"/cielo", "cielo-api-key")),
+		)
+
+		ctx := iservice.WithSubmission(context.Background())
+		_, err = paymentService.ProcessTransaction(ctx, createTransaction("cielo", 1000))
+
+		var unavailableErr *errors.UnavailableError
+		require.ErrorAs(t, err, &unavailableErr)
+		assert.True(t, errors.IsTemporary(err))
+
+		var timeoutErr *errors.TimeoutError
+		assert.False(t, stderrors.As(err, &timeoutErr))
+		assert.False(t, iservice.Submitted(ctx))
+	})
+
+	t.Run("uses a client per acquirer", func(t *testing.T) {
+		assert.NotSame(t, paymentService.clients["cielo"], paymentService.clients["rede"])
+		assert.Equal(t, 50*time.Millisecond, paymentService.clients["cielo"].Transport.(*http.Transport).ResponseHeaderTimeout)
+		assert.Equal(t, DefaultTransportConfig().RequestTimeout, paymentService.clients["rede"].Timeout)
+	})
+}
+
+func TestPaymentServiceUnreadableResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code": 200,`))
+	}))
+	defer server.Close()
+
+	paymentService := NewPaymentService(
+		PaymentWithAcquirer(acquirer.NewCielo(server.URL+"/cielo", "cielo-api-key")),
+	)
+
+	ctx := iservice.WithSubmission(context.Background())
+	_, err := paymentService.ProcessTransaction(ctx, createTransaction("cielo", 1000))
+
+	var internalErr *errors.InternalError
+	require.ErrorAs(t, err, &internalErr)
+	assert.False(t, errors.IsTemporary(err))
+	assert.True(t, iservice.Submitted(ctx))
+	assert.Equal(t, int64(1), paymentService.breakers["cielo"].Health("cielo").Failures)
+}
+
+func TestLoadTransportConfigs(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("loads the configs over the defaults", func(t *testing.T) {
+		path := filepath.Join(dir, "transports.json")
+		data := `{"stone": {"connect_timeout_ms": 500, "response_timeout_ms": 3000, "max_conns": 5}}`
+		require.Nil(t, os.WriteFile(path, []byte(data), 0o600))
+
+		configs, err := LoadTransportConfigs(path)
+		require.Nil(t, err)
+
+		expected := DefaultTransportConfig()
+		expected.ConnectTimeout = 500 * time.Millisecond
+		expected.ResponseTimeout = 3 * time.Second
+		expected.MaxConns = 5
+		assert.Equal(t, map[string]TransportConfig{"stone": expected}, configs)
+	})
+
+	t.Run("fails with invalid configs", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		require.Nil(t, os.WriteFile(path, []byte(`{"stone": {"max_conns": -1}}`), 0o600))
+
+		configs, err := LoadTransportConfigs(path)
+		assert.Nil(t, configs)
+		assert.ErrorContains(t, err, "transport config of stone is invalid")
+	})
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+//go:embed data/ceps.csv
+var defaultAddressDataset []byte
+
+func DefaultAddressDataset() []*entity.Address {
+	addresses, err := readAddressDataset(bytes.NewReader(defaultAddressDataset))
+	if err != nil {
+		panic(err)
+	}
+
+	return addresses
+}
+
+// LoadAddressDataset reads a csv file with the header cep,street,district,city,state.
+func LoadAddressDataset(path string) ([]*entity.Address, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read address dataset: %w", err)
+	}
+	defer file.Close()
+
+	return readAddressDataset(file)
+}
+
+func readAddressDataset(r io.Reader) ([]*entity.Address, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 5
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode address dataset: %w", err)
+	}
+
+	addresses := make([]*entity.Address, 0, len(records))
+	for i, record := range records {
+		if i == 0 {
+			continue
+		}
+
+		address := &entity.Address{
+			Cep:      entity.NormalizeCep(record[0]),
+			Street:   record[1],
+			District: record[2],
+			City:     record[3],
+			State:    record[4],
+		}
+
+		if !entity.ValidCep(address.Cep) || address.City == "" || entity.CepState(address.Cep) != address.State {
+			return nil, fmt.Errorf("failed to validate address dataset: line %d is invalid", i+1)
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+// LocalAddressLookup falls back to the CEP of the city, ending in 000, when it covers the whole city.
+type LocalAddressLookup struct {
+	addresses map[string]*entity.Address
+}
+
+func NewLocalAddressLookup(addresses []*entity.Address) *LocalAddressLookup {
+	lookup := &LocalAddressLookup{
+		addresses: make(map[string]*entity.Address, len(addresses)),
+	}
+
+	for _, address := range addresses {
+		lookup.addresses[address.Cep] = address
+	}
+
+	return lookup
+}
+
+func (l *LocalAddressLookup) LookupAddress(ctx context.Context, cep string) (*entity.Address, error) {
+	if address, ok := l.addresses[cep]; ok {
+		return address, nil
+	}
+
+	if len(cep) == 8 {
+		if address, ok := l.addresses[cep[:5]+"000"]; ok && address.Street == "" {
+			return address, nil
+		}
+	}
+
+	return nil, nil
+}
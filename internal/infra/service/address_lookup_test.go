@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultAddressDataset(t *testing.T) {
+	ctx := context.Background()
+	lookup := NewLocalAddressLookup(DefaultAddressDataset())
+
+	address, err := lookup.LookupAddress(ctx, "01310100")
+	require.Nil(t, err)
+	assert.Equal(t, &entity.Address{
+		Cep:      "01310100",
+		Street:   "Avenida Paulista",
+		District: "Bela Vista",
+		City:     "São Paulo",
+		State:    "SP",
+	}, address)
+
+	address, err = lookup.LookupAddress(ctx, "01310999")
+	require.Nil(t, err)
+	assert.Nil(t, address)
+}
+
+func TestLoadAddressDataset(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "ceps.csv")
+
+	err := os.WriteFile(path, []byte("cep,street,district,city,state\n"+
+		"78455-000,,,Lucas do Rio Verde,MT\n"+
+		"78455-001,Rua Principal,Centro,Lucas do Rio Verde,MT\n"), 0600)
+	require.Nil(t, err)
+
+	addresses, err := LoadAddressDataset(path)
+	require.Nil(t, err)
+	lookup := NewLocalAddressLookup(addresses)
+
+	address, err := lookup.LookupAddress(ctx, "78455001")
+	require.Nil(t, err)
+	assert.Equal(t, "Rua Principal", address.Street)
+
+	address, err = lookup.LookupAddress(ctx, "78455970")
+	require.Nil(t, err)
+	assert.Equal(t, "Lucas do Rio Verde", address.City)
+	assert.Empty(t, address.Street)
+
+	err = os.WriteFile(path, []byte("cep,street,district,city,state\n01310100,Avenida Paulista,Bela Vista,São Paulo,RJ\n"), 0600)
+	require.Nil(t, err)
+
+	_, err = LoadAddressDataset(path)
+	assert.NotNil(t, err)
+
+	_, err = LoadAddressDataset(filepath.Join(t.TempDir(), "missing.csv"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// jwksMinRefreshInterval and jwksLookupTimeout limit the fetches caused by tokens signed with
+// unknown keys, since they hold the request.
+const (
+	jwksRefreshInterval    = 5 * time.Minute
+	jwksMinRefreshInterval = 30 * time.Second
+	jwksFetchTimeout       = 10 * time.Second
+	jwksLookupTimeout      = 2 * time.Second
+)
+
+var ErrUnknownAuthKey = errors.New("auth key is unknown")
+
+// ParseRSAPublicKey parses a PEM or base64 DER key in the PKCS1 or PKIX format.
+func ParseRSAPublicKey(key string) (*rsa.PublicKey, error) {
+	der := []byte(key)
+
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		der = block.Bytes
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key from base64: %w", err)
+		}
+		der = decoded
+	}
+
+	if publicKey, err := x509.ParsePKCS1PublicKey(der); err == nil {
+		return publicKey, nil
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key format: %w", err)
+	}
+
+	publicKey, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("failed to parse key format: key is not rsa")
+	}
+
+	return publicKey, nil
+}
+
+type Jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type Jwks struct {
+	Keys []*Jwk `json:"keys"`
+}
+
+func ParseJwks(data []byte) (map[string]*rsa.PublicKey, error) {
+	var jwks Jwks
+	err := json.Unmarshal(data, &jwks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode jwks: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, jwk := range jwks.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode modulus of key %s: %w", jwk.Kid, err)
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode exponent of key %s: %w", jwk.Kid, err)
+		}
+
+		exponent := new(big.Int).SetBytes(e)
+		if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("failed to decode key %s: key is invalid", jwk.Kid)
+		}
+
+		keys[jwk.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(exponent.Int64()),
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("failed to decode jwks: no rsa signing keys")
+	}
+
+	return keys, nil
+}
+
+// AuthKeySet holds the keys that sign the auth tokens. A static set has a single key, used
+// whatever the key id of the token.
+type AuthKeySet struct {
+	mu        sync.Mutex
+	static    *rsa.PublicKey
+	keys      map[string]*rsa.PublicKey
+	source    string
+	client    *http.Client
+	interval  time.Duration
+	fetchedAt time.Time
+	now       func() time.Time
+}
+
+func NewStaticAuthKeySet(key *rsa.PublicKey) *AuthKeySet {
+	return &AuthKeySet{
+		static: key,
+		now:    time.Now,
+	}
+}
+
+// NewJwksAuthKeySet only logs a failure to fetch the set, so the service can start before the
+// auth server.
+func NewJwksAuthKeySet(source string) *AuthKeySet {
+	s := &AuthKeySet{
+		keys:     make(map[string]*rsa.PublicKey),
+		source:   source,
+		client:   &http.Client{Timeout: jwksFetchTimeout},
+		interval: jwksRefreshInterval,
+		now:      time.Now,
+	}
+
+	s.fetchedAt = s.now()
+	s.reload(context.Background())
+
+	return s
+}
+
+func (s *AuthKeySet) Run(ctx context.Context) {
+	if s.static != nil {
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		s.mu.Lock()
+		s.fetchedAt = s.now()
+		s.mu.Unlock()
+
+		s.reload(ctx)
+	}
+}
+
+// Key fetches the key set again for an unknown key id. Concurrent callers keep using the loaded
+// keys while one of them fetches.
+func (s *AuthKeySet) Key(kid string) (*rsa.PublicKey, error) {
+	if s.static != nil {
+		return s.static, nil
+	}
+
+	s.mu.Lock()
+	key, ok := s.keys[kid]
+	now := s.now()
+	lookup := !ok && now.Sub(s.fetchedAt) >= jwksMinRefreshInterval
+	if lookup {
+		s.fetchedAt = now
+	}
+	s.mu.Unlock()
+
+	if lookup {
+		ctx, cancel := context.WithTimeout(context.Background(), jwksLookupTimeout)
+		s.reload(ctx)
+		cancel()
+
+		s.mu.Lock()
+		key, ok = s.keys[kid]
+		s.mu.Unlock()
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAuthKey, kid)
+	}
+
+	return key, nil
+}
+
+func (s *AuthKeySet) reload(ctx context.Context) {
+	keys, err := s.fetch(ctx)
+	if err != nil {
+		slog.Error(err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.mu.Unlock()
+
+	slog.Info("auth keys reloaded", "keys", len(keys))
+}
+
+func (s *AuthKeySet) fetch(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	if !strings.HasPrefix(s.source, "http://") && !strings.HasPrefix(s.source, "https://") {
+		data, err := os.ReadFile(s.source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read jwks: %w", err)
+		}
+
+		return ParseJwks(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.source, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch jwks: status %d", res.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+
+	return ParseJwks(data)
+}
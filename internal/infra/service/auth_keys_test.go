@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRSAPublicKey(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	pkcs1 := x509.MarshalPKCS1PublicKey(&privateKey.PublicKey)
+	pkix, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.Nil(t, err)
+
+	testCases := []struct {
+		Test string
+		Key  string
+	}{
+		{"base64 pkcs1", base64.StdEncoding.EncodeToString(pkcs1)},
+		{"base64 pkix", base64.StdEncoding.EncodeToString(pkix)},
+		{"pem pkcs1", string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: pkcs1}))},
+		{"pem pkix", string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix}))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Test, func(t *testing.T) {
+			key, err := ParseRSAPublicKey(tc.Key)
+			require.Nil(t, err)
+			assert.True(t, privateKey.PublicKey.Equal(key))
+		})
+	}
+
+	_, err = ParseRSAPublicKey("not a key")
+	assert.NotNil(t, err)
+
+	_, err = ParseRSAPublicKey(base64.StdEncoding.EncodeToString([]byte("not a key")))
+	assert.NotNil(t, err)
+}
+
+func TestStaticAuthKeySet(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	keys := NewStaticAuthKeySet(&privateKey.PublicKey)
+
+	for _, kid := range []string{"", "any-key"} {
+		key, err := keys.Key(kid)
+		require.Nil(t, err)
+		assert.True(t, privateKey.PublicKey.Equal(key))
+	}
+}
+
+func TestParseJwks(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	jwks := Jwks{Keys: []*Jwk{
+		createJwk("signing-key", &privateKey.PublicKey),
+		{Kty: "EC", Kid: "ec-key", N: "", E: ""},
+		{Kty: "RSA", Kid: "encryption-key", Use: "enc", N: "AQAB", E: "AQAB"},
+	}}
+
+	data, err := json.Marshal(jwks)
+	require.Nil(t, err)
+
+	keys, err := ParseJwks(data)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(keys))
+	assert.True(t, privateKey.PublicKey.Equal(keys["signing-key"]))
+
+	_, err = ParseJwks([]byte(`{"keys": []}`))
+	assert.NotNil(t, err)
+
+	_, err = ParseJwks([]byte(`{"keys": [{"kty": "RSA", "kid": "key", "n": "AQAB", "e": "!"}]}`))
+	assert.NotNil(t, err)
+}
+
+func TestJwksAuthKeySetFromUrl(t *testing.T) {
+	firstKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	secondKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	var mu sync.Mutex
+	fetches := 0
+	jwks := Jwks{Keys: []*Jwk{createJwk("first-key", &firstKey.PublicKey)}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		fetches++
+		json.NewEncoder(w).Encode(jwks)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	keys := NewJwksAuthKeySet(server.URL)
+	keys.now = func() time.Time { return now }
+	keys.fetchedAt = now
+
+	key, err := keys.Key("first-key")
+	require.Nil(t, err)
+	assert.True(t, firstKey.PublicKey.Equal(key))
+	assert.Equal(t, 1, fetches)
+
+	// the signing key is rotated
+	mu.Lock()
+	jwks = Jwks{Keys: []*Jwk{createJwk("second-key", &secondKey.PublicKey)}}
+	mu.Unlock()
+
+	// unknown keys are not fetched again before the min refresh interval
+	_, err = keys.Key("second-key")
+	assert.True(t, errors.Is(err, ErrUnknownAuthKey))
+	assert.Equal(t, 1, fetches)
+
+	now = now.Add(jwksMinRefreshInterval)
+
+	key, err = keys.Key("second-key")
+	require.Nil(t, err)
+	assert.True(t, secondKey.PublicKey.Equal(key))
+	assert.Equal(t, 2, fetches)
+
+	_, err = keys.Key("first-key")
+	assert.True(t, errors.Is(err, ErrUnknownAuthKey))
+	assert.Equal(t, 2, fetches)
+}
+
+func TestJwksAuthKeySetFromFile(t *testing.T) {
+	firstKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	secondKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	writeJwks(t, file, Jwks{Keys: []*Jwk{createJwk("first-key", &firstKey.PublicKey)}})
+
+	now := time.Now()
+	keys := NewJwksAuthKeySet(file)
+	keys.now = func() time.Time { return now }
+	keys.fetchedAt = now
+
+	key, err := keys.Key("first-key")
+	require.Nil(t, err)
+	assert.True(t, firstKey.PublicKey.Equal(key))
+
+	// a new key is added while the old one still signs in-flight tokens
+	writeJwks(t, file, Jwks{Keys: []*Jwk{
+		createJwk("first-key", &firstKey.PublicKey),
+		createJwk("second-key", &secondKey.PublicKey),
+	}})
+
+	now = now.Add(jwksMinRefreshInterval)
+
+	key, err = keys.Key("second-key")
+	require.Nil(t, err)
+	assert.True(t, secondKey.PublicKey.Equal(key))
+
+	key, err = keys.Key("first-key")
+	require.Nil(t, err)
+	assert.True(t, firstKey.PublicKey.Equal(key))
+
+	// an invalid set keeps the loaded keys
+	err = os.WriteFile(file, []byte("invalid"), 0o600)
+	require.Nil(t, err)
+
+	now = now.Add(jwksMinRefreshInterval)
+
+	_, err = keys.Key("third-key")
+	assert.True(t, errors.Is(err, ErrUnknownAuthKey))
+
+	key, err = keys.Key("second-key")
+	require.Nil(t, err)
+	assert.True(t, secondKey.PublicKey.Equal(key))
+}
+
+func TestJwksAuthKeySetRun(t *testing.T) {
+	firstKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	secondKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	writeJwks(t, file, Jwks{Keys: []*Jwk{createJwk("first-key", &firstKey.PublicKey)}})
+
+	keys := NewJwksAuthKeySet(file)
+	keys.interval = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		keys.Run(ctx)
+		close(done)
+	}()
+
+	// the rotated key is fetched in the background, as the lookups are within the min refresh interval
+	writeJwks(t, file, Jwks{Keys: []*Jwk{createJwk("second-key", &secondKey.PublicKey)}})
+
+	assert.Eventually(t, func() bool {
+		_, err := keys.Key("second-key")
+		return err == nil
+	}, time.Second, time.Millisecond)
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("key set did not stop")
+	}
+}
+
+func TestJwksAuthKeySetUnavailable(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	file := filepath.Join(t.TempDir(), "jwks.json")
+
+	now := time.Now()
+	keys := NewJwksAuthKeySet(file)
+	keys.now = func() time.Time { return now }
+	keys.fetchedAt = now
+
+	_, err = keys.Key("signing-key")
+	assert.True(t, errors.Is(err, ErrUnknownAuthKey))
+
+	writeJwks(t, file, Jwks{Keys: []*Jwk{createJwk("signing-key", &privateKey.PublicKey)}})
+	now = now.Add(jwksMinRefreshInterval)
+
+	key, err := keys.Key("signing-key")
+	require.Nil(t, err)
+	assert.True(t, privateKey.PublicKey.Equal(key))
+}
+
+func createJwk(kid string, key *rsa.PublicKey) *Jwk {
+	return &Jwk{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func writeJwks(t *testing.T, file string, jwks Jwks) {
+	data, err := json.Marshal(jwks)
+	require.Nil(t, err)
+
+	err = os.WriteFile(file, data, 0o600)
+	require.Nil(t, err)
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+const binReloadInterval = 10 * time.Second
+
+// DefaultBinRanges has the more specific ranges of Elo and Hipercard overlap the ones of Visa,
+// Mastercard and Diners.
+func DefaultBinRanges() []*entity.BinRange {
+	return []*entity.BinRange{
+		{Start: "4", End: "4", Brand: "VISA"},
+		{Start: "51", End: "55", Brand: "MASTERCARD"},
+		{Start: "2221", End: "2720", Brand: "MASTERCARD"},
+		{Start: "34", End: "34", Brand: "AMERICAN EXPRESS"},
+		{Start: "37", End: "37", Brand: "AMERICAN EXPRESS"},
+		{Start: "300", End: "305", Brand: "DINERS CLUB"},
+		{Start: "36", End: "36", Brand: "DINERS CLUB"},
+		{Start: "38", End: "39", Brand: "DINERS CLUB"},
+		{Start: "6011", End: "6011", Brand: "DISCOVER"},
+		{Start: "644", End: "649", Brand: "DISCOVER"},
+		{Start: "65", End: "65", Brand: "DISCOVER"},
+		{Start: "3528", End: "3589", Brand: "JCB"},
+		{Start: "401178", End: "401179", Brand: "ELO", Country: "BR"},
+		{Start: "431274", End: "431274", Brand: "ELO", Country: "BR"},
+		{Start: "438935", End: "438935", Brand: "ELO", Country: "BR"},
+		{Start: "451416", End: "451416", Brand: "ELO", Country: "BR"},
+		{Start: "457393", End: "457393", Brand: "ELO", Country: "BR"},
+		{Start: "457631", End: "457632", Brand: "ELO", Country: "BR"},
+		{Start: "504175", End: "504175", Brand: "ELO", Country: "BR"},
+		{Start: "506699", End: "506778", Brand: "ELO", Country: "BR"},
+		{Start: "509000", End: "509999", Brand: "ELO", Country: "BR"},
+		{Start: "627780", End: "627780", Brand: "ELO", Country: "BR"},
+		{Start: "636297", End: "636297", Brand: "ELO", Country: "BR"},
+		{Start: "636368", End: "636368", Brand: "ELO", Country: "BR"},
+		{Start: "650031", End: "650033", Brand: "ELO", Country: "BR"},
+		{Start: "650035", End: "650051", Brand: "ELO", Country: "BR"},
+		{Start: "650405", End: "650439", Brand: "ELO", Country: "BR"},
+		{Start: "650485", End: "650538", Brand: "ELO", Country: "BR"},
+		{Start: "650541", End: "650598", Brand: "ELO", Country: "BR"},
+		{Start: "650700", End: "650718", Brand: "ELO", Country: "BR"},
+		{Start: "650720", End: "650727", Brand: "ELO", Country: "BR"},
+		{Start: "650901", End: "650978", Brand: "ELO", Country: "BR"},
+		{Start: "651652", End: "651679", Brand: "ELO", Country: "BR"},
+		{Start: "655000", End: "655019", Brand: "ELO", Country: "BR"},
+		{Start: "655021", End: "655058", Brand: "ELO", Country: "BR"},
+		{Start: "606282", End: "606282", Brand: "HIPERCARD", Country: "BR"},
+		{Start: "3841", End: "3841", Brand: "HIPERCARD", Country: "BR"},
+	}
+}
+
+func LoadBinRanges(path string) ([]*entity.BinRange, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bin table: %w", err)
+	}
+
+	var ranges []*entity.BinRange
+	err = json.Unmarshal(data, &ranges)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bin table: %w", err)
+	}
+
+	for _, r := range ranges {
+		err = r.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate bin range %s-%s: %w", r.Start, r.End, err)
+		}
+	}
+
+	return ranges, nil
+}
+
+// BinService reloads its table file after it changes, so the table can be updated without a restart.
+type BinService struct {
+	mu        sync.Mutex
+	ranges    []*entity.BinRange
+	path      string
+	modTime   time.Time
+	checkedAt time.Time
+	now       func() time.Time
+}
+
+func NewBinService(ranges []*entity.BinRange) *BinService {
+	return &BinService{
+		ranges: ranges,
+		now:    time.Now,
+	}
+}
+
+func NewFileBinService(path string) (*BinService, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bin table: %w", err)
+	}
+
+	ranges, err := LoadBinRanges(path)
+	if err != nil {
+		return nil, err
+	}
+
+	service := NewBinService(ranges)
+	service.path = path
+	service.modTime = info.ModTime()
+	service.checkedAt = service.now()
+
+	return service, nil
+}
+
+func (s *BinService) FindBin(ctx context.Context, number string) (*entity.BinRange, error) {
+	s.mu.Lock()
+	s.refresh()
+	ranges := s.ranges
+	s.mu.Unlock()
+
+	return entity.FindBinRange(ranges, number), nil
+}
+
+func (s *BinService) refresh() {
+	now := s.now()
+	if s.path == "" || now.Sub(s.checkedAt) < binReloadInterval {
+		return
+	}
+	s.checkedAt = now
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		slog.Error(err.Error())
+		return
+	}
+
+	if info.ModTime().Equal(s.modTime) {
+		return
+	}
+
+	ranges, err := LoadBinRanges(s.path)
+	if err != nil {
+		slog.Error(err.Error())
+		return
+	}
+
+	s.ranges = ranges
+	s.modTime = info.ModTime()
+	slog.Info("bin table reloaded", "ranges", len(ranges))
+}
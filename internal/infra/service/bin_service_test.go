@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultBinRanges(t *testing.T) {
+	ctx := context.Background()
+	service := NewBinService(DefaultBinRanges())
+
+	for _, r := range DefaultBinRanges() {
+		assert.Nil(t, r.Validate())
+	}
+
+	testCases := []struct {
+		Number string
+		Brand  string
+	}{
+		{"4111111111111111", "VISA"},
+		{"5555555555554444", "MASTERCARD"},
+		{"2223000048400011", "MASTERCARD"},
+		{"378282246310005", "AMERICAN EXPRESS"},
+		{"36227206271667", "DINERS CLUB"},
+		{"6011111111111117", "DISCOVER"},
+		{"3530111333300000", "JCB"},
+		{"4389351111111111", "ELO"},
+		{"6362970000457013", "ELO"},
+		{"5067001111111111", "ELO"},
+		{"6062825624254001", "HIPERCARD"},
+		{"3841001111222233334", "HIPERCARD"},
+	}
+
+	for _, tc := range testCases {
+		binRange, err := service.FindBin(ctx, tc.Number)
+		require.Nil(t, err)
+		require.NotNil(t, binRange, tc.Number)
+		assert.Equal(t, tc.Brand, binRange.Brand, tc.Number)
+	}
+
+	binRange, err := service.FindBin(ctx, "9111111111111119")
+	require.Nil(t, err)
+	assert.Nil(t, binRange)
+}
+
+func TestFileBinService(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "bins.json")
+
+	err := os.WriteFile(path, []byte(`[{"start": "4", "end": "4", "brand": "VISA"}]`), 0600)
+	require.Nil(t, err)
+
+	service, err := NewFileBinService(path)
+	require.Nil(t, err)
+
+	now := time.Now()
+	service.now = func() time.Time { return now }
+
+	binRange, err := service.FindBin(ctx, "4111111111111111")
+	require.Nil(t, err)
+	assert.Equal(t, "VISA", binRange.Brand)
+	assert.Empty(t, binRange.FundingType)
+
+	err = os.WriteFile(path, []byte(`[
+		{"start": "4", "end": "4", "brand": "VISA"},
+		{"start": "411111", "end": "411111", "brand": "VISA", "funding_type": "prepaid", "country": "US"}
+	]`), 0600)
+	require.Nil(t, err)
+	err = os.Chtimes(path, now, now.Add(time.Minute))
+	require.Nil(t, err)
+
+	binRange, err = service.FindBin(ctx, "4111111111111111")
+	require.Nil(t, err)
+	assert.Empty(t, binRange.FundingType, "the file is only checked after the reload interval")
+
+	now = now.Add(binReloadInterval)
+
+	binRange, err = service.FindBin(ctx, "4111111111111111")
+	require.Nil(t, err)
+	assert.Equal(t, entity.FundingTypePrepaid, binRange.FundingType)
+	assert.Equal(t, "US", binRange.Country)
+
+	err = os.WriteFile(path, []byte(`[{"start": "4", "end": "3", "brand": "VISA"}]`), 0600)
+	require.Nil(t, err)
+	err = os.Chtimes(path, now, now.Add(2*time.Minute))
+	require.Nil(t, err)
+	now = now.Add(binReloadInterval)
+
+	binRange, err = service.FindBin(ctx, "4111111111111111")
+	require.Nil(t, err)
+	assert.Equal(t, entity.FundingTypePrepaid, binRange.FundingType, "an invalid table is not loaded")
+
+	_, err = NewFileBinService(filepath.Join(t.TempDir(), "missing.json"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+var ErrCircuitOpen = errors.New("acquirer circuit is open")
+
+// CircuitBreakerConfig rates are measured over the last WindowSize calls once there are at
+// least MinRequests.
+type CircuitBreakerConfig struct {
+	WindowSize       int
+	MinRequests      int
+	ErrorRate        float64
+	SlowCallDuration time.Duration
+	SlowCallRate     float64
+	OpenTimeout      time.Duration
+	HalfOpenRequests int
+}
+
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		WindowSize:       20,
+		MinRequests:      10,
+		ErrorRate:        0.5,
+		SlowCallDuration: 5 * time.Second,
+		SlowCallRate:     0.8,
+		OpenTimeout:      30 * time.Second,
+		HalfOpenRequests: 3,
+	}
+}
+
+// LoadCircuitBreakerConfigs reads durations in milliseconds and keeps the defaults of omitted fields.
+func LoadCircuitBreakerConfigs(path string) (map[string]CircuitBreakerConfig, error) {
+	type fileConfig struct {
+		WindowSize       *int     `json:"window_size"`
+		MinRequests      *int     `json:"min_requests"`
+		ErrorRate        *float64 `json:"error_rate"`
+		SlowCallMs       *int64   `json:"slow_call_ms"`
+		SlowCallRate     *float64 `json:"slow_call_rate"`
+		OpenMs           *int64   `json:"open_ms"`
+		HalfOpenRequests *int     `json:"half_open_requests"`
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read circuit breaker configs: %w", err)
+	}
+
+	var files map[string]*fileConfig
+	err = json.Unmarshal(data, &files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode circuit breaker configs: %w", err)
+	}
+
+	configs := make(map[string]CircuitBreakerConfig, len(files))
+	for acquirer, file := range files {
+		config := DefaultCircuitBreakerConfig()
+
+		if file.WindowSize != nil {
+			config.WindowSize = *file.WindowSize
+		}
+		if file.MinRequests != nil {
+			config.MinRequests = *file.MinRequests
+		}
+		if file.ErrorRate != nil {
+			config.ErrorRate = *file.ErrorRate
+		}
+		if file.SlowCallMs != nil {
+			config.SlowCallDuration = time.Duration(*file.SlowCallMs) * time.Millisecond
+		}
+		if file.SlowCallRate != nil {
+			config.SlowCallRate = *file.SlowCallRate
+		}
+		if file.OpenMs != nil {
+			config.OpenTimeout = time.Duration(*file.OpenMs) * time.Millisecond
+		}
+		if file.HalfOpenRequests != nil {
+			config.HalfOpenRequests = *file.HalfOpenRequests
+		}
+
+		if config.WindowSize <= 0 || config.MinRequests <= 0 || config.MinRequests > config.WindowSize ||
+			config.HalfOpenRequests <= 0 {
+			return nil, fmt.Errorf("circuit breaker config of %s is invalid", acquirer)
+		}
+
+		configs[acquirer] = config
+	}
+
+	return configs, nil
+}
+
+type call struct {
+	failed bool
+	slow   bool
+}
+
+// CircuitBreaker lets a few probe calls through once its timeout elapses, closing again when
+// all of them succeed.
+type CircuitBreaker struct {
+	mu     sync.Mutex
+	config CircuitBreakerConfig
+	now    func() time.Time
+
+	state    entity.CircuitState
+	openedAt time.Time
+	window   []call
+	next     int
+	probes   int
+	passed   int
+
+	requests   int64
+	failures   int64
+	slowCalls  int64
+	rejections int64
+}
+
+func NewCircuitBreaker(config CircuitBreakerConfig) *CircuitBreaker {
+	return &CircuitBreaker{
+		config: config,
+		now:    time.Now,
+		state:  entity.CircuitStateClosed,
+		window: make([]call, 0, config.WindowSize),
+	}
+}
+
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.expire()
+
+	switch b.state {
+	case entity.CircuitStateOpen:
+		b.rejections++
+		return ErrCircuitOpen
+	case entity.CircuitStateHalfOpen:
+		if b.probes >= b.config.HalfOpenRequests {
+			b.rejections++
+			return ErrCircuitOpen
+		}
+		b.probes++
+	}
+
+	return nil
+}
+
+func (b *CircuitBreaker) Record(failed bool, duration time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	slow := b.config.SlowCallDuration > 0 && duration >= b.config.SlowCallDuration
+
+	b.requests++
+	if failed {
+		b.failures++
+	}
+	if slow {
+		b.slowCalls++
+	}
+
+	if b.state == entity.CircuitStateHalfOpen {
+		if failed || slow {
+			b.open()
+			return
+		}
+
+		b.passed++
+		if b.passed >= b.config.HalfOpenRequests {
+			b.close()
+		}
+		return
+	}
+
+	if b.state != entity.CircuitStateClosed {
+		return
+	}
+
+	c := call{failed: failed, slow: slow}
+	if len(b.window) < b.config.WindowSize {
+		b.window = append(b.window, c)
+	} else {
+		b.window[b.next] = c
+		b.next = (b.next + 1) % b.config.WindowSize
+	}
+
+	if len(b.window) < b.config.MinRequests {
+		return
+	}
+
+	errorRate, slowCallRate := b.rates()
+	if (b.config.ErrorRate > 0 && errorRate >= b.config.ErrorRate) ||
+		(b.config.SlowCallRate > 0 && slowCallRate >= b.config.SlowCallRate) {
+		b.open()
+	}
+}
+
+// Release gives back the probe of a call canceled by the caller, so another call can probe.
+func (b *CircuitBreaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == entity.CircuitStateHalfOpen && b.probes > 0 {
+		b.probes--
+	}
+}
+
+func (b *CircuitBreaker) Available() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.expire()
+	return b.state == entity.CircuitStateClosed ||
+		(b.state == entity.CircuitStateHalfOpen && b.probes < b.config.HalfOpenRequests)
+}
+
+func (b *CircuitBreaker) Health(acquirer string) *entity.AcquirerHealth {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.expire()
+	errorRate, slowCallRate := b.rates()
+
+	return &entity.AcquirerHealth{
+		Acquirer:     acquirer,
+		State:        b.state,
+		Requests:     b.requests,
+		Failures:     b.failures,
+		SlowCalls:    b.slowCalls,
+		Rejections:   b.rejections,
+		ErrorRate:    errorRate,
+		SlowCallRate: slowCallRate,
+		OpenedAt:     b.openedAt,
+	}
+}
+
+func (b *CircuitBreaker) expire() {
+	if b.state == entity.CircuitStateOpen && b.now().Sub(b.openedAt) >= b.config.OpenTimeout {
+		b.state = entity.CircuitStateHalfOpen
+		b.probes = 0
+		b.passed = 0
+	}
+}
+
+func (b *CircuitBreaker) open() {
+	b.state = entity.CircuitStateOpen
+	b.openedAt = b.now()
+}
+
+func (b *CircuitBreaker) close() {
+	b.state = entity.CircuitStateClosed
+	b.openedAt = time.Time{}
+	b.window = b.window[:0]
+	b.next = 0
+}
+
+func (b *CircuitBreaker) rates() (float64, float64) {
+	if len(b.window) == 0 {
+		return 0, 0
+	}
+
+	var failed, slow int
+	for _, c := range b.window {
+		if c.failed {
+			failed++
+		}
+		if c.slow {
+			slow++
+		}
+	}
+
+	total := float64(len(b.window))
+	return float64(failed) / total, float64(slow) / total
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	config := CircuitBreakerConfig{
+		WindowSize:       4,
+		MinRequests:      2,
+		ErrorRate:        0.5,
+		SlowCallDuration: time.Second,
+		SlowCallRate:     0.75,
+		OpenTimeout:      time.Minute,
+		HalfOpenRequests: 2,
+	}
+
+	now := time.Now()
+	createBreaker := func() *CircuitBreaker {
+		breaker := NewCircuitBreaker(config)
+		breaker.now = func() time.Time { return now }
+		return breaker
+	}
+
+	t.Run("stays closed below the minimum requests", func(t *testing.T) {
+		breaker := createBreaker()
+		require.Nil(t, breaker.Allow())
+		breaker.Record(true, time.Millisecond)
+
+		assert.True(t, breaker.Available())
+		assert.Equal(t, entity.CircuitStateClosed, breaker.Health("cielo").State)
+	})
+
+	t.Run("opens when the error rate is reached", func(t *testing.T) {
+		breaker := createBreaker()
+		breaker.Record(false, time.Millisecond)
+		breaker.Record(true, time.Millisecond)
+
+		health := breaker.Health("cielo")
+		assert.Equal(t, entity.CircuitStateOpen, health.State)
+		assert.Equal(t, 0.5, health.ErrorRate)
+		assert.Equal(t, now, health.OpenedAt)
+		assert.False(t, breaker.Available())
+
+		assert.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+		assert.Equal(t, int64(1), breaker.Health("cielo").Rejections)
+	})
+
+	t.Run("opens when the slow call rate is reached", func(t *testing.T) {
+		breaker := createBreaker()
+		breaker.Record(false, time.Millisecond)
+		breaker.Record(false, 2*time.Second)
+		breaker.Record(false, 2*time.Second)
+		assert.Equal(t, entity.CircuitStateClosed, breaker.Health("cielo").State)
+
+		breaker.Record(false, 2*time.Second)
+		health := breaker.Health("cielo")
+		assert.Equal(t, entity.CircuitStateOpen, health.State)
+		assert.Equal(t, 0.75, health.SlowCallRate)
+		assert.Equal(t, int64(3), health.SlowCalls)
+	})
+
+	t.Run("forgets calls outside the window", func(t *testing.T) {
+		breaker := createBreaker()
+		breaker.Record(false, time.Millisecond)
+		breaker.Record(false, time.Millisecond)
+		breaker.Record(false, time.Millisecond)
+		breaker.Record(true, time.Millisecond)
+		breaker.Record(false, time.Millisecond)
+
+		health := breaker.Health("cielo")
+		assert.Equal(t, entity.CircuitStateClosed, health.State)
+		assert.Equal(t, 0.25, health.ErrorRate)
+		assert.Equal(t, int64(5), health.Requests)
+		assert.Equal(t, int64(1), health.Failures)
+	})
+
+	t.Run("closes after the half-open probes succeed", func(t *testing.T) {
+		breaker := createBreaker()
+		breaker.Record(true, time.Millisecond)
+		breaker.Record(true, time.Millisecond)
+		assert.Equal(t, entity.CircuitStateOpen, breaker.Health("cielo").State)
+
+		breaker.now = func() time.Time { return now.Add(time.Minute) }
+		assert.Equal(t, entity.CircuitStateHalfOpen, breaker.Health("cielo").State)
+
+		require.Nil(t, breaker.Allow())
+		require.Nil(t, breaker.Allow())
+		assert.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+		assert.False(t, breaker.Available())
+
+		breaker.Record(false, time.Millisecond)
+		assert.Equal(t, entity.CircuitStateHalfOpen, breaker.Health("cielo").State)
+
+		breaker.Record(false, time.Millisecond)
+		health := breaker.Health("cielo")
+		assert.Equal(t, entity.CircuitStateClosed, health.State)
+		assert.Equal(t, 0.0, health.ErrorRate)
+		assert.True(t, health.OpenedAt.IsZero())
+	})
+
+	t.Run("gives back the probe of a released call", func(t *testing.T) {
+		breaker := createBreaker()
+		breaker.Record(true, time.Millisecond)
+		breaker.Record(true, time.Millisecond)
+
+		breaker.now = func() time.Time { return now.Add(time.Minute) }
+		require.Nil(t, breaker.Allow())
+		require.Nil(t, breaker.Allow())
+		assert.False(t, breaker.Available())
+
+		breaker.Release()
+		assert.True(t, breaker.Available())
+		require.Nil(t, breaker.Allow())
+
+		breaker.Record(false, time.Millisecond)
+		breaker.Record(false, time.Millisecond)
+		assert.Equal(t, entity.CircuitStateClosed, breaker.Health("cielo").State)
+	})
+
+	t.Run("opens again when a half-open probe fails", func(t *testing.T) {
+		breaker := createBreaker()
+		breaker.Record(true, time.Millisecond)
+		breaker.Record(true, time.Millisecond)
+
+		later := now.Add(time.Minute)
+		breaker.now = func() time.Time { return later }
+		require.Nil(t, breaker.Allow())
+
+		breaker.Record(true, time.Millisecond)
+		health := breaker.Health("cielo")
+		assert.Equal(t, entity.CircuitStateOpen, health.State)
+		assert.Equal(t, later, health.OpenedAt)
+	})
+}
+
+func TestLoadCircuitBreakerConfigs(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("loads the configs over the defaults", func(t *testing.T) {
+		path := filepath.Join(dir, "breakers.json")
+		data := `{"cielo": {"error_rate": 0.2, "slow_call_ms": 1500, "open_ms": 10000}}`
+		require.Nil(t, os.WriteFile(path, []byte(data), 0o600))
+
+		configs, err := LoadCircuitBreakerConfigs(path)
+		require.Nil(t, err)
+
+		expected := DefaultCircuitBreakerConfig()
+		expected.ErrorRate = 0.2
+		expected.SlowCallDuration = 1500 * time.Millisecond
+		expected.OpenTimeout = 10 * time.Second
+		assert.Equal(t, map[string]CircuitBreakerConfig{"cielo": expected}, configs)
+	})
+
+	t.Run("fails with invalid configs", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		require.Nil(t, os.WriteFile(path, []byte(`{"rede": {"window_size": 5, "min_requests": 10}}`), 0o600))
+
+		configs, err := LoadCircuitBreakerConfigs(path)
+		assert.Nil(t, configs)
+		assert.ErrorContains(t, err, "circuit breaker config of rede is invalid")
+	})
+}
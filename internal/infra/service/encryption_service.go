@@ -0,0 +1,62 @@
+package service
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+var ErrInvalidCiphertext = errors.New("ciphertext is invalid")
+
+// AesEncryptionService encrypts data with AES-256-GCM, prefixing each ciphertext with its
+// random nonce.
+type AesEncryptionService struct {
+	aead cipher.AEAD
+}
+
+func NewAesEncryptionService(key []byte) (*AesEncryptionService, error) {
+	if len(key) != 32 {
+		return nil, errors.New("encryption key should have 32 bytes")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AesEncryptionService{
+		aead: aead,
+	}, nil
+}
+
+func (s *AesEncryptionService) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return s.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (s *AesEncryptionService) Decrypt(ciphertext []byte) ([]byte, error) {
+	size := s.aead.NonceSize()
+	if len(ciphertext) < size {
+		return nil, core_errors.NewInternalError(ErrInvalidCiphertext)
+	}
+
+	plaintext, err := s.aead.Open(nil, ciphertext[:size], ciphertext[size:], nil)
+	if err != nil {
+		return nil, core_errors.NewInternalError(err)
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAesEncryptionService(t *testing.T) {
+	_, err := NewAesEncryptionService([]byte("short key"))
+	assert.NotNil(t, err)
+
+	service, err := NewAesEncryptionService(bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+
+	first, err := service.Encrypt([]byte("4111111111111111"))
+	require.Nil(t, err)
+	second, err := service.Encrypt([]byte("4111111111111111"))
+	require.Nil(t, err)
+	assert.NotEqual(t, first, second)
+	assert.NotContains(t, string(first), "4111111111111111")
+
+	plaintext, err := service.Decrypt(first)
+	require.Nil(t, err)
+	assert.Equal(t, "4111111111111111", string(plaintext))
+
+	first[len(first)-1] ^= 1
+	_, err = service.Decrypt(first)
+	assert.NotNil(t, err)
+
+	_, err = service.Decrypt([]byte("x"))
+	assert.ErrorIs(t, err, ErrInvalidCiphertext)
+}
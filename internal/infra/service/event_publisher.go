@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+)
+
+type LogEventPublisher struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewLogEventPublisher(w io.Writer) *LogEventPublisher {
+	return &LogEventPublisher{
+		w: w,
+	}
+}
+
+func NewFileEventPublisher(path string) (*LogEventPublisher, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLogEventPublisher(file), nil
+}
+
+func NewStdoutEventPublisher() *LogEventPublisher {
+	return NewLogEventPublisher(os.Stdout)
+}
+
+type EventPublisherConfig struct {
+	File string
+}
+
+func NewEventPublisher(config EventPublisherConfig) (*LogEventPublisher, error) {
+	if config.File == "" {
+		return NewStdoutEventPublisher(), nil
+	}
+
+	return NewFileEventPublisher(config.File)
+}
+
+func (p *LogEventPublisher) Publish(ctx context.Context, event *entity.DomainEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, err = p.w.Write(append(line, '\n'))
+	return err
+}
+
+type MemoryEventPublisher struct {
+	mu     sync.Mutex
+	events []*entity.DomainEvent
+	errs   []error
+}
+
+func NewMemoryEventPublisher() *MemoryEventPublisher {
+	return &MemoryEventPublisher{}
+}
+
+func (p *MemoryEventPublisher) Publish(ctx context.Context, event *entity.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.errs) > 0 {
+		err := p.errs[0]
+		p.errs = p.errs[1:]
+		return err
+	}
+
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *MemoryEventPublisher) FailNext(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.errs = append(p.errs, err)
+}
+
+func (p *MemoryEventPublisher) Events() []*entity.DomainEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return slices.Clone(p.events)
+}
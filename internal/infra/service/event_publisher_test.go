@@ -0,0 +1,102 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogEventPublisher(t *testing.T) {
+	ctx := context.Background()
+	event := createTestDomainEvent("payment.created")
+
+	var buffer bytes.Buffer
+	publisher := NewLogEventPublisher(&buffer)
+
+	err := publisher.Publish(ctx, event)
+	require.Nil(t, err)
+
+	var published map[string]any
+	err = json.Unmarshal(bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), &published)
+	require.Nil(t, err)
+	assert.Equal(t, event.Id, published["id"])
+	assert.Equal(t, float64(event.Sequence), published["sequence"])
+	assert.Equal(t, "payment.created", published["type"])
+	assert.Equal(t, event.PaymentId, published["payment_id"])
+	assert.Equal(t, map[string]any{"status": "pending"}, published["data"])
+}
+
+func TestFileEventPublisher(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+
+	publisher, err := NewFileEventPublisher(path)
+	require.Nil(t, err)
+
+	for _, eventType := range []string{"payment.created", "payment.approved"} {
+		err = publisher.Publish(ctx, createTestDomainEvent(eventType))
+		require.Nil(t, err)
+	}
+
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	lines := bytes.Split(bytes.TrimSuffix(data, []byte("\n")), []byte("\n"))
+	require.Equal(t, 2, len(lines))
+	assert.Contains(t, string(lines[0]), `"type":"payment.created"`)
+	assert.Contains(t, string(lines[1]), `"type":"payment.approved"`)
+}
+
+func TestNewEventPublisher(t *testing.T) {
+	publisher, err := NewEventPublisher(EventPublisherConfig{})
+	require.Nil(t, err)
+	assert.Equal(t, os.Stdout, publisher.w)
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	publisher, err = NewEventPublisher(EventPublisherConfig{File: path})
+	require.Nil(t, err)
+	assert.FileExists(t, path)
+
+	_, err = NewEventPublisher(EventPublisherConfig{File: filepath.Join(t.TempDir(), "missing", "events.jsonl")})
+	assert.NotNil(t, err)
+}
+
+func TestMemoryEventPublisher(t *testing.T) {
+	ctx := context.Background()
+	created := createTestDomainEvent("payment.created")
+	approved := createTestDomainEvent("payment.approved")
+
+	publisher := NewMemoryEventPublisher()
+	publisher.FailNext(errors.New("connection refused"))
+
+	err := publisher.Publish(ctx, created)
+	assert.EqualError(t, err, "connection refused")
+
+	for _, event := range []*entity.DomainEvent{created, approved} {
+		err = publisher.Publish(ctx, event)
+		require.Nil(t, err)
+	}
+
+	assert.Equal(t, []*entity.DomainEvent{created, approved}, publisher.Events())
+}
+
+func createTestDomainEvent(eventType string) *entity.DomainEvent {
+	return &entity.DomainEvent{
+		Id:        "Event Id " + eventType,
+		Sequence:  1,
+		Type:      eventType,
+		PaymentId: "Payment Id",
+		Payload:   []byte(`{"status":"pending"}`),
+		CreatedAt: time.Now().UTC(),
+	}
+}
@@ -0,0 +1,269 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+var ErrUnknownKeyVersion = errors.New("key version is unknown")
+
+type keystore struct {
+	CurrentVersion int            `json:"current_version"`
+	Keys           []*keystoreKey `json:"keys"`
+}
+
+type keystoreKey struct {
+	Version    int       `json:"version"`
+	WrappedKey []byte    `json:"wrapped_key"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// LocalKeyManager reloads the keystore file when it changes, so the instances sharing it encrypt
+// with the new key after a rotation made by another process.
+type LocalKeyManager struct {
+	mu       sync.Mutex
+	path     string
+	master   *AesEncryptionService
+	modTime  time.Time
+	current  int
+	dataKeys map[int]*AesEncryptionService
+}
+
+func NewLocalKeyManager(path string, masterKey []byte) (*LocalKeyManager, error) {
+	master, err := NewAesEncryptionService(masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load master key: %w", err)
+	}
+
+	manager := &LocalKeyManager{
+		path:     path,
+		master:   master,
+		dataKeys: make(map[int]*AesEncryptionService),
+	}
+
+	_, err = os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		_, err = manager.Rotate()
+		return manager, err
+	}
+
+	err = manager.reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
+
+func (m *LocalKeyManager) CurrentVersion() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.refresh()
+	return m.current
+}
+
+func (m *LocalKeyManager) Encrypt(plaintext []byte) ([]byte, int, error) {
+	m.mu.Lock()
+	m.refresh()
+	version := m.current
+	dataKey := m.dataKeys[version]
+	m.mu.Unlock()
+
+	ciphertext, err := dataKey.Encrypt(plaintext)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ciphertext, version, nil
+}
+
+func (m *LocalKeyManager) Decrypt(ciphertext []byte, version int) ([]byte, error) {
+	m.mu.Lock()
+	dataKey, ok := m.dataKeys[version]
+	if !ok {
+		m.refresh()
+		dataKey, ok = m.dataKeys[version]
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		return nil, core_errors.NewInternalError(fmt.Errorf("%w: %d", ErrUnknownKeyVersion, version))
+	}
+
+	return dataKey.Decrypt(ciphertext)
+}
+
+func (m *LocalKeyManager) Rotate() (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.refresh()
+
+	store, err := m.read()
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, err
+	}
+	if store == nil {
+		store = &keystore{}
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return 0, err
+	}
+
+	wrapped, err := m.master.Encrypt(key)
+	if err != nil {
+		return 0, err
+	}
+
+	version := 1
+	for _, k := range store.Keys {
+		if k.Version >= version {
+			version = k.Version + 1
+		}
+	}
+
+	store.CurrentVersion = version
+	store.Keys = append(store.Keys, &keystoreKey{
+		Version:    version,
+		WrappedKey: wrapped,
+		CreatedAt:  time.Now().UTC(),
+	})
+
+	err = m.write(store)
+	if err != nil {
+		return 0, err
+	}
+
+	err = m.load(store)
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (m *LocalKeyManager) refresh() {
+	info, err := os.Stat(m.path)
+	if err != nil || info.ModTime().Equal(m.modTime) {
+		return
+	}
+
+	store, err := m.read()
+	if err == nil {
+		err = m.load(store)
+	}
+	if err != nil {
+		return
+	}
+
+	m.modTime = info.ModTime()
+}
+
+func (m *LocalKeyManager) reload() error {
+	info, err := os.Stat(m.path)
+	if err != nil {
+		return fmt.Errorf("failed to read keystore: %w", err)
+	}
+
+	store, err := m.read()
+	if err != nil {
+		return err
+	}
+
+	err = m.load(store)
+	if err != nil {
+		return err
+	}
+
+	m.modTime = info.ModTime()
+	return nil
+}
+
+func (m *LocalKeyManager) read() (*keystore, error) {
+	data, err := os.ReadFile(m.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var store keystore
+	err = json.Unmarshal(data, &store)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse keystore: %w", err)
+	}
+
+	return &store, nil
+}
+
+// write replaces the keystore atomically, so a concurrent reader never sees a partial file.
+func (m *LocalKeyManager) write(store *keystore) error {
+	data, err := json.MarshalIndent(store, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(m.path), filepath.Base(m.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write keystore: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Chmod(0600)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write keystore: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), m.path)
+	if err != nil {
+		return fmt.Errorf("failed to write keystore: %w", err)
+	}
+
+	info, err := os.Stat(m.path)
+	if err == nil {
+		m.modTime = info.ModTime()
+	}
+
+	return nil
+}
+
+func (m *LocalKeyManager) load(store *keystore) error {
+	dataKeys := make(map[int]*AesEncryptionService, len(store.Keys))
+
+	for _, k := range store.Keys {
+		key, err := m.master.Decrypt(k.WrappedKey)
+		if err != nil {
+			return fmt.Errorf("failed to unwrap key version %d: %w", k.Version, err)
+		}
+
+		dataKey, err := NewAesEncryptionService(key)
+		if err != nil {
+			return fmt.Errorf("failed to unwrap key version %d: %w", k.Version, err)
+		}
+
+		dataKeys[k.Version] = dataKey
+	}
+
+	if _, ok := dataKeys[store.CurrentVersion]; !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownKeyVersion, store.CurrentVersion)
+	}
+
+	m.current = store.CurrentVersion
+	m.dataKeys = dataKeys
+	return nil
+}
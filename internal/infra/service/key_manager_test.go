@@ -0,0 +1,84 @@
+package service
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalKeyManager(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keystore.json")
+	masterKey := bytes.Repeat([]byte{1}, 32)
+
+	_, err := NewLocalKeyManager(path, []byte("short key"))
+	assert.NotNil(t, err)
+
+	manager, err := NewLocalKeyManager(path, masterKey)
+	require.Nil(t, err)
+	assert.Equal(t, 1, manager.CurrentVersion())
+
+	keystore, err := os.ReadFile(path)
+	require.Nil(t, err)
+	assert.Contains(t, string(keystore), `"current_version": 1`)
+
+	first, version, err := manager.Encrypt([]byte("4111111111111111"))
+	require.Nil(t, err)
+	assert.Equal(t, 1, version)
+
+	t.Run("rotate the key", func(t *testing.T) {
+		version, err := manager.Rotate()
+		require.Nil(t, err)
+		assert.Equal(t, 2, version)
+		assert.Equal(t, 2, manager.CurrentVersion())
+
+		second, version, err := manager.Encrypt([]byte("4111111111111111"))
+		require.Nil(t, err)
+		assert.Equal(t, 2, version)
+
+		plaintext, err := manager.Decrypt(first, 1)
+		require.Nil(t, err)
+		assert.Equal(t, "4111111111111111", string(plaintext))
+
+		plaintext, err = manager.Decrypt(second, 2)
+		require.Nil(t, err)
+		assert.Equal(t, "4111111111111111", string(plaintext))
+
+		_, err = manager.Decrypt(first, 2)
+		assert.NotNil(t, err)
+
+		_, err = manager.Decrypt(first, 3)
+		assert.ErrorIs(t, err, ErrUnknownKeyVersion)
+	})
+
+	t.Run("pick up a rotation made by another instance", func(t *testing.T) {
+		other, err := NewLocalKeyManager(path, masterKey)
+		require.Nil(t, err)
+		assert.Equal(t, 2, other.CurrentVersion())
+
+		// ensures the keystore modification time changes on coarse clocks
+		time.Sleep(10 * time.Millisecond)
+
+		version, err := other.Rotate()
+		require.Nil(t, err)
+		assert.Equal(t, 3, version)
+
+		assert.Equal(t, 3, manager.CurrentVersion())
+
+		third, version, err := other.Encrypt([]byte("4111111111111111"))
+		require.Nil(t, err)
+
+		plaintext, err := manager.Decrypt(third, version)
+		require.Nil(t, err)
+		assert.Equal(t, "4111111111111111", string(plaintext))
+	})
+
+	t.Run("wrong master key", func(t *testing.T) {
+		_, err := NewLocalKeyManager(path, bytes.Repeat([]byte{2}, 32))
+		assert.NotNil(t, err)
+	})
+}
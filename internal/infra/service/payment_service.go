@@ -2,12 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
+	"sort"
+	"time"
 
 	"github.com/sesaquecruz/go-payment-processor/internal/acquirer"
 	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
 	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	iservice "github.com/sesaquecruz/go-payment-processor/internal/core/service"
 )
 
 type PaymentOption func(*PaymentService)
@@ -24,25 +28,61 @@ func PaymentWithAcquirer(acquirer acquirer.IAcquirer) PaymentOption {
 	}
 }
 
+func PaymentWithCircuitBreaker(acquirerName string, config CircuitBreakerConfig) PaymentOption {
+	return func(s *PaymentService) {
+		s.breakerConfigs[acquirerName] = config
+	}
+}
+
+func PaymentWithTransport(acquirerName string, config TransportConfig) PaymentOption {
+	return func(s *PaymentService) {
+		s.transportConfigs[acquirerName] = config
+	}
+}
+
 type PaymentService struct {
-	httpClient *http.Client
-	acquirers  map[string]acquirer.IAcquirer
+	httpClient       *http.Client
+	acquirers        map[string]acquirer.IAcquirer
+	transportConfigs map[string]TransportConfig
+	clients          map[string]*http.Client
+	breakerConfigs   map[string]CircuitBreakerConfig
+	breakers         map[string]*CircuitBreaker
 }
 
 func NewPaymentService(options ...PaymentOption) *PaymentService {
 	service := &PaymentService{
-		httpClient: &http.Client{},
-		acquirers:  make(map[string]acquirer.IAcquirer),
+		acquirers:        make(map[string]acquirer.IAcquirer),
+		transportConfigs: make(map[string]TransportConfig),
+		clients:          make(map[string]*http.Client),
+		breakerConfigs:   make(map[string]CircuitBreakerConfig),
+		breakers:         make(map[string]*CircuitBreaker),
 	}
 
 	for _, option := range options {
 		option(service)
 	}
 
+	for name := range service.acquirers {
+		if transportConfig, ok := service.transportConfigs[name]; ok {
+			service.clients[name] = NewHttpClient(transportConfig)
+		} else if service.httpClient != nil {
+			service.clients[name] = service.httpClient
+		} else {
+			service.clients[name] = NewHttpClient(DefaultTransportConfig())
+		}
+
+		breakerConfig, ok := service.breakerConfigs[name]
+		if !ok {
+			breakerConfig = DefaultCircuitBreakerConfig()
+		}
+
+		service.breakers[name] = NewCircuitBreaker(breakerConfig)
+	}
+
 	return service
 }
 
-func (s *PaymentService) ProcessTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.Payment, error) {
+func (s *PaymentService) ProcessTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.AcquirerResponse, error) {
 	acquirer, ok := s.acquirers[transaction.Acquirer.Name]
 	if !ok {
 		return nil, core_errors.NewNotFoundError("acquirer is invalid")
@@ -54,17 +94,130 @@ func (s *PaymentService) ProcessTransaction(ctx context.Context, transaction *en
 		return nil, err
 	}
 
-	response, err := s.httpClient.Do(request)
+	return s.send(acquirer.Name(), request, acquirer.ResponseExtractor)
+}
+
+func (s *PaymentService) CaptureTransaction(ctx context.Context, payment *entity.Payment, value entity.Money) (*entity.AcquirerResponse, error) {
+	acquirer, ok := s.acquirers[payment.Transaction.Acquirer.Name]
+	if !ok {
+		return nil, core_errors.NewNotFoundError("acquirer is invalid")
+	}
+
+	request, err := acquirer.CaptureRequestBuilder(ctx, payment, value)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	return s.send(acquirer.Name(), request, acquirer.CaptureResponseExtractor)
+}
+
+func (s *PaymentService) RefundTransaction(ctx context.Context, payment *entity.Payment, refund *entity.Refund) (*entity.AcquirerResponse, error) {
+	acquirer, ok := s.acquirers[payment.Transaction.Acquirer.Name]
+	if !ok {
+		return nil, core_errors.NewNotFoundError("acquirer is invalid")
+	}
+
+	request, err := acquirer.RefundRequestBuilder(ctx, payment, refund)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	return s.send(acquirer.Name(), request, acquirer.RefundResponseExtractor)
+}
+
+func (s *PaymentService) ReverseTransaction(ctx context.Context, reversal *entity.Reversal) (*entity.AcquirerResponse, error) {
+	acquirer, ok := s.acquirers[reversal.Acquirer]
+	if !ok {
+		return nil, core_errors.NewNotFoundError("acquirer is invalid")
+	}
+
+	request, err := acquirer.ReversalRequestBuilder(ctx, reversal)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	return s.send(acquirer.Name(), request, acquirer.ReversalResponseExtractor)
+}
+
+func (s *PaymentService) IsAvailable(acquirerName string) bool {
+	breaker, ok := s.breakers[acquirerName]
+	return ok && breaker.Available()
+}
+
+func (s *PaymentService) AcquirerHealth(ctx context.Context) []*entity.AcquirerHealth {
+	names := make([]string, 0, len(s.breakers))
+	for name := range s.breakers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	health := make([]*entity.AcquirerHealth, 0, len(names))
+	for _, name := range names {
+		health = append(health, s.breakers[name].Health(name))
+	}
+
+	return health
+}
+
+// send does not count declines as failures, since they are answers from a healthy acquirer,
+// and cancellations by the caller only give back their probe.
+func (s *PaymentService) send(
+	acquirerName string,
+	request *http.Request,
+	extractor func(*http.Response) (*entity.AcquirerResponse, error),
+) (*entity.AcquirerResponse, error) {
+	breaker := s.breakers[acquirerName]
+
+	err := breaker.Allow()
+	if err != nil {
+		slog.Error(err.Error(), "acquirer", acquirerName)
+		return nil, core_errors.NewUnavailableError(err)
+	}
+
+	start := time.Now()
+	result, err := s.do(s.clients[acquirerName], request, extractor)
+	if errors.Is(err, context.Canceled) {
+		breaker.Release()
+	} else {
+		var internalErr *core_errors.InternalError
+		breaker.Record(core_errors.IsTemporary(err) || errors.As(err, &internalErr), time.Since(start))
+	}
+
+	return result, err
+}
+
+func (s *PaymentService) do(
+	client *http.Client,
+	request *http.Request,
+	extractor func(*http.Response) (*entity.AcquirerResponse, error),
+) (*entity.AcquirerResponse, error) {
+	response, err := client.Do(request)
+	if err == nil || !isDialError(err) {
+		iservice.MarkSubmitted(request.Context())
+	}
+
 	if err != nil {
 		slog.Error(err.Error())
+		if isDialError(err) {
+			return nil, core_errors.NewUnavailableError(err)
+		}
+		if isTimeout(err) {
+			return nil, core_errors.NewTimeoutError("acquirer timed out")
+		}
 		return nil, core_errors.NewInternalError(err)
 	}
 
 	defer response.Body.Close()
-	payment, err := acquirer.ResponseExtractor(response)
+	result, err := extractor(response)
 	if err != nil {
 		slog.Error(err.Error())
+		if isTimeout(err) {
+			return nil, core_errors.NewTimeoutError("acquirer timed out")
+		}
 	}
 
-	return payment, err
+	return result, err
 }
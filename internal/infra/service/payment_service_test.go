@@ -50,14 +50,14 @@ func (s *PaymentServiceTestSuite) TestAcquirerCielo() {
 	acquirer := "cielo"
 
 	s.T().Run("process the transaction successfully", func(t *testing.T) {
-		transaction := createTransaction(acquirer, 100)
-		payment, err := s.paymentService.ProcessTransaction(s.ctx, transaction)
+		transaction := createTransaction(acquirer, 10000)
+		result, err := s.paymentService.ProcessTransaction(s.ctx, transaction)
 		require.Nil(t, err)
-		assert.NotEmpty(t, payment.Id)
+		assert.NotEmpty(t, result.Id)
 	})
 
 	s.T().Run("fails to process the transaction", func(t *testing.T) {
-		transaction := createTransaction(acquirer, 101)
+		transaction := createTransaction(acquirer, 10100)
 		_, err := s.paymentService.ProcessTransaction(s.ctx, transaction)
 		require.NotNil(t, err)
 
@@ -72,14 +72,14 @@ func (s *PaymentServiceTestSuite) TestAcquirerRede() {
 	acquirer := "rede"
 
 	s.T().Run("process the transaction successfully", func(t *testing.T) {
-		transaction := createTransaction(acquirer, 500)
-		payment, err := s.paymentService.ProcessTransaction(s.ctx, transaction)
+		transaction := createTransaction(acquirer, 50000)
+		result, err := s.paymentService.ProcessTransaction(s.ctx, transaction)
 		require.Nil(t, err)
-		assert.NotEmpty(t, payment.Id)
+		assert.NotEmpty(t, result.Id)
 	})
 
 	s.T().Run("fails to process the transaction", func(t *testing.T) {
-		transaction := createTransaction(acquirer, 501)
+		transaction := createTransaction(acquirer, 50100)
 		_, err := s.paymentService.ProcessTransaction(s.ctx, transaction)
 		require.NotNil(t, err)
 
@@ -94,14 +94,14 @@ func (s *PaymentServiceTestSuite) TestAcquirerStone() {
 	acquirer := "stone"
 
 	s.T().Run("process the transaction successfully", func(t *testing.T) {
-		transaction := createTransaction(acquirer, 1000)
-		payment, err := s.paymentService.ProcessTransaction(s.ctx, transaction)
+		transaction := createTransaction(acquirer, 100000)
+		result, err := s.paymentService.ProcessTransaction(s.ctx, transaction)
 		require.Nil(t, err)
-		assert.NotEmpty(t, payment.Id)
+		assert.NotEmpty(t, result.Id)
 	})
 
 	s.T().Run("fails to process the transaction", func(t *testing.T) {
-		transaction := createTransaction(acquirer, 1001)
+		transaction := createTransaction(acquirer, 100100)
 		_, err := s.paymentService.ProcessTransaction(s.ctx, transaction)
 		require.NotNil(t, err)
 
@@ -112,6 +112,108 @@ func (s *PaymentServiceTestSuite) TestAcquirerStone() {
 	})
 }
 
+func (s *PaymentServiceTestSuite) TestCaptures() {
+	for _, acquirer := range []string{"cielo", "rede", "stone"} {
+		s.T().Run(acquirer+" captures the authorization partially", func(t *testing.T) {
+			payment := createAuthorizedPayment(t, s, acquirer, 10000)
+
+			result, err := s.paymentService.CaptureTransaction(s.ctx, payment, entity.NewMoney(6000, "BRL"))
+			require.Nil(t, err)
+			assert.NotEmpty(t, result.Id)
+			payment.Capture(entity.NewMoney(6000, "BRL"))
+
+			refund := entity.NewRefund(payment.Id, entity.RefundTypeRefund, entity.NewMoney(6100, "BRL"))
+			_, err = s.paymentService.RefundTransaction(s.ctx, payment, refund)
+			require.NotNil(t, err)
+
+			var e *errors.AcquirerError
+			require.ErrorAs(t, err, &e)
+			assert.Equal(t, "the refund value should not exceed the transaction value", e.Message)
+		})
+
+		s.T().Run(acquirer+" voids the authorization", func(t *testing.T) {
+			payment := createAuthorizedPayment(t, s, acquirer, 10000)
+
+			refund := entity.NewRefund(payment.Id, entity.RefundTypeVoid, entity.NewMoney(10000, "BRL"))
+			_, err := s.paymentService.RefundTransaction(s.ctx, payment, refund)
+			require.Nil(t, err)
+
+			_, err = s.paymentService.CaptureTransaction(s.ctx, payment, entity.NewMoney(10000, "BRL"))
+			require.NotNil(t, err)
+
+			var e *errors.AcquirerError
+			require.ErrorAs(t, err, &e)
+			assert.Equal(t, "the transaction was voided", e.Message)
+		})
+	}
+}
+
+func (s *PaymentServiceTestSuite) TestRefunds() {
+	for _, acquirer := range []string{"cielo", "rede", "stone"} {
+		s.T().Run(acquirer+" refunds the transaction partially", func(t *testing.T) {
+			payment := createApprovedPayment(t, s, acquirer, 10000)
+
+			refund := entity.NewRefund(payment.Id, entity.RefundTypeRefund, entity.NewMoney(4000, "BRL"))
+			result, err := s.paymentService.RefundTransaction(s.ctx, payment, refund)
+			require.Nil(t, err)
+			assert.NotEmpty(t, result.Id)
+
+			refund = entity.NewRefund(payment.Id, entity.RefundTypeRefund, entity.NewMoney(6100, "BRL"))
+			_, err = s.paymentService.RefundTransaction(s.ctx, payment, refund)
+			require.NotNil(t, err)
+
+			var e *errors.AcquirerError
+			require.ErrorAs(t, err, &e)
+			assert.Equal(t, http.StatusUnprocessableEntity, e.Code)
+			assert.Equal(t, "the refund value should not exceed the transaction value", e.Message)
+		})
+
+		s.T().Run(acquirer+" voids the transaction", func(t *testing.T) {
+			payment := createApprovedPayment(t, s, acquirer, 10000)
+
+			refund := entity.NewRefund(payment.Id, entity.RefundTypeVoid, entity.NewMoney(10000, "BRL"))
+			result, err := s.paymentService.RefundTransaction(s.ctx, payment, refund)
+			require.Nil(t, err)
+			assert.NotEmpty(t, result.Id)
+		})
+	}
+}
+
+func (s *PaymentServiceTestSuite) TestReversals() {
+	for _, acquirer := range []string{"cielo", "rede", "stone"} {
+		s.T().Run(acquirer+" reverses a processed transaction", func(t *testing.T) {
+			payment := entity.NewPayment(createTransaction(acquirer, 10000))
+			attempt := payment.AddAttempt(acquirer)
+			payment.Transaction.Reference = attempt.Id
+
+			_, err := s.paymentService.ProcessTransaction(s.ctx, payment.Transaction)
+			require.Nil(t, err)
+
+			result, err := s.paymentService.ReverseTransaction(s.ctx, entity.NewReversal(payment, attempt))
+			require.Nil(t, err)
+			assert.NotEmpty(t, result.Id)
+		})
+
+		s.T().Run(acquirer+" does not find an unprocessed transaction", func(t *testing.T) {
+			payment := entity.NewPayment(createTransaction(acquirer, 10000))
+			attempt := payment.AddAttempt(acquirer)
+
+			_, err := s.paymentService.ReverseTransaction(s.ctx, entity.NewReversal(payment, attempt))
+			require.NotNil(t, err)
+
+			var e *errors.AcquirerError
+			require.ErrorAs(t, err, &e)
+			assert.Equal(t, http.StatusNotFound, e.Code)
+			assert.Equal(t, "the transaction was not found", e.Message)
+
+			payment.Transaction.Reference = attempt.Id
+			_, err = s.paymentService.ProcessTransaction(s.ctx, payment.Transaction)
+			require.ErrorAs(t, err, &e)
+			assert.Equal(t, "the transaction was reversed", e.Message)
+		})
+	}
+}
+
 func (s *PaymentServiceTestSuite) TearDownSuite() {
 	if err := s.acquirerApp.Shutdown(); err != nil {
 		s.FailNow(err.Error())
@@ -122,10 +224,132 @@ func TestPaymentServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(PaymentServiceTestSuite))
 }
 
-func createTransaction(acquirerName string, value float64) *entity.Transaction {
+func TestPaymentServiceCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+
+	paymentService := NewPaymentService(
+		PaymentWithAcquirer(acquirer.NewCielo("http://127.0.0.1:1/cielo", "cielo-api-key")),
+		PaymentWithAcquirer(acquirer.NewRede("http://127.0.0.1:1/rede", "rede-api-key")),
+		PaymentWithCircuitBreaker("cielo", CircuitBreakerConfig{
+			WindowSize:       1,
+			MinRequests:      1,
+			ErrorRate:        1,
+			OpenTimeout:      time.Minute,
+			HalfOpenRequests: 1,
+		}),
+	)
+
+	_, err := paymentService.ProcessTransaction(ctx, createTransaction("cielo", 1000))
+	var unavailableErr *errors.UnavailableError
+	require.ErrorAs(t, err, &unavailableErr)
+	assert.NotErrorIs(t, err, ErrCircuitOpen)
+
+	_, err = paymentService.ProcessTransaction(ctx, createTransaction("cielo", 1000))
+	require.ErrorAs(t, err, &unavailableErr)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+
+	assert.False(t, paymentService.IsAvailable("cielo"))
+	assert.True(t, paymentService.IsAvailable("rede"))
+	assert.False(t, paymentService.IsAvailable("stone"))
+
+	health := paymentService.AcquirerHealth(ctx)
+	require.Equal(t, 2, len(health))
+	assert.Equal(t, "cielo", health[0].Acquirer)
+	assert.Equal(t, entity.CircuitStateOpen, health[0].State)
+	assert.Equal(t, int64(1), health[0].Requests)
+	assert.Equal(t, int64(1), health[0].Failures)
+	assert.Equal(t, int64(1), health[0].Rejections)
+	assert.Equal(t, "rede", health[1].Acquirer)
+	assert.Equal(t, entity.CircuitStateClosed, health[1].State)
+}
+
+func TestPaymentServiceCanceledProbe(t *testing.T) {
+	paymentService := NewPaymentService(
+		PaymentWithAcquirer(acquirer.NewCielo("http://127.0.0.1:1/cielo", "cielo-api-key")),
+		PaymentWithCircuitBreaker("cielo", CircuitBreakerConfig{
+			WindowSize:       1,
+			MinRequests:      1,
+			ErrorRate:        1,
+			OpenTimeout:      time.Millisecond,
+			HalfOpenRequests: 1,
+		}),
+	)
+
+	_, err := paymentService.ProcessTransaction(context.Background(), createTransaction("cielo", 1000))
+	require.NotNil(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	// the probe canceled by the caller lets another call probe the acquirer
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = paymentService.ProcessTransaction(ctx, createTransaction("cielo", 1000))
+	require.ErrorIs(t, err, context.Canceled)
+	assert.True(t, paymentService.IsAvailable("cielo"))
+	assert.Equal(t, entity.CircuitStateHalfOpen, paymentService.AcquirerHealth(ctx)[0].State)
+}
+
+func TestPaymentServiceReversalAfterTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	acquirerApp := acquirer_app.App(acquirer_app.WithDelay(500 * time.Millisecond))
+	go func() {
+		acquirerApp.Listen(":6063")
+	}()
+	defer acquirerApp.Shutdown()
+
+	time.Sleep(1 * time.Second)
+
+	paymentService := NewPaymentService(
+		PaymentWithAcquirer(acquirer.NewCielo("http://127.0.0.1:6063/cielo", "cielo-api-key")),
+		PaymentWithTransport("cielo", TransportConfig{
+			ConnectTimeout:  time.Second,
+			ResponseTimeout: 100 * time.Millisecond,
+			RequestTimeout:  time.Second,
+			MaxIdleConns:    1,
+			MaxConns:        2,
+			IdleConnTimeout: time.Second,
+		}),
+	)
+
+	payment := entity.NewPayment(createTransaction("cielo", 1000))
+	attempt := payment.AddAttempt("cielo")
+	payment.Transaction.Reference = attempt.Id
+
+	_, err := paymentService.ProcessTransaction(ctx, payment.Transaction)
+	var timeoutErr *errors.TimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+
+	_, err = paymentService.ReverseTransaction(ctx, entity.NewReversal(payment, attempt))
+	require.Nil(t, err)
+}
+
+func createTransaction(acquirerName string, amount int64) *entity.Transaction {
 	card := entity.NewCard("Token", "Holder", "01/2030", "Brand")
-	purchase := entity.NewPurchase(value, []string{"Item 1", "Item 2"}, 2)
-	store := entity.NewStore("Identification", "Address", "Cep")
+	purchase := entity.NewPurchase(entity.NewMoney(amount, "BRL"), []string{"Item 1", "Item 2"}, 2)
+	store := entity.NewStore("11222333000181", "Address", "01310100")
 	acquirer := entity.NewAcquirer(acquirerName)
 	return entity.NewTransaction(card, purchase, store, acquirer)
 }
+
+func createApprovedPayment(t *testing.T, s *PaymentServiceTestSuite, acquirerName string, amount int64) *entity.Payment {
+	transaction := createTransaction(acquirerName, amount)
+	result, err := s.paymentService.ProcessTransaction(s.ctx, transaction)
+	require.Nil(t, err)
+
+	payment := entity.NewPayment(transaction)
+	payment.Approve(result)
+	return payment
+}
+
+func createAuthorizedPayment(t *testing.T, s *PaymentServiceTestSuite, acquirerName string, amount int64) *entity.Payment {
+	transaction := createTransaction(acquirerName, amount)
+	transaction.AuthorizeOnly = true
+	result, err := s.paymentService.ProcessTransaction(s.ctx, transaction)
+	require.Nil(t, err)
+
+	payment := entity.NewPayment(transaction)
+	payment.Authorize(result)
+	return payment
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	iservice "github.com/sesaquecruz/go-payment-processor/internal/core/service"
+)
+
+func DefaultRoutingRules() []*entity.RouteRule {
+	return []*entity.RouteRule{
+		{
+			Name:      "cielo-up-to-100",
+			Currency:  "BRL",
+			MaxAmount: 10000,
+			Targets:   []*entity.RouteTarget{{Acquirer: "cielo", Weight: 100}},
+			Fallbacks: []string{"rede", "stone"},
+		},
+		{
+			Name:      "rede-up-to-500",
+			Currency:  "BRL",
+			MaxAmount: 50000,
+			Targets:   []*entity.RouteTarget{{Acquirer: "rede", Weight: 100}},
+			Fallbacks: []string{"stone"},
+		},
+		{
+			Name:      "stone-up-to-1000",
+			Currency:  "BRL",
+			MaxAmount: 100000,
+			Targets:   []*entity.RouteTarget{{Acquirer: "stone", Weight: 100}},
+		},
+	}
+}
+
+func LoadRoutingRules(path string) ([]*entity.RouteRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read routing rules: %w", err)
+	}
+
+	var rules []*entity.RouteRule
+	err = json.Unmarshal(data, &rules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode routing rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		err = rule.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate routing rule %s: %w", rule.Name, err)
+		}
+	}
+
+	return rules, nil
+}
+
+// RoutingService skips the acquirers whose circuit breaker is open.
+type RoutingService struct {
+	rules  []*entity.RouteRule
+	health iservice.IAcquirerHealthService
+	random func(n int) int
+}
+
+func NewRoutingService(rules []*entity.RouteRule, health iservice.IAcquirerHealthService) *RoutingService {
+	return &RoutingService{
+		rules:  rules,
+		health: health,
+		random: rand.Intn,
+	}
+}
+
+func (s *RoutingService) Route(ctx context.Context, transaction *entity.Transaction) (*entity.Route, error) {
+	route := s.Explain(ctx, transaction)
+	if route.Acquirer == "" {
+		return nil, core_errors.NewValidationError("no acquirer route matches the transaction")
+	}
+
+	return route, nil
+}
+
+func (s *RoutingService) Explain(ctx context.Context, transaction *entity.Transaction) *entity.Route {
+	evaluations := make([]*entity.RouteEvaluation, 0, len(s.rules))
+
+	for _, rule := range s.rules {
+		matched, reason := rule.Match(transaction)
+
+		var acquirer string
+		var fallbacks []string
+		if matched {
+			acquirer, fallbacks = s.choose(rule)
+			if acquirer == "" {
+				matched, reason = false, "acquirers are unavailable"
+			}
+		}
+
+		evaluations = append(evaluations, &entity.RouteEvaluation{
+			Rule:    rule.Name,
+			Matched: matched,
+			Reason:  reason,
+		})
+
+		if matched {
+			route := entity.NewRoute(acquirer, rule.Name, evaluations)
+			route.Fallbacks = fallbacks
+			return route
+		}
+	}
+
+	return entity.NewRoute("", "", evaluations)
+}
+
+// choose takes the first available fallback when every target is unavailable.
+func (s *RoutingService) choose(rule *entity.RouteRule) (string, []string) {
+	targets := make([]*entity.RouteTarget, 0, len(rule.Targets))
+	for _, target := range rule.Targets {
+		if s.health.IsAvailable(target.Acquirer) {
+			targets = append(targets, target)
+		}
+	}
+
+	var acquirer string
+	if len(targets) > 0 {
+		acquirer = s.pick(targets)
+	}
+
+	fallbacks := make([]string, 0, len(rule.Fallbacks))
+	for _, fallback := range rule.Chain(acquirer) {
+		if s.health.IsAvailable(fallback) {
+			fallbacks = append(fallbacks, fallback)
+		}
+	}
+
+	if acquirer == "" && len(fallbacks) > 0 {
+		acquirer, fallbacks = fallbacks[0], fallbacks[1:]
+	}
+
+	return acquirer, fallbacks
+}
+
+func (s *RoutingService) pick(targets []*entity.RouteTarget) string {
+	total := 0
+	for _, target := range targets {
+		total += target.Weight
+	}
+
+	n := s.random(total)
+	for _, target := range targets {
+		if n < target.Weight {
+			return target.Acquirer
+		}
+		n -= target.Weight
+	}
+
+	return targets[len(targets)-1].Acquirer
+}
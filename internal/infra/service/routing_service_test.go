@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	serviceMocks "github.com/sesaquecruz/go-payment-processor/test/mocks/core/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutingServiceWithDefaultRules(t *testing.T) {
+	ctx := context.Background()
+	routingService := NewRoutingService(DefaultRoutingRules(), createHealthService(t, nil))
+
+	for _, tc := range []struct {
+		Amount   int64
+		Acquirer string
+	}{
+		{10000, "cielo"},
+		{10001, "rede"},
+		{50000, "rede"},
+		{50001, "stone"},
+		{100000, "stone"},
+	} {
+		route, err := routingService.Route(ctx, createTransaction("", tc.Amount))
+		require.Nil(t, err)
+		assert.Equal(t, tc.Acquirer, route.Acquirer)
+	}
+
+	t.Run("explains the rules evaluated until the match", func(t *testing.T) {
+		route := routingService.Explain(ctx, createTransaction("", 20000))
+		assert.Equal(t, "rede", route.Acquirer)
+		assert.Equal(t, "rede-up-to-500", route.Rule)
+		assert.Equal(t, []string{"stone"}, route.Fallbacks)
+		require.Equal(t, 2, len(route.Evaluations))
+		assert.False(t, route.Evaluations[0].Matched)
+		assert.Equal(t, "amount is above 10000", route.Evaluations[0].Reason)
+		assert.True(t, route.Evaluations[1].Matched)
+	})
+
+	t.Run("fails when no rule matches", func(t *testing.T) {
+		route, err := routingService.Route(ctx, createTransaction("", 100001))
+		assert.Nil(t, route)
+
+		var verr *errors.ValidationError
+		require.ErrorAs(t, err, &verr)
+		assert.Equal(t, []string{"no acquirer route matches the transaction"}, verr.Messages)
+
+		route = routingService.Explain(ctx, createTransaction("", 100001))
+		assert.Empty(t, route.Acquirer)
+		assert.Equal(t, 3, len(route.Evaluations))
+	})
+}
+
+func TestRoutingServiceWithWeightedTargets(t *testing.T) {
+	ctx := context.Background()
+	routingService := NewRoutingService([]*entity.RouteRule{
+		{
+			Name: "split",
+			Targets: []*entity.RouteTarget{
+				{Acquirer: "cielo", Weight: 70},
+				{Acquirer: "rede", Weight: 30},
+			},
+		},
+	}, createHealthService(t, nil))
+
+	for _, tc := range []struct {
+		Random   int
+		Acquirer string
+	}{
+		{0, "cielo"},
+		{69, "cielo"},
+		{70, "rede"},
+		{99, "rede"},
+	} {
+		routingService.random = func(n int) int {
+			assert.Equal(t, 100, n)
+			return tc.Random
+		}
+
+		route, err := routingService.Route(ctx, createTransaction("", 1000))
+		require.Nil(t, err)
+		assert.Equal(t, tc.Acquirer, route.Acquirer)
+		assert.Equal(t, "split", route.Rule)
+	}
+}
+
+func TestRoutingServiceSkipsUnavailableAcquirers(t *testing.T) {
+	ctx := context.Background()
+	rules := []*entity.RouteRule{
+		{
+			Name: "split",
+			Targets: []*entity.RouteTarget{
+				{Acquirer: "cielo", Weight: 50},
+				{Acquirer: "rede", Weight: 50},
+			},
+			Fallbacks: []string{"rede", "stone"},
+		},
+		{
+			Name:      "last",
+			Targets:   []*entity.RouteTarget{{Acquirer: "getnet", Weight: 1}},
+			Fallbacks: []string{},
+		},
+	}
+
+	t.Run("picks an available target", func(t *testing.T) {
+		routingService := NewRoutingService(rules, createHealthService(t, []string{"cielo"}))
+		routingService.random = func(n int) int { return 0 }
+
+		route, err := routingService.Route(ctx, createTransaction("", 1000))
+		require.Nil(t, err)
+		assert.Equal(t, "rede", route.Acquirer)
+		assert.Equal(t, []string{"stone"}, route.Fallbacks)
+	})
+
+	t.Run("uses the first available fallback when every target is unavailable", func(t *testing.T) {
+		routingService := NewRoutingService(rules, createHealthService(t, []string{"cielo", "rede"}))
+
+		route, err := routingService.Route(ctx, createTransaction("", 1000))
+		require.Nil(t, err)
+		assert.Equal(t, "stone", route.Acquirer)
+		assert.Empty(t, route.Fallbacks)
+	})
+
+	t.Run("skips the rule when every acquirer is unavailable", func(t *testing.T) {
+		routingService := NewRoutingService(rules, createHealthService(t, []string{"cielo", "rede", "stone"}))
+
+		route := routingService.Explain(ctx, createTransaction("", 1000))
+		assert.Equal(t, "getnet", route.Acquirer)
+		assert.Equal(t, "last", route.Rule)
+		require.Equal(t, 2, len(route.Evaluations))
+		assert.False(t, route.Evaluations[0].Matched)
+		assert.Equal(t, "acquirers are unavailable", route.Evaluations[0].Reason)
+	})
+}
+
+func TestLoadRoutingRules(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("loads valid rules", func(t *testing.T) {
+		path := filepath.Join(dir, "rules.json")
+		data := `[{"name": "elo", "card_brands": ["elo"], "targets": [{"acquirer": "stone", "weight": 1}]}]`
+		require.Nil(t, os.WriteFile(path, []byte(data), 0o600))
+
+		rules, err := LoadRoutingRules(path)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(rules))
+		assert.Equal(t, "elo", rules[0].Name)
+		assert.Equal(t, []string{"elo"}, rules[0].CardBrands)
+		assert.Equal(t, "stone", rules[0].Targets[0].Acquirer)
+	})
+
+	t.Run("fails with invalid rules", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		require.Nil(t, os.WriteFile(path, []byte(`[{"name": "empty"}]`), 0o600))
+
+		rules, err := LoadRoutingRules(path)
+		assert.Nil(t, rules)
+		assert.ErrorContains(t, err, "route rule targets is required")
+	})
+}
+
+func createHealthService(t *testing.T, unavailable []string) *serviceMocks.IAcquirerHealthServiceMock {
+	healthService := serviceMocks.NewIAcquirerHealthServiceMock(t)
+	healthService.
+		EXPECT().
+		IsAvailable(mock.Anything).
+		RunAndReturn(func(acquirer string) bool {
+			return !slices.Contains(unavailable, acquirer)
+		}).
+		Maybe()
+
+	return healthService
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+)
+
+var settlementCsvColumns = []string{"acquirer_id", "date", "amount", "currency"}
+
+// The records of an edi settlement file, one per line:
+//
+//	H  date YYYYMMDD (8)  acquirer (10)
+//	D  acquirer id (36)  date YYYYMMDD (8)  amount in minor units (13)  currency (3)
+//	T  detail records (9)  total amount in minor units (15)
+//
+// The text fields are padded with spaces on the right and the numbers with zeros on the left.
+const (
+	settlementEdiHeaderLength  = 1 + 8 + 10
+	settlementEdiDetailLength  = 1 + 36 + 8 + 13 + 3
+	settlementEdiTrailerLength = 1 + 9 + 15
+)
+
+const settlementDateLayout = "2006-01-02"
+
+type SettlementParser struct{}
+
+func NewSettlementParser() *SettlementParser {
+	return &SettlementParser{}
+}
+
+func (p *SettlementParser) Parse(format entity.SettlementFormat, acquirer string, r io.Reader) ([]*entity.SettlementLine, error) {
+	switch format {
+	case entity.SettlementFormatCsv:
+		return parseSettlementCsv(r)
+	case entity.SettlementFormatEdi:
+		return parseSettlementEdi(acquirer, r)
+	}
+
+	return nil, core_errors.NewValidationError("settlement format is invalid")
+}
+
+// parseSettlementCsv reads a file separated by commas or, when its header has none, by semicolons.
+func parseSettlementCsv(r io.Reader) ([]*entity.SettlementLine, error) {
+	reader := bufio.NewReader(r)
+
+	header, err := reader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	header = strings.TrimPrefix(strings.TrimSpace(header), "\ufeff")
+	if header == "" {
+		return nil, core_errors.NewValidationError("settlement file is empty")
+	}
+
+	records := csv.NewReader(io.MultiReader(strings.NewReader(header+"\n"), reader))
+	records.FieldsPerRecord = -1
+	if !strings.Contains(header, ",") && strings.Contains(header, ";") {
+		records.Comma = ';'
+	}
+
+	names, _ := records.Read()
+	columns := make(map[string]int)
+	for i, name := range names {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	msgs := make([]string, 0)
+	for _, column := range settlementCsvColumns {
+		if _, ok := columns[column]; !ok {
+			msgs = append(msgs, fmt.Sprintf("settlement file column %s is required", column))
+		}
+	}
+
+	if len(msgs) > 0 {
+		return nil, core_errors.NewValidationError(msgs...)
+	}
+
+	lines := make([]*entity.SettlementLine, 0)
+	for {
+		record, err := records.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			msgs = append(msgs, fmt.Sprintf("line %d: %v", parseErr.StartLine, parseErr.Err))
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// the blank lines are skipped, so the number is the one of the line in the file
+		number, _ := records.FieldPos(0)
+
+		field := func(column string) string {
+			i := columns[column]
+			if i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		line, lineMsgs := newSettlementLine(number, field("acquirer_id"), field("date"), settlementDateLayout, field("currency"), func(currency string) (entity.Money, error) {
+			value, err := strconv.ParseFloat(field("amount"), 64)
+			return entity.NewMoneyFromDecimal(value, currency), err
+		})
+
+		msgs = append(msgs, lineMsgs...)
+		if line != nil {
+			lines = append(lines, line)
+		}
+	}
+
+	if len(msgs) > 0 {
+		return nil, core_errors.NewValidationError(msgs...)
+	}
+
+	return lines, nil
+}
+
+func parseSettlementEdi(acquirer string, r io.Reader) ([]*entity.SettlementLine, error) {
+	scanner := bufio.NewScanner(r)
+
+	lines := make([]*entity.SettlementLine, 0)
+	msgs := make([]string, 0)
+	header := false
+	trailer := false
+	var total int64
+
+	for number := 1; scanner.Scan(); number++ {
+		record := strings.TrimRight(scanner.Text(), "\r")
+		if record == "" {
+			continue
+		}
+
+		if trailer {
+			msgs = append(msgs, fmt.Sprintf("line %d: record after the trailer", number))
+			continue
+		}
+
+		switch record[0] {
+		case 'H':
+			if header || number != 1 {
+				msgs = append(msgs, fmt.Sprintf("line %d: header must be the first record", number))
+				continue
+			}
+			header = true
+
+			if len(record) != settlementEdiHeaderLength {
+				msgs = append(msgs, fmt.Sprintf("line %d: header must have %d characters", number, settlementEdiHeaderLength))
+				continue
+			}
+
+			name := strings.TrimSpace(record[9:19])
+			if !strings.EqualFold(name, acquirer) {
+				return nil, core_errors.NewValidationError(fmt.Sprintf("settlement file is of acquirer %s", name))
+			}
+
+		case 'D':
+			if len(record) != settlementEdiDetailLength {
+				msgs = append(msgs, fmt.Sprintf("line %d: detail must have %d characters", number, settlementEdiDetailLength))
+				continue
+			}
+
+			line, lineMsgs := newSettlementLine(number, strings.TrimSpace(record[1:37]), record[37:45], "20060102", record[58:61], func(currency string) (entity.Money, error) {
+				amount, err := strconv.ParseInt(record[45:58], 10, 64)
+				return entity.NewMoney(amount, currency), err
+			})
+
+			msgs = append(msgs, lineMsgs...)
+			if line != nil {
+				lines = append(lines, line)
+				total += line.Value.Amount
+			}
+
+		case 'T':
+			trailer = true
+
+			if len(record) != settlementEdiTrailerLength {
+				msgs = append(msgs, fmt.Sprintf("line %d: trailer must have %d characters", number, settlementEdiTrailerLength))
+				continue
+			}
+
+			count, err := strconv.Atoi(record[1:10])
+			if err != nil || count != len(lines) {
+				msgs = append(msgs, fmt.Sprintf("line %d: trailer record count does not match the %d detail records", number, len(lines)))
+			}
+
+			amount, err := strconv.ParseInt(record[10:25], 10, 64)
+			if err != nil || amount != total {
+				msgs = append(msgs, fmt.Sprintf("line %d: trailer total amount does not match the detail records", number))
+			}
+
+		default:
+			msgs = append(msgs, fmt.Sprintf("line %d: record type %c is invalid", number, record[0]))
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if !header {
+		msgs = append(msgs, "settlement file header is required")
+	}
+
+	if !trailer {
+		msgs = append(msgs, "settlement file trailer is required")
+	}
+
+	if len(msgs) > 0 {
+		return nil, core_errors.NewValidationError(msgs...)
+	}
+
+	return lines, nil
+}
+
+func newSettlementLine(
+	number int,
+	acquirerId string,
+	date string,
+	dateLayout string,
+	currency string,
+	value func(currency string) (entity.Money, error),
+) (*entity.SettlementLine, []string) {
+	msgs := make([]string, 0)
+
+	day, err := time.Parse(dateLayout, date)
+	if err != nil {
+		msgs = append(msgs, fmt.Sprintf("line %d: date is invalid", number))
+	}
+
+	money, err := value(strings.ToUpper(strings.TrimSpace(currency)))
+	if err != nil || money.Amount <= 0 {
+		msgs = append(msgs, fmt.Sprintf("line %d: amount is invalid", number))
+	}
+
+	if !money.IsCurrencyValid() {
+		msgs = append(msgs, fmt.Sprintf("line %d: currency is invalid", number))
+	}
+
+	if len(msgs) > 0 {
+		return nil, msgs
+	}
+
+	return entity.NewSettlementLine(number, acquirerId, money, day), nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSettlementCsv(t *testing.T) {
+	parser := NewSettlementParser()
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	file := "date,acquirer_id,amount,currency\r\n" +
+		"2026-10-01,A1,10.50,BRL\r\n" +
+		"\r\n" +
+		"2026-10-02,,1000,jpy\r\n"
+
+	lines, err := parser.Parse(entity.SettlementFormatCsv, "cielo", strings.NewReader(file))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(lines))
+	assert.Equal(t, entity.NewSettlementLine(2, "A1", entity.NewMoney(1050, "BRL"), day), lines[0])
+	assert.Equal(t, entity.NewSettlementLine(4, "", entity.NewMoney(1000, "JPY"), day.AddDate(0, 0, 1)), lines[1])
+
+	// the columns may be separated by semicolons
+	lines, err = parser.Parse(entity.SettlementFormatCsv, "cielo", strings.NewReader("acquirer_id;date;amount;currency\nA1;2026-10-01;10.50;BRL\n"))
+	require.Nil(t, err)
+	assert.Equal(t, entity.NewMoney(1050, "BRL"), lines[0].Value)
+
+	_, err = parser.Parse(entity.SettlementFormatCsv, "cielo", strings.NewReader("acquirer_id,amount\n"))
+	assert.Equal(t, errors.NewValidationError("settlement file column date is required", "settlement file column currency is required"), err)
+
+	_, err = parser.Parse(entity.SettlementFormatCsv, "cielo", strings.NewReader(""))
+	assert.Equal(t, errors.NewValidationError("settlement file is empty"), err)
+
+	file = "acquirer_id,date,amount,currency\n" +
+		"A1,01/10/2026,10.50,BRL\n" +
+		"A2,2026-10-01,-1,XXX\n" +
+		"A3,2026-10-01,1.00,BRL\n"
+
+	_, err = parser.Parse(entity.SettlementFormatCsv, "cielo", strings.NewReader(file))
+	assert.Equal(t, errors.NewValidationError(
+		"line 2: date is invalid",
+		"line 3: amount is invalid",
+		"line 3: currency is invalid",
+	), err)
+
+	_, err = parser.Parse("xml", "cielo", strings.NewReader(file))
+	assert.Equal(t, errors.NewValidationError("settlement format is invalid"), err)
+}
+
+func TestParseSettlementEdi(t *testing.T) {
+	parser := NewSettlementParser()
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	file := "H20261002cielo     \n" +
+		"DA1                                  202610010000000001050BRL\n" +
+		"D                                    202610020000000002000BRL\n" +
+		"T000000002000000000003050\n"
+
+	lines, err := parser.Parse(entity.SettlementFormatEdi, "cielo", strings.NewReader(file))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(lines))
+	assert.Equal(t, entity.NewSettlementLine(2, "A1", entity.NewMoney(1050, "BRL"), day), lines[0])
+	assert.Equal(t, entity.NewSettlementLine(3, "", entity.NewMoney(2000, "BRL"), day.AddDate(0, 0, 1)), lines[1])
+
+	_, err = parser.Parse(entity.SettlementFormatEdi, "rede", strings.NewReader(file))
+	assert.Equal(t, errors.NewValidationError("settlement file is of acquirer cielo"), err)
+
+	file = "H20261002cielo     \n" +
+		"DA1                                  2026100100000000010BRL\n" +
+		"DA2                                  202610010000000000100BRL\n" +
+		"X\n" +
+		"T000000002000000000000099\n"
+
+	_, err = parser.Parse(entity.SettlementFormatEdi, "cielo", strings.NewReader(file))
+	assert.Equal(t, errors.NewValidationError(
+		"line 2: detail must have 61 characters",
+		"line 4: record type X is invalid",
+		"line 5: trailer record count does not match the 1 detail records",
+		"line 5: trailer total amount does not match the detail records",
+	), err)
+
+	_, err = parser.Parse(entity.SettlementFormatEdi, "cielo", strings.NewReader("DA1                                  202610010000000001050BRL\n"))
+	assert.Equal(t, errors.NewValidationError("settlement file header is required", "settlement file trailer is required"), err)
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSender(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	payload := []byte(`{"id":"Event Id","type":"payment.approved"}`)
+
+	var received *http.Request
+	var body []byte
+	status := http.StatusNoContent
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sender := NewWebhookSender()
+	sender.client = newWebhookClient(DefaultTransportConfig(), func(netip.Addr) bool { return true })
+	sender.now = func() time.Time { return now }
+
+	webhook := entity.NewWebhook("Caller", server.URL, []string{"payment.approved"}, "a-secret-of-16-chars")
+	delivery := &entity.WebhookDelivery{
+		EventId:   "Event Id",
+		EventType: entity.WebhookEventPaymentApproved,
+		Payload:   payload,
+	}
+
+	code, err := sender.Send(ctx, webhook, delivery)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, code)
+
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, payload, body)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, "Event Id", received.Header.Get(WebhookIdHeader))
+	assert.Equal(t, "payment.approved", received.Header.Get(WebhookEventHeader))
+	assert.Equal(t, strconv.FormatInt(now.Unix(), 10), received.Header.Get(WebhookTimestampHeader))
+	assert.Equal(t, SignWebhook("a-secret-of-16-chars", now.Unix(), payload), received.Header.Get(WebhookSignatureHeader))
+
+	status = http.StatusInternalServerError
+
+	code, err = sender.Send(ctx, webhook, delivery)
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.EqualError(t, err, "webhook answered with status 500")
+
+	server.Close()
+
+	code, err = sender.Send(ctx, webhook, delivery)
+	assert.Equal(t, 0, code)
+	assert.NotNil(t, err)
+}
+
+func TestWebhookSenderDestinations(t *testing.T) {
+	ctx := context.Background()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/internal", http.StatusFound)
+		}
+	}))
+	defer server.Close()
+
+	delivery := &entity.WebhookDelivery{
+		EventId:   "Event Id",
+		EventType: entity.WebhookEventPaymentApproved,
+		Payload:   []byte("{}"),
+	}
+
+	t.Run("does not connect to internal addresses", func(t *testing.T) {
+		webhook := entity.NewWebhook("Caller", server.URL, []string{"payment.approved"}, "a-secret-of-16-chars")
+
+		code, err := NewWebhookSender().Send(ctx, webhook, delivery)
+		assert.Equal(t, 0, code)
+		assert.ErrorContains(t, err, "webhook address 127.0.0.1 is not allowed")
+		assert.Equal(t, 0, requests)
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		sender := NewWebhookSender()
+		sender.client = newWebhookClient(DefaultTransportConfig(), func(netip.Addr) bool { return true })
+
+		webhook := entity.NewWebhook("Caller", server.URL+"/redirect", []string{"payment.approved"}, "a-secret-of-16-chars")
+
+		code, err := sender.Send(ctx, webhook, delivery)
+		assert.Equal(t, http.StatusFound, code)
+		assert.EqualError(t, err, "webhook answered with status 302")
+		assert.Equal(t, 1, requests)
+	})
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	for _, addr := range []string{"127.0.0.1", "10.0.0.1", "172.16.0.1", "192.168.0.1", "169.254.169.254", "100.64.0.1", "0.0.0.0", "::1", "fe80::1", "fd00::1", "::ffff:10.0.0.1"} {
+		assert.False(t, isPublicAddress(netip.MustParseAddr(addr).Unmap()), addr)
+	}
+
+	for _, addr := range []string{"8.8.8.8", "2001:4860:4860::8888"} {
+		assert.True(t, isPublicAddress(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestSignWebhook(t *testing.T) {
+	// echo -n '1704067200.{}' | openssl dgst -sha256 -hmac 'a-secret-of-16-chars'
+	signature := SignWebhook("a-secret-of-16-chars", 1704067200, []byte("{}"))
+	assert.Equal(t, "sha256=471129e0004fb0524657a363b3812c35068866dc03a5e20b6f0f995eb00adc56", signature)
+	assert.NotEqual(t, signature, SignWebhook("another-secret-of-16-chars", 1704067200, []byte("{}")))
+	assert.NotEqual(t, signature, SignWebhook("a-secret-of-16-chars", 1704067201, []byte("{}")))
+}
@@ -1,23 +1,48 @@
 package web
 
 import (
-	"crypto/rsa"
+	"context"
+	"time"
 
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/handler"
 
-	jwtmiddleware "github.com/gofiber/contrib/jwt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/swagger"
 
 	_ "github.com/sesaquecruz/go-payment-processor/docs"
 )
 
+// RequestTimeout leaves room for a transaction to time out on an acquirer and on its fallbacks.
+const RequestTimeout = 60 * time.Second
+
 func InitApp(
-	authPublicKey *rsa.PublicKey,
+	authConfig AuthConfig,
 	paymentHandler handler.IPaymentHandler,
+	idempotencyHandler handler.IIdempotencyHandler,
+	routingHandler handler.IRoutingHandler,
+	acquirerHandler handler.IAcquirerHandler,
+	cardHandler handler.ICardHandler,
+	storeHandler handler.IStoreHandler,
+	webhookHandler handler.IWebhookHandler,
+	paymentBatchHandler handler.IPaymentBatchHandler,
+	settlementHandler handler.ISettlementHandler,
+	paymentSearchHandler handler.IPaymentSearchHandler,
 ) *fiber.App {
 	app := fiber.New()
 
+	app.Use(requestContext(RequestTimeout))
+
+	auth := newAuthMiddleware(authConfig)
+
+	// the metrics reveal the acquirers health, so only admins may scrape them
+	app.Get("/metrics", auth, requireScope(ScopeAdmin), acquirerHandler.Metrics)
+
+	paymentsWrite := requireScope(ScopePaymentsWrite)
+	paymentsRead := requireScope(ScopePaymentsRead)
+	refundsWrite := requireScope(ScopeRefundsWrite)
+	cardsWrite := requireScope(ScopeCardsWrite)
+	webhooksWrite := requireScope(ScopeWebhooksWrite)
+
 	v1 := app.Group("/api/v1")
 
 	// public routes
@@ -25,20 +50,85 @@ func InitApp(
 		v1.Get("/swagger/*", swagger.HandlerDefault)
 	}
 
-	v1 = v1.Use(jwtmiddleware.New(jwtmiddleware.Config{
-		SigningKey: jwtmiddleware.SigningKey{
-			JWTAlg: jwtmiddleware.RS256,
-			Key:    authPublicKey,
-		},
-	}))
+	v1 = v1.Use(auth)
 
 	// protected routes
 	{
 		payments := v1.Group("/payments")
 		{
-			payments.Post("/process", paymentHandler.ProcessPayment)
+			payments.Get("/", paymentsRead, paymentSearchHandler.SearchPayments)
+			payments.Post("/process", paymentsWrite, idempotencyHandler.CheckIdempotency, paymentHandler.ProcessPayment)
+			payments.Post("/batch", paymentsWrite, idempotencyHandler.CheckIdempotency, paymentBatchHandler.CreateBatch)
+			payments.Get("/batch/:id", paymentsRead, paymentBatchHandler.FindBatch)
+			payments.Get("/:id", paymentsRead, paymentHandler.FindPayment)
+			payments.Post("/:id/capture", paymentsWrite, idempotencyHandler.CheckIdempotency, paymentHandler.CapturePayment)
+			payments.Post("/:id/refunds", refundsWrite, idempotencyHandler.CheckIdempotency, paymentHandler.RefundPayment)
+			payments.Post("/:id/void", paymentsWrite, idempotencyHandler.CheckIdempotency, paymentHandler.VoidPayment)
+		}
+
+		cards := v1.Group("/cards")
+		{
+			cards.Post("/", cardsWrite, cardHandler.TokenizeCard)
+			cards.Delete("/:token", cardsWrite, cardHandler.DeleteCard)
+		}
+	}
+
+	v2 := app.Group("/api/v2", auth)
+
+	// protected routes
+	{
+		payments := v2.Group("/payments")
+		{
+			payments.Post("/process", paymentsWrite, idempotencyHandler.CheckIdempotency, paymentHandler.ProcessPaymentV2)
+			payments.Post("/batch", paymentsWrite, idempotencyHandler.CheckIdempotency, paymentBatchHandler.CreateBatchV2)
+			payments.Get("/batch/:id", paymentsRead, paymentBatchHandler.FindBatch)
+			payments.Post("/route", paymentsRead, routingHandler.RouteTransaction)
+			payments.Get("/:id", paymentsRead, paymentHandler.FindPayment)
+			payments.Post("/:id/capture", paymentsWrite, idempotencyHandler.CheckIdempotency, paymentHandler.CapturePaymentV2)
+			payments.Post("/:id/refunds", refundsWrite, idempotencyHandler.CheckIdempotency, paymentHandler.RefundPaymentV2)
+			payments.Post("/:id/void", paymentsWrite, idempotencyHandler.CheckIdempotency, paymentHandler.VoidPayment)
+		}
+
+		cards := v2.Group("/cards")
+		{
+			cards.Post("/", cardsWrite, cardHandler.TokenizeCard)
+			cards.Delete("/:token", cardsWrite, cardHandler.DeleteCard)
+		}
+
+		webhooks := v2.Group("/webhooks", webhooksWrite)
+		{
+			webhooks.Post("/", webhookHandler.CreateWebhook)
+			webhooks.Get("/", webhookHandler.ListWebhooks)
+			webhooks.Get("/:id", webhookHandler.FindWebhook)
+			webhooks.Put("/:id", webhookHandler.UpdateWebhook)
+			webhooks.Delete("/:id", webhookHandler.DeleteWebhook)
+			webhooks.Get("/:id/deliveries", webhookHandler.ListDeliveries)
+			webhooks.Post("/:id/deliveries/:deliveryId/redeliver", webhookHandler.RedeliverWebhook)
+		}
+
+		admin := v2.Group("/admin", requireScope(ScopeAdmin))
+		{
+			admin.Get("/acquirers", acquirerHandler.AcquirerHealth)
+			admin.Post("/stores", storeHandler.CreateStore)
+			admin.Get("/stores", storeHandler.ListStores)
+			admin.Get("/stores/:id", storeHandler.FindStore)
+			admin.Put("/stores/:id", storeHandler.UpdateStore)
+			admin.Delete("/stores/:id", storeHandler.DeleteStore)
+			admin.Post("/settlements", settlementHandler.ImportSettlement)
+			admin.Get("/settlements/:id", settlementHandler.FindSettlement)
 		}
 	}
 
 	return app
 }
+
+// requestContext sets a deadline, since the context of fasthttp is never canceled.
+func requestContext(timeout time.Duration) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx, cancel := context.WithTimeout(c.UserContext(), timeout)
+		defer cancel()
+
+		c.SetUserContext(ctx)
+		return c.Next()
+	}
+}
@@ -6,17 +6,25 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	iservice "github.com/sesaquecruz/go-payment-processor/internal/core/service"
 	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/service"
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/handler"
 	"github.com/sesaquecruz/go-payment-processor/test/authentication"
 	usecaseMocks "github.com/sesaquecruz/go-payment-processor/test/mocks/core/usecase"
 
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -24,7 +32,7 @@ import (
 )
 
 func TestProcessPayment(t *testing.T) {
-	authPublicKey := &authentication.PublicKey
+	authConfig := createAuthConfig()
 	authToken, err := createAuthToken()
 	require.Nil(t, err)
 
@@ -32,8 +40,8 @@ func TestProcessPayment(t *testing.T) {
 
 	t.Run("with invalid auth token", func(t *testing.T) {
 		processPaymentUsecase := usecaseMocks.NewIProcessPaymentMock(t)
-		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase)
-		app := InitApp(authPublicKey, paymentHandler)
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
 
 		req := httptest.NewRequest("POST", endpoint, nil)
 		req.Header.Set("Authorization", "a token")
@@ -46,7 +54,7 @@ func TestProcessPayment(t *testing.T) {
 
 	t.Run("with valid transaction should return payment data", func(t *testing.T) {
 		transaction := createTransactionDto()
-		expectedPayment := &dto.Payment{Id: uuid.NewString()}
+		expectedPayment := &dto.Payment{Id: uuid.NewString(), Status: "approved"}
 
 		processPaymentUsecase := usecaseMocks.NewIProcessPaymentMock(t)
 		processPaymentUsecase.
@@ -54,21 +62,24 @@ func TestProcessPayment(t *testing.T) {
 			Execute(mock.Anything, mock.Anything).
 			Run(func(ctx context.Context, input *usecase.ProcessPaymentInput) {
 				assert.Equal(t, transaction.CardToken, input.CardToken)
-				assert.Equal(t, transaction.PurchaseValue, input.PurchaseValue)
+				assert.Equal(t, int64(999), input.PurchaseAmount)
+				assert.Equal(t, "BRL", input.PurchaseCurrency)
 				assert.Equal(t, transaction.PurchaseItens, input.PurchaseItems)
 				assert.Equal(t, transaction.PurchaseInstallments, input.PurchaseInstallments)
-				assert.Equal(t, transaction.StoreIdentification, input.StoreIdentification)
-				assert.Equal(t, transaction.StoreAddress, input.StoreAddress)
-				assert.Equal(t, transaction.StoreCep, input.StoreCep)
+				assert.Equal(t, transaction.StoreId, input.StoreId)
+				assert.Equal(t, authentication.Stores, input.AllowedStores)
+				assert.NotEmpty(t, input.Caller)
 				assert.Equal(t, transaction.AcquirerName, input.AcquirerName)
+				assert.False(t, input.Async)
 			}).
 			Return(&usecase.ProcessPaymentOutput{
-				PaymentId: expectedPayment.Id,
+				PaymentId:     expectedPayment.Id,
+				PaymentStatus: expectedPayment.Status,
 			}, nil).
 			Once()
 
-		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase)
-		app := InitApp(authPublicKey, paymentHandler)
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
 
 		reqBody, err := json.Marshal(&transaction)
 		require.Nil(t, err)
@@ -89,12 +100,120 @@ func TestProcessPayment(t *testing.T) {
 		err = json.Unmarshal(resBody, &payment)
 		require.Nil(t, err)
 		assert.Equal(t, expectedPayment.Id, payment.Id)
+		assert.Equal(t, expectedPayment.Status, payment.Status)
+	})
+
+	t.Run("with v2 transaction should use the amount and currency", func(t *testing.T) {
+		transaction := createTransactionV2Dto()
+
+		processPaymentUsecase := usecaseMocks.NewIProcessPaymentMock(t)
+		processPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.ProcessPaymentInput) {
+				assert.Equal(t, transaction.Amount, input.PurchaseAmount)
+				assert.Equal(t, transaction.Currency, input.PurchaseCurrency)
+			}).
+			Return(&usecase.ProcessPaymentOutput{
+				PaymentId:     uuid.NewString(),
+				PaymentStatus: "approved",
+			}, nil).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		reqBody, err := json.Marshal(&transaction)
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v2/payments/process", bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("with respond-async preference should return status accepted and the status url", func(t *testing.T) {
+		transaction := createTransactionV2Dto()
+		paymentId := uuid.NewString()
+
+		processPaymentUsecase := usecaseMocks.NewIProcessPaymentMock(t)
+		processPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.ProcessPaymentInput) {
+				assert.True(t, input.Async)
+			}).
+			Return(&usecase.ProcessPaymentOutput{
+				PaymentId:     paymentId,
+				PaymentStatus: "pending",
+			}, nil).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		reqBody, err := json.Marshal(&transaction)
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v2/payments/process", bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Prefer", "respond-async, wait=10")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusAccepted, res.StatusCode)
+		assert.Equal(t, "/api/v2/payments/"+paymentId, res.Header.Get("Location"))
+		assert.Equal(t, "respond-async", res.Header.Get("Preference-Applied"))
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var payment *dto.Payment
+		err = json.Unmarshal(resBody, &payment)
+		require.Nil(t, err)
+		assert.Equal(t, paymentId, payment.Id)
+		assert.Equal(t, "pending", payment.Status)
+		assert.Equal(t, "/api/v2/payments/"+paymentId, payment.StatusUrl)
+	})
+
+	t.Run("with v2 transaction without currency should return status bad request", func(t *testing.T) {
+		transaction := createTransactionV2Dto()
+		transaction.Currency = ""
+
+		processPaymentUsecase := usecaseMocks.NewIProcessPaymentMock(t)
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		reqBody, err := json.Marshal(&transaction)
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v2/payments/process", bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var httpErr *dto.HttpError
+		err = json.Unmarshal(resBody, &httpErr)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"transaction v2 currency is required"}, httpErr.Message)
 	})
 
 	t.Run("with invalid json should return status bad request", func(t *testing.T) {
 		processPaymentUsecase := usecaseMocks.NewIProcessPaymentMock(t)
-		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase)
-		app := InitApp(authPublicKey, paymentHandler)
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
 
 		req := httptest.NewRequest("POST", endpoint, nil)
 		req.Header.Set("Authorization", authToken)
@@ -117,8 +236,8 @@ func TestProcessPayment(t *testing.T) {
 
 	t.Run("with empty transaction should return status bad request", func(t *testing.T) {
 		processPaymentUsecase := usecaseMocks.NewIProcessPaymentMock(t)
-		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase)
-		app := InitApp(authPublicKey, paymentHandler)
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
 
 		req := httptest.NewRequest("POST", endpoint, bytes.NewReader([]byte("{}")))
 		req.Header.Set("Authorization", authToken)
@@ -141,10 +260,7 @@ func TestProcessPayment(t *testing.T) {
 			"transaction purchase value is required",
 			"transaction purchase itens is required",
 			"transaction purchase installments is required",
-			"transaction store identification is required",
-			"transaction store address is required",
-			"transaction store cep is required",
-			"transaction acquirer name is required",
+			"transaction store id is required",
 		}, httpErr.Message)
 	})
 
@@ -158,8 +274,8 @@ func TestProcessPayment(t *testing.T) {
 			Return(nil, core_errors.NewValidationError("A validation error message")).
 			Once()
 
-		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase)
-		app := InitApp(authPublicKey, paymentHandler)
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
 
 		reqBody, err := json.Marshal(&transaction)
 		require.Nil(t, err)
@@ -193,8 +309,8 @@ func TestProcessPayment(t *testing.T) {
 			Return(nil, core_errors.NewNotFoundError("A not found error message")).
 			Once()
 
-		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase)
-		app := InitApp(authPublicKey, paymentHandler)
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
 
 		reqBody, err := json.Marshal(&transaction)
 		require.Nil(t, err)
@@ -218,6 +334,41 @@ func TestProcessPayment(t *testing.T) {
 		assert.Equal(t, []string{"A not found error message"}, httpErr.Message)
 	})
 
+	t.Run("with store not allowed should return status forbidden", func(t *testing.T) {
+		transaction := createTransactionDto()
+		transaction.StoreId = uuid.NewString()
+
+		processPaymentUsecase := usecaseMocks.NewIProcessPaymentMock(t)
+		processPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, core_errors.NewForbiddenError("store is not allowed for this client")).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		reqBody, err := json.Marshal(&transaction)
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", endpoint, bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var httpErr *dto.HttpError
+		err = json.Unmarshal(resBody, &httpErr)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"store is not allowed for this client"}, httpErr.Message)
+	})
+
 	t.Run("when occurs acquirer error should return acquirer response status", func(t *testing.T) {
 		transaction := createTransactionDto()
 
@@ -228,8 +379,8 @@ func TestProcessPayment(t *testing.T) {
 			Return(nil, core_errors.NewAcquirerError(429, "A rate limit error message")).
 			Once()
 
-		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase)
-		app := InitApp(authPublicKey, paymentHandler)
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
 
 		reqBody, err := json.Marshal(&transaction)
 		require.Nil(t, err)
@@ -253,6 +404,40 @@ func TestProcessPayment(t *testing.T) {
 		assert.Equal(t, []string{"A rate limit error message"}, httpErr.Message)
 	})
 
+	t.Run("when the acquirer times out should return status gateway timeout", func(t *testing.T) {
+		transaction := createTransactionDto()
+
+		processPaymentUsecase := usecaseMocks.NewIProcessPaymentMock(t)
+		processPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, core_errors.NewTimeoutError("acquirer timed out")).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		reqBody, err := json.Marshal(&transaction)
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", endpoint, bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusGatewayTimeout, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var httpErr *dto.HttpError
+		err = json.Unmarshal(resBody, &httpErr)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"acquirer timed out"}, httpErr.Message)
+	})
+
 	t.Run("when occurs server error should return status internal server error", func(t *testing.T) {
 		transaction := createTransactionDto()
 
@@ -263,8 +448,8 @@ func TestProcessPayment(t *testing.T) {
 			Return(nil, core_errors.NewInternalError(errors.New("an internal error message"))).
 			Once()
 
-		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase)
-		app := InitApp(authPublicKey, paymentHandler)
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
 
 		reqBody, err := json.Marshal(&transaction)
 		require.Nil(t, err)
@@ -289,24 +474,2050 @@ func TestProcessPayment(t *testing.T) {
 	})
 }
 
-func createAuthToken() (string, error) {
-	token, err := authentication.GetAuthToken()
-	if err != nil {
-		return "", err
-	}
+func TestFindPayment(t *testing.T) {
+	authConfig := createAuthConfig()
+	authToken, err := createAuthToken()
+	require.Nil(t, err)
 
-	return "Bearer " + token, nil
+	paymentId := uuid.NewString()
+	endpoint := "/api/v1/payments/" + paymentId
+
+	t.Run("with invalid auth token", func(t *testing.T) {
+		findPaymentUsecase := usecaseMocks.NewIFindPaymentMock(t)
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), findPaymentUsecase, usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("GET", endpoint, nil)
+		req.Header.Set("Authorization", "a token")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+
+	t.Run("with existent payment should return payment details", func(t *testing.T) {
+		output := &usecase.FindPaymentOutput{
+			PaymentId:            paymentId,
+			PaymentStatus:        "approved",
+			CardToken:            "A card token",
+			CardBrand:            "A card brand",
+			PurchaseAmount:       999,
+			PurchaseCurrency:     "BRL",
+			PurchaseItems:        []string{"Item 1"},
+			PurchaseInstallments: 2,
+			StoreIdentification:  "A store identification",
+			StoreAddress:         "A store address",
+			StoreCep:             "A store cep",
+			AcquirerName:         "An acquirer name",
+			AcquirerId:           "An acquirer id",
+			AcquirerCode:         200,
+			AcquirerMessage:      "An acquirer message",
+			RouteRule:            "A route rule",
+			Attempts: []*usecase.PaymentAttemptOutput{
+				{AcquirerName: "A failed acquirer", Status: "failed", AcquirerMessage: "timeout"},
+				{AcquirerName: "An acquirer name", Status: "succeeded", AcquirerId: "An acquirer id", AcquirerCode: 200},
+			},
+			CreatedAt: time.Now().UTC(),
+			UpdatedAt: time.Now().UTC(),
+		}
+
+		findPaymentUsecase := usecaseMocks.NewIFindPaymentMock(t)
+		findPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.FindPaymentInput{AllowedStores: authentication.Stores, PaymentId: paymentId}).
+			Return(output, nil).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), findPaymentUsecase, usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("GET", endpoint, nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var payment *dto.PaymentDetails
+		err = json.Unmarshal(resBody, &payment)
+		require.Nil(t, err)
+		assert.Equal(t, output.PaymentId, payment.Id)
+		assert.Equal(t, output.PaymentStatus, payment.Status)
+		assert.Equal(t, output.CardToken, payment.CardToken)
+		assert.Equal(t, output.PurchaseAmount, payment.Amount)
+		assert.Equal(t, output.PurchaseCurrency, payment.Currency)
+		assert.Equal(t, 9.99, payment.PurchaseValue)
+		assert.Equal(t, output.AcquirerName, payment.AcquirerName)
+		assert.Equal(t, output.AcquirerId, payment.AcquirerId)
+		assert.Equal(t, output.RouteRule, payment.RouteRule)
+		require.Equal(t, 2, len(payment.Attempts))
+		assert.Equal(t, "A failed acquirer", payment.Attempts[0].AcquirerName)
+		assert.Equal(t, "failed", payment.Attempts[0].Status)
+		assert.Equal(t, "timeout", payment.Attempts[0].AcquirerMessage)
+		assert.Equal(t, "succeeded", payment.Attempts[1].Status)
+		assert.True(t, output.CreatedAt.Equal(payment.CreatedAt))
+	})
+
+	t.Run("with non-existent payment should return status not found", func(t *testing.T) {
+		findPaymentUsecase := usecaseMocks.NewIFindPaymentMock(t)
+		findPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, core_errors.NewNotFoundError("payment id is invalid")).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), findPaymentUsecase, usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("GET", endpoint, nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var httpErr *dto.HttpError
+		err = json.Unmarshal(resBody, &httpErr)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, httpErr.Code)
+		assert.Equal(t, []string{"payment id is invalid"}, httpErr.Message)
+	})
 }
 
-func createTransactionDto() *dto.Transaction {
-	return &dto.Transaction{
-		CardToken:            "A card token",
-		PurchaseValue:        9.99,
+func TestProcessPaymentIdempotency(t *testing.T) {
+	authConfig := createAuthConfig()
+	authToken, err := createAuthToken()
+	require.Nil(t, err)
+
+	endpoint := "/api/v1/payments/process"
+	idempotencyKey := uuid.NewString()
+
+	transaction := createTransactionDto()
+	reqBody, err := json.Marshal(&transaction)
+	require.Nil(t, err)
+
+	t.Run("first request should be processed and stored", func(t *testing.T) {
+		expectedPayment := &dto.Payment{Id: uuid.NewString(), Status: "approved"}
+
+		processPaymentUsecase := usecaseMocks.NewIProcessPaymentMock(t)
+		processPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(&usecase.ProcessPaymentOutput{
+				PaymentId:     expectedPayment.Id,
+				PaymentStatus: expectedPayment.Status,
+			}, nil).
+			Once()
+
+		var requestHash string
+		startUsecase := usecaseMocks.NewIStartIdempotentRequestMock(t)
+		startUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.StartIdempotentRequestInput) {
+				assert.NotEmpty(t, input.Caller)
+				assert.Equal(t, idempotencyKey, input.Key)
+				assert.NotEmpty(t, input.RequestHash)
+				requestHash = input.RequestHash
+			}).
+			Return(&usecase.StartIdempotentRequestOutput{Replay: false}, nil).
+			Once()
+
+		completeUsecase := usecaseMocks.NewICompleteIdempotentRequestMock(t)
+		completeUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.CompleteIdempotentRequestInput) {
+				assert.NotEmpty(t, input.Caller)
+				assert.Equal(t, idempotencyKey, input.Key)
+				assert.Equal(t, requestHash, input.RequestHash)
+				assert.Equal(t, http.StatusOK, input.ResponseCode)
+				assert.False(t, input.Submitted)
+
+				var payment *dto.Payment
+				err := json.Unmarshal(input.ResponseBody, &payment)
+				require.Nil(t, err)
+				assert.Equal(t, expectedPayment, payment)
+			}).
+			Return(nil).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		idempotencyHandler := handler.NewIdempotencyHandler(startUsecase, completeUsecase)
+		app := InitApp(authConfig, paymentHandler, idempotencyHandler, createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("POST", endpoint, bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Empty(t, res.Header.Get("Idempotent-Replayed"))
+	})
+
+	t.Run("replayed request should return the stored response", func(t *testing.T) {
+		storedBody := []byte(`{"id":"a payment id","status":"approved"}`)
+
+		startUsecase := usecaseMocks.NewIStartIdempotentRequestMock(t)
+		startUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(&usecase.StartIdempotentRequestOutput{
+				Replay:       true,
+				ResponseCode: http.StatusOK,
+				ResponseBody: storedBody,
+			}, nil).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		idempotencyHandler := handler.NewIdempotencyHandler(startUsecase, usecaseMocks.NewICompleteIdempotentRequestMock(t))
+		app := InitApp(authConfig, paymentHandler, idempotencyHandler, createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("POST", endpoint, bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "true", res.Header.Get("Idempotent-Replayed"))
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+		assert.Equal(t, storedBody, resBody)
+	})
+
+	t.Run("conflicting request should return status conflict", func(t *testing.T) {
+		startUsecase := usecaseMocks.NewIStartIdempotentRequestMock(t)
+		startUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, core_errors.NewConflictError("idempotency key was already used with a different request")).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		idempotencyHandler := handler.NewIdempotencyHandler(startUsecase, usecaseMocks.NewICompleteIdempotentRequestMock(t))
+		app := InitApp(authConfig, paymentHandler, idempotencyHandler, createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("POST", endpoint, bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusConflict, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var httpErr *dto.HttpError
+		err = json.Unmarshal(resBody, &httpErr)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusConflict, httpErr.Code)
+		assert.Equal(t, []string{"idempotency key was already used with a different request"}, httpErr.Message)
+	})
+
+	t.Run("same body on another route should have another request hash", func(t *testing.T) {
+		hashes := make([]string, 0)
+		startUsecase := usecaseMocks.NewIStartIdempotentRequestMock(t)
+		startUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.StartIdempotentRequestInput) {
+				hashes = append(hashes, input.RequestHash)
+			}).
+			Return(nil, core_errors.NewConflictError("a request with this idempotency key is in progress")).
+			Times(3)
+
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		idempotencyHandler := handler.NewIdempotencyHandler(startUsecase, usecaseMocks.NewICompleteIdempotentRequestMock(t))
+		app := InitApp(authConfig, paymentHandler, idempotencyHandler, createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		for _, path := range []string{"/api/v1/payments/Payment1/capture", "/api/v1/payments/Payment2/capture", "/api/v1/payments/Payment1/void"} {
+			req := httptest.NewRequest("POST", path, bytes.NewReader([]byte("{}")))
+			req.Header.Set("Authorization", authToken)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Idempotency-Key", idempotencyKey)
+
+			res, err := app.Test(req, -1)
+			require.Nil(t, err)
+			assert.Equal(t, http.StatusConflict, res.StatusCode)
+		}
+
+		require.Len(t, hashes, 3)
+		assert.NotEqual(t, hashes[0], hashes[1])
+		assert.NotEqual(t, hashes[0], hashes[2])
+	})
+
+	t.Run("server error after reaching the acquirer should be stored", func(t *testing.T) {
+		processPaymentUsecase := usecaseMocks.NewIProcessPaymentMock(t)
+		processPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.ProcessPaymentInput) {
+				iservice.MarkSubmitted(ctx)
+			}).
+			Return(nil, core_errors.NewTimeoutError("acquirer timed out")).
+			Once()
+
+		startUsecase := usecaseMocks.NewIStartIdempotentRequestMock(t)
+		startUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(&usecase.StartIdempotentRequestOutput{Replay: false}, nil).
+			Once()
+
+		completeUsecase := usecaseMocks.NewICompleteIdempotentRequestMock(t)
+		completeUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.CompleteIdempotentRequestInput) {
+				assert.Equal(t, http.StatusGatewayTimeout, input.ResponseCode)
+				assert.True(t, input.Submitted)
+			}).
+			Return(nil).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(processPaymentUsecase, usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		idempotencyHandler := handler.NewIdempotencyHandler(startUsecase, completeUsecase)
+		app := InitApp(authConfig, paymentHandler, idempotencyHandler, createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("POST", endpoint, bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusGatewayTimeout, res.StatusCode)
+	})
+}
+
+func TestRefundPayment(t *testing.T) {
+	authConfig := createAuthConfig()
+	authToken, err := createAuthToken()
+	require.Nil(t, err)
+
+	paymentId := uuid.NewString()
+
+	t.Run("with partial refund should return refund data", func(t *testing.T) {
+		refundPaymentUsecase := usecaseMocks.NewIRefundPaymentMock(t)
+		refundPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.RefundPaymentInput{
+				AllowedStores: authentication.Stores,
+				PaymentId:     paymentId,
+				RefundType:    "refund",
+				RefundAmount:  499,
+				Currency:      handler.LegacyCurrency,
+			}).
+			Return(&usecase.RefundPaymentOutput{
+				RefundId:       "A refund id",
+				RefundType:     "refund",
+				RefundAmount:   499,
+				RefundCurrency: "BRL",
+				RefundStatus:   "approved",
+				PaymentId:      paymentId,
+				PaymentStatus:  "partially_refunded",
+			}, nil).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(
+			usecaseMocks.NewIProcessPaymentMock(t),
+			usecaseMocks.NewIFindPaymentMock(t),
+			usecaseMocks.NewICapturePaymentMock(t),
+			refundPaymentUsecase,
+		)
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("POST", "/api/v1/payments/"+paymentId+"/refunds", bytes.NewReader([]byte(`{"value":4.99}`)))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var refund *dto.Refund
+		err = json.Unmarshal(resBody, &refund)
+		require.Nil(t, err)
+		assert.Equal(t, &dto.Refund{
+			Id:            "A refund id",
+			Type:          "refund",
+			Amount:        499,
+			Currency:      "BRL",
+			Value:         4.99,
+			Status:        "approved",
+			PaymentId:     paymentId,
+			PaymentStatus: "partially_refunded",
+		}, refund)
+	})
+
+	t.Run("with v2 partial refund should use the amount", func(t *testing.T) {
+		refundPaymentUsecase := usecaseMocks.NewIRefundPaymentMock(t)
+		refundPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.RefundPaymentInput{
+				AllowedStores: authentication.Stores,
+				PaymentId:     paymentId,
+				RefundType:    "refund",
+				RefundAmount:  499,
+			}).
+			Return(&usecase.RefundPaymentOutput{
+				RefundId:       "A refund id",
+				RefundType:     "refund",
+				RefundAmount:   499,
+				RefundCurrency: "BRL",
+				RefundStatus:   "approved",
+				PaymentId:      paymentId,
+				PaymentStatus:  "partially_refunded",
+			}, nil).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(
+			usecaseMocks.NewIProcessPaymentMock(t),
+			usecaseMocks.NewIFindPaymentMock(t),
+			usecaseMocks.NewICapturePaymentMock(t),
+			refundPaymentUsecase,
+		)
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("POST", "/api/v2/payments/"+paymentId+"/refunds", bytes.NewReader([]byte(`{"amount":499}`)))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("with void should return status UnprocessableEntity when not allowed", func(t *testing.T) {
+		refundPaymentUsecase := usecaseMocks.NewIRefundPaymentMock(t)
+		refundPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.RefundPaymentInput{
+				AllowedStores: authentication.Stores,
+				PaymentId:     paymentId,
+				RefundType:    "void",
+			}).
+			Return(nil, core_errors.NewValidationError("payment cannot be voided")).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(
+			usecaseMocks.NewIProcessPaymentMock(t),
+			usecaseMocks.NewIFindPaymentMock(t),
+			usecaseMocks.NewICapturePaymentMock(t),
+			refundPaymentUsecase,
+		)
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("POST", "/api/v1/payments/"+paymentId+"/void", nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var httpErr *dto.HttpError
+		err = json.Unmarshal(resBody, &httpErr)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"payment cannot be voided"}, httpErr.Message)
+	})
+}
+
+func TestCapturePayment(t *testing.T) {
+	authConfig := createAuthConfig()
+	authToken, err := createAuthToken()
+	require.Nil(t, err)
+
+	paymentId := uuid.NewString()
+
+	t.Run("with partial capture should return capture data", func(t *testing.T) {
+		capturePaymentUsecase := usecaseMocks.NewICapturePaymentMock(t)
+		capturePaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.CapturePaymentInput{
+				AllowedStores: authentication.Stores,
+				PaymentId:     paymentId,
+				CaptureAmount: 499,
+				Currency:      handler.LegacyCurrency,
+			}).
+			Return(&usecase.CapturePaymentOutput{
+				PaymentId:      paymentId,
+				PaymentStatus:  "approved",
+				CapturedAmount: 499,
+				Currency:       "BRL",
+			}, nil).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(
+			usecaseMocks.NewIProcessPaymentMock(t),
+			usecaseMocks.NewIFindPaymentMock(t),
+			capturePaymentUsecase,
+			usecaseMocks.NewIRefundPaymentMock(t),
+		)
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("POST", "/api/v1/payments/"+paymentId+"/capture", bytes.NewReader([]byte(`{"value":4.99}`)))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var capture *dto.Capture
+		err = json.Unmarshal(resBody, &capture)
+		require.Nil(t, err)
+		assert.Equal(t, &dto.Capture{
+			PaymentId:      paymentId,
+			PaymentStatus:  "approved",
+			CapturedAmount: 499,
+			Currency:       "BRL",
+			CapturedValue:  4.99,
+		}, capture)
+	})
+
+	t.Run("with full capture should return status UnprocessableEntity when not authorized", func(t *testing.T) {
+		capturePaymentUsecase := usecaseMocks.NewICapturePaymentMock(t)
+		capturePaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.CapturePaymentInput{
+				AllowedStores: authentication.Stores,
+				PaymentId:     paymentId,
+				Currency:      handler.LegacyCurrency,
+			}).
+			Return(nil, core_errors.NewValidationError("payment cannot be captured")).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(
+			usecaseMocks.NewIProcessPaymentMock(t),
+			usecaseMocks.NewIFindPaymentMock(t),
+			capturePaymentUsecase,
+			usecaseMocks.NewIRefundPaymentMock(t),
+		)
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("POST", "/api/v1/payments/"+paymentId+"/capture", nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var httpErr *dto.HttpError
+		err = json.Unmarshal(resBody, &httpErr)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"payment cannot be captured"}, httpErr.Message)
+	})
+}
+
+func TestRouteTransaction(t *testing.T) {
+	authConfig := createAuthConfig()
+	authToken, err := createAuthToken()
+	require.Nil(t, err)
+
+	endpoint := "/api/v2/payments/route"
+
+	t.Run("with valid request should return the route", func(t *testing.T) {
+		request := &dto.RouteRequest{
+			CardBrand:            "visa",
+			Amount:               20000,
+			Currency:             "BRL",
+			PurchaseInstallments: 1,
+			StoreId:              authentication.Stores[0],
+		}
+
+		routeTransactionUsecase := usecaseMocks.NewIRouteTransactionMock(t)
+		routeTransactionUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.RouteTransactionInput) {
+				assert.Equal(t, request.CardBrand, input.CardBrand)
+				assert.Equal(t, request.Amount, input.PurchaseAmount)
+				assert.Equal(t, request.Currency, input.PurchaseCurrency)
+				assert.Equal(t, request.PurchaseInstallments, input.PurchaseInstallments)
+				assert.Equal(t, request.StoreId, input.StoreId)
+				assert.Equal(t, authentication.Stores, input.AllowedStores)
+			}).
+			Return(&usecase.RouteTransactionOutput{
+				AcquirerName: "rede",
+				RouteRule:    "rede-up-to-500",
+				Evaluations: []*usecase.RouteEvaluationOutput{
+					{Rule: "cielo-up-to-100", Matched: false, Reason: "amount is above 10000"},
+					{Rule: "rede-up-to-500", Matched: true},
+				},
+			}, nil).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		routingHandler := handler.NewRoutingHandler(routeTransactionUsecase)
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), routingHandler, createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		reqBody, err := json.Marshal(request)
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", endpoint, bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var route *dto.Route
+		err = json.Unmarshal(resBody, &route)
+		require.Nil(t, err)
+		assert.Equal(t, "rede", route.AcquirerName)
+		assert.Equal(t, "rede-up-to-500", route.RouteRule)
+		require.Equal(t, 2, len(route.Evaluations))
+		assert.Equal(t, "amount is above 10000", route.Evaluations[0].Reason)
+		assert.True(t, route.Evaluations[1].Matched)
+	})
+
+	t.Run("with empty request should return status bad request", func(t *testing.T) {
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("POST", endpoint, bytes.NewReader([]byte("{}")))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var httpErr *dto.HttpError
+		err = json.Unmarshal(resBody, &httpErr)
+		require.Nil(t, err)
+		assert.Equal(t, []string{
+			"route request card brand is required",
+			"route request amount is required",
+			"route request currency is required",
+			"route request purchase installments is required",
+			"route request store id is required",
+		}, httpErr.Message)
+	})
+}
+
+func TestAcquirerHealth(t *testing.T) {
+	authConfig := createAuthConfig()
+	authToken, err := createAuthToken()
+	require.Nil(t, err)
+
+	openedAt := time.Now().UTC()
+	output := &usecase.FindAcquirerHealthOutput{
+		Acquirers: []*usecase.AcquirerHealthOutput{
+			{AcquirerName: "cielo", State: "closed", Requests: 10, Failures: 1, ErrorRate: 0.1},
+			{AcquirerName: "rede", State: "open", Requests: 20, Failures: 12, Rejections: 3, ErrorRate: 0.6, OpenedAt: openedAt},
+		},
+	}
+
+	createApp := func(t *testing.T) *fiber.App {
+		findAcquirerHealthUsecase := usecaseMocks.NewIFindAcquirerHealthMock(t)
+		findAcquirerHealthUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(output, nil).
+			Once()
+
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		acquirerHandler := handler.NewAcquirerHandler(findAcquirerHealthUsecase)
+		return InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), acquirerHandler, createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+	}
+
+	t.Run("should return the circuit breaker state of each acquirer", func(t *testing.T) {
+		app := createApp(t)
+
+		req := httptest.NewRequest("GET", "/api/v2/admin/acquirers", nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var acquirers []*dto.AcquirerHealth
+		err = json.Unmarshal(resBody, &acquirers)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(acquirers))
+		assert.Equal(t, "cielo", acquirers[0].Name)
+		assert.Equal(t, "closed", acquirers[0].State)
+		assert.Nil(t, acquirers[0].OpenedAt)
+		assert.Equal(t, "rede", acquirers[1].Name)
+		assert.Equal(t, "open", acquirers[1].State)
+		assert.Equal(t, int64(3), acquirers[1].Rejections)
+		assert.True(t, openedAt.Equal(*acquirers[1].OpenedAt))
+	})
+
+	t.Run("should expose the metrics", func(t *testing.T) {
+		app := createApp(t)
+
+		req := httptest.NewRequest("GET", "/metrics", nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		metrics := string(resBody)
+		assert.Contains(t, metrics, `acquirer_circuit_breaker_state{acquirer="cielo",state="closed"} 1`)
+		assert.Contains(t, metrics, `acquirer_circuit_breaker_state{acquirer="rede",state="open"} 1`)
+		assert.Contains(t, metrics, `acquirer_circuit_breaker_state{acquirer="rede",state="closed"} 0`)
+		assert.Contains(t, metrics, `acquirer_requests_total{acquirer="rede"} 20`)
+		assert.Contains(t, metrics, `acquirer_failures_total{acquirer="rede"} 12`)
+		assert.Contains(t, metrics, `acquirer_rejections_total{acquirer="rede"} 3`)
+	})
+
+	t.Run("should not expose the metrics without a token", func(t *testing.T) {
+		acquirerHandler := handler.NewAcquirerHandler(usecaseMocks.NewIFindAcquirerHealthMock(t))
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), acquirerHandler, createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+		req := httptest.NewRequest("GET", "/metrics", nil)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+}
+
+func TestCards(t *testing.T) {
+	authConfig := createAuthConfig()
+	authToken, err := createAuthToken()
+	require.Nil(t, err)
+
+	createApp := func(t *testing.T, cardHandler handler.ICardHandler) *fiber.App {
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		return InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), cardHandler, createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+	}
+
+	t.Run("with valid card should return its token", func(t *testing.T) {
+		request := &dto.CardRequest{
+			CardNumber:       "4111111111111111",
+			CardHolder:       "Holder",
+			CardExpiration:   "01/2030",
+			CardBrand:        "VISA",
+			CardSecurityCode: "123",
+		}
+
+		tokenizeCardUsecase := usecaseMocks.NewITokenizeCardMock(t)
+		tokenizeCardUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.TokenizeCardInput) {
+				assert.Equal(t, request.CardNumber, input.CardNumber)
+				assert.Equal(t, request.CardHolder, input.CardHolder)
+				assert.Equal(t, request.CardExpiration, input.CardExpiration)
+				assert.Equal(t, request.CardBrand, input.CardBrand)
+				assert.Equal(t, request.CardSecurityCode, input.CardSecurityCode)
+			}).
+			Return(&usecase.TokenizeCardOutput{
+				CardToken:       "Token",
+				CardBrand:       "VISA",
+				CardBin:         "411111",
+				CardLast4:       "1111",
+				CardFundingType: "credit",
+				CardCountry:     "US",
+			}, nil).
+			Once()
+
+		app := createApp(t, handler.NewCardHandler(tokenizeCardUsecase, usecaseMocks.NewIDeleteCardMock(t)))
+
+		reqBody, err := json.Marshal(request)
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v1/cards", bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var card dto.Card
+		err = json.Unmarshal(resBody, &card)
+		require.Nil(t, err)
+		assert.Equal(t, dto.Card{
+			CardToken:       "Token",
+			CardBrand:       "VISA",
+			CardBin:         "411111",
+			CardLast4:       "1111",
+			CardFundingType: "credit",
+			CardCountry:     "US",
+		}, card)
+		assert.NotContains(t, string(resBody), request.CardNumber)
+	})
+
+	t.Run("with invalid card should return the validation errors", func(t *testing.T) {
+		tokenizeCardUsecase := usecaseMocks.NewITokenizeCardMock(t)
+		tokenizeCardUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, core_errors.NewValidationError("card number is invalid")).
+			Once()
+
+		app := createApp(t, handler.NewCardHandler(tokenizeCardUsecase, usecaseMocks.NewIDeleteCardMock(t)))
+
+		reqBody, err := json.Marshal(&dto.CardRequest{
+			CardNumber:       "4111",
+			CardHolder:       "Holder",
+			CardExpiration:   "01/2030",
+			CardBrand:        "VISA",
+			CardSecurityCode: "123",
+		})
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v2/cards", bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+	})
+
+	t.Run("with mismatched brand should return the validation errors", func(t *testing.T) {
+		tokenizeCardUsecase := usecaseMocks.NewITokenizeCardMock(t)
+		tokenizeCardUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, core_errors.NewValidationError("card brand does not match the card number")).
+			Once()
+
+		app := createApp(t, handler.NewCardHandler(tokenizeCardUsecase, usecaseMocks.NewIDeleteCardMock(t)))
+
+		reqBody, err := json.Marshal(&dto.CardRequest{
+			CardNumber:       "5555555555554444",
+			CardHolder:       "Holder",
+			CardExpiration:   "01/2030",
+			CardBrand:        "VISA",
+			CardSecurityCode: "123",
+		})
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v1/cards", bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+		assert.Contains(t, string(resBody), "card brand does not match the card number")
+	})
+
+	t.Run("with missing fields should return bad request", func(t *testing.T) {
+		app := createApp(t, handler.NewCardHandler(usecaseMocks.NewITokenizeCardMock(t), usecaseMocks.NewIDeleteCardMock(t)))
+
+		req := httptest.NewRequest("POST", "/api/v1/cards", bytes.NewReader([]byte("{}")))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("delete a card", func(t *testing.T) {
+		deleteCardUsecase := usecaseMocks.NewIDeleteCardMock(t)
+		deleteCardUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.DeleteCardInput{CardToken: "Token"}).
+			Return(&usecase.DeleteCardOutput{}, nil).
+			Once()
+		deleteCardUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.DeleteCardInput{CardToken: "Unknown"}).
+			Return(nil, core_errors.NewNotFoundError("card token is invalid")).
+			Once()
+
+		app := createApp(t, handler.NewCardHandler(usecaseMocks.NewITokenizeCardMock(t), deleteCardUsecase))
+
+		req := httptest.NewRequest("DELETE", "/api/v1/cards/Token", nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+		req = httptest.NewRequest("DELETE", "/api/v1/cards/Unknown", nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err = app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
+
+func TestStores(t *testing.T) {
+	authConfig := createAuthConfig()
+	authToken, err := createAuthToken()
+	require.Nil(t, err)
+
+	storeOutput := &usecase.StoreOutput{
+		StoreId:        authentication.Stores[0],
+		Identification: "11222333000181",
+		DocumentType:   "cnpj",
+		Address:        "Avenida Paulista, 1000",
+		Cep:            "01310100",
+		Street:         "Avenida Paulista",
+		Number:         "1000",
+		City:           "São Paulo",
+		State:          "SP",
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+	}
+
+	request := &dto.StoreRequest{
+		Identification: "11.222.333/0001-81",
+		Address:        "Avenida Paulista, 1000",
+		Cep:            "01310-100",
+		Number:         "1000",
+	}
+
+	createApp := func(t *testing.T, storeHandler handler.IStoreHandler) *fiber.App {
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		return InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), storeHandler, createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+	}
+
+	t.Run("should register a store", func(t *testing.T) {
+		createStoreUsecase := usecaseMocks.NewICreateStoreMock(t)
+		createStoreUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.CreateStoreInput) {
+				assert.Equal(t, request.Identification, input.Identification)
+				assert.Equal(t, request.Address, input.Address)
+				assert.Equal(t, request.Cep, input.Cep)
+				assert.Equal(t, request.Number, input.Number)
+			}).
+			Return(&usecase.CreateStoreOutput{Store: storeOutput}, nil).
+			Once()
+
+		storeHandler := handler.NewStoreHandler(createStoreUsecase, usecaseMocks.NewIListStoresMock(t), usecaseMocks.NewIFindStoreMock(t), usecaseMocks.NewIUpdateStoreMock(t), usecaseMocks.NewIDeleteStoreMock(t))
+		app := createApp(t, storeHandler)
+
+		reqBody, err := json.Marshal(request)
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v2/admin/stores", bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var store *dto.Store
+		err = json.Unmarshal(resBody, &store)
+		require.Nil(t, err)
+		assert.Equal(t, storeOutput.StoreId, store.Id)
+		assert.Equal(t, storeOutput.DocumentType, store.DocumentType)
+		assert.Equal(t, storeOutput.Street, store.Street)
+		assert.Equal(t, storeOutput.State, store.State)
+	})
+
+	t.Run("with empty store should return status bad request", func(t *testing.T) {
+		app := createApp(t, createStoreHandler(t))
+
+		req := httptest.NewRequest("POST", "/api/v2/admin/stores", bytes.NewReader([]byte("{}")))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var httpErr *dto.HttpError
+		err = json.Unmarshal(resBody, &httpErr)
+		require.Nil(t, err)
+		assert.Equal(t, []string{
+			"store request identification is required",
+			"store request address is required",
+			"store request cep is required",
+		}, httpErr.Message)
+	})
+
+	t.Run("should list, find, update and delete stores", func(t *testing.T) {
+		listStoresUsecase := usecaseMocks.NewIListStoresMock(t)
+		listStoresUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(&usecase.ListStoresOutput{Stores: []*usecase.StoreOutput{storeOutput}}, nil).
+			Once()
+
+		findStoreUsecase := usecaseMocks.NewIFindStoreMock(t)
+		findStoreUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.FindStoreInput{StoreId: storeOutput.StoreId}).
+			Return(&usecase.FindStoreOutput{Store: storeOutput}, nil).
+			Once()
+
+		updateStoreUsecase := usecaseMocks.NewIUpdateStoreMock(t)
+		updateStoreUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.UpdateStoreInput) {
+				assert.Equal(t, storeOutput.StoreId, input.StoreId)
+				assert.Equal(t, request.Identification, input.Identification)
+			}).
+			Return(&usecase.UpdateStoreOutput{Store: storeOutput}, nil).
+			Once()
+
+		deleteStoreUsecase := usecaseMocks.NewIDeleteStoreMock(t)
+		deleteStoreUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.DeleteStoreInput{StoreId: storeOutput.StoreId}).
+			Return(nil, core_errors.NewNotFoundError("store id is invalid")).
+			Once()
+
+		storeHandler := handler.NewStoreHandler(usecaseMocks.NewICreateStoreMock(t), listStoresUsecase, findStoreUsecase, updateStoreUsecase, deleteStoreUsecase)
+		app := createApp(t, storeHandler)
+		endpoint := "/api/v2/admin/stores/" + storeOutput.StoreId
+
+		req := httptest.NewRequest("GET", "/api/v2/admin/stores", nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var stores []*dto.Store
+		err = json.Unmarshal(resBody, &stores)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(stores))
+		assert.Equal(t, storeOutput.StoreId, stores[0].Id)
+
+		req = httptest.NewRequest("GET", endpoint, nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err = app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		reqBody, err := json.Marshal(request)
+		require.Nil(t, err)
+
+		req = httptest.NewRequest("PUT", endpoint, bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err = app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		req = httptest.NewRequest("DELETE", endpoint, nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err = app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
+
+func TestWebhooks(t *testing.T) {
+	authConfig := createAuthConfig()
+	caller := uuid.NewString()
+
+	token, err := authentication.NewAuthToken(jwt.MapClaims{
+		"sub":   caller,
+		"iss":   authentication.Issuer,
+		"aud":   authentication.Audience,
+		"scope": "webhooks:write",
+		"exp":   time.Now().Add(5 * time.Minute).Unix(),
+	})
+	require.Nil(t, err)
+	authToken := "Bearer " + token
+
+	webhookOutput := &usecase.WebhookOutput{
+		WebhookId: uuid.NewString(),
+		Url:       "https://example.com/webhooks",
+		Events:    []string{"payment.approved", "payment.refunded"},
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	deliveryOutput := &usecase.WebhookDeliveryOutput{
+		DeliveryId:      uuid.NewString(),
+		EventId:         uuid.NewString(),
+		EventType:       "payment.approved",
+		PaymentId:       uuid.NewString(),
+		Payload:         []byte(`{"type":"payment.approved"}`),
+		Status:          "failed",
+		Attempts:        15,
+		NextAttemptAt:   time.Now().UTC(),
+		ResponseCode:    500,
+		ResponseMessage: "webhook answered with status 500",
+		CreatedAt:       time.Now().UTC(),
+		UpdatedAt:       time.Now().UTC(),
+	}
+
+	request := &dto.WebhookRequest{
+		Url:    "https://example.com/webhooks",
+		Events: []string{"payment.approved", "payment.refunded"},
+		Secret: "a-secret-of-16-chars",
+	}
+
+	createApp := func(t *testing.T, webhookHandler handler.IWebhookHandler) *fiber.App {
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		return InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), webhookHandler, createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+	}
+
+	t.Run("should subscribe a webhook of the caller", func(t *testing.T) {
+		createWebhookUsecase := usecaseMocks.NewICreateWebhookMock(t)
+		createWebhookUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.CreateWebhookInput{
+				Caller: caller,
+				Url:    request.Url,
+				Events: request.Events,
+				Secret: request.Secret,
+			}).
+			Return(&usecase.CreateWebhookOutput{Webhook: webhookOutput}, nil).
+			Once()
+
+		webhookHandler := handler.NewWebhookHandler(createWebhookUsecase, usecaseMocks.NewIListWebhooksMock(t), usecaseMocks.NewIFindWebhookMock(t), usecaseMocks.NewIUpdateWebhookMock(t), usecaseMocks.NewIDeleteWebhookMock(t), usecaseMocks.NewIListWebhookDeliveriesMock(t), usecaseMocks.NewIRedeliverWebhookMock(t))
+		app := createApp(t, webhookHandler)
+
+		reqBody, err := json.Marshal(request)
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v2/webhooks", bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var webhook *dto.Webhook
+		err = json.Unmarshal(resBody, &webhook)
+		require.Nil(t, err)
+		assert.Equal(t, webhookOutput.WebhookId, webhook.Id)
+		assert.Equal(t, webhookOutput.Url, webhook.Url)
+		assert.Equal(t, webhookOutput.Events, webhook.Events)
+		assert.NotContains(t, string(resBody), "secret")
+	})
+
+	t.Run("with empty webhook should return status bad request", func(t *testing.T) {
+		app := createApp(t, createWebhookHandler(t))
+
+		req := httptest.NewRequest("POST", "/api/v2/webhooks", bytes.NewReader([]byte("{}")))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var httpErr *dto.HttpError
+		err = json.Unmarshal(resBody, &httpErr)
+		require.Nil(t, err)
+		assert.Equal(t, []string{
+			"webhook request url is required",
+			"webhook request events is required",
+		}, httpErr.Message)
+	})
+
+	t.Run("should list, find, update and delete webhooks", func(t *testing.T) {
+		listWebhooksUsecase := usecaseMocks.NewIListWebhooksMock(t)
+		listWebhooksUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.ListWebhooksInput{Caller: caller}).
+			Return(&usecase.ListWebhooksOutput{Webhooks: []*usecase.WebhookOutput{webhookOutput}}, nil).
+			Once()
+
+		findWebhookUsecase := usecaseMocks.NewIFindWebhookMock(t)
+		findWebhookUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.FindWebhookInput{Caller: caller, WebhookId: webhookOutput.WebhookId}).
+			Return(&usecase.FindWebhookOutput{Webhook: webhookOutput}, nil).
+			Once()
+
+		updateWebhookUsecase := usecaseMocks.NewIUpdateWebhookMock(t)
+		updateWebhookUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.UpdateWebhookInput) {
+				assert.Equal(t, caller, input.Caller)
+				assert.Equal(t, webhookOutput.WebhookId, input.WebhookId)
+				assert.Equal(t, request.Url, input.Url)
+			}).
+			Return(&usecase.UpdateWebhookOutput{Webhook: webhookOutput}, nil).
+			Once()
+
+		deleteWebhookUsecase := usecaseMocks.NewIDeleteWebhookMock(t)
+		deleteWebhookUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.DeleteWebhookInput{Caller: caller, WebhookId: webhookOutput.WebhookId}).
+			Return(&usecase.DeleteWebhookOutput{}, nil).
+			Once()
+
+		webhookHandler := handler.NewWebhookHandler(usecaseMocks.NewICreateWebhookMock(t), listWebhooksUsecase, findWebhookUsecase, updateWebhookUsecase, deleteWebhookUsecase, usecaseMocks.NewIListWebhookDeliveriesMock(t), usecaseMocks.NewIRedeliverWebhookMock(t))
+		app := createApp(t, webhookHandler)
+		endpoint := "/api/v2/webhooks/" + webhookOutput.WebhookId
+
+		req := httptest.NewRequest("GET", "/api/v2/webhooks", nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var webhooks []*dto.Webhook
+		err = json.Unmarshal(resBody, &webhooks)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(webhooks))
+		assert.Equal(t, webhookOutput.WebhookId, webhooks[0].Id)
+
+		req = httptest.NewRequest("GET", endpoint, nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err = app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		reqBody, err := json.Marshal(request)
+		require.Nil(t, err)
+
+		req = httptest.NewRequest("PUT", endpoint, bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err = app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		req = httptest.NewRequest("DELETE", endpoint, nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err = app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+	})
+
+	t.Run("should list and redeliver the deliveries of a webhook", func(t *testing.T) {
+		listDeliveriesUsecase := usecaseMocks.NewIListWebhookDeliveriesMock(t)
+		listDeliveriesUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.ListWebhookDeliveriesInput{Caller: caller, WebhookId: webhookOutput.WebhookId, Limit: 100}).
+			Return(&usecase.ListWebhookDeliveriesOutput{Deliveries: []*usecase.WebhookDeliveryOutput{deliveryOutput}}, nil).
+			Once()
+
+		redeliverWebhookUsecase := usecaseMocks.NewIRedeliverWebhookMock(t)
+		redeliverWebhookUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.RedeliverWebhookInput{Caller: caller, WebhookId: webhookOutput.WebhookId, DeliveryId: deliveryOutput.DeliveryId}).
+			Return(&usecase.RedeliverWebhookOutput{Delivery: deliveryOutput}, nil).
+			Once()
+		redeliverWebhookUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.RedeliverWebhookInput{Caller: caller, WebhookId: webhookOutput.WebhookId, DeliveryId: "Invalid"}).
+			Return(nil, core_errors.NewNotFoundError("delivery id is invalid")).
+			Once()
+
+		webhookHandler := handler.NewWebhookHandler(usecaseMocks.NewICreateWebhookMock(t), usecaseMocks.NewIListWebhooksMock(t), usecaseMocks.NewIFindWebhookMock(t), usecaseMocks.NewIUpdateWebhookMock(t), usecaseMocks.NewIDeleteWebhookMock(t), listDeliveriesUsecase, redeliverWebhookUsecase)
+		app := createApp(t, webhookHandler)
+		endpoint := "/api/v2/webhooks/" + webhookOutput.WebhookId + "/deliveries"
+
+		req := httptest.NewRequest("GET", endpoint, nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var deliveries []*dto.WebhookDelivery
+		err = json.Unmarshal(resBody, &deliveries)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(deliveries))
+		assert.Equal(t, deliveryOutput.DeliveryId, deliveries[0].Id)
+		assert.JSONEq(t, string(deliveryOutput.Payload), string(deliveries[0].Payload))
+
+		req = httptest.NewRequest("POST", endpoint+"/"+deliveryOutput.DeliveryId+"/redeliver", nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err = app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusAccepted, res.StatusCode)
+
+		req = httptest.NewRequest("POST", endpoint+"/Invalid/redeliver", nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err = app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
+
+func TestPaymentBatches(t *testing.T) {
+	authConfig := createAuthConfig()
+	authToken, err := createAuthToken()
+	require.Nil(t, err)
+
+	endpoint := "/api/v1/payments/batch"
+
+	createApp := func(t *testing.T, paymentBatchHandler handler.IPaymentBatchHandler) *fiber.App {
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		return InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), paymentBatchHandler, createSettlementHandler(t), createPaymentSearchHandler(t))
+	}
+
+	batchOutput := func(status string) *usecase.PaymentBatchOutput {
+		return &usecase.PaymentBatchOutput{
+			BatchId:   uuid.NewString(),
+			Status:    status,
+			Succeeded: 1,
+			Failed:    1,
+			Items: []*usecase.PaymentBatchItemOutput{
+				{Index: 0, Status: "succeeded", PaymentId: uuid.NewString(), PaymentStatus: "approved"},
+				{Index: 1, Status: "failed", ErrorType: "validation", ErrorMessages: []string{"transaction card_token is required"}},
+			},
+			CreatedAt: time.Now().UTC(),
+			UpdatedAt: time.Now().UTC(),
+		}
+	}
+
+	t.Run("with small batch should return the result of each transaction", func(t *testing.T) {
+		valid := createTransactionDto()
+		invalid := createTransactionDto()
+		invalid.CardToken = ""
+		output := batchOutput("done")
+
+		createPaymentBatchUsecase := usecaseMocks.NewICreatePaymentBatchMock(t)
+		createPaymentBatchUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.CreatePaymentBatchInput) {
+				assert.NotEmpty(t, input.Caller)
+				assert.Equal(t, authentication.Stores, input.AllowedStores)
+				require.Equal(t, 2, len(input.Transactions))
+				assert.Equal(t, int64(999), input.Transactions[0].PurchaseAmount)
+				assert.Equal(t, "BRL", input.Transactions[0].PurchaseCurrency)
+				assert.Empty(t, input.Transactions[0].Invalid)
+				assert.NotEmpty(t, input.Transactions[1].Invalid)
+			}).
+			Return(&usecase.CreatePaymentBatchOutput{Batch: output}, nil).
+			Once()
+
+		app := createApp(t, handler.NewPaymentBatchHandler(createPaymentBatchUsecase, usecaseMocks.NewIFindPaymentBatchMock(t)))
+
+		reqBody, err := json.Marshal(&dto.PaymentBatchRequest{Transactions: []*dto.Transaction{valid, invalid}})
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", endpoint, bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var batch *dto.PaymentBatch
+		err = json.Unmarshal(resBody, &batch)
+		require.Nil(t, err)
+		assert.Equal(t, output.BatchId, batch.Id)
+		assert.Equal(t, "done", batch.Status)
+		assert.Equal(t, endpoint+"/"+output.BatchId, batch.StatusUrl)
+		assert.Equal(t, 1, batch.Succeeded)
+		assert.Equal(t, 1, batch.Failed)
+		require.Equal(t, 2, len(batch.Items))
+		assert.Equal(t, output.Items[0].PaymentId, batch.Items[0].PaymentId)
+		assert.Equal(t, "approved", batch.Items[0].PaymentStatus)
+		assert.Nil(t, batch.Items[0].Error)
+		require.NotNil(t, batch.Items[1].Error)
+		assert.Equal(t, "validation", batch.Items[1].Error.Type)
+		assert.Equal(t, []string{"transaction card_token is required"}, batch.Items[1].Error.Message)
+	})
+
+	t.Run("with large batch should return status accepted and the status url", func(t *testing.T) {
+		output := batchOutput("queued")
+
+		createPaymentBatchUsecase := usecaseMocks.NewICreatePaymentBatchMock(t)
+		createPaymentBatchUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(&usecase.CreatePaymentBatchOutput{Batch: output}, nil).
+			Once()
+
+		app := createApp(t, handler.NewPaymentBatchHandler(createPaymentBatchUsecase, usecaseMocks.NewIFindPaymentBatchMock(t)))
+
+		reqBody, err := json.Marshal(&dto.PaymentBatchRequest{Transactions: []*dto.Transaction{createTransactionDto()}})
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", endpoint, bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusAccepted, res.StatusCode)
+		assert.Equal(t, endpoint+"/"+output.BatchId, res.Header.Get("Location"))
+	})
+
+	t.Run("with v2 batch should use the amount and currency of each transaction", func(t *testing.T) {
+		usd := createTransactionV2Dto()
+		usd.Currency = "USD"
+		invalid := createTransactionV2Dto()
+		invalid.Amount = 0
+		output := batchOutput("done")
+
+		createPaymentBatchUsecase := usecaseMocks.NewICreatePaymentBatchMock(t)
+		createPaymentBatchUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.CreatePaymentBatchInput) {
+				assert.NotEmpty(t, input.Caller)
+				assert.Equal(t, authentication.Stores, input.AllowedStores)
+				require.Equal(t, 2, len(input.Transactions))
+				assert.Equal(t, int64(999), input.Transactions[0].PurchaseAmount)
+				assert.Equal(t, "USD", input.Transactions[0].PurchaseCurrency)
+				assert.Empty(t, input.Transactions[0].Invalid)
+				assert.NotEmpty(t, input.Transactions[1].Invalid)
+			}).
+			Return(&usecase.CreatePaymentBatchOutput{Batch: output}, nil).
+			Once()
+
+		app := createApp(t, handler.NewPaymentBatchHandler(createPaymentBatchUsecase, usecaseMocks.NewIFindPaymentBatchMock(t)))
+
+		reqBody, err := json.Marshal(&dto.PaymentBatchRequestV2{Transactions: []*dto.TransactionV2{usd, invalid}})
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v2/payments/batch", bytes.NewReader(reqBody))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var batch *dto.PaymentBatch
+		err = json.Unmarshal(resBody, &batch)
+		require.Nil(t, err)
+		assert.Equal(t, "/api/v2/payments/batch/"+output.BatchId, batch.StatusUrl)
+	})
+
+	t.Run("without transactions should return status bad request", func(t *testing.T) {
+		app := createApp(t, createPaymentBatchHandler(t))
+
+		req := httptest.NewRequest("POST", endpoint, bytes.NewReader([]byte(`{}`)))
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("should find a batch of the caller", func(t *testing.T) {
+		output := batchOutput("processing")
+
+		findPaymentBatchUsecase := usecaseMocks.NewIFindPaymentBatchMock(t)
+		findPaymentBatchUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.FindPaymentBatchInput) {
+				assert.NotEmpty(t, input.Caller)
+				assert.Equal(t, output.BatchId, input.BatchId)
+			}).
+			Return(&usecase.FindPaymentBatchOutput{Batch: output}, nil).
+			Once()
+
+		app := createApp(t, handler.NewPaymentBatchHandler(usecaseMocks.NewICreatePaymentBatchMock(t), findPaymentBatchUsecase))
+
+		req := httptest.NewRequest("GET", endpoint+"/"+output.BatchId, nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var batch *dto.PaymentBatch
+		err = json.Unmarshal(resBody, &batch)
+		require.Nil(t, err)
+		assert.Equal(t, "processing", batch.Status)
+		assert.Equal(t, endpoint+"/"+output.BatchId, batch.StatusUrl)
+	})
+}
+
+func TestSettlements(t *testing.T) {
+	authConfig := createAuthConfig()
+	authToken, err := createAuthToken()
+	require.Nil(t, err)
+
+	createApp := func(t *testing.T, settlementHandler handler.ISettlementHandler) *fiber.App {
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		return InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), settlementHandler, createPaymentSearchHandler(t))
+	}
+
+	settlementOutput := &usecase.SettlementOutput{
+		SettlementId: uuid.NewString(),
+		Acquirer:     "cielo",
+		Format:       "csv",
+		FileName:     "cielo.csv",
+		Lines:        2,
+		Matched:      1,
+		Unexpected:   1,
+		PeriodStart:  time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:    time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC),
+		Discrepancies: []*usecase.SettlementDiscrepancyOutput{
+			{Type: "unexpected", Line: 3, AcquirerId: "A9", SettledAmount: 500, SettledCurrency: "BRL", Date: time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)},
+		},
+		CreatedAt: time.Now().UTC(),
+	}
+
+	newRequest := func(t *testing.T, fields map[string]string, file string) *http.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+
+		for name, value := range fields {
+			err := writer.WriteField(name, value)
+			require.Nil(t, err)
+		}
+
+		if file != "" {
+			part, err := writer.CreateFormFile("file", "cielo.csv")
+			require.Nil(t, err)
+
+			_, err = part.Write([]byte(file))
+			require.Nil(t, err)
+		}
+
+		err := writer.Close()
+		require.Nil(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v2/admin/settlements", body)
+		req.Header.Set("Authorization", authToken)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		return req
+	}
+
+	t.Run("should import a settlement file", func(t *testing.T) {
+		file := "acquirer_id,date,amount,currency\nA1,2026-10-01,9.99,BRL\n"
+
+		importSettlementUsecase := usecaseMocks.NewIImportSettlementMock(t)
+		importSettlementUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.ImportSettlementInput) {
+				assert.Equal(t, "cielo", input.Acquirer)
+				assert.Equal(t, "csv", input.Format)
+				assert.Equal(t, "cielo.csv", input.FileName)
+
+				content, err := io.ReadAll(input.File)
+				require.Nil(t, err)
+				assert.Equal(t, file, string(content))
+			}).
+			Return(&usecase.ImportSettlementOutput{Settlement: settlementOutput}, nil).
+			Once()
+
+		app := createApp(t, handler.NewSettlementHandler(importSettlementUsecase, usecaseMocks.NewIFindSettlementMock(t)))
+
+		res, err := app.Test(newRequest(t, map[string]string{"acquirer": "cielo", "format": "csv"}, file), -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var settlement *dto.Settlement
+		err = json.Unmarshal(resBody, &settlement)
+		require.Nil(t, err)
+		assert.Equal(t, settlementOutput.SettlementId, settlement.Id)
+		assert.Equal(t, 1, settlement.Matched)
+		assert.Equal(t, 1, settlement.Unexpected)
+		assert.Equal(t, "2026-10-01", settlement.PeriodStart)
+		assert.Equal(t, "2026-10-02", settlement.PeriodEnd)
+		require.Equal(t, 1, len(settlement.Discrepancies))
+		assert.Equal(t, "unexpected", settlement.Discrepancies[0].Type)
+		assert.Equal(t, int64(500), settlement.Discrepancies[0].SettledAmount)
+	})
+
+	t.Run("without file should return status unprocessable entity", func(t *testing.T) {
+		importSettlementUsecase := usecaseMocks.NewIImportSettlementMock(t)
+		importSettlementUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, input *usecase.ImportSettlementInput) {
+				assert.Nil(t, input.File)
+			}).
+			Return(nil, core_errors.NewValidationError("settlement file is required")).
+			Once()
+
+		app := createApp(t, handler.NewSettlementHandler(importSettlementUsecase, usecaseMocks.NewIFindSettlementMock(t)))
+
+		res, err := app.Test(newRequest(t, map[string]string{"acquirer": "cielo", "format": "csv"}, ""), -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+	})
+
+	t.Run("should find a settlement", func(t *testing.T) {
+		findSettlementUsecase := usecaseMocks.NewIFindSettlementMock(t)
+		findSettlementUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.FindSettlementInput{SettlementId: settlementOutput.SettlementId}).
+			Return(&usecase.FindSettlementOutput{Settlement: settlementOutput}, nil).
+			Once()
+
+		app := createApp(t, handler.NewSettlementHandler(usecaseMocks.NewIImportSettlementMock(t), findSettlementUsecase))
+
+		req := httptest.NewRequest("GET", "/api/v2/admin/settlements/"+settlementOutput.SettlementId, nil)
+		req.Header.Set("Authorization", authToken)
+
+		res, err := app.Test(req, -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
+}
+
+func TestSearchPayments(t *testing.T) {
+	authConfig := createAuthConfig()
+	authToken, err := createAuthToken()
+	require.Nil(t, err)
+
+	createApp := func(t *testing.T, paymentSearchHandler handler.IPaymentSearchHandler) *fiber.App {
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), usecaseMocks.NewIFindPaymentMock(t), usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		return InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), paymentSearchHandler)
+	}
+
+	newRequest := func(query string) *http.Request {
+		req := httptest.NewRequest("GET", "/api/v1/payments?"+query, nil)
+		req.Header.Set("Authorization", authToken)
+		return req
+	}
+
+	t.Run("should search the payments", func(t *testing.T) {
+		paymentOutput := &usecase.PaymentSummaryOutput{
+			PaymentId:        uuid.NewString(),
+			PaymentStatus:    "approved",
+			CardBrand:        "VISA",
+			CardLast4:        "1111",
+			PurchaseAmount:   999,
+			PurchaseCurrency: "BRL",
+			StoreId:          authentication.Stores[0],
+			AcquirerName:     "cielo",
+			AcquirerId:       "Acquirer Id",
+			CapturedAmount:   999,
+			CreatedAt:        time.Now().UTC(),
+			UpdatedAt:        time.Now().UTC(),
+		}
+
+		searchPaymentsUsecase := usecaseMocks.NewISearchPaymentsMock(t)
+		searchPaymentsUsecase.
+			EXPECT().
+			Execute(mock.Anything, &usecase.SearchPaymentsInput{
+				AllowedStores: authentication.Stores,
+				StoreId:       authentication.Stores[0],
+				AcquirerName:  "cielo",
+				Statuses:      []string{"approved", "refunded"},
+				CardLast4:     "1111",
+				MinAmount:     100,
+				MaxAmount:     5000,
+				CreatedFrom:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+				CreatedTo:     time.Date(2026, 10, 11, 0, 0, 0, 0, time.UTC),
+				Sort:          "-amount",
+				Cursor:        "Cursor",
+				Limit:         10,
+			}).
+			Return(&usecase.SearchPaymentsOutput{Payments: []*usecase.PaymentSummaryOutput{paymentOutput}, NextCursor: "Next Cursor"}, nil).
+			Once()
+
+		app := createApp(t, handler.NewPaymentSearchHandler(searchPaymentsUsecase))
+
+		query := "store_id=" + authentication.Stores[0] +
+			"&acquirer_name=cielo&status=approved,refunded&card_last4=1111&min_amount=100&max_amount=5000" +
+			"&created_from=2026-10-01T00:00:00Z&created_to=2026-10-10&sort=-amount&cursor=Cursor&limit=10"
+
+		res, err := app.Test(newRequest(query), -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var page *dto.PaymentPage
+		err = json.Unmarshal(resBody, &page)
+		require.Nil(t, err)
+		assert.Equal(t, "Next Cursor", page.NextCursor)
+		require.Equal(t, 1, len(page.Payments))
+		assert.Equal(t, paymentOutput.PaymentId, page.Payments[0].Id)
+		assert.Equal(t, "1111", page.Payments[0].CardLast4)
+		assert.Equal(t, int64(999), page.Payments[0].Amount)
+		assert.Equal(t, authentication.Stores[0], page.Payments[0].StoreId)
+	})
+
+	t.Run("with invalid filters should return status bad request", func(t *testing.T) {
+		app := createApp(t, createPaymentSearchHandler(t))
+
+		res, err := app.Test(newRequest("min_amount=abc&created_to=10/10/2026"), -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(res.Body)
+		require.Nil(t, err)
+
+		var httpError *dto.HttpError
+		err = json.Unmarshal(resBody, &httpError)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"min_amount must be an integer", "created_to must be a RFC 3339 time or a YYYY-MM-DD date"}, httpError.Message)
+	})
+
+	t.Run("with a store of another client should return status forbidden", func(t *testing.T) {
+		searchPaymentsUsecase := usecaseMocks.NewISearchPaymentsMock(t)
+		searchPaymentsUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, core_errors.NewForbiddenError("store is not allowed for this client")).
+			Once()
+
+		app := createApp(t, handler.NewPaymentSearchHandler(searchPaymentsUsecase))
+
+		res, err := app.Test(newRequest("store_id="+uuid.NewString()), -1)
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+}
+
+func TestAuthorization(t *testing.T) {
+	authConfig := createAuthConfig()
+
+	createApp := func(t *testing.T) *fiber.App {
+		findPaymentUsecase := usecaseMocks.NewIFindPaymentMock(t)
+		findPaymentUsecase.
+			EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			Return(nil, core_errors.NewNotFoundError("payment id is invalid")).
+			Maybe()
+
+		paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), findPaymentUsecase, usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+		return InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+	}
+
+	claims := func(edit func(claims jwt.MapClaims)) jwt.MapClaims {
+		claims := jwt.MapClaims{
+			"sub":   "A service",
+			"iss":   authentication.Issuer,
+			"aud":   authentication.Audience,
+			"scope": "payments:read",
+			"exp":   time.Now().Add(time.Minute).Unix(),
+		}
+		edit(claims)
+		return claims
+	}
+
+	testCases := []struct {
+		Test     string
+		Method   string
+		Endpoint string
+		Claims   jwt.MapClaims
+		Status   int
+	}{
+		{
+			"token with the route scope",
+			"GET", "/api/v2/payments/" + uuid.NewString(),
+			claims(func(claims jwt.MapClaims) {}),
+			http.StatusNotFound,
+		},
+		{
+			"token of another issuer",
+			"GET", "/api/v2/payments/" + uuid.NewString(),
+			claims(func(claims jwt.MapClaims) { claims["iss"] = "another-issuer" }),
+			http.StatusUnauthorized,
+		},
+		{
+			"token for another audience",
+			"GET", "/api/v2/payments/" + uuid.NewString(),
+			claims(func(claims jwt.MapClaims) { claims["aud"] = []string{"another-audience"} }),
+			http.StatusUnauthorized,
+		},
+		{
+			"token among audiences",
+			"GET", "/api/v2/payments/" + uuid.NewString(),
+			claims(func(claims jwt.MapClaims) { claims["aud"] = []string{"another-audience", authentication.Audience} }),
+			http.StatusNotFound,
+		},
+		{
+			"token without subject",
+			"GET", "/api/v2/payments/" + uuid.NewString(),
+			claims(func(claims jwt.MapClaims) { delete(claims, "sub") }),
+			http.StatusUnauthorized,
+		},
+		{
+			"token without the route scope",
+			"POST", "/api/v2/payments/process",
+			claims(func(claims jwt.MapClaims) {}),
+			http.StatusForbidden,
+		},
+		{
+			"token without scopes",
+			"GET", "/api/v1/payments/" + uuid.NewString(),
+			claims(func(claims jwt.MapClaims) { delete(claims, "scope") }),
+			http.StatusForbidden,
+		},
+		{
+			"token without the admin scope",
+			"GET", "/api/v2/admin/stores",
+			claims(func(claims jwt.MapClaims) { claims["scope"] = "payments:read payments:write refunds:write" }),
+			http.StatusForbidden,
+		},
+		{
+			"metrics token without the admin scope",
+			"GET", "/metrics",
+			claims(func(claims jwt.MapClaims) { claims["scope"] = "payments:read payments:write refunds:write" }),
+			http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Test, func(t *testing.T) {
+			token, err := authentication.NewAuthToken(tc.Claims)
+			require.Nil(t, err)
+
+			app := createApp(t)
+
+			req := httptest.NewRequest(tc.Method, tc.Endpoint, nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := app.Test(req, -1)
+			require.Nil(t, err)
+			assert.Equal(t, tc.Status, res.StatusCode)
+		})
+	}
+}
+
+func TestAuthorizationWithJwks(t *testing.T) {
+	testCases := []struct {
+		Test   string
+		KeyId  string
+		Status int
+	}{
+		{"token signed by a key of the set", authentication.KeyId, http.StatusNotFound},
+		{"token signed by a key out of the set", "another-key", http.StatusUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Test, func(t *testing.T) {
+			jwks := authentication.Jwks()
+			jwks["keys"].([]fiber.Map)[0]["kid"] = tc.KeyId
+
+			data, err := json.Marshal(jwks)
+			require.Nil(t, err)
+
+			jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+			err = os.WriteFile(jwksFile, data, 0o600)
+			require.Nil(t, err)
+
+			authConfig := createAuthConfig()
+			authConfig.Keys = service.NewJwksAuthKeySet(jwksFile)
+
+			findPaymentUsecase := usecaseMocks.NewIFindPaymentMock(t)
+			findPaymentUsecase.
+				EXPECT().
+				Execute(mock.Anything, mock.Anything).
+				Return(nil, core_errors.NewNotFoundError("payment id is invalid")).
+				Maybe()
+
+			paymentHandler := handler.NewPaymentHandler(usecaseMocks.NewIProcessPaymentMock(t), findPaymentUsecase, usecaseMocks.NewICapturePaymentMock(t), usecaseMocks.NewIRefundPaymentMock(t))
+			app := InitApp(authConfig, paymentHandler, createIdempotencyHandler(t), createRoutingHandler(t), createAcquirerHandler(t), createCardHandler(t), createStoreHandler(t), createWebhookHandler(t), createPaymentBatchHandler(t), createSettlementHandler(t), createPaymentSearchHandler(t))
+
+			token, err := createAuthToken()
+			require.Nil(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/payments/"+uuid.NewString(), nil)
+			req.Header.Set("Authorization", token)
+
+			res, err := app.Test(req, -1)
+			require.Nil(t, err)
+			assert.Equal(t, tc.Status, res.StatusCode)
+		})
+	}
+}
+
+func createAuthConfig() AuthConfig {
+	return AuthConfig{
+		Keys:     service.NewStaticAuthKeySet(&authentication.PublicKey),
+		Issuer:   authentication.Issuer,
+		Audience: authentication.Audience,
+	}
+}
+
+func createAuthToken() (string, error) {
+	token, err := authentication.GetAuthToken()
+	if err != nil {
+		return "", err
+	}
+
+	return "Bearer " + token, nil
+}
+
+func createIdempotencyHandler(t *testing.T) *handler.IdempotencyHandler {
+	return handler.NewIdempotencyHandler(
+		usecaseMocks.NewIStartIdempotentRequestMock(t),
+		usecaseMocks.NewICompleteIdempotentRequestMock(t),
+	)
+}
+
+func createRoutingHandler(t *testing.T) *handler.RoutingHandler {
+	return handler.NewRoutingHandler(usecaseMocks.NewIRouteTransactionMock(t))
+}
+
+func createAcquirerHandler(t *testing.T) *handler.AcquirerHandler {
+	return handler.NewAcquirerHandler(usecaseMocks.NewIFindAcquirerHealthMock(t))
+}
+
+func createCardHandler(t *testing.T) *handler.CardHandler {
+	return handler.NewCardHandler(usecaseMocks.NewITokenizeCardMock(t), usecaseMocks.NewIDeleteCardMock(t))
+}
+
+func createStoreHandler(t *testing.T) *handler.StoreHandler {
+	return handler.NewStoreHandler(
+		usecaseMocks.NewICreateStoreMock(t),
+		usecaseMocks.NewIListStoresMock(t),
+		usecaseMocks.NewIFindStoreMock(t),
+		usecaseMocks.NewIUpdateStoreMock(t),
+		usecaseMocks.NewIDeleteStoreMock(t),
+	)
+}
+
+func createWebhookHandler(t *testing.T) *handler.WebhookHandler {
+	return handler.NewWebhookHandler(
+		usecaseMocks.NewICreateWebhookMock(t),
+		usecaseMocks.NewIListWebhooksMock(t),
+		usecaseMocks.NewIFindWebhookMock(t),
+		usecaseMocks.NewIUpdateWebhookMock(t),
+		usecaseMocks.NewIDeleteWebhookMock(t),
+		usecaseMocks.NewIListWebhookDeliveriesMock(t),
+		usecaseMocks.NewIRedeliverWebhookMock(t),
+	)
+}
+
+func createPaymentBatchHandler(t *testing.T) *handler.PaymentBatchHandler {
+	return handler.NewPaymentBatchHandler(usecaseMocks.NewICreatePaymentBatchMock(t), usecaseMocks.NewIFindPaymentBatchMock(t))
+}
+
+func createSettlementHandler(t *testing.T) *handler.SettlementHandler {
+	return handler.NewSettlementHandler(usecaseMocks.NewIImportSettlementMock(t), usecaseMocks.NewIFindSettlementMock(t))
+}
+
+func createPaymentSearchHandler(t *testing.T) *handler.PaymentSearchHandler {
+	return handler.NewPaymentSearchHandler(usecaseMocks.NewISearchPaymentsMock(t))
+}
+
+func createTransactionDto() *dto.Transaction {
+	return &dto.Transaction{
+		CardToken:            "A card token",
+		PurchaseValue:        9.99,
+		PurchaseItens:        []string{"Item 1"},
+		PurchaseInstallments: 2,
+		StoreId:              authentication.Stores[0],
+		AcquirerName:         "An acquirer name",
+	}
+}
+
+func createTransactionV2Dto() *dto.TransactionV2 {
+	return &dto.TransactionV2{
+		CardToken:            "A card token",
+		Amount:               999,
+		Currency:             "BRL",
 		PurchaseItens:        []string{"Item 1"},
 		PurchaseInstallments: 2,
-		StoreIdentification:  "A store identification",
-		StoreAddress:         "A store address",
-		StoreCep:             "A store cep",
+		StoreId:              authentication.Stores[0],
 		AcquirerName:         "An acquirer name",
 	}
 }
@@ -0,0 +1,107 @@
+package web
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"slices"
+	"strings"
+
+	core_errors "github.com/sesaquecruz/go-payment-processor/internal/core/errors"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/handler"
+
+	jwtmiddleware "github.com/gofiber/contrib/jwt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	ScopePaymentsWrite = "payments:write"
+	ScopePaymentsRead  = "payments:read"
+	ScopeRefundsWrite  = "refunds:write"
+	ScopeCardsWrite    = "cards:write"
+	ScopeWebhooksWrite = "webhooks:write"
+	ScopeAdmin         = "admin"
+)
+
+const scopeClaim = "scope"
+
+type AuthKeys interface {
+	Key(kid string) (*rsa.PublicKey, error)
+}
+
+type AuthConfig struct {
+	Keys     AuthKeys
+	Issuer   string
+	Audience string
+}
+
+func newAuthMiddleware(config AuthConfig) fiber.Handler {
+	return jwtmiddleware.New(jwtmiddleware.Config{
+		KeyFunc: func(token *jwt.Token) (any, error) {
+			if token.Method.Alg() != jwtmiddleware.RS256 {
+				return nil, fmt.Errorf("unexpected jwt signing method %s", token.Method.Alg())
+			}
+
+			kid, _ := token.Header["kid"].(string)
+			return config.Keys.Key(kid)
+		},
+		SuccessHandler: func(c *fiber.Ctx) error {
+			caller, ok := verifyClaims(c, config)
+			if !ok {
+				return c.Status(fiber.StatusUnauthorized).SendString("Invalid or expired JWT")
+			}
+
+			c.Locals(handler.CallerKey, caller)
+			return c.Next()
+		},
+	})
+}
+
+func verifyClaims(c *fiber.Ctx, config AuthConfig) (string, bool) {
+	claims, ok := tokenClaims(c)
+	if !ok {
+		return "", false
+	}
+
+	issuer, err := claims.GetIssuer()
+	if err != nil || issuer != config.Issuer {
+		return "", false
+	}
+
+	audience, err := claims.GetAudience()
+	if err != nil || !slices.Contains(audience, config.Audience) {
+		return "", false
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return "", false
+	}
+
+	return subject, true
+}
+
+func requireScope(scope string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		claims, ok := tokenClaims(c)
+		if ok {
+			scopes, _ := claims[scopeClaim].(string)
+			if slices.Contains(strings.Fields(scopes), scope) {
+				return c.Next()
+			}
+		}
+
+		return dto.NewHttpError(c, core_errors.NewForbiddenError(fmt.Sprintf("auth token is missing the %s scope", scope)))
+	}
+}
+
+func tokenClaims(c *fiber.Ctx) (jwt.MapClaims, bool) {
+	token, ok := c.Locals("user").(*jwt.Token)
+	if !ok {
+		return nil, false
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	return claims, ok
+}
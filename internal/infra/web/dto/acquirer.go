@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+type AcquirerHealth struct {
+	Name         string     `json:"name"`
+	State        string     `json:"state"`
+	Requests     int64      `json:"requests"`
+	Failures     int64      `json:"failures"`
+	SlowCalls    int64      `json:"slow_calls"`
+	Rejections   int64      `json:"rejections"`
+	ErrorRate    float64    `json:"error_rate"`
+	SlowCallRate float64    `json:"slow_call_rate"`
+	OpenedAt     *time.Time `json:"opened_at,omitempty"`
+}
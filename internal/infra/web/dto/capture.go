@@ -0,0 +1,17 @@
+package dto
+
+type CaptureRequest struct {
+	Value float64 `json:"value"`
+}
+
+type CaptureRequestV2 struct {
+	Amount int64 `json:"amount"`
+}
+
+type Capture struct {
+	PaymentId      string  `json:"payment_id"`
+	PaymentStatus  string  `json:"payment_status"`
+	CapturedAmount int64   `json:"captured_amount"`
+	Currency       string  `json:"currency"`
+	CapturedValue  float64 `json:"captured_value"` // Deprecated: use captured_amount.
+}
@@ -0,0 +1,23 @@
+package dto
+
+// CardRequest only validates the security code, which is not stored.
+type CardRequest struct {
+	CardNumber       string `json:"card_number"        validate:"required"`
+	CardHolder       string `json:"card_holder"        validate:"required"`
+	CardExpiration   string `json:"card_expiration"    validate:"required"`
+	CardBrand        string `json:"card_brand"`
+	CardSecurityCode string `json:"card_security_code" validate:"required"`
+}
+
+func (r *CardRequest) Validate() error {
+	return validateRequired(r)
+}
+
+type Card struct {
+	CardToken       string `json:"card_token"`
+	CardBrand       string `json:"card_brand"`
+	CardBin         string `json:"card_bin"`
+	CardLast4       string `json:"card_last4"`
+	CardFundingType string `json:"card_funding_type,omitempty"`
+	CardCountry     string `json:"card_country,omitempty"`
+}
@@ -44,6 +44,21 @@ func NewHttpError(c *fiber.Ctx, err error) error {
 		httpErr.Message = []string{t.Message}
 		break
 
+	case *core_errors.ForbiddenError:
+		httpErr.Code = http.StatusForbidden
+		httpErr.Message = []string{t.Message}
+		break
+
+	case *core_errors.ConflictError:
+		httpErr.Code = http.StatusConflict
+		httpErr.Message = []string{t.Message}
+		break
+
+	case *core_errors.TimeoutError:
+		httpErr.Code = http.StatusGatewayTimeout
+		httpErr.Message = []string{t.Message}
+		break
+
 	case *core_errors.AcquirerError:
 		httpErr.Code = t.Code
 		httpErr.Message = []string{t.Message}
@@ -1,11 +1,82 @@
 package dto
 
+import "time"
+
 type Payment struct {
-	Id string `json:"id"`
+	Id        string `json:"id"`
+	Status    string `json:"status"`
+	StatusUrl string `json:"status_url,omitempty"`
 }
 
-func NewPayment(id string) *Payment {
+func NewPayment(id string, status string) *Payment {
 	return &Payment{
-		Id: id,
+		Id:     id,
+		Status: status,
 	}
 }
+
+type PaymentAttempt struct {
+	AcquirerName    string    `json:"acquirer_name"`
+	Status          string    `json:"status"`
+	AcquirerId      string    `json:"acquirer_id"`
+	AcquirerCode    int       `json:"acquirer_code"`
+	AcquirerMessage string    `json:"acquirer_message"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type PaymentDetails struct {
+	Id                   string            `json:"id"`
+	Status               string            `json:"status"`
+	CardToken            string            `json:"card_token"`
+	CardBrand            string            `json:"card_brand"`
+	Amount               int64             `json:"amount"`
+	Currency             string            `json:"currency"`
+	PurchaseValue        float64           `json:"purchase_value"` // Deprecated: use amount.
+	PurchaseItems        []string          `json:"purchase_items"`
+	PurchaseInstallments int               `json:"purchase_installments"`
+	StoreId              string            `json:"store_id,omitempty"`
+	StoreIdentification  string            `json:"store_identification"`
+	StoreDocumentType    string            `json:"store_document_type"`
+	StoreAddress         string            `json:"store_address"`
+	StoreCep             string            `json:"store_cep"`
+	StoreStreet          string            `json:"store_street"`
+	StoreNumber          string            `json:"store_number"`
+	StoreCity            string            `json:"store_city"`
+	StoreState           string            `json:"store_state"`
+	AcquirerName         string            `json:"acquirer_name"`
+	RouteRule            string            `json:"route_rule,omitempty"`
+	AcquirerId           string            `json:"acquirer_id"`
+	AcquirerCode         int               `json:"acquirer_code"`
+	AcquirerMessage      string            `json:"acquirer_message"`
+	CapturedAmount       int64             `json:"captured_amount"`
+	RefundedAmount       int64             `json:"refunded_amount"`
+	CapturedValue        float64           `json:"captured_value"` // Deprecated: use captured_amount.
+	RefundedValue        float64           `json:"refunded_value"` // Deprecated: use refunded_amount.
+	Attempts             []*PaymentAttempt `json:"attempts"`
+	Caller               string            `json:"caller"`
+	CreatedAt            time.Time         `json:"created_at"`
+	UpdatedAt            time.Time         `json:"updated_at"`
+}
+
+type PaymentSummary struct {
+	Id             string    `json:"id"`
+	Status         string    `json:"status"`
+	CardBrand      string    `json:"card_brand"`
+	CardLast4      string    `json:"card_last4"`
+	Amount         int64     `json:"amount"`
+	Currency       string    `json:"currency"`
+	StoreId        string    `json:"store_id"`
+	AcquirerName   string    `json:"acquirer_name"`
+	AcquirerId     string    `json:"acquirer_id"`
+	CapturedAmount int64     `json:"captured_amount"`
+	RefundedAmount int64     `json:"refunded_amount"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// PaymentPage omits next_cursor on the last page. The next page is requested with the same
+// filters and sort.
+type PaymentPage struct {
+	Payments   []*PaymentSummary `json:"payments"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,47 @@
+package dto
+
+import "time"
+
+type PaymentBatchRequest struct {
+	Transactions []*Transaction `json:"transactions" validate:"required"`
+}
+
+func (r *PaymentBatchRequest) Validate() error {
+	return validateRequired(r)
+}
+
+type PaymentBatchRequestV2 struct {
+	Transactions []*TransactionV2 `json:"transactions" validate:"required"`
+}
+
+func (r *PaymentBatchRequestV2) Validate() error {
+	return validateRequired(r)
+}
+
+// PaymentBatchItemError has the code answered by the acquirer.
+type PaymentBatchItemError struct {
+	Type    string   `json:"type"`
+	Code    int      `json:"code,omitempty"`
+	Message []string `json:"message"`
+}
+
+// PaymentBatchItem informs the payment once it was recorded, even when the transaction failed.
+type PaymentBatchItem struct {
+	Index         int                    `json:"index"`
+	Status        string                 `json:"status"`
+	PaymentId     string                 `json:"payment_id,omitempty"`
+	PaymentStatus string                 `json:"payment_status,omitempty"`
+	Error         *PaymentBatchItemError `json:"error,omitempty"`
+}
+
+type PaymentBatch struct {
+	Id        string              `json:"id"`
+	Status    string              `json:"status"`
+	StatusUrl string              `json:"status_url"`
+	Succeeded int                 `json:"succeeded"`
+	Failed    int                 `json:"failed"`
+	Pending   int                 `json:"pending"`
+	Items     []*PaymentBatchItem `json:"items"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
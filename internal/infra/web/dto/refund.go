@@ -0,0 +1,20 @@
+package dto
+
+type RefundRequest struct {
+	Value float64 `json:"value"`
+}
+
+type RefundRequestV2 struct {
+	Amount int64 `json:"amount"`
+}
+
+type Refund struct {
+	Id            string  `json:"id"`
+	Type          string  `json:"type"`
+	Amount        int64   `json:"amount"`
+	Currency      string  `json:"currency"`
+	Value         float64 `json:"value"` // Deprecated: use amount.
+	Status        string  `json:"status"`
+	PaymentId     string  `json:"payment_id"`
+	PaymentStatus string  `json:"payment_status"`
+}
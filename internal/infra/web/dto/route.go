@@ -0,0 +1,27 @@
+package dto
+
+type RouteRequest struct {
+	CardBrand            string `json:"card_brand"            validate:"required"`
+	Amount               int64  `json:"amount"                validate:"required"`
+	Currency             string `json:"currency"              validate:"required"`
+	PurchaseInstallments int    `json:"purchase_installments" validate:"required"`
+	StoreId              string `json:"store_id"              validate:"required"`
+}
+
+func (r *RouteRequest) Validate() error {
+	return validateRequired(r)
+}
+
+type RouteEvaluation struct {
+	Rule    string `json:"rule"`
+	Matched bool   `json:"matched"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// Route has an empty acquirer when no rule matches.
+type Route struct {
+	AcquirerName string             `json:"acquirer_name"`
+	RouteRule    string             `json:"route_rule"`
+	Fallbacks    []string           `json:"fallbacks"`
+	Evaluations  []*RouteEvaluation `json:"evaluations"`
+}
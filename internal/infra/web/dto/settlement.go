@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+// SettlementDiscrepancy is missing for a captured payment not settled, and unexpected for a
+// settled line without a captured payment.
+type SettlementDiscrepancy struct {
+	Type             string    `json:"type"`
+	Line             int       `json:"line,omitempty"`
+	PaymentId        string    `json:"payment_id,omitempty"`
+	AcquirerId       string    `json:"acquirer_id,omitempty"`
+	ExpectedAmount   int64     `json:"expected_amount"`
+	ExpectedCurrency string    `json:"expected_currency,omitempty"`
+	SettledAmount    int64     `json:"settled_amount"`
+	SettledCurrency  string    `json:"settled_currency,omitempty"`
+	Date             time.Time `json:"date"`
+}
+
+type Settlement struct {
+	Id               string                   `json:"id"`
+	Acquirer         string                   `json:"acquirer"`
+	Format           string                   `json:"format"`
+	FileName         string                   `json:"file_name"`
+	Lines            int                      `json:"lines"`
+	Matched          int                      `json:"matched"`
+	Missing          int                      `json:"missing"`
+	Unexpected       int                      `json:"unexpected"`
+	AmountMismatches int                      `json:"amount_mismatches"`
+	PeriodStart      string                   `json:"period_start,omitempty"`
+	PeriodEnd        string                   `json:"period_end,omitempty"`
+	Discrepancies    []*SettlementDiscrepancy `json:"discrepancies"`
+	CreatedAt        time.Time                `json:"created_at"`
+}
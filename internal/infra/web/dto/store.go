@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+// StoreRequest completes the structured address not informed from the cep.
+type StoreRequest struct {
+	Identification string `json:"identification" validate:"required"`
+	Address        string `json:"address"        validate:"required"`
+	Cep            string `json:"cep"            validate:"required"`
+	Street         string `json:"street"`
+	Number         string `json:"number"`
+	City           string `json:"city"`
+	State          string `json:"state"`
+}
+
+func (r *StoreRequest) Validate() error {
+	return validateRequired(r)
+}
+
+type Store struct {
+	Id             string    `json:"id"`
+	Identification string    `json:"identification"`
+	DocumentType   string    `json:"document_type"`
+	Address        string    `json:"address"`
+	Cep            string    `json:"cep"`
+	Street         string    `json:"street"`
+	Number         string    `json:"number"`
+	City           string    `json:"city"`
+	State          string    `json:"state"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
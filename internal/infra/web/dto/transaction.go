@@ -9,19 +9,39 @@ import (
 	"github.com/go-playground/validator/v10"
 )
 
+// Transaction informs the purchase value as a decimal in BRL.
 type Transaction struct {
 	CardToken            string   `json:"card_token"            validate:"required"`
 	PurchaseValue        float64  `json:"purchase_value"        validate:"required"`
 	PurchaseItens        []string `json:"purchase_items"        validate:"required"`
 	PurchaseInstallments int      `json:"purchase_installments" validate:"required"`
-	StoreIdentification  string   `json:"store_identification"  validate:"required"`
-	StoreAddress         string   `json:"store_address"         validate:"required"`
-	StoreCep             string   `json:"store_cep"             validate:"required"`
-	AcquirerName         string   `json:"acquirer_name"         validate:"required"`
+	StoreId              string   `json:"store_id"              validate:"required"`
+	AcquirerName         string   `json:"acquirer_name"`
+	AuthorizeOnly        bool     `json:"authorize_only"`
 }
 
 func (t *Transaction) Validate() error {
-	err := utils.GetValidator().Struct(t)
+	return validateRequired(t)
+}
+
+// TransactionV2 informs the amount in the minor unit of the currency, such as 999 for BRL 9.99.
+type TransactionV2 struct {
+	CardToken            string   `json:"card_token"            validate:"required"`
+	Amount               int64    `json:"amount"                validate:"required"`
+	Currency             string   `json:"currency"              validate:"required"`
+	PurchaseItens        []string `json:"purchase_items"        validate:"required"`
+	PurchaseInstallments int      `json:"purchase_installments" validate:"required"`
+	StoreId              string   `json:"store_id"              validate:"required"`
+	AcquirerName         string   `json:"acquirer_name"`
+	AuthorizeOnly        bool     `json:"authorize_only"`
+}
+
+func (t *TransactionV2) Validate() error {
+	return validateRequired(t)
+}
+
+func validateRequired(s any) error {
+	err := utils.GetValidator().Struct(s)
 	if err == nil {
 		return nil
 	}
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// WebhookRequest keeps the secret on update when it is not informed.
+type WebhookRequest struct {
+	Url    string   `json:"url"    validate:"required"`
+	Events []string `json:"events" validate:"required"`
+	Secret string   `json:"secret"`
+}
+
+func (r *WebhookRequest) Validate() error {
+	return validateRequired(r)
+}
+
+type Webhook struct {
+	Id        string    `json:"id"`
+	Url       string    `json:"url"`
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	Id              string          `json:"id"`
+	EventId         string          `json:"event_id"`
+	EventType       string          `json:"event_type"`
+	PaymentId       string          `json:"payment_id"`
+	Payload         json.RawMessage `json:"payload" swaggertype:"object"`
+	Status          string          `json:"status"`
+	Attempts        int             `json:"attempts"`
+	NextAttemptAt   time.Time       `json:"next_attempt_at"`
+	ResponseCode    int             `json:"response_code"`
+	ResponseMessage string          `json:"response_message"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type IAcquirerHandler interface {
+	AcquirerHealth(c *fiber.Ctx) error
+	Metrics(c *fiber.Ctx) error
+}
+
+type AcquirerHandler struct {
+	findAcquirerHealth usecase.IFindAcquirerHealth
+}
+
+func NewAcquirerHandler(findAcquirerHealth usecase.IFindAcquirerHealth) *AcquirerHandler {
+	return &AcquirerHandler{
+		findAcquirerHealth: findAcquirerHealth,
+	}
+}
+
+// Acquirer Health godoc
+//
+// @Summary		List the acquirers health
+// @Description	List the circuit breaker state of each acquirer. Routing skips acquirers whose breaker is open.
+// @Tags		admin
+// @Produce		json
+// @Success		200	{array} 		dto.AcquirerHealth
+// @Security	Bearer token
+// @Router		/v2/admin/acquirers	[get]
+func (h *AcquirerHandler) AcquirerHealth(c *fiber.Ctx) error {
+	output, err := h.findAcquirerHealth.Execute(c.UserContext(), &usecase.FindAcquirerHealthInput{})
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	acquirers := make([]*dto.AcquirerHealth, 0, len(output.Acquirers))
+	for _, a := range output.Acquirers {
+		acquirer := &dto.AcquirerHealth{
+			Name:         a.AcquirerName,
+			State:        a.State,
+			Requests:     a.Requests,
+			Failures:     a.Failures,
+			SlowCalls:    a.SlowCalls,
+			Rejections:   a.Rejections,
+			ErrorRate:    a.ErrorRate,
+			SlowCallRate: a.SlowCallRate,
+		}
+
+		if !a.OpenedAt.IsZero() {
+			openedAt := a.OpenedAt
+			acquirer.OpenedAt = &openedAt
+		}
+
+		acquirers = append(acquirers, acquirer)
+	}
+
+	return c.JSON(acquirers)
+}
+
+// Metrics exposes the acquirers circuit breaker metrics in the Prometheus text format. It is
+// served outside the api base path, where scrapers expect it, and requires the admin scope.
+func (h *AcquirerHandler) Metrics(c *fiber.Ctx) error {
+	output, err := h.findAcquirerHealth.Execute(c.UserContext(), &usecase.FindAcquirerHealthInput{})
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	var b strings.Builder
+
+	b.WriteString("# HELP acquirer_circuit_breaker_state Whether the circuit breaker of the acquirer is in the state.\n")
+	b.WriteString("# TYPE acquirer_circuit_breaker_state gauge\n")
+	for _, a := range output.Acquirers {
+		for _, state := range []entity.CircuitState{
+			entity.CircuitStateClosed,
+			entity.CircuitStateOpen,
+			entity.CircuitStateHalfOpen,
+		} {
+			value := 0
+			if a.State == string(state) {
+				value = 1
+			}
+			fmt.Fprintf(&b, "acquirer_circuit_breaker_state{acquirer=%q,state=%q} %d\n", a.AcquirerName, state, value)
+		}
+	}
+
+	counters := []struct {
+		name  string
+		help  string
+		value func(a *usecase.AcquirerHealthOutput) int64
+	}{
+		{"acquirer_requests_total", "Calls sent to the acquirer.", func(a *usecase.AcquirerHealthOutput) int64 { return a.Requests }},
+		{"acquirer_failures_total", "Calls to the acquirer that failed technically.", func(a *usecase.AcquirerHealthOutput) int64 { return a.Failures }},
+		{"acquirer_slow_calls_total", "Calls to the acquirer slower than its threshold.", func(a *usecase.AcquirerHealthOutput) int64 { return a.SlowCalls }},
+		{"acquirer_rejections_total", "Calls rejected by the circuit breaker of the acquirer.", func(a *usecase.AcquirerHealthOutput) int64 { return a.Rejections }},
+	}
+
+	for _, counter := range counters {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s counter\n", counter.name, counter.help, counter.name)
+		for _, a := range output.Acquirers {
+			fmt.Fprintf(&b, "%s{acquirer=%q} %d\n", counter.name, a.AcquirerName, counter.value(a))
+		}
+	}
+
+	c.Set(fiber.HeaderContentType, "text/plain; version=0.0.4")
+	return c.SendString(b.String())
+}
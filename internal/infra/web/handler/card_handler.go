@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ICardHandler interface {
+	TokenizeCard(c *fiber.Ctx) error
+	DeleteCard(c *fiber.Ctx) error
+}
+
+type CardHandler struct {
+	tokenizeCard usecase.ITokenizeCard
+	deleteCard   usecase.IDeleteCard
+}
+
+func NewCardHandler(tokenizeCard usecase.ITokenizeCard, deleteCard usecase.IDeleteCard) *CardHandler {
+	return &CardHandler{
+		tokenizeCard: tokenizeCard,
+		deleteCard:   deleteCard,
+	}
+}
+
+// Tokenize Card godoc
+//
+// @Summary		Tokenize a card
+// @Description	Store a card in the vault with its number encrypted, returning the token used in the payment requests. The brand is inferred from the card number when omitted and must match it when declared. The security code is validated but never stored.
+// @Tags		cards
+// @Accept		json
+// @Produce		json
+// @Param		card				body			dto.CardRequest		true	"Card"
+// @Success		201	{object} 		dto.Card
+// @Failure		400	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v1/cards			[post]
+// @Router		/v2/cards			[post]
+func (h *CardHandler) TokenizeCard(c *fiber.Ctx) error {
+	request := dto.CardRequest{}
+	err := c.BodyParser(&request)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	err = request.Validate()
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	input := usecase.TokenizeCardInput{
+		CardNumber:       request.CardNumber,
+		CardHolder:       request.CardHolder,
+		CardExpiration:   request.CardExpiration,
+		CardBrand:        request.CardBrand,
+		CardSecurityCode: request.CardSecurityCode,
+	}
+
+	output, err := h.tokenizeCard.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	card := dto.Card{
+		CardToken:       output.CardToken,
+		CardBrand:       output.CardBrand,
+		CardBin:         output.CardBin,
+		CardLast4:       output.CardLast4,
+		CardFundingType: output.CardFundingType,
+		CardCountry:     output.CardCountry,
+	}
+
+	return c.Status(http.StatusCreated).JSON(card)
+}
+
+// Delete Card godoc
+//
+// @Summary		Delete a card
+// @Description	Remove a card from the vault. Its token can no longer be used in payments.
+// @Tags		cards
+// @Param		token				path			string				true	"Card Token"
+// @Success		204
+// @Failure		404	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v1/cards/{token}	[delete]
+// @Router		/v2/cards/{token}	[delete]
+func (h *CardHandler) DeleteCard(c *fiber.Ctx) error {
+	input := usecase.DeleteCardInput{
+		CardToken: c.Params("token"),
+	}
+
+	_, err := h.deleteCard.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
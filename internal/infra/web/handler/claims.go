@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// CallerKey is the key of the Fiber context holding the client identified by the auth token.
+const CallerKey = "caller"
+
+// StoresClaim is the claim of the auth token listing the ids of the stores the client is
+// allowed to charge for.
+const StoresClaim = "stores"
+
+// allowedStores returns the stores listed in the auth token of the request, which are none
+// when the claim is missing or malformed.
+func allowedStores(c *fiber.Ctx) []string {
+	token, ok := c.Locals("user").(*jwt.Token)
+	if !ok {
+		return nil
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil
+	}
+
+	values, ok := claims[StoresClaim].([]any)
+	if !ok {
+		return nil
+	}
+
+	stores := make([]string, 0, len(values))
+	for _, value := range values {
+		if store, ok := value.(string); ok {
+			stores = append(stores, store)
+		}
+	}
+
+	return stores
+}
+
+// callerIdentity returns the client identified by the auth token of the request.
+func callerIdentity(c *fiber.Ctx) string {
+	caller, _ := c.Locals(CallerKey).(string)
+	return caller
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/service"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+type IIdempotencyHandler interface {
+	CheckIdempotency(c *fiber.Ctx) error
+}
+
+type IdempotencyHandler struct {
+	startIdempotentRequest    usecase.IStartIdempotentRequest
+	completeIdempotentRequest usecase.ICompleteIdempotentRequest
+}
+
+func NewIdempotencyHandler(
+	startIdempotentRequest usecase.IStartIdempotentRequest,
+	completeIdempotentRequest usecase.ICompleteIdempotentRequest,
+) *IdempotencyHandler {
+	return &IdempotencyHandler{
+		startIdempotentRequest:    startIdempotentRequest,
+		completeIdempotentRequest: completeIdempotentRequest,
+	}
+}
+
+// CheckIdempotency runs before a handler when the request carries an Idempotency-Key header.
+// The first response for a key of the caller is stored and replayed verbatim for retries of
+// the same request, with the same method, path and body.
+func (h *IdempotencyHandler) CheckIdempotency(c *fiber.Ctx) error {
+	key := c.Get(IdempotencyKeyHeader)
+	if key == "" {
+		return c.Next()
+	}
+
+	// a key is bound to the route it was used on, such as the capture of a given payment
+	hash := sha256.New()
+	hash.Write([]byte(c.Method() + " " + c.Path() + "\n"))
+	hash.Write(c.Body())
+	requestHash := hex.EncodeToString(hash.Sum(nil))
+
+	startInput := usecase.StartIdempotentRequestInput{
+		Caller:      callerIdentity(c),
+		Key:         key,
+		RequestHash: requestHash,
+	}
+
+	startOutput, err := h.startIdempotentRequest.Execute(c.UserContext(), &startInput)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	if startOutput.Replay {
+		c.Set(IdempotentReplayedHeader, "true")
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Status(startOutput.ResponseCode).Send(startOutput.ResponseBody)
+	}
+
+	ctx := service.WithSubmission(c.UserContext())
+	c.SetUserContext(ctx)
+
+	nextErr := c.Next()
+
+	responseCode := c.Response().StatusCode()
+	if nextErr != nil {
+		responseCode = http.StatusInternalServerError
+	}
+
+	responseBody := make([]byte, len(c.Response().Body()))
+	copy(responseBody, c.Response().Body())
+
+	completeInput := usecase.CompleteIdempotentRequestInput{
+		Caller:       callerIdentity(c),
+		Key:          key,
+		RequestHash:  requestHash,
+		ResponseCode: responseCode,
+		ResponseBody: responseBody,
+		Submitted:    service.Submitted(ctx),
+	}
+
+	// the response is stored even when the deadline of the request expired
+	err = h.completeIdempotentRequest.Execute(context.WithoutCancel(ctx), &completeInput)
+	if err != nil {
+		slog.Error(err.Error())
+	}
+
+	return nextErr
+}
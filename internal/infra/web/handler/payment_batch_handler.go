@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
+	web_errors "github.com/sesaquecruz/go-payment-processor/internal/infra/web/errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type IPaymentBatchHandler interface {
+	CreateBatch(c *fiber.Ctx) error
+	CreateBatchV2(c *fiber.Ctx) error
+	FindBatch(c *fiber.Ctx) error
+}
+
+type PaymentBatchHandler struct {
+	createPaymentBatch usecase.ICreatePaymentBatch
+	findPaymentBatch   usecase.IFindPaymentBatch
+}
+
+func NewPaymentBatchHandler(
+	createPaymentBatch usecase.ICreatePaymentBatch,
+	findPaymentBatch usecase.IFindPaymentBatch,
+) *PaymentBatchHandler {
+	return &PaymentBatchHandler{
+		createPaymentBatch: createPaymentBatch,
+		findPaymentBatch:   findPaymentBatch,
+	}
+}
+
+// Create Payment Batch godoc
+//
+// @Summary		Process a batch of payments
+// @Description	Process up to 500 payment transactions, each one as in the process route, and return the result of each transaction without a failed one stopping the others. At most 4 transactions are sent to the same acquirer at once, fallbacks included. A batch of up to 4 transactions is answered with 200 once processed, and a larger one with 202 once queued, to be followed at the status_url.
+// @Tags		payments
+// @Accept		json
+// @Produce		json
+// @Param		batch				body			dto.PaymentBatchRequest	true	"Batch"
+// @Param		Idempotency-Key		header			string					false	"Idempotency Key"
+// @Success		200	{object} 		dto.PaymentBatch
+// @Success		202	{object} 		dto.PaymentBatch
+// @Failure		400	{object}		dto.HttpError
+// @Failure		409	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v1/payments/batch	[post]
+func (h *PaymentBatchHandler) CreateBatch(c *fiber.Ctx) error {
+	request := dto.PaymentBatchRequest{}
+	err := c.BodyParser(&request)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	err = request.Validate()
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	transactions := make([]*usecase.PaymentBatchTransactionInput, 0, len(request.Transactions))
+	for _, transaction := range request.Transactions {
+		transactions = append(transactions, newPaymentBatchTransactionInput(transaction))
+	}
+
+	return h.createBatch(c, transactions)
+}
+
+// Create Payment Batch V2 godoc
+//
+// @Summary		Process a batch of payments
+// @Description	Process up to 500 payment transactions, each one as in the v2 process route with an amount in the minor unit of its currency, and return the result of each transaction without a failed one stopping the others. At most 4 transactions are sent to the same acquirer at once, fallbacks included. A batch of up to 4 transactions is answered with 200 once processed, and a larger one with 202 once queued, to be followed at the status_url.
+// @Tags		payments
+// @Accept		json
+// @Produce		json
+// @Param		batch				body			dto.PaymentBatchRequestV2	true	"Batch"
+// @Param		Idempotency-Key		header			string						false	"Idempotency Key"
+// @Success		200	{object} 		dto.PaymentBatch
+// @Success		202	{object} 		dto.PaymentBatch
+// @Failure		400	{object}		dto.HttpError
+// @Failure		409	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/payments/batch	[post]
+func (h *PaymentBatchHandler) CreateBatchV2(c *fiber.Ctx) error {
+	request := dto.PaymentBatchRequestV2{}
+	err := c.BodyParser(&request)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	err = request.Validate()
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	transactions := make([]*usecase.PaymentBatchTransactionInput, 0, len(request.Transactions))
+	for _, transaction := range request.Transactions {
+		transactions = append(transactions, newPaymentBatchTransactionInputV2(transaction))
+	}
+
+	return h.createBatch(c, transactions)
+}
+
+func (h *PaymentBatchHandler) createBatch(c *fiber.Ctx, transactions []*usecase.PaymentBatchTransactionInput) error {
+	input := usecase.CreatePaymentBatchInput{
+		Caller:        callerIdentity(c),
+		AllowedStores: allowedStores(c),
+		Transactions:  transactions,
+	}
+
+	output, err := h.createPaymentBatch.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	batch := newPaymentBatchDto(output.Batch, strings.TrimSuffix(c.Path(), "/")+"/"+output.Batch.BatchId)
+	if batch.Status != string(entity.PaymentBatchStatusQueued) {
+		return c.JSON(batch)
+	}
+
+	c.Set(fiber.HeaderLocation, batch.StatusUrl)
+	return c.Status(http.StatusAccepted).JSON(batch)
+}
+
+// Find Payment Batch godoc
+//
+// @Summary		Find a batch of payments
+// @Description	Find a batch of payments of the client by id, with the result of each of its transactions so far.
+// @Tags		payments
+// @Produce		json
+// @Param		id					path			string				true	"Batch Id"
+// @Success		200	{object} 		dto.PaymentBatch
+// @Failure		404	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v1/payments/batch/{id}	[get]
+// @Router		/v2/payments/batch/{id}	[get]
+func (h *PaymentBatchHandler) FindBatch(c *fiber.Ctx) error {
+	input := usecase.FindPaymentBatchInput{
+		Caller:  callerIdentity(c),
+		BatchId: c.Params("id"),
+	}
+
+	output, err := h.findPaymentBatch.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.JSON(newPaymentBatchDto(output.Batch, c.Path()))
+}
+
+// newPaymentBatchTransactionInput converts a transaction of a batch, which carries its
+// validation errors instead of failing the whole batch.
+func newPaymentBatchTransactionInput(transaction *dto.Transaction) *usecase.PaymentBatchTransactionInput {
+	if transaction == nil {
+		return &usecase.PaymentBatchTransactionInput{Invalid: []string{"transaction is required"}}
+	}
+
+	input := &usecase.PaymentBatchTransactionInput{
+		CardToken:            transaction.CardToken,
+		PurchaseAmount:       entity.NewMoneyFromDecimal(transaction.PurchaseValue, LegacyCurrency).Amount,
+		PurchaseCurrency:     LegacyCurrency,
+		PurchaseItems:        transaction.PurchaseItens,
+		PurchaseInstallments: transaction.PurchaseInstallments,
+		StoreId:              transaction.StoreId,
+		AcquirerName:         transaction.AcquirerName,
+		AuthorizeOnly:        transaction.AuthorizeOnly,
+	}
+
+	var validationErr *web_errors.Error
+	if err := transaction.Validate(); errors.As(err, &validationErr) {
+		input.Invalid = validationErr.Messages
+	}
+
+	return input
+}
+
+// newPaymentBatchTransactionInputV2 converts a v2 transaction of a batch like
+// newPaymentBatchTransactionInput.
+func newPaymentBatchTransactionInputV2(transaction *dto.TransactionV2) *usecase.PaymentBatchTransactionInput {
+	if transaction == nil {
+		return &usecase.PaymentBatchTransactionInput{Invalid: []string{"transaction is required"}}
+	}
+
+	input := &usecase.PaymentBatchTransactionInput{
+		CardToken:            transaction.CardToken,
+		PurchaseAmount:       transaction.Amount,
+		PurchaseCurrency:     transaction.Currency,
+		PurchaseItems:        transaction.PurchaseItens,
+		PurchaseInstallments: transaction.PurchaseInstallments,
+		StoreId:              transaction.StoreId,
+		AcquirerName:         transaction.AcquirerName,
+		AuthorizeOnly:        transaction.AuthorizeOnly,
+	}
+
+	var validationErr *web_errors.Error
+	if err := transaction.Validate(); errors.As(err, &validationErr) {
+		input.Invalid = validationErr.Messages
+	}
+
+	return input
+}
+
+func newPaymentBatchDto(batch *usecase.PaymentBatchOutput, statusUrl string) *dto.PaymentBatch {
+	items := make([]*dto.PaymentBatchItem, 0, len(batch.Items))
+	for _, item := range batch.Items {
+		itemDto := &dto.PaymentBatchItem{
+			Index:         item.Index,
+			Status:        item.Status,
+			PaymentId:     item.PaymentId,
+			PaymentStatus: item.PaymentStatus,
+		}
+
+		if item.ErrorType != "" {
+			itemDto.Error = &dto.PaymentBatchItemError{
+				Type:    item.ErrorType,
+				Code:    item.ErrorCode,
+				Message: item.ErrorMessages,
+			}
+		}
+
+		items = append(items, itemDto)
+	}
+
+	return &dto.PaymentBatch{
+		Id:        batch.BatchId,
+		Status:    batch.Status,
+		StatusUrl: statusUrl,
+		Succeeded: batch.Succeeded,
+		Failed:    batch.Failed,
+		Pending:   batch.Pending,
+		Items:     items,
+		CreatedAt: batch.CreatedAt,
+		UpdatedAt: batch.UpdatedAt,
+	}
+}
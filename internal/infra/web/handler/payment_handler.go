@@ -1,40 +1,80 @@
 package handler
 
 import (
+	"net/http"
+	"strings"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
 	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
 	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// LegacyCurrency is the currency of the decimal values informed in the v1 routes.
+const LegacyCurrency = "BRL"
+
+// A request with the respond-async preference is answered once the payment is queued, and
+// the payment is processed in the background.
+const (
+	PreferHeader            = "Prefer"
+	PreferenceAppliedHeader = "Preference-Applied"
+	PreferRespondAsync      = "respond-async"
+)
+
 type IPaymentHandler interface {
 	ProcessPayment(c *fiber.Ctx) error
+	ProcessPaymentV2(c *fiber.Ctx) error
+	FindPayment(c *fiber.Ctx) error
+	CapturePayment(c *fiber.Ctx) error
+	CapturePaymentV2(c *fiber.Ctx) error
+	RefundPayment(c *fiber.Ctx) error
+	RefundPaymentV2(c *fiber.Ctx) error
+	VoidPayment(c *fiber.Ctx) error
 }
 
 type PaymentHandler struct {
 	processPayment usecase.IProcessPayment
+	findPayment    usecase.IFindPayment
+	capturePayment usecase.ICapturePayment
+	refundPayment  usecase.IRefundPayment
 }
 
-func NewPaymentHandler(processPayment usecase.IProcessPayment) *PaymentHandler {
+func NewPaymentHandler(
+	processPayment usecase.IProcessPayment,
+	findPayment usecase.IFindPayment,
+	capturePayment usecase.ICapturePayment,
+	refundPayment usecase.IRefundPayment,
+) *PaymentHandler {
 	return &PaymentHandler{
 		processPayment: processPayment,
+		findPayment:    findPayment,
+		capturePayment: capturePayment,
+		refundPayment:  refundPayment,
 	}
 }
 
 // Process Payment godoc
 //
 // @Summary		Process a payment
-// @Description	Process a payment transaction.
+// @Description	Process a payment transaction with a decimal purchase value in BRL. When authorize_only is set, the purchase value is only authorized and must be captured later. When acquirer_name is omitted, the acquirer is chosen by the routing rules and technical failures are retried on the fallback acquirers of the route. The store_id must be a registered store listed in the stores claim of the auth token. With the Prefer: respond-async header, the payment is answered as pending with 202 once queued, and its outcome is found at the status_url.
 // @Tags		payments
 // @Accept		json
 // @Produce		json
 // @Param		transaction			body			dto.Transaction		true	"Transaction"
+// @Param		Idempotency-Key		header			string				false	"Idempotency Key"
+// @Param		Prefer				header			string				false	"respond-async"
 // @Success		200	{object} 		dto.Payment
+// @Success		202	{object} 		dto.Payment
 // @Failure		400	{object}		dto.HttpError
+// @Failure		403	{object}		dto.HttpError
 // @Failure		404	{object}		dto.HttpError
+// @Failure		409	{object}		dto.HttpError
 // @Failure		422	{object}		dto.HttpError
+// @Failure		504	{object}		dto.HttpError
 // @Security	Bearer token
-// @Router		/payments/process	[post]
+// @Deprecated
+// @Router		/v1/payments/process	[post]
 func (h *PaymentHandler) ProcessPayment(c *fiber.Ctx) error {
 	transaction := dto.Transaction{}
 	err := c.BodyParser(&transaction)
@@ -49,20 +89,386 @@ func (h *PaymentHandler) ProcessPayment(c *fiber.Ctx) error {
 
 	input := usecase.ProcessPaymentInput{
 		CardToken:            transaction.CardToken,
-		PurchaseValue:        transaction.PurchaseValue,
+		PurchaseAmount:       entity.NewMoneyFromDecimal(transaction.PurchaseValue, LegacyCurrency).Amount,
+		PurchaseCurrency:     LegacyCurrency,
 		PurchaseItems:        transaction.PurchaseItens,
 		PurchaseInstallments: transaction.PurchaseInstallments,
-		StoreIdentification:  transaction.StoreIdentification,
-		StoreAddress:         transaction.StoreAddress,
-		StoreCep:             transaction.StoreCep,
+		StoreId:              transaction.StoreId,
 		AcquirerName:         transaction.AcquirerName,
+		AuthorizeOnly:        transaction.AuthorizeOnly,
+		Caller:               callerIdentity(c),
+		AllowedStores:        allowedStores(c),
+		Async:                prefersAsync(c),
+	}
+
+	return h.process(c, &input)
+}
+
+// Process Payment V2 godoc
+//
+// @Summary		Process a payment
+// @Description	Process a payment transaction with an amount in the minor unit of the currency. When authorize_only is set, the amount is only authorized and must be captured later. When acquirer_name is omitted, the acquirer is chosen by the routing rules and technical failures are retried on the fallback acquirers of the route. The store_id must be a registered store listed in the stores claim of the auth token. With the Prefer: respond-async header, the payment is answered as pending with 202 once queued, and its outcome is found at the status_url.
+// @Tags		payments
+// @Accept		json
+// @Produce		json
+// @Param		transaction			body			dto.TransactionV2	true	"Transaction"
+// @Param		Idempotency-Key		header			string				false	"Idempotency Key"
+// @Param		Prefer				header			string				false	"respond-async"
+// @Success		200	{object} 		dto.Payment
+// @Success		202	{object} 		dto.Payment
+// @Failure		400	{object}		dto.HttpError
+// @Failure		403	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		409	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		504	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/payments/process	[post]
+func (h *PaymentHandler) ProcessPaymentV2(c *fiber.Ctx) error {
+	transaction := dto.TransactionV2{}
+	err := c.BodyParser(&transaction)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	err = transaction.Validate()
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	input := usecase.ProcessPaymentInput{
+		CardToken:            transaction.CardToken,
+		PurchaseAmount:       transaction.Amount,
+		PurchaseCurrency:     transaction.Currency,
+		PurchaseItems:        transaction.PurchaseItens,
+		PurchaseInstallments: transaction.PurchaseInstallments,
+		StoreId:              transaction.StoreId,
+		AcquirerName:         transaction.AcquirerName,
+		AuthorizeOnly:        transaction.AuthorizeOnly,
+		Caller:               callerIdentity(c),
+		AllowedStores:        allowedStores(c),
+		Async:                prefersAsync(c),
+	}
+
+	return h.process(c, &input)
+}
+
+func (h *PaymentHandler) process(c *fiber.Ctx, input *usecase.ProcessPaymentInput) error {
+	output, err := h.processPayment.Execute(c.UserContext(), input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	payment := dto.NewPayment(output.PaymentId, output.PaymentStatus)
+	if !input.Async {
+		return c.JSON(payment)
+	}
+
+	payment.StatusUrl = strings.TrimSuffix(c.Path(), "/process") + "/" + output.PaymentId
+	c.Set(fiber.HeaderLocation, payment.StatusUrl)
+	c.Set(PreferenceAppliedHeader, PreferRespondAsync)
+	return c.Status(http.StatusAccepted).JSON(payment)
+}
+
+// prefersAsync reports whether the Prefer header of the request has the respond-async
+// preference.
+func prefersAsync(c *fiber.Ctx) bool {
+	for _, preference := range strings.Split(c.Get(PreferHeader), ",") {
+		name, _, _ := strings.Cut(preference, "=")
+		if strings.EqualFold(strings.TrimSpace(name), PreferRespondAsync) {
+			return true
+		}
 	}
 
-	output, err := h.processPayment.Execute(c.Context(), &input)
+	return false
+}
+
+// Find Payment godoc
+//
+// @Summary		Find a payment
+// @Description	Find a processed payment by id, including every attempt to process it on an acquirer. Only the payments of the stores listed in the stores claim of the auth token are found.
+// @Tags		payments
+// @Produce		json
+// @Param		id					path			string				true	"Payment Id"
+// @Success		200	{object} 		dto.PaymentDetails
+// @Failure		404	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v1/payments/{id}		[get]
+// @Router		/v2/payments/{id}		[get]
+func (h *PaymentHandler) FindPayment(c *fiber.Ctx) error {
+	input := usecase.FindPaymentInput{
+		AllowedStores: allowedStores(c),
+		PaymentId:     c.Params("id"),
+	}
+
+	output, err := h.findPayment.Execute(c.UserContext(), &input)
 	if err != nil {
 		return dto.NewHttpError(c, err)
 	}
 
-	payment := dto.NewPayment(output.PaymentId)
+	attempts := make([]*dto.PaymentAttempt, 0, len(output.Attempts))
+	for _, attempt := range output.Attempts {
+		attempts = append(attempts, &dto.PaymentAttempt{
+			AcquirerName:    attempt.AcquirerName,
+			Status:          attempt.Status,
+			AcquirerId:      attempt.AcquirerId,
+			AcquirerCode:    attempt.AcquirerCode,
+			AcquirerMessage: attempt.AcquirerMessage,
+			CreatedAt:       attempt.CreatedAt,
+		})
+	}
+
+	currency := output.PurchaseCurrency
+	payment := dto.PaymentDetails{
+		Id:                   output.PaymentId,
+		Status:               output.PaymentStatus,
+		CardToken:            output.CardToken,
+		CardBrand:            output.CardBrand,
+		Amount:               output.PurchaseAmount,
+		Currency:             currency,
+		PurchaseValue:        entity.NewMoney(output.PurchaseAmount, currency).Decimal(),
+		PurchaseItems:        output.PurchaseItems,
+		PurchaseInstallments: output.PurchaseInstallments,
+		StoreId:              output.StoreId,
+		StoreIdentification:  output.StoreIdentification,
+		StoreDocumentType:    output.StoreDocumentType,
+		StoreAddress:         output.StoreAddress,
+		StoreCep:             output.StoreCep,
+		StoreStreet:          output.StoreStreet,
+		StoreNumber:          output.StoreNumber,
+		StoreCity:            output.StoreCity,
+		StoreState:           output.StoreState,
+		AcquirerName:         output.AcquirerName,
+		RouteRule:            output.RouteRule,
+		AcquirerId:           output.AcquirerId,
+		AcquirerCode:         output.AcquirerCode,
+		AcquirerMessage:      output.AcquirerMessage,
+		CapturedAmount:       output.CapturedAmount,
+		RefundedAmount:       output.RefundedAmount,
+		CapturedValue:        entity.NewMoney(output.CapturedAmount, currency).Decimal(),
+		RefundedValue:        entity.NewMoney(output.RefundedAmount, currency).Decimal(),
+		Attempts:             attempts,
+		Caller:               output.Caller,
+		CreatedAt:            output.CreatedAt,
+		UpdatedAt:            output.UpdatedAt,
+	}
+
 	return c.JSON(payment)
 }
+
+// Capture Payment godoc
+//
+// @Summary		Capture a payment
+// @Description	Capture an authorized payment in BRL fully or partially with a decimal value. The full authorized value is used when no value is informed. The payments in other currencies are rejected with 422.
+// @Tags		payments
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string				true	"Payment Id"
+// @Param		capture				body			dto.CaptureRequest	false	"Capture"
+// @Param		Idempotency-Key		header			string				false	"Idempotency Key"
+// @Success		200	{object} 		dto.Capture
+// @Failure		400	{object}		dto.HttpError
+// @Failure		403	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		409	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		504	{object}		dto.HttpError
+// @Security	Bearer token
+// @Deprecated
+// @Router		/v1/payments/{id}/capture	[post]
+func (h *PaymentHandler) CapturePayment(c *fiber.Ctx) error {
+	request := dto.CaptureRequest{}
+	if len(c.Body()) > 0 {
+		err := c.BodyParser(&request)
+		if err != nil {
+			return dto.NewHttpError(c, err)
+		}
+	}
+
+	input := usecase.CapturePaymentInput{
+		AllowedStores: allowedStores(c),
+		PaymentId:     c.Params("id"),
+		CaptureAmount: entity.NewMoneyFromDecimal(request.Value, LegacyCurrency).Amount,
+		Currency:      LegacyCurrency,
+	}
+
+	return h.capture(c, &input)
+}
+
+// Capture Payment V2 godoc
+//
+// @Summary		Capture a payment
+// @Description	Capture an authorized payment fully or partially with an amount in the minor unit of the payment currency. The full authorized amount is used when no amount is informed.
+// @Tags		payments
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string					true	"Payment Id"
+// @Param		capture				body			dto.CaptureRequestV2	false	"Capture"
+// @Param		Idempotency-Key		header			string					false	"Idempotency Key"
+// @Success		200	{object} 		dto.Capture
+// @Failure		400	{object}		dto.HttpError
+// @Failure		403	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		409	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		504	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/payments/{id}/capture	[post]
+func (h *PaymentHandler) CapturePaymentV2(c *fiber.Ctx) error {
+	request := dto.CaptureRequestV2{}
+	if len(c.Body()) > 0 {
+		err := c.BodyParser(&request)
+		if err != nil {
+			return dto.NewHttpError(c, err)
+		}
+	}
+
+	input := usecase.CapturePaymentInput{
+		AllowedStores: allowedStores(c),
+		PaymentId:     c.Params("id"),
+		CaptureAmount: request.Amount,
+	}
+
+	return h.capture(c, &input)
+}
+
+func (h *PaymentHandler) capture(c *fiber.Ctx, input *usecase.CapturePaymentInput) error {
+	output, err := h.capturePayment.Execute(c.UserContext(), input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	capture := dto.Capture{
+		PaymentId:      output.PaymentId,
+		PaymentStatus:  output.PaymentStatus,
+		CapturedAmount: output.CapturedAmount,
+		Currency:       output.Currency,
+		CapturedValue:  entity.NewMoney(output.CapturedAmount, output.Currency).Decimal(),
+	}
+
+	return c.JSON(capture)
+}
+
+// Refund Payment godoc
+//
+// @Summary		Refund a payment
+// @Description	Refund a processed payment in BRL fully or partially with a decimal value. The full refundable value is used when no value is informed. The payments in other currencies are rejected with 422.
+// @Tags		payments
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string				true	"Payment Id"
+// @Param		refund				body			dto.RefundRequest	false	"Refund"
+// @Param		Idempotency-Key		header			string				false	"Idempotency Key"
+// @Success		200	{object} 		dto.Refund
+// @Failure		400	{object}		dto.HttpError
+// @Failure		403	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		409	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		504	{object}		dto.HttpError
+// @Security	Bearer token
+// @Deprecated
+// @Router		/v1/payments/{id}/refunds	[post]
+func (h *PaymentHandler) RefundPayment(c *fiber.Ctx) error {
+	request := dto.RefundRequest{}
+	if len(c.Body()) > 0 {
+		err := c.BodyParser(&request)
+		if err != nil {
+			return dto.NewHttpError(c, err)
+		}
+	}
+
+	input := usecase.RefundPaymentInput{
+		AllowedStores: allowedStores(c),
+		PaymentId:     c.Params("id"),
+		RefundType:    "refund",
+		RefundAmount:  entity.NewMoneyFromDecimal(request.Value, LegacyCurrency).Amount,
+		Currency:      LegacyCurrency,
+	}
+
+	return h.refund(c, &input)
+}
+
+// Refund Payment V2 godoc
+//
+// @Summary		Refund a payment
+// @Description	Refund a processed payment fully or partially with an amount in the minor unit of the payment currency. The full refundable amount is used when no amount is informed.
+// @Tags		payments
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string					true	"Payment Id"
+// @Param		refund				body			dto.RefundRequestV2		false	"Refund"
+// @Param		Idempotency-Key		header			string					false	"Idempotency Key"
+// @Success		200	{object} 		dto.Refund
+// @Failure		400	{object}		dto.HttpError
+// @Failure		403	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		409	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		504	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/payments/{id}/refunds	[post]
+func (h *PaymentHandler) RefundPaymentV2(c *fiber.Ctx) error {
+	request := dto.RefundRequestV2{}
+	if len(c.Body()) > 0 {
+		err := c.BodyParser(&request)
+		if err != nil {
+			return dto.NewHttpError(c, err)
+		}
+	}
+
+	input := usecase.RefundPaymentInput{
+		AllowedStores: allowedStores(c),
+		PaymentId:     c.Params("id"),
+		RefundType:    "refund",
+		RefundAmount:  request.Amount,
+	}
+
+	return h.refund(c, &input)
+}
+
+// Void Payment godoc
+//
+// @Summary		Void a payment
+// @Description	Cancel an uncaptured authorization, or a payment captured on the same day.
+// @Tags		payments
+// @Produce		json
+// @Param		id					path			string				true	"Payment Id"
+// @Param		Idempotency-Key		header			string				false	"Idempotency Key"
+// @Success		200	{object} 		dto.Refund
+// @Failure		404	{object}		dto.HttpError
+// @Failure		409	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		504	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v1/payments/{id}/void	[post]
+// @Router		/v2/payments/{id}/void	[post]
+func (h *PaymentHandler) VoidPayment(c *fiber.Ctx) error {
+	input := usecase.RefundPaymentInput{
+		AllowedStores: allowedStores(c),
+		PaymentId:     c.Params("id"),
+		RefundType:    "void",
+	}
+
+	return h.refund(c, &input)
+}
+
+func (h *PaymentHandler) refund(c *fiber.Ctx, input *usecase.RefundPaymentInput) error {
+	output, err := h.refundPayment.Execute(c.UserContext(), input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	refund := dto.Refund{
+		Id:            output.RefundId,
+		Type:          output.RefundType,
+		Amount:        output.RefundAmount,
+		Currency:      output.RefundCurrency,
+		Value:         entity.NewMoney(output.RefundAmount, output.RefundCurrency).Decimal(),
+		Status:        output.RefundStatus,
+		PaymentId:     output.PaymentId,
+		PaymentStatus: output.PaymentStatus,
+	}
+
+	return c.JSON(refund)
+}
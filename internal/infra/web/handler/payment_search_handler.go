@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
+	web_errors "github.com/sesaquecruz/go-payment-processor/internal/infra/web/errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// searchDateLayout is the layout of the dates informed instead of times in a search, which
+// are days in UTC.
+const searchDateLayout = "2006-01-02"
+
+type IPaymentSearchHandler interface {
+	SearchPayments(c *fiber.Ctx) error
+}
+
+type PaymentSearchHandler struct {
+	searchPayments usecase.ISearchPayments
+}
+
+func NewPaymentSearchHandler(searchPayments usecase.ISearchPayments) *PaymentSearchHandler {
+	return &PaymentSearchHandler{
+		searchPayments: searchPayments,
+	}
+}
+
+// Search Payments godoc
+//
+// @Summary		Search payments
+// @Description	Search the payments of the stores listed in the stores claim of the auth token, one page at a time. The amounts are in minor units of the currency. The created_from and created_to filters are RFC 3339 times or YYYY-MM-DD dates in UTC, and created_to excludes its time or includes its whole date. The next page is requested with the same filters and sort, and the next_cursor of the page as the cursor.
+// @Tags		payments
+// @Produce		json
+// @Param		store_id			query			string				false	"Store Id"
+// @Param		acquirer_name		query			string				false	"Acquirer Name"
+// @Param		status				query			string				false	"Statuses separated by commas"
+// @Param		card_last4			query			string				false	"Card Last 4 Digits"
+// @Param		min_amount			query			int					false	"Min Amount"
+// @Param		max_amount			query			int					false	"Max Amount"
+// @Param		created_from		query			string				false	"Created From"
+// @Param		created_to			query			string				false	"Created To"
+// @Param		sort				query			string				false	"Sort"	Enums(created_at, -created_at, amount, -amount)	default(-created_at)
+// @Param		cursor				query			string				false	"Cursor"
+// @Param		limit				query			int					false	"Limit"	minimum(1)	maximum(100)	default(20)
+// @Success		200	{object} 		dto.PaymentPage
+// @Failure		400	{object}		dto.HttpError
+// @Failure		403	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v1/payments	[get]
+func (h *PaymentSearchHandler) SearchPayments(c *fiber.Ctx) error {
+	input := usecase.SearchPaymentsInput{
+		AllowedStores: allowedStores(c),
+		StoreId:       c.Query("store_id"),
+		AcquirerName:  c.Query("acquirer_name"),
+		CardLast4:     c.Query("card_last4"),
+		Sort:          c.Query("sort"),
+		Cursor:        c.Query("cursor"),
+	}
+
+	if statuses := c.Query("status"); statuses != "" {
+		input.Statuses = strings.Split(statuses, ",")
+	}
+
+	msgs := make([]string, 0)
+
+	parseInt := func(name string) int64 {
+		value := c.Query(name)
+		if value == "" {
+			return 0
+		}
+
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("%s must be an integer", name))
+		}
+		return number
+	}
+
+	parseTime := func(name string, endOfDate bool) time.Time {
+		value := c.Query(name)
+		if value == "" {
+			return time.Time{}
+		}
+
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t
+		}
+
+		day, err := time.Parse(searchDateLayout, value)
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("%s must be a RFC 3339 time or a YYYY-MM-DD date", name))
+			return time.Time{}
+		}
+
+		if endOfDate {
+			return day.AddDate(0, 0, 1)
+		}
+		return day
+	}
+
+	input.MinAmount = parseInt("min_amount")
+	input.MaxAmount = parseInt("max_amount")
+	input.Limit = int(parseInt("limit"))
+	input.CreatedFrom = parseTime("created_from", false)
+	input.CreatedTo = parseTime("created_to", true)
+
+	if len(msgs) > 0 {
+		return dto.NewHttpError(c, web_errors.NewError(msgs...))
+	}
+
+	output, err := h.searchPayments.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	page := dto.PaymentPage{
+		Payments:   make([]*dto.PaymentSummary, 0, len(output.Payments)),
+		NextCursor: output.NextCursor,
+	}
+
+	for _, payment := range output.Payments {
+		page.Payments = append(page.Payments, &dto.PaymentSummary{
+			Id:             payment.PaymentId,
+			Status:         payment.PaymentStatus,
+			CardBrand:      payment.CardBrand,
+			CardLast4:      payment.CardLast4,
+			Amount:         payment.PurchaseAmount,
+			Currency:       payment.PurchaseCurrency,
+			StoreId:        payment.StoreId,
+			AcquirerName:   payment.AcquirerName,
+			AcquirerId:     payment.AcquirerId,
+			CapturedAmount: payment.CapturedAmount,
+			RefundedAmount: payment.RefundedAmount,
+			CreatedAt:      payment.CreatedAt,
+			UpdatedAt:      payment.UpdatedAt,
+		})
+	}
+
+	return c.JSON(page)
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type IRoutingHandler interface {
+	RouteTransaction(c *fiber.Ctx) error
+}
+
+type RoutingHandler struct {
+	routeTransaction usecase.IRouteTransaction
+}
+
+func NewRoutingHandler(routeTransaction usecase.IRouteTransaction) *RoutingHandler {
+	return &RoutingHandler{
+		routeTransaction: routeTransaction,
+	}
+}
+
+// Route Transaction godoc
+//
+// @Summary		Explain a payment route
+// @Description	Evaluate the routing rules for a transaction of a store without processing it, showing the acquirer that would be chosen when acquirer_name is omitted and why each rule did or did not match. The request takes the amount in the minor unit of the currency and the store_id, as the v2 payment, and is only available on v2.
+// @Tags		payments
+// @Accept		json
+// @Produce		json
+// @Param		route				body			dto.RouteRequest	true	"Route"
+// @Success		200	{object} 		dto.Route
+// @Failure		400	{object}		dto.HttpError
+// @Failure		403	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/payments/route	[post]
+func (h *RoutingHandler) RouteTransaction(c *fiber.Ctx) error {
+	request := dto.RouteRequest{}
+	err := c.BodyParser(&request)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	err = request.Validate()
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	input := usecase.RouteTransactionInput{
+		CardBrand:            request.CardBrand,
+		PurchaseAmount:       request.Amount,
+		PurchaseCurrency:     request.Currency,
+		PurchaseInstallments: request.PurchaseInstallments,
+		StoreId:              request.StoreId,
+		AllowedStores:        allowedStores(c),
+	}
+
+	output, err := h.routeTransaction.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	evaluations := make([]*dto.RouteEvaluation, 0, len(output.Evaluations))
+	for _, evaluation := range output.Evaluations {
+		evaluations = append(evaluations, &dto.RouteEvaluation{
+			Rule:    evaluation.Rule,
+			Matched: evaluation.Matched,
+			Reason:  evaluation.Reason,
+		})
+	}
+
+	route := dto.Route{
+		AcquirerName: output.AcquirerName,
+		RouteRule:    output.RouteRule,
+		Fallbacks:    output.Fallbacks,
+		Evaluations:  evaluations,
+	}
+
+	return c.JSON(route)
+}
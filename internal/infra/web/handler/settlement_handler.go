@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ISettlementHandler interface {
+	ImportSettlement(c *fiber.Ctx) error
+	FindSettlement(c *fiber.Ctx) error
+}
+
+type SettlementHandler struct {
+	importSettlement usecase.IImportSettlement
+	findSettlement   usecase.IFindSettlement
+}
+
+func NewSettlementHandler(
+	importSettlement usecase.IImportSettlement,
+	findSettlement usecase.IFindSettlement,
+) *SettlementHandler {
+	return &SettlementHandler{
+		importSettlement: importSettlement,
+		findSettlement:   findSettlement,
+	}
+}
+
+// Import Settlement godoc
+//
+// @Summary		Import a settlement file
+// @Description	Reconcile a settlement file of an acquirer with the payments, matching its lines by acquirer id or, for the lines without one, by amount and date. The report tells the payments captured in the days of the file that were not settled (missing), the lines without a captured payment (unexpected) and the ones settled with another amount (amount_mismatch).
+// @Tags		admin
+// @Accept		mpfd
+// @Produce		json
+// @Param		acquirer			formData		string				true	"Acquirer Name"
+// @Param		format				formData		string				true	"File Format"	Enums(csv, edi)
+// @Param		file				formData		file				true	"Settlement File"
+// @Success		201	{object} 		dto.Settlement
+// @Failure		422	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/admin/settlements	[post]
+func (h *SettlementHandler) ImportSettlement(c *fiber.Ctx) error {
+	input := usecase.ImportSettlementInput{
+		Acquirer: c.FormValue("acquirer"),
+		Format:   c.FormValue("format"),
+	}
+
+	// a missing file is reported by the usecase along with the other fields
+	header, err := c.FormFile("file")
+	if err == nil {
+		file, err := header.Open()
+		if err != nil {
+			return dto.NewHttpError(c, err)
+		}
+		defer file.Close()
+
+		input.FileName = header.Filename
+		input.File = file
+	}
+
+	output, err := h.importSettlement.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(newSettlementDto(output.Settlement))
+}
+
+// Find Settlement godoc
+//
+// @Summary		Find a settlement
+// @Description	Find the reconciliation report of an imported settlement file by id.
+// @Tags		admin
+// @Produce		json
+// @Param		id					path			string				true	"Settlement Id"
+// @Success		200	{object} 		dto.Settlement
+// @Failure		404	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/admin/settlements/{id}	[get]
+func (h *SettlementHandler) FindSettlement(c *fiber.Ctx) error {
+	input := usecase.FindSettlementInput{
+		SettlementId: c.Params("id"),
+	}
+
+	output, err := h.findSettlement.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.JSON(newSettlementDto(output.Settlement))
+}
+
+func newSettlementDto(settlement *usecase.SettlementOutput) *dto.Settlement {
+	discrepancies := make([]*dto.SettlementDiscrepancy, 0, len(settlement.Discrepancies))
+	for _, discrepancy := range settlement.Discrepancies {
+		discrepancies = append(discrepancies, &dto.SettlementDiscrepancy{
+			Type:             discrepancy.Type,
+			Line:             discrepancy.Line,
+			PaymentId:        discrepancy.PaymentId,
+			AcquirerId:       discrepancy.AcquirerId,
+			ExpectedAmount:   discrepancy.ExpectedAmount,
+			ExpectedCurrency: discrepancy.ExpectedCurrency,
+			SettledAmount:    discrepancy.SettledAmount,
+			SettledCurrency:  discrepancy.SettledCurrency,
+			Date:             discrepancy.Date,
+		})
+	}
+
+	settlementDto := &dto.Settlement{
+		Id:               settlement.SettlementId,
+		Acquirer:         settlement.Acquirer,
+		Format:           settlement.Format,
+		FileName:         settlement.FileName,
+		Lines:            settlement.Lines,
+		Matched:          settlement.Matched,
+		Missing:          settlement.Missing,
+		Unexpected:       settlement.Unexpected,
+		AmountMismatches: settlement.AmountMismatches,
+		Discrepancies:    discrepancies,
+		CreatedAt:        settlement.CreatedAt,
+	}
+
+	if !settlement.PeriodStart.IsZero() {
+		settlementDto.PeriodStart = settlement.PeriodStart.Format(time.DateOnly)
+		settlementDto.PeriodEnd = settlement.PeriodEnd.Format(time.DateOnly)
+	}
+
+	return settlementDto
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type IStoreHandler interface {
+	CreateStore(c *fiber.Ctx) error
+	ListStores(c *fiber.Ctx) error
+	FindStore(c *fiber.Ctx) error
+	UpdateStore(c *fiber.Ctx) error
+	DeleteStore(c *fiber.Ctx) error
+}
+
+type StoreHandler struct {
+	createStore usecase.ICreateStore
+	listStores  usecase.IListStores
+	findStore   usecase.IFindStore
+	updateStore usecase.IUpdateStore
+	deleteStore usecase.IDeleteStore
+}
+
+func NewStoreHandler(
+	createStore usecase.ICreateStore,
+	listStores usecase.IListStores,
+	findStore usecase.IFindStore,
+	updateStore usecase.IUpdateStore,
+	deleteStore usecase.IDeleteStore,
+) *StoreHandler {
+	return &StoreHandler{
+		createStore: createStore,
+		listStores:  listStores,
+		findStore:   findStore,
+		updateStore: updateStore,
+		deleteStore: deleteStore,
+	}
+}
+
+// Create Store godoc
+//
+// @Summary		Register a store
+// @Description	Register a store the payments are charged for. The identification must be a valid CPF or CNPJ and the structured address not informed is completed from the cep.
+// @Tags		admin
+// @Accept		json
+// @Produce		json
+// @Param		store				body			dto.StoreRequest	true	"Store"
+// @Success		201	{object} 		dto.Store
+// @Failure		400	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/admin/stores	[post]
+func (h *StoreHandler) CreateStore(c *fiber.Ctx) error {
+	request := dto.StoreRequest{}
+	err := c.BodyParser(&request)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	err = request.Validate()
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	input := usecase.CreateStoreInput{
+		Identification: request.Identification,
+		Address:        request.Address,
+		Cep:            request.Cep,
+		Street:         request.Street,
+		Number:         request.Number,
+		City:           request.City,
+		State:          request.State,
+	}
+
+	output, err := h.createStore.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(newStoreDto(output.Store))
+}
+
+// List Stores godoc
+//
+// @Summary		List the stores
+// @Description	List the registered stores, oldest first.
+// @Tags		admin
+// @Produce		json
+// @Success		200	{array} 		dto.Store
+// @Security	Bearer token
+// @Router		/v2/admin/stores	[get]
+func (h *StoreHandler) ListStores(c *fiber.Ctx) error {
+	output, err := h.listStores.Execute(c.UserContext(), &usecase.ListStoresInput{})
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	stores := make([]*dto.Store, 0, len(output.Stores))
+	for _, store := range output.Stores {
+		stores = append(stores, newStoreDto(store))
+	}
+
+	return c.JSON(stores)
+}
+
+// Find Store godoc
+//
+// @Summary		Find a store
+// @Description	Find a registered store by id.
+// @Tags		admin
+// @Produce		json
+// @Param		id					path			string				true	"Store Id"
+// @Success		200	{object} 		dto.Store
+// @Failure		404	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/admin/stores/{id}	[get]
+func (h *StoreHandler) FindStore(c *fiber.Ctx) error {
+	input := usecase.FindStoreInput{
+		StoreId: c.Params("id"),
+	}
+
+	output, err := h.findStore.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.JSON(newStoreDto(output.Store))
+}
+
+// Update Store godoc
+//
+// @Summary		Update a store
+// @Description	Replace the registered data of a store. The payments already processed keep the store data they were sent with.
+// @Tags		admin
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string				true	"Store Id"
+// @Param		store				body			dto.StoreRequest	true	"Store"
+// @Success		200	{object} 		dto.Store
+// @Failure		400	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/admin/stores/{id}	[put]
+func (h *StoreHandler) UpdateStore(c *fiber.Ctx) error {
+	request := dto.StoreRequest{}
+	err := c.BodyParser(&request)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	err = request.Validate()
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	input := usecase.UpdateStoreInput{
+		StoreId:        c.Params("id"),
+		Identification: request.Identification,
+		Address:        request.Address,
+		Cep:            request.Cep,
+		Street:         request.Street,
+		Number:         request.Number,
+		City:           request.City,
+		State:          request.State,
+	}
+
+	output, err := h.updateStore.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.JSON(newStoreDto(output.Store))
+}
+
+// Delete Store godoc
+//
+// @Summary		Delete a store
+// @Description	Remove a store from the registry. Its id can no longer be used in payments.
+// @Tags		admin
+// @Param		id					path			string				true	"Store Id"
+// @Success		204
+// @Failure		404	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/admin/stores/{id}	[delete]
+func (h *StoreHandler) DeleteStore(c *fiber.Ctx) error {
+	input := usecase.DeleteStoreInput{
+		StoreId: c.Params("id"),
+	}
+
+	_, err := h.deleteStore.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func newStoreDto(store *usecase.StoreOutput) *dto.Store {
+	return &dto.Store{
+		Id:             store.StoreId,
+		Identification: store.Identification,
+		DocumentType:   store.DocumentType,
+		Address:        store.Address,
+		Cep:            store.Cep,
+		Street:         store.Street,
+		Number:         store.Number,
+		City:           store.City,
+		State:          store.State,
+		CreatedAt:      store.CreatedAt,
+		UpdatedAt:      store.UpdatedAt,
+	}
+}
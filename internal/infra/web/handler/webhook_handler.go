@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/web/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// deliveriesLimit is how many of the last deliveries of a webhook are listed.
+const deliveriesLimit = 100
+
+type IWebhookHandler interface {
+	CreateWebhook(c *fiber.Ctx) error
+	ListWebhooks(c *fiber.Ctx) error
+	FindWebhook(c *fiber.Ctx) error
+	UpdateWebhook(c *fiber.Ctx) error
+	DeleteWebhook(c *fiber.Ctx) error
+	ListDeliveries(c *fiber.Ctx) error
+	RedeliverWebhook(c *fiber.Ctx) error
+}
+
+type WebhookHandler struct {
+	createWebhook         usecase.ICreateWebhook
+	listWebhooks          usecase.IListWebhooks
+	findWebhook           usecase.IFindWebhook
+	updateWebhook         usecase.IUpdateWebhook
+	deleteWebhook         usecase.IDeleteWebhook
+	listWebhookDeliveries usecase.IListWebhookDeliveries
+	redeliverWebhook      usecase.IRedeliverWebhook
+}
+
+func NewWebhookHandler(
+	createWebhook usecase.ICreateWebhook,
+	listWebhooks usecase.IListWebhooks,
+	findWebhook usecase.IFindWebhook,
+	updateWebhook usecase.IUpdateWebhook,
+	deleteWebhook usecase.IDeleteWebhook,
+	listWebhookDeliveries usecase.IListWebhookDeliveries,
+	redeliverWebhook usecase.IRedeliverWebhook,
+) *WebhookHandler {
+	return &WebhookHandler{
+		createWebhook:         createWebhook,
+		listWebhooks:          listWebhooks,
+		findWebhook:           findWebhook,
+		updateWebhook:         updateWebhook,
+		deleteWebhook:         deleteWebhook,
+		listWebhookDeliveries: listWebhookDeliveries,
+		redeliverWebhook:      redeliverWebhook,
+	}
+}
+
+// Create Webhook godoc
+//
+// @Summary		Subscribe a webhook
+// @Description	Subscribe a url to events of the payments of the client. The deliveries are signed with the secret, which must have at least 16 characters.
+// @Tags		webhooks
+// @Accept		json
+// @Produce		json
+// @Param		webhook				body			dto.WebhookRequest	true	"Webhook"
+// @Success		201	{object} 		dto.Webhook
+// @Failure		400	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/webhooks		[post]
+func (h *WebhookHandler) CreateWebhook(c *fiber.Ctx) error {
+	request := dto.WebhookRequest{}
+	err := c.BodyParser(&request)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	err = request.Validate()
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	input := usecase.CreateWebhookInput{
+		Caller: callerIdentity(c),
+		Url:    request.Url,
+		Events: request.Events,
+		Secret: request.Secret,
+	}
+
+	output, err := h.createWebhook.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(newWebhookDto(output.Webhook))
+}
+
+// List Webhooks godoc
+//
+// @Summary		List the webhooks
+// @Description	List the webhooks of the client, oldest first.
+// @Tags		webhooks
+// @Produce		json
+// @Success		200	{array} 		dto.Webhook
+// @Security	Bearer token
+// @Router		/v2/webhooks		[get]
+func (h *WebhookHandler) ListWebhooks(c *fiber.Ctx) error {
+	input := usecase.ListWebhooksInput{
+		Caller: callerIdentity(c),
+	}
+
+	output, err := h.listWebhooks.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	webhooks := make([]*dto.Webhook, 0, len(output.Webhooks))
+	for _, webhook := range output.Webhooks {
+		webhooks = append(webhooks, newWebhookDto(webhook))
+	}
+
+	return c.JSON(webhooks)
+}
+
+// Find Webhook godoc
+//
+// @Summary		Find a webhook
+// @Description	Find a webhook of the client by id.
+// @Tags		webhooks
+// @Produce		json
+// @Param		id					path			string				true	"Webhook Id"
+// @Success		200	{object} 		dto.Webhook
+// @Failure		404	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/webhooks/{id}	[get]
+func (h *WebhookHandler) FindWebhook(c *fiber.Ctx) error {
+	input := usecase.FindWebhookInput{
+		Caller:    callerIdentity(c),
+		WebhookId: c.Params("id"),
+	}
+
+	output, err := h.findWebhook.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.JSON(newWebhookDto(output.Webhook))
+}
+
+// Update Webhook godoc
+//
+// @Summary		Update a webhook
+// @Description	Replace the url and events of a webhook of the client, and its secret when informed. The deliveries already queued are sent with the new url and secret.
+// @Tags		webhooks
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string				true	"Webhook Id"
+// @Param		webhook				body			dto.WebhookRequest	true	"Webhook"
+// @Success		200	{object} 		dto.Webhook
+// @Failure		400	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/webhooks/{id}	[put]
+func (h *WebhookHandler) UpdateWebhook(c *fiber.Ctx) error {
+	request := dto.WebhookRequest{}
+	err := c.BodyParser(&request)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	err = request.Validate()
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	input := usecase.UpdateWebhookInput{
+		Caller:    callerIdentity(c),
+		WebhookId: c.Params("id"),
+		Url:       request.Url,
+		Events:    request.Events,
+		Secret:    request.Secret,
+	}
+
+	output, err := h.updateWebhook.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.JSON(newWebhookDto(output.Webhook))
+}
+
+// Delete Webhook godoc
+//
+// @Summary		Delete a webhook
+// @Description	Unsubscribe a webhook of the client. Its pending deliveries are discarded.
+// @Tags		webhooks
+// @Param		id					path			string				true	"Webhook Id"
+// @Success		204
+// @Failure		404	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/webhooks/{id}	[delete]
+func (h *WebhookHandler) DeleteWebhook(c *fiber.Ctx) error {
+	input := usecase.DeleteWebhookInput{
+		Caller:    callerIdentity(c),
+		WebhookId: c.Params("id"),
+	}
+
+	_, err := h.deleteWebhook.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// List Deliveries godoc
+//
+// @Summary		List the deliveries of a webhook
+// @Description	List the last 100 deliveries of a webhook of the client, newest first, with the last answer of the webhook.
+// @Tags		webhooks
+// @Produce		json
+// @Param		id					path			string				true	"Webhook Id"
+// @Success		200	{array} 		dto.WebhookDelivery
+// @Failure		404	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/webhooks/{id}/deliveries	[get]
+func (h *WebhookHandler) ListDeliveries(c *fiber.Ctx) error {
+	input := usecase.ListWebhookDeliveriesInput{
+		Caller:    callerIdentity(c),
+		WebhookId: c.Params("id"),
+		Limit:     deliveriesLimit,
+	}
+
+	output, err := h.listWebhookDeliveries.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	deliveries := make([]*dto.WebhookDelivery, 0, len(output.Deliveries))
+	for _, delivery := range output.Deliveries {
+		deliveries = append(deliveries, newWebhookDeliveryDto(delivery))
+	}
+
+	return c.JSON(deliveries)
+}
+
+// Redeliver Webhook godoc
+//
+// @Summary		Redeliver an event
+// @Description	Queue a delivery of a webhook of the client to be sent again right away, whatever its status, with a new round of attempts. The event keeps its id.
+// @Tags		webhooks
+// @Produce		json
+// @Param		id					path			string				true	"Webhook Id"
+// @Param		deliveryId			path			string				true	"Delivery Id"
+// @Success		202	{object} 		dto.WebhookDelivery
+// @Failure		404	{object}		dto.HttpError
+// @Security	Bearer token
+// @Router		/v2/webhooks/{id}/deliveries/{deliveryId}/redeliver	[post]
+func (h *WebhookHandler) RedeliverWebhook(c *fiber.Ctx) error {
+	input := usecase.RedeliverWebhookInput{
+		Caller:     callerIdentity(c),
+		WebhookId:  c.Params("id"),
+		DeliveryId: c.Params("deliveryId"),
+	}
+
+	output, err := h.redeliverWebhook.Execute(c.UserContext(), &input)
+	if err != nil {
+		return dto.NewHttpError(c, err)
+	}
+
+	return c.Status(http.StatusAccepted).JSON(newWebhookDeliveryDto(output.Delivery))
+}
+
+func newWebhookDto(webhook *usecase.WebhookOutput) *dto.Webhook {
+	return &dto.Webhook{
+		Id:        webhook.WebhookId,
+		Url:       webhook.Url,
+		Events:    webhook.Events,
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+	}
+}
+
+func newWebhookDeliveryDto(delivery *usecase.WebhookDeliveryOutput) *dto.WebhookDelivery {
+	return &dto.WebhookDelivery{
+		Id:              delivery.DeliveryId,
+		EventId:         delivery.EventId,
+		EventType:       delivery.EventType,
+		PaymentId:       delivery.PaymentId,
+		Payload:         delivery.Payload,
+		Status:          delivery.Status,
+		Attempts:        delivery.Attempts,
+		NextAttemptAt:   delivery.NextAttemptAt,
+		ResponseCode:    delivery.ResponseCode,
+		ResponseMessage: delivery.ResponseMessage,
+		CreatedAt:       delivery.CreatedAt,
+		UpdatedAt:       delivery.UpdatedAt,
+	}
+}
@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+)
+
+type ExpiringCardsConfig struct {
+	Interval time.Duration
+	Days     int
+}
+
+func DefaultExpiringCardsConfig() ExpiringCardsConfig {
+	return ExpiringCardsConfig{
+		Interval: 24 * time.Hour,
+		Days:     30,
+	}
+}
+
+type ExpiringCardsWorker struct {
+	reportExpiringCards usecase.IReportExpiringCards
+	config              ExpiringCardsConfig
+}
+
+func NewExpiringCardsWorker(reportExpiringCards usecase.IReportExpiringCards, config ExpiringCardsConfig) *ExpiringCardsWorker {
+	return &ExpiringCardsWorker{
+		reportExpiringCards: reportExpiringCards,
+		config:              config,
+	}
+}
+
+func (w *ExpiringCardsWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.report(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *ExpiringCardsWorker) report(ctx context.Context) {
+	output, err := w.reportExpiringCards.Execute(ctx, &usecase.ReportExpiringCardsInput{Days: w.config.Days})
+	if err != nil {
+		slog.Error(err.Error())
+		return
+	}
+
+	for _, card := range output.Cards {
+		slog.Info("card expiring",
+			"card_token", maskCardToken(card.CardToken),
+			"card_brand", card.CardBrand,
+			"card_last4", card.CardLast4,
+			"card_expiration", card.CardExpiration,
+		)
+	}
+
+	slog.Info("expiring cards report", "days", w.config.Days, "until", output.Until, "cards", len(output.Cards))
+}
+
+// maskCardToken keeps enough of the token to tell the cards apart in the logs, but not to charge them.
+func maskCardToken(token string) string {
+	if len(token) <= 4 {
+		return strings.Repeat("*", len(token))
+	}
+
+	return strings.Repeat("*", len(token)-4) + token[len(token)-4:]
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	usecaseMocks "github.com/sesaquecruz/go-payment-processor/test/mocks/core/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpiringCardsWorker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var logs bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+
+	cardToken := "0f1e2d3c4b5a69788796a5b4c3d2e1f0"
+
+	reports := 0
+	reportExpiringCards := usecaseMocks.NewIReportExpiringCardsMock(t)
+	reportExpiringCards.
+		EXPECT().
+		Execute(mock.Anything, &usecase.ReportExpiringCardsInput{Days: 15}).
+		Run(func(ctx context.Context, input *usecase.ReportExpiringCardsInput) {
+			reports++
+		}).
+		Return(nil, errors.New("connection refused")).
+		Once()
+	reportExpiringCards.
+		EXPECT().
+		Execute(mock.Anything, &usecase.ReportExpiringCardsInput{Days: 15}).
+		Run(func(ctx context.Context, input *usecase.ReportExpiringCardsInput) {
+			reports++
+			cancel()
+		}).
+		Return(&usecase.ReportExpiringCardsOutput{
+			Cards: []*usecase.ExpiringCard{{CardToken: cardToken, CardBrand: "VISA", CardLast4: "1111", CardExpiration: "12/2099"}},
+		}, nil).
+		Once()
+
+	worker := NewExpiringCardsWorker(reportExpiringCards, ExpiringCardsConfig{Interval: 10 * time.Millisecond, Days: 15})
+
+	done := make(chan struct{})
+	go func() {
+		worker.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop")
+	}
+
+	assert.Equal(t, 2, reports)
+	assert.NotContains(t, logs.String(), cardToken)
+	assert.Contains(t, logs.String(), "card_token=****************************e1f0")
+}
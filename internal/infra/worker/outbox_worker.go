@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+)
+
+type OutboxConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+func DefaultOutboxConfig() OutboxConfig {
+	return OutboxConfig{
+		Interval:  time.Second,
+		BatchSize: 100,
+	}
+}
+
+// OutboxWorker takes turns on the lock of the outbox with the workers of the other instances,
+// since concurrent relays could publish the events of a payment out of order.
+type OutboxWorker struct {
+	publishEvents usecase.IPublishEvents
+	config        OutboxConfig
+}
+
+func NewOutboxWorker(publishEvents usecase.IPublishEvents, config OutboxConfig) *OutboxWorker {
+	return &OutboxWorker{
+		publishEvents: publishEvents,
+		config:        config,
+	}
+}
+
+// Run drains a backlog without waiting, while the events held back by a failure wait for the
+// next interval.
+func (w *OutboxWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		for w.publish(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *OutboxWorker) publish(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	output, err := w.publishEvents.Execute(ctx, &usecase.PublishEventsInput{Limit: w.config.BatchSize})
+	if err != nil {
+		slog.Error(err.Error())
+		return false
+	}
+
+	if output.Published+output.Pending > 0 {
+		slog.Info("events published", "published", output.Published, "pending", output.Pending)
+	}
+
+	return output.Published >= w.config.BatchSize
+}
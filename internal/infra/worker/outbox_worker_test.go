@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	"github.com/sesaquecruz/go-payment-processor/internal/infra/service"
+	repositoryMocks "github.com/sesaquecruz/go-payment-processor/test/mocks/core/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOutboxWorker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	created := &entity.DomainEvent{Id: "Created", Sequence: 1, Type: "payment.created", PaymentId: "Payment", Payload: []byte(`{}`)}
+	approved := &entity.DomainEvent{Id: "Approved", Sequence: 2, Type: "payment.approved", PaymentId: "Payment", Payload: []byte(`{}`)}
+
+	// the first relay fails and holds back the approved event, the second publishes both
+	outboxRepository := repositoryMocks.NewIOutboxRepositoryMock(t)
+	outboxRepository.
+		EXPECT().
+		LockOutbox(mock.Anything).
+		Return(func() {}, nil).
+		Times(3)
+	outboxRepository.
+		EXPECT().
+		FindPendingEvents(mock.Anything, 2).
+		Return([]*entity.DomainEvent{created, approved}, nil).
+		Twice()
+	outboxRepository.
+		EXPECT().
+		FindPendingEvents(mock.Anything, 2).
+		Run(func(ctx context.Context, limit int) {
+			cancel()
+		}).
+		Return([]*entity.DomainEvent{}, nil).
+		Once()
+	outboxRepository.
+		EXPECT().
+		UpdateEvent(mock.Anything, mock.Anything).
+		Return(nil).
+		Times(3)
+
+	eventPublisher := service.NewMemoryEventPublisher()
+	eventPublisher.FailNext(errors.New("connection refused"))
+
+	worker := NewOutboxWorker(usecase.NewPublishEvents(outboxRepository, repositoryMocks.NewIWebhookDeliveryRepositoryMock(t), eventPublisher), OutboxConfig{Interval: time.Millisecond, BatchSize: 2})
+
+	done := make(chan struct{})
+	go func() {
+		worker.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop")
+	}
+
+	assert.Equal(t, []*entity.DomainEvent{created, approved}, eventPublisher.Events())
+	assert.Equal(t, 2, created.Attempts)
+	assert.Equal(t, 1, approved.Attempts)
+}
@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+)
+
+type PaymentBatchConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+func DefaultPaymentBatchConfig() PaymentBatchConfig {
+	return PaymentBatchConfig{
+		Interval:  time.Second,
+		BatchSize: 1,
+	}
+}
+
+type PaymentBatchWorker struct {
+	processPaymentBatches usecase.IProcessPaymentBatches
+	config                PaymentBatchConfig
+}
+
+func NewPaymentBatchWorker(processPaymentBatches usecase.IProcessPaymentBatches, config PaymentBatchConfig) *PaymentBatchWorker {
+	return &PaymentBatchWorker{
+		processPaymentBatches: processPaymentBatches,
+		config:                config,
+	}
+}
+
+func (w *PaymentBatchWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		for w.process(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *PaymentBatchWorker) process(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	output, err := w.processPaymentBatches.Execute(ctx, &usecase.ProcessPaymentBatchesInput{Limit: w.config.BatchSize})
+	if err != nil {
+		slog.Error(err.Error())
+		return false
+	}
+
+	claimed := output.Processed + output.Failed
+	if claimed > 0 {
+		slog.Info("payment batches processed", "processed", output.Processed, "failed", output.Failed)
+	}
+
+	return claimed >= w.config.BatchSize
+}
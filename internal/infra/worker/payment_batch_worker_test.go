@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	usecaseMocks "github.com/sesaquecruz/go-payment-processor/test/mocks/core/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPaymentBatchWorker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lookups := 0
+	processPaymentBatches := usecaseMocks.NewIProcessPaymentBatchesMock(t)
+	processPaymentBatches.
+		EXPECT().
+		Execute(mock.Anything, &usecase.ProcessPaymentBatchesInput{Limit: 2}).
+		Run(func(ctx context.Context, input *usecase.ProcessPaymentBatchesInput) {
+			lookups++
+		}).
+		Return(&usecase.ProcessPaymentBatchesOutput{Processed: 1, Failed: 1}, nil).
+		Once()
+	processPaymentBatches.
+		EXPECT().
+		Execute(mock.Anything, &usecase.ProcessPaymentBatchesInput{Limit: 2}).
+		Run(func(ctx context.Context, input *usecase.ProcessPaymentBatchesInput) {
+			lookups++
+			cancel()
+		}).
+		Return(&usecase.ProcessPaymentBatchesOutput{Processed: 1}, nil).
+		Once()
+
+	worker := NewPaymentBatchWorker(processPaymentBatches, PaymentBatchConfig{Interval: time.Hour, BatchSize: 2})
+
+	done := make(chan struct{})
+	go func() {
+		worker.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop")
+	}
+
+	assert.Equal(t, 2, lookups)
+}
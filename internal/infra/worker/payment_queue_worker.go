@@ -0,0 +1,86 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+)
+
+type PaymentQueueConfig struct {
+	Workers   int
+	Interval  time.Duration
+	BatchSize int
+}
+
+func DefaultPaymentQueueConfig() PaymentQueueConfig {
+	return PaymentQueueConfig{
+		Workers:   4,
+		Interval:  time.Second,
+		BatchSize: 10,
+	}
+}
+
+type PaymentQueueWorker struct {
+	processQueuedPayments usecase.IProcessQueuedPayments
+	config                PaymentQueueConfig
+}
+
+func NewPaymentQueueWorker(processQueuedPayments usecase.IProcessQueuedPayments, config PaymentQueueConfig) *PaymentQueueWorker {
+	return &PaymentQueueWorker{
+		processQueuedPayments: processQueuedPayments,
+		config:                config,
+	}
+}
+
+// Run waits for the workers to stop when the context is done.
+func (w *PaymentQueueWorker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < max(w.config.Workers, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.work(ctx)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (w *PaymentQueueWorker) work(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		for w.process(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *PaymentQueueWorker) process(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	output, err := w.processQueuedPayments.Execute(ctx, &usecase.ProcessQueuedPaymentsInput{Limit: w.config.BatchSize})
+	if err != nil {
+		slog.Error(err.Error())
+		return false
+	}
+
+	claimed := output.Processed + output.Failed
+	if claimed > 0 {
+		slog.Info("queued payments processed", "processed", output.Processed, "failed", output.Failed)
+	}
+
+	return claimed >= w.config.BatchSize
+}
@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	usecaseMocks "github.com/sesaquecruz/go-payment-processor/test/mocks/core/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPaymentQueueWorker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// every worker drains a full batch and then an empty one
+	var batches atomic.Int32
+	processQueuedPayments := usecaseMocks.NewIProcessQueuedPaymentsMock(t)
+	processQueuedPayments.
+		EXPECT().
+		Execute(mock.Anything, &usecase.ProcessQueuedPaymentsInput{Limit: 2}).
+		Return(&usecase.ProcessQueuedPaymentsOutput{Processed: 1, Failed: 1}, nil).
+		Times(3)
+	processQueuedPayments.
+		EXPECT().
+		Execute(mock.Anything, &usecase.ProcessQueuedPaymentsInput{Limit: 2}).
+		Run(func(ctx context.Context, input *usecase.ProcessQueuedPaymentsInput) {
+			if batches.Add(1) == 3 {
+				cancel()
+			}
+		}).
+		Return(&usecase.ProcessQueuedPaymentsOutput{}, nil).
+		Times(3)
+
+	worker := NewPaymentQueueWorker(processQueuedPayments, PaymentQueueConfig{Workers: 3, Interval: time.Hour, BatchSize: 2})
+
+	done := make(chan struct{})
+	go func() {
+		worker.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("workers did not stop")
+	}
+
+	assert.Equal(t, int32(3), batches.Load())
+}
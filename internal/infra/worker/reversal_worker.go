@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+)
+
+type ReversalConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+func DefaultReversalConfig() ReversalConfig {
+	return ReversalConfig{
+		Interval:  5 * time.Second,
+		BatchSize: 100,
+	}
+}
+
+type ReversalWorker struct {
+	resolveReversals usecase.IResolveReversals
+	config           ReversalConfig
+}
+
+func NewReversalWorker(resolveReversals usecase.IResolveReversals, config ReversalConfig) *ReversalWorker {
+	return &ReversalWorker{
+		resolveReversals: resolveReversals,
+		config:           config,
+	}
+}
+
+func (w *ReversalWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		for w.resolve(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *ReversalWorker) resolve(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	output, err := w.resolveReversals.Execute(ctx, &usecase.ResolveReversalsInput{Limit: w.config.BatchSize})
+	if err != nil {
+		slog.Error(err.Error())
+		return false
+	}
+
+	if output.Resolved+output.Pending > 0 {
+		slog.Info("reversals processed", "resolved", output.Resolved, "pending", output.Pending)
+	}
+
+	return output.Resolved+output.Pending >= w.config.BatchSize
+}
@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	usecaseMocks "github.com/sesaquecruz/go-payment-processor/test/mocks/core/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReversalWorker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	batches := 0
+	resolveReversals := usecaseMocks.NewIResolveReversalsMock(t)
+	resolveReversals.
+		EXPECT().
+		Execute(mock.Anything, &usecase.ResolveReversalsInput{Limit: 2}).
+		Run(func(ctx context.Context, input *usecase.ResolveReversalsInput) {
+			batches++
+		}).
+		Return(&usecase.ResolveReversalsOutput{Resolved: 1, Pending: 1}, nil).
+		Once()
+	resolveReversals.
+		EXPECT().
+		Execute(mock.Anything, &usecase.ResolveReversalsInput{Limit: 2}).
+		Run(func(ctx context.Context, input *usecase.ResolveReversalsInput) {
+			batches++
+			cancel()
+		}).
+		Return(&usecase.ResolveReversalsOutput{Resolved: 1}, nil).
+		Once()
+
+	worker := NewReversalWorker(resolveReversals, ReversalConfig{Interval: time.Hour, BatchSize: 2})
+
+	done := make(chan struct{})
+	go func() {
+		worker.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop")
+	}
+
+	assert.Equal(t, 2, batches)
+}
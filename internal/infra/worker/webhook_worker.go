@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+)
+
+type WebhookConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+func DefaultWebhookConfig() WebhookConfig {
+	return WebhookConfig{
+		Interval:  time.Second,
+		BatchSize: 100,
+	}
+}
+
+type WebhookWorker struct {
+	deliverWebhooks usecase.IDeliverWebhooks
+	config          WebhookConfig
+}
+
+func NewWebhookWorker(deliverWebhooks usecase.IDeliverWebhooks, config WebhookConfig) *WebhookWorker {
+	return &WebhookWorker{
+		deliverWebhooks: deliverWebhooks,
+		config:          config,
+	}
+}
+
+func (w *WebhookWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		for w.deliver(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *WebhookWorker) deliver(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	output, err := w.deliverWebhooks.Execute(ctx, &usecase.DeliverWebhooksInput{Limit: w.config.BatchSize})
+	if err != nil {
+		slog.Error(err.Error())
+		return false
+	}
+
+	sent := output.Delivered + output.Pending + output.Failed
+	if sent > 0 {
+		slog.Info("webhooks processed", "delivered", output.Delivered, "pending", output.Pending, "failed", output.Failed)
+	}
+
+	return sent >= w.config.BatchSize
+}
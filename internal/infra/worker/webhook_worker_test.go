@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	usecaseMocks "github.com/sesaquecruz/go-payment-processor/test/mocks/core/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWebhookWorker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	batches := 0
+	deliverWebhooks := usecaseMocks.NewIDeliverWebhooksMock(t)
+	deliverWebhooks.
+		EXPECT().
+		Execute(mock.Anything, &usecase.DeliverWebhooksInput{Limit: 3}).
+		Run(func(ctx context.Context, input *usecase.DeliverWebhooksInput) {
+			batches++
+		}).
+		Return(&usecase.DeliverWebhooksOutput{Delivered: 1, Pending: 1, Failed: 1}, nil).
+		Once()
+	deliverWebhooks.
+		EXPECT().
+		Execute(mock.Anything, &usecase.DeliverWebhooksInput{Limit: 3}).
+		Run(func(ctx context.Context, input *usecase.DeliverWebhooksInput) {
+			batches++
+			cancel()
+		}).
+		Return(&usecase.DeliverWebhooksOutput{Delivered: 1}, nil).
+		Once()
+
+	worker := NewWebhookWorker(deliverWebhooks, WebhookConfig{Interval: time.Hour, BatchSize: 3})
+
+	done := make(chan struct{})
+	go func() {
+		worker.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop")
+	}
+
+	assert.Equal(t, 2, batches)
+}
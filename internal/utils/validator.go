@@ -19,7 +19,7 @@ func GetNamespaceError(err validator.FieldError) string {
 	for i, c := range namespace {
 		if c == '.' {
 			continue
-		} else if c > 'Z' {
+		} else if c < 'A' || c > 'Z' {
 			msg.WriteRune(c)
 		} else {
 			if i > 0 {
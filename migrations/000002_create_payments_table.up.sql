@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS payments (
+	id UUID PRIMARY KEY,
+	card_token VARCHAR(100) NOT NULL,
+	card_brand VARCHAR(20) NOT NULL,
+	purchase_value NUMERIC(12, 2) NOT NULL,
+	purchase_items TEXT[] NOT NULL,
+	purchase_installments INTEGER NOT NULL,
+	store_identification VARCHAR(100) NOT NULL,
+	store_address VARCHAR(255) NOT NULL,
+	store_cep VARCHAR(20) NOT NULL,
+	acquirer_name VARCHAR(50) NOT NULL,
+	status VARCHAR(20) NOT NULL,
+	acquirer_id VARCHAR(100) NOT NULL DEFAULT '',
+	acquirer_code INTEGER NOT NULL DEFAULT 0,
+	acquirer_message TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
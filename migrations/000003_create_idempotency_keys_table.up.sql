@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+	key VARCHAR(255) PRIMARY KEY,
+	request_hash CHAR(64) NOT NULL,
+	status VARCHAR(20) NOT NULL,
+	response_code INTEGER NOT NULL DEFAULT 0,
+	response_body BYTEA,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS refunds;
+ALTER TABLE payments DROP COLUMN IF EXISTS refunded_value;
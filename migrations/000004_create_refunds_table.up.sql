@@ -0,0 +1,16 @@
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS refunded_value NUMERIC(12, 2) NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS refunds (
+	id UUID PRIMARY KEY,
+	payment_id UUID NOT NULL REFERENCES payments (id),
+	type VARCHAR(20) NOT NULL,
+	value NUMERIC(12, 2) NOT NULL,
+	status VARCHAR(20) NOT NULL,
+	acquirer_id VARCHAR(100) NOT NULL DEFAULT '',
+	acquirer_code INTEGER NOT NULL DEFAULT 0,
+	acquirer_message TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS refunds_payment_id_idx ON refunds (payment_id);
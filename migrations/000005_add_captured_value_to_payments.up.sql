@@ -0,0 +1,5 @@
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS captured_value NUMERIC(12, 2) NOT NULL DEFAULT 0;
+
+UPDATE payments
+SET captured_value = purchase_value
+WHERE status IN ('approved', 'partially_refunded', 'refunded', 'voided');
@@ -0,0 +1,26 @@
+ALTER TABLE refunds ADD COLUMN IF NOT EXISTS value NUMERIC(12, 2) NOT NULL DEFAULT 0;
+
+UPDATE refunds SET value = amount / 100.0;
+
+ALTER TABLE refunds
+	ALTER COLUMN value DROP DEFAULT,
+	DROP COLUMN IF EXISTS amount,
+	DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE payments
+	ADD COLUMN IF NOT EXISTS purchase_value NUMERIC(12, 2) NOT NULL DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS captured_value NUMERIC(12, 2) NOT NULL DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS refunded_value NUMERIC(12, 2) NOT NULL DEFAULT 0;
+
+UPDATE payments
+SET
+	purchase_value = purchase_amount / 100.0,
+	captured_value = captured_amount / 100.0,
+	refunded_value = refunded_amount / 100.0;
+
+ALTER TABLE payments
+	ALTER COLUMN purchase_value DROP DEFAULT,
+	DROP COLUMN IF EXISTS purchase_amount,
+	DROP COLUMN IF EXISTS currency,
+	DROP COLUMN IF EXISTS captured_amount,
+	DROP COLUMN IF EXISTS refunded_amount;
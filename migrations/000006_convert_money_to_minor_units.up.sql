@@ -0,0 +1,29 @@
+ALTER TABLE payments
+	ADD COLUMN IF NOT EXISTS purchase_amount BIGINT,
+	ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'BRL',
+	ADD COLUMN IF NOT EXISTS captured_amount BIGINT NOT NULL DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS refunded_amount BIGINT NOT NULL DEFAULT 0;
+
+UPDATE payments
+SET
+	purchase_amount = ROUND(purchase_value * 100),
+	captured_amount = ROUND(captured_value * 100),
+	refunded_amount = ROUND(refunded_value * 100);
+
+ALTER TABLE payments
+	ALTER COLUMN purchase_amount SET NOT NULL,
+	ALTER COLUMN currency DROP DEFAULT,
+	DROP COLUMN IF EXISTS purchase_value,
+	DROP COLUMN IF EXISTS captured_value,
+	DROP COLUMN IF EXISTS refunded_value;
+
+ALTER TABLE refunds
+	ADD COLUMN IF NOT EXISTS amount BIGINT,
+	ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'BRL';
+
+UPDATE refunds SET amount = ROUND(value * 100);
+
+ALTER TABLE refunds
+	ALTER COLUMN amount SET NOT NULL,
+	ALTER COLUMN currency DROP DEFAULT,
+	DROP COLUMN IF EXISTS value;
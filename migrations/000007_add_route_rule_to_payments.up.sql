@@ -0,0 +1 @@
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS route_rule VARCHAR(100) NOT NULL DEFAULT '';
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS payment_attempts (
+	id UUID PRIMARY KEY,
+	payment_id UUID NOT NULL REFERENCES payments (id),
+	acquirer_name VARCHAR(100) NOT NULL,
+	status VARCHAR(20) NOT NULL,
+	acquirer_id VARCHAR(100) NOT NULL DEFAULT '',
+	acquirer_code INTEGER NOT NULL DEFAULT 0,
+	acquirer_message TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS payment_attempts_payment_id_idx ON payment_attempts (payment_id);
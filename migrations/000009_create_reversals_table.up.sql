@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS reversals (
+	id UUID PRIMARY KEY,
+	payment_id UUID NOT NULL REFERENCES payments (id),
+	attempt_id UUID NOT NULL REFERENCES payment_attempts (id),
+	acquirer_name VARCHAR(100) NOT NULL,
+	amount BIGINT NOT NULL,
+	currency CHAR(3) NOT NULL,
+	status VARCHAR(20) NOT NULL,
+	retries INTEGER NOT NULL DEFAULT 0,
+	next_retry_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	acquirer_id VARCHAR(100) NOT NULL DEFAULT '',
+	acquirer_code INTEGER NOT NULL DEFAULT 0,
+	acquirer_message TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS reversals_payment_id_idx ON reversals (payment_id);
+CREATE INDEX IF NOT EXISTS reversals_pending_idx ON reversals (next_retry_at) WHERE status = 'pending';
@@ -0,0 +1,4 @@
+ALTER TABLE cards
+	DROP COLUMN IF EXISTS number_encrypted,
+	DROP COLUMN IF EXISTS bin,
+	DROP COLUMN IF EXISTS last4;
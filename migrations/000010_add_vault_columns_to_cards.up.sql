@@ -0,0 +1,4 @@
+ALTER TABLE cards
+	ADD COLUMN IF NOT EXISTS number_encrypted BYTEA,
+	ADD COLUMN IF NOT EXISTS bin VARCHAR(8) NOT NULL DEFAULT '',
+	ADD COLUMN IF NOT EXISTS last4 VARCHAR(4) NOT NULL DEFAULT '';
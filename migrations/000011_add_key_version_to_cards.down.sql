@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS cards_key_version_idx;
+
+ALTER TABLE cards
+	DROP COLUMN IF EXISTS key_version;
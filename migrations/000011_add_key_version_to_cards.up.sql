@@ -0,0 +1,4 @@
+ALTER TABLE cards
+	ADD COLUMN IF NOT EXISTS key_version INTEGER NOT NULL DEFAULT 1;
+
+CREATE INDEX IF NOT EXISTS cards_key_version_idx ON cards (key_version) WHERE number_encrypted IS NOT NULL;
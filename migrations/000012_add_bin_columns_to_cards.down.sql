@@ -0,0 +1,3 @@
+ALTER TABLE cards
+	DROP COLUMN IF EXISTS funding_type,
+	DROP COLUMN IF EXISTS country;
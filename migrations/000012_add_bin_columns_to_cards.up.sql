@@ -0,0 +1,3 @@
+ALTER TABLE cards
+	ADD COLUMN IF NOT EXISTS funding_type VARCHAR(20) NOT NULL DEFAULT '',
+	ADD COLUMN IF NOT EXISTS country CHAR(2) NOT NULL DEFAULT '';
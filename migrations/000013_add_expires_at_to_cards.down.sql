@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS cards_expires_at_idx;
+
+ALTER TABLE cards
+	DROP COLUMN IF EXISTS expires_at;
@@ -0,0 +1,11 @@
+ALTER TABLE cards
+	ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE cards
+SET expires_at = CASE
+	WHEN expiration ~ '^(0[1-9]|1[0-2])/[0-9]{4}$' THEN (to_date(expiration, 'MM/YYYY') + INTERVAL '1 month')::timestamp AT TIME ZONE 'UTC'
+	WHEN expiration ~ '^(0[1-9]|1[0-2])/[0-9]{2}$' THEN (to_date('20' || right(expiration, 2) || left(expiration, 2), 'YYYYMM') + INTERVAL '1 month')::timestamp AT TIME ZONE 'UTC'
+END
+WHERE expires_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS cards_expires_at_idx ON cards (expires_at);
@@ -0,0 +1,2 @@
+ALTER TABLE payments
+	DROP COLUMN IF EXISTS store_document_type;
@@ -0,0 +1,2 @@
+ALTER TABLE payments
+	ADD COLUMN IF NOT EXISTS store_document_type VARCHAR(4) NOT NULL DEFAULT '';
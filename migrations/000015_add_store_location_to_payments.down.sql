@@ -0,0 +1,5 @@
+ALTER TABLE payments
+	DROP COLUMN IF EXISTS store_street,
+	DROP COLUMN IF EXISTS store_number,
+	DROP COLUMN IF EXISTS store_city,
+	DROP COLUMN IF EXISTS store_state;
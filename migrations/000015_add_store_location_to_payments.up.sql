@@ -0,0 +1,5 @@
+ALTER TABLE payments
+	ADD COLUMN IF NOT EXISTS store_street VARCHAR(255) NOT NULL DEFAULT '',
+	ADD COLUMN IF NOT EXISTS store_number VARCHAR(20) NOT NULL DEFAULT '',
+	ADD COLUMN IF NOT EXISTS store_city VARCHAR(100) NOT NULL DEFAULT '',
+	ADD COLUMN IF NOT EXISTS store_state CHAR(2) NOT NULL DEFAULT '';
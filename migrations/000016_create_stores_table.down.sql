@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS payments_store_id_idx;
+
+ALTER TABLE payments
+	DROP COLUMN IF EXISTS store_id;
+
+DROP TABLE IF EXISTS stores;
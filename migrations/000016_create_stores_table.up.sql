@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS stores (
+	id UUID PRIMARY KEY,
+	identification VARCHAR(100) NOT NULL,
+	document_type VARCHAR(4) NOT NULL,
+	address VARCHAR(255) NOT NULL,
+	cep VARCHAR(20) NOT NULL,
+	street VARCHAR(255) NOT NULL DEFAULT '',
+	number VARCHAR(20) NOT NULL DEFAULT '',
+	city VARCHAR(100) NOT NULL DEFAULT '',
+	state CHAR(2) NOT NULL DEFAULT '',
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS stores_identification_idx ON stores (identification);
+
+ALTER TABLE payments
+	ADD COLUMN IF NOT EXISTS store_id UUID REFERENCES stores (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS payments_store_id_idx ON payments (store_id);
@@ -0,0 +1,2 @@
+ALTER TABLE payments
+	ADD COLUMN IF NOT EXISTS caller VARCHAR(255) NOT NULL DEFAULT '';
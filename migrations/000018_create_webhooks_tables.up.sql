@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS webhooks (
+	id UUID PRIMARY KEY,
+	caller VARCHAR(255) NOT NULL,
+	url TEXT NOT NULL,
+	events TEXT[] NOT NULL,
+	secret TEXT NOT NULL,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS webhooks_caller_idx ON webhooks (caller);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+	id UUID PRIMARY KEY,
+	webhook_id UUID NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+	event_id UUID NOT NULL,
+	event_type VARCHAR(50) NOT NULL,
+	payment_id UUID NOT NULL REFERENCES payments (id),
+	payload JSONB NOT NULL,
+	status VARCHAR(20) NOT NULL,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	response_code INTEGER NOT NULL DEFAULT 0,
+	response_message TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id, created_at);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
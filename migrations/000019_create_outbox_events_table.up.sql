@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS outbox_events (
+	sequence BIGSERIAL PRIMARY KEY,
+	id UUID NOT NULL UNIQUE,
+	type VARCHAR(50) NOT NULL,
+	payment_id UUID NOT NULL REFERENCES payments (id),
+	payload JSONB NOT NULL,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	last_error TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	published_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS outbox_events_unpublished_idx ON outbox_events (sequence) WHERE published_at IS NULL;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS payment_jobs (
+	payment_id UUID PRIMARY KEY REFERENCES payments (id),
+	authorize_only BOOLEAN NOT NULL,
+	fallbacks TEXT[] NOT NULL,
+	status VARCHAR(20) NOT NULL,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	locked_until TIMESTAMP WITH TIME ZONE,
+	last_error TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS payment_jobs_claimable_idx ON payment_jobs (created_at) WHERE status IN ('queued', 'processing');
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS payment_batches (
+	id UUID PRIMARY KEY,
+	caller VARCHAR(255) NOT NULL,
+	allowed_stores TEXT[] NOT NULL,
+	status VARCHAR(20) NOT NULL,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	locked_until TIMESTAMP WITH TIME ZONE,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS payment_batches_claimable_idx ON payment_batches (created_at) WHERE status IN ('queued', 'processing');
+
+CREATE TABLE IF NOT EXISTS payment_batch_items (
+	batch_id UUID NOT NULL REFERENCES payment_batches (id),
+	item_index INTEGER NOT NULL,
+	transaction JSONB NOT NULL,
+	status VARCHAR(20) NOT NULL,
+	payment_id UUID REFERENCES payments (id),
+	payment_status VARCHAR(20) NOT NULL DEFAULT '',
+	error_type VARCHAR(20) NOT NULL DEFAULT '',
+	error_code INTEGER NOT NULL DEFAULT 0,
+	error_messages TEXT[] NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	PRIMARY KEY (batch_id, item_index)
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS payments_acquirer_created_at_idx;
+DROP INDEX IF EXISTS payments_acquirer_id_idx;
+DROP TABLE IF EXISTS settlement_discrepancies;
+DROP TABLE IF EXISTS settlements;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS settlements (
+	id UUID PRIMARY KEY,
+	acquirer VARCHAR(50) NOT NULL,
+	format VARCHAR(10) NOT NULL,
+	file_name VARCHAR(255) NOT NULL,
+	lines INTEGER NOT NULL,
+	matched INTEGER NOT NULL,
+	period_start DATE,
+	period_end DATE,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS settlement_discrepancies (
+	settlement_id UUID NOT NULL REFERENCES settlements (id) ON DELETE CASCADE,
+	position INTEGER NOT NULL,
+	type VARCHAR(20) NOT NULL,
+	line INTEGER NOT NULL,
+	payment_id UUID REFERENCES payments (id),
+	acquirer_id VARCHAR(100) NOT NULL,
+	expected_amount BIGINT NOT NULL,
+	expected_currency VARCHAR(3) NOT NULL,
+	settled_amount BIGINT NOT NULL,
+	settled_currency VARCHAR(3) NOT NULL,
+	date TIMESTAMP WITH TIME ZONE NOT NULL,
+	PRIMARY KEY (settlement_id, position)
+);
+
+CREATE INDEX IF NOT EXISTS payments_acquirer_id_idx ON payments (acquirer_name, acquirer_id);
+CREATE INDEX IF NOT EXISTS payments_acquirer_created_at_idx ON payments (acquirer_name, created_at);
@@ -0,0 +1,2 @@
+ALTER TABLE payments
+	ADD COLUMN IF NOT EXISTS card_last4 VARCHAR(4) NOT NULL DEFAULT '';
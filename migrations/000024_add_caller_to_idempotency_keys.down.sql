@@ -0,0 +1,8 @@
+DELETE FROM idempotency_keys a
+USING idempotency_keys b
+WHERE a.key = b.key AND a.caller > b.caller;
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (key);
+
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS caller;
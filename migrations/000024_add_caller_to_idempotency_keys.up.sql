@@ -0,0 +1,5 @@
+ALTER TABLE idempotency_keys
+	ADD COLUMN IF NOT EXISTS caller VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (caller, key);
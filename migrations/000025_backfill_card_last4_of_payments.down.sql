@@ -0,0 +1 @@
+-- The backfilled column is dropped by the down migration of 000023.
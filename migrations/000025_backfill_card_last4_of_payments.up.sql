@@ -0,0 +1,24 @@
+-- Commits every batch, so the rows of the payments being processed are only locked briefly.
+DO $$
+DECLARE
+	updated INTEGER;
+BEGIN
+	LOOP
+		UPDATE payments
+		SET card_last4 = cards.last4
+		FROM cards
+		WHERE cards.token = payments.card_token AND payments.id IN (
+			SELECT payments.id
+			FROM payments
+			JOIN cards ON cards.token = payments.card_token
+			WHERE payments.card_last4 = '' AND cards.last4 <> ''
+			LIMIT 10000
+		);
+
+		GET DIAGNOSTICS updated = ROW_COUNT;
+		EXIT WHEN updated = 0;
+
+		COMMIT;
+	END LOOP;
+END
+$$;
@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS payments_store_created_at_idx;
@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS payments_store_created_at_idx ON payments (store_id, created_at, id);
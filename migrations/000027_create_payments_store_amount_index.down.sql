@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS payments_store_amount_idx;
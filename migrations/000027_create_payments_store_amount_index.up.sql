@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS payments_store_amount_idx ON payments (store_id, purchase_amount, id);
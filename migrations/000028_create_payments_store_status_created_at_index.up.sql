@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS payments_store_status_created_at_idx ON payments (store_id, status, created_at, id);
@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS payments_store_card_last4_created_at_idx;
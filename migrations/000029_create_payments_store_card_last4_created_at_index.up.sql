@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS payments_store_card_last4_created_at_idx ON payments (store_id, card_last4, created_at, id);
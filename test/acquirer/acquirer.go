@@ -4,6 +4,8 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -14,28 +16,95 @@ import (
 type (
 	transaction struct {
 		CardToken            string   `json:"card_token"            validate:"required"`
+		CardNumber           string   `json:"card_number"           validate:"omitempty,numeric,min=12,max=19"`
 		CardHolder           string   `json:"card_holder"           validate:"required"`
 		CardExpiration       string   `json:"card_expiration"       validate:"required"`
 		CardBrand            string   `json:"card_brand"            validate:"required"`
-		PurchaseValue        float64  `json:"purchase_value"        validate:"required"`
+		PurchaseAmount       int64    `json:"purchase_amount"       validate:"required"`
+		PurchaseCurrency     string   `json:"purchase_currency"     validate:"required,len=3"`
 		PurchaseItems        []string `json:"purchase_items"        validate:"required"`
 		PurchaseInstallments int      `json:"purchase_installments" validate:"required"`
 		StoreIdentification  string   `json:"store_identification"  validate:"required"`
 		StoreAddress         string   `json:"store_address"         validate:"required"`
 		StoreCep             string   `json:"store_cep"             validate:"required"`
+		Reference            string   `json:"reference"`
+	}
+
+	capture struct {
+		TransactionId   string `json:"transaction_id"   validate:"required"`
+		CaptureAmount   int64  `json:"capture_amount"   validate:"required"`
+		CaptureCurrency string `json:"capture_currency" validate:"required,len=3"`
+	}
+
+	refund struct {
+		TransactionId  string `json:"transaction_id"  validate:"required"`
+		RefundAmount   int64  `json:"refund_amount"   validate:"required"`
+		RefundCurrency string `json:"refund_currency" validate:"required,len=3"`
+	}
+
+	reversal struct {
+		Reference        string `json:"reference"         validate:"required"`
+		ReversalAmount   int64  `json:"reversal_amount"   validate:"required"`
+		ReversalCurrency string `json:"reversal_currency" validate:"required,len=3"`
+	}
+
+	// mode delays or drops the responses after processing the transaction, which is how a
+	// charge with an unknown outcome happens.
+	mode struct {
+		DelayMs int64 `json:"delay_ms"`
+		Drop    bool  `json:"drop"`
+	}
+
+	simulator struct {
+		mu   sync.Mutex
+		mode mode
 	}
 
 	response struct {
 		Code    int    `json:"code"`
 		Message string `json:"message"`
 	}
+
+	ledger struct {
+		mu           sync.Mutex
+		transactions map[string]*record
+		references   map[string]string
+		reversed     map[string]bool
+	}
+
+	record struct {
+		currency   string
+		value      int64
+		captured   int64
+		refunded   int64
+		uncaptured bool
+		voided     bool
+	}
 )
 
 var (
 	validate = validator.New(validator.WithRequiredStructEnabled())
+
+	errReferenceNotFound = errors.New("the transaction was not found")
 )
 
-func App() *fiber.App {
+const dropTimeout = time.Minute
+
+type Option func(*simulator)
+
+func WithDelay(delay time.Duration) Option {
+	return func(s *simulator) {
+		s.mode.DelayMs = delay.Milliseconds()
+	}
+}
+
+func WithDrop() Option {
+	return func(s *simulator) {
+		s.mode.Drop = true
+	}
+}
+
+func App(options ...Option) *fiber.App {
 	app := fiber.New()
 	app.Use(logger.New())
 
@@ -46,40 +115,71 @@ func App() *fiber.App {
 		return c.Next()
 	})
 
-	app.Post("/cielo", handler(func(c *fiber.Ctx, t *transaction) error {
+	sim := &simulator{}
+	for _, option := range options {
+		option(sim)
+	}
+
+	app.Post("/mode", sim.modeHandler())
+
+	l := &ledger{
+		transactions: make(map[string]*record),
+		references:   make(map[string]string),
+		reversed:     make(map[string]bool),
+	}
+
+	cielo := func(c *fiber.Ctx, t *transaction) error {
 		if c.Get("Api-Key") != "cielo-api-key" {
 			return errors.New("unauthorized")
 		}
-		if t.PurchaseValue > 100 {
+		if t.PurchaseAmount > 10000 {
 			return errors.New("the maximum purchase value should not exceed 100")
 		}
 		return nil
-	}))
+	}
+	app.Post("/cielo", handler(l, sim, true, cielo))
+	app.Post("/cielo/authorizations", handler(l, sim, false, cielo))
+	app.Post("/cielo/captures", captureHandler(l, "cielo-api-key"))
+	app.Post("/cielo/refunds", refundHandler(l, "cielo-api-key", false))
+	app.Post("/cielo/voids", refundHandler(l, "cielo-api-key", true))
+	app.Post("/cielo/reversals", reversalHandler(l, "cielo-api-key"))
 
-	app.Post("/rede", handler(func(c *fiber.Ctx, t *transaction) error {
+	rede := func(c *fiber.Ctx, t *transaction) error {
 		if c.Get("Api-Key") != "rede-api-key" {
 			return errors.New("unauthorized")
 		}
-		if t.PurchaseValue > 500 {
+		if t.PurchaseAmount > 50000 {
 			return errors.New("the maximum purchase value should not exceed 500")
 		}
 		return nil
-	}))
+	}
+	app.Post("/rede", handler(l, sim, true, rede))
+	app.Post("/rede/authorizations", handler(l, sim, false, rede))
+	app.Post("/rede/captures", captureHandler(l, "rede-api-key"))
+	app.Post("/rede/refunds", refundHandler(l, "rede-api-key", false))
+	app.Post("/rede/voids", refundHandler(l, "rede-api-key", true))
+	app.Post("/rede/reversals", reversalHandler(l, "rede-api-key"))
 
-	app.Post("/stone", handler(func(c *fiber.Ctx, t *transaction) error {
+	stone := func(c *fiber.Ctx, t *transaction) error {
 		if c.Get("Api-Key") != "stone-api-key" {
 			return errors.New("unauthorized")
 		}
-		if t.PurchaseValue > 1000 {
+		if t.PurchaseAmount > 100000 {
 			return errors.New("the maximum purchase value should not exceed 1000")
 		}
 		return nil
-	}))
+	}
+	app.Post("/stone", handler(l, sim, true, stone))
+	app.Post("/stone/authorizations", handler(l, sim, false, stone))
+	app.Post("/stone/captures", captureHandler(l, "stone-api-key"))
+	app.Post("/stone/refunds", refundHandler(l, "stone-api-key", false))
+	app.Post("/stone/voids", refundHandler(l, "stone-api-key", true))
+	app.Post("/stone/reversals", reversalHandler(l, "stone-api-key"))
 
 	return app
 }
 
-func handler(process func(c *fiber.Ctx, t *transaction) error) func(c *fiber.Ctx) error {
+func handler(l *ledger, sim *simulator, capture bool, process func(c *fiber.Ctx, t *transaction) error) func(c *fiber.Ctx) error {
 	return func(c *fiber.Ctx) error {
 		var t transaction
 
@@ -94,19 +194,276 @@ func handler(process func(c *fiber.Ctx, t *transaction) error) func(c *fiber.Ctx
 			return c.JSON(&response{http.StatusBadRequest, "invalid request"})
 		}
 
+		if l.wasReversed(t.Reference) {
+			return errorResponse(c, errors.New("the transaction was reversed"))
+		}
+
 		err = process(c, &t)
 		if err == nil {
-			return c.JSON(&response{http.StatusOK, uuid.NewString()})
+			id := uuid.NewString()
+			l.add(id, t.Reference, t.PurchaseAmount, t.PurchaseCurrency, capture)
+
+			if !sim.wait() {
+				return c.Context().Conn().Close()
+			}
+
+			return c.JSON(&response{http.StatusOK, id})
+		}
+
+		return errorResponse(c, err)
+	}
+}
+
+func captureHandler(l *ledger, key string) func(c *fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		if c.Get("Api-Key") != key {
+			return errorResponse(c, errors.New("unauthorized"))
+		}
+
+		var r capture
+
+		err := c.BodyParser(&r)
+		if err == nil {
+			err = validate.Struct(r)
+		}
+
+		if err != nil {
+			slog.Error(err.Error())
+			c.Status(http.StatusBadRequest)
+			return c.JSON(&response{http.StatusBadRequest, "invalid request"})
+		}
+
+		err = l.capture(r.TransactionId, r.CaptureAmount, r.CaptureCurrency)
+		if err != nil {
+			return errorResponse(c, err)
+		}
+
+		return c.JSON(&response{http.StatusOK, uuid.NewString()})
+	}
+}
+
+func refundHandler(l *ledger, key string, void bool) func(c *fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		if c.Get("Api-Key") != key {
+			return errorResponse(c, errors.New("unauthorized"))
+		}
+
+		var r refund
+
+		err := c.BodyParser(&r)
+		if err == nil {
+			err = validate.Struct(r)
+		}
+
+		if err != nil {
+			slog.Error(err.Error())
+			c.Status(http.StatusBadRequest)
+			return c.JSON(&response{http.StatusBadRequest, "invalid request"})
+		}
+
+		err = l.refund(r.TransactionId, r.RefundAmount, r.RefundCurrency, void)
+		if err != nil {
+			return errorResponse(c, err)
+		}
+
+		return c.JSON(&response{http.StatusOK, uuid.NewString()})
+	}
+}
+
+// reversalHandler remembers the reference of a transaction never received, so a late delivery
+// of it is rejected.
+func reversalHandler(l *ledger, key string) func(c *fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		if c.Get("Api-Key") != key {
+			return errorResponse(c, errors.New("unauthorized"))
+		}
+
+		var r reversal
+
+		err := c.BodyParser(&r)
+		if err == nil {
+			err = validate.Struct(r)
 		}
 
-		slog.Error(err.Error())
+		if err != nil {
+			slog.Error(err.Error())
+			c.Status(http.StatusBadRequest)
+			return c.JSON(&response{http.StatusBadRequest, "invalid request"})
+		}
 
-		if err.Error() == "unauthorized" {
-			c.Status(http.StatusUnauthorized)
-			return c.JSON(&response{http.StatusUnauthorized, err.Error()})
+		err = l.reverse(r.Reference, r.ReversalAmount, r.ReversalCurrency)
+		if errors.Is(err, errReferenceNotFound) {
+			c.Status(http.StatusNotFound)
+			return c.JSON(&response{http.StatusNotFound, err.Error()})
 		}
 
-		c.Status(http.StatusUnprocessableEntity)
-		return c.JSON(&response{http.StatusUnprocessableEntity, err.Error()})
+		if err != nil {
+			return errorResponse(c, err)
+		}
+
+		return c.JSON(&response{http.StatusOK, uuid.NewString()})
+	}
+}
+
+func (s *simulator) modeHandler() func(c *fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		var m mode
+
+		err := c.BodyParser(&m)
+		if err != nil || m.DelayMs < 0 {
+			c.Status(http.StatusBadRequest)
+			return c.JSON(&response{http.StatusBadRequest, "invalid request"})
+		}
+
+		s.mu.Lock()
+		s.mode = m
+		s.mu.Unlock()
+
+		return c.JSON(&response{http.StatusOK, "mode updated"})
+	}
+}
+
+func (s *simulator) wait() bool {
+	s.mu.Lock()
+	m := s.mode
+	s.mu.Unlock()
+
+	if m.Drop {
+		time.Sleep(dropTimeout)
+		return false
+	}
+
+	time.Sleep(time.Duration(m.DelayMs) * time.Millisecond)
+	return true
+}
+
+func errorResponse(c *fiber.Ctx, err error) error {
+	slog.Error(err.Error())
+
+	if err.Error() == "unauthorized" {
+		c.Status(http.StatusUnauthorized)
+		return c.JSON(&response{http.StatusUnauthorized, err.Error()})
+	}
+
+	c.Status(http.StatusUnprocessableEntity)
+	return c.JSON(&response{http.StatusUnprocessableEntity, err.Error()})
+}
+
+func (l *ledger) add(id string, reference string, amount int64, currency string, capture bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if reference != "" {
+		l.references[reference] = id
+	}
+
+	r := &record{currency: currency, value: amount, uncaptured: !capture}
+	if capture {
+		r.captured = r.value
+	}
+
+	l.transactions[id] = r
+}
+
+func (l *ledger) capture(id string, amount int64, currency string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r, ok := l.transactions[id]
+	if !ok {
+		return errors.New("the transaction was not found")
 	}
+
+	if r.voided {
+		return errors.New("the transaction was voided")
+	}
+
+	if !r.uncaptured {
+		return errors.New("the transaction was already captured")
+	}
+
+	if currency != r.currency {
+		return errors.New("the currency should match the transaction currency")
+	}
+
+	if amount > r.value {
+		return errors.New("the capture value should not exceed the authorized value")
+	}
+
+	r.captured = amount
+	r.uncaptured = false
+	return nil
+}
+
+func (l *ledger) refund(id string, amount int64, currency string, void bool) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r, ok := l.transactions[id]
+	if !ok {
+		return errors.New("the transaction was not found")
+	}
+
+	if r.voided {
+		return errors.New("the transaction was voided")
+	}
+
+	if currency != r.currency {
+		return errors.New("the currency should match the transaction currency")
+	}
+
+	if void {
+		if r.uncaptured {
+			if amount != r.value {
+				return errors.New("the transaction cannot be voided")
+			}
+		} else if r.refunded > 0 || amount != r.captured {
+			return errors.New("the transaction cannot be voided")
+		}
+
+		r.voided = true
+		return nil
+	}
+
+	if r.uncaptured {
+		return errors.New("the transaction was not captured")
+	}
+
+	if r.refunded+amount > r.captured {
+		return errors.New("the refund value should not exceed the transaction value")
+	}
+
+	r.refunded += amount
+	return nil
+}
+
+func (l *ledger) wasReversed(reference string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return reference != "" && l.reversed[reference]
+}
+
+func (l *ledger) reverse(reference string, amount int64, currency string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	id, ok := l.references[reference]
+	if !ok {
+		l.reversed[reference] = true
+		return errReferenceNotFound
+	}
+
+	r := l.transactions[id]
+
+	if currency != r.currency {
+		return errors.New("the currency should match the transaction currency")
+	}
+
+	if amount != r.value {
+		return errors.New("the reversal value should match the transaction value")
+	}
+
+	r.voided = true
+	return nil
 }
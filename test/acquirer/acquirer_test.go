@@ -6,7 +6,9 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
@@ -17,7 +19,7 @@ func TestCieloAcquirer(t *testing.T) {
 	key := "cielo-api-key"
 
 	t.Run("with transaction value less than or equal to 100", func(t *testing.T) {
-		reqData := createTransaction(100)
+		reqData := createTransaction(10000)
 		reqBody, err := json.Marshal(reqData)
 		assert.Nil(t, err)
 
@@ -46,7 +48,7 @@ func TestCieloAcquirer(t *testing.T) {
 	})
 
 	t.Run("with transaction value greater than 100", func(t *testing.T) {
-		reqData := createTransaction(101)
+		reqData := createTransaction(10100)
 		reqBody, err := json.Marshal(reqData)
 		assert.Nil(t, err)
 
@@ -79,7 +81,7 @@ func TestRedeAcquirer(t *testing.T) {
 	key := "rede-api-key"
 
 	t.Run("with transaction value less than or equal to 500", func(t *testing.T) {
-		reqData := createTransaction(500)
+		reqData := createTransaction(50000)
 		reqBody, err := json.Marshal(reqData)
 		assert.Nil(t, err)
 
@@ -108,7 +110,7 @@ func TestRedeAcquirer(t *testing.T) {
 	})
 
 	t.Run("with transaction value greater than 500", func(t *testing.T) {
-		reqData := createTransaction(501)
+		reqData := createTransaction(50100)
 		reqBody, err := json.Marshal(reqData)
 		assert.Nil(t, err)
 
@@ -141,7 +143,7 @@ func TestStoneAcquirer(t *testing.T) {
 	key := "stone-api-key"
 
 	t.Run("with transaction value less than or equal to 1000", func(t *testing.T) {
-		reqData := createTransaction(1000)
+		reqData := createTransaction(100000)
 		reqBody, err := json.Marshal(reqData)
 		assert.Nil(t, err)
 
@@ -170,7 +172,7 @@ func TestStoneAcquirer(t *testing.T) {
 	})
 
 	t.Run("with transaction value greater than 1000", func(t *testing.T) {
-		reqData := createTransaction(1001)
+		reqData := createTransaction(100100)
 		reqBody, err := json.Marshal(reqData)
 		assert.Nil(t, err)
 
@@ -197,13 +199,211 @@ func TestStoneAcquirer(t *testing.T) {
 	})
 }
 
-func createTransaction(value float64) *transaction {
+func TestRefunds(t *testing.T) {
+	app := App()
+	send := func(url string, data any) (*response, int) {
+		return send(t, app, url, "cielo-api-key", data)
+	}
+
+	t.Run("with partial and full refunds", func(t *testing.T) {
+		resData, status := send("/cielo", createTransaction(10000))
+		assert.Equal(t, http.StatusOK, status)
+		transactionId := resData.Message
+
+		_, status = send("/cielo/refunds", &refund{transactionId, 4000, "BRL"})
+		assert.Equal(t, http.StatusOK, status)
+
+		_, status = send("/cielo/refunds", &refund{transactionId, 6000, "BRL"})
+		assert.Equal(t, http.StatusOK, status)
+
+		resData, status = send("/cielo/refunds", &refund{transactionId, 1, "BRL"})
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, "the refund value should not exceed the transaction value", resData.Message)
+	})
+
+	t.Run("with void", func(t *testing.T) {
+		resData, status := send("/cielo", createTransaction(10000))
+		assert.Equal(t, http.StatusOK, status)
+		transactionId := resData.Message
+
+		_, status = send("/cielo/voids", &refund{transactionId, 10000, "BRL"})
+		assert.Equal(t, http.StatusOK, status)
+
+		resData, status = send("/cielo/refunds", &refund{transactionId, 1000, "BRL"})
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, "the transaction was voided", resData.Message)
+	})
+
+	t.Run("with unknown transaction", func(t *testing.T) {
+		resData, status := send("/cielo/refunds", &refund{"an unknown id", 1000, "BRL"})
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, "the transaction was not found", resData.Message)
+	})
+}
+
+func TestCaptures(t *testing.T) {
+	app := App()
+	send := func(url string, data any) (*response, int) {
+		return send(t, app, url, "cielo-api-key", data)
+	}
+
+	t.Run("with partial capture", func(t *testing.T) {
+		resData, status := send("/cielo/authorizations", createTransaction(10000))
+		assert.Equal(t, http.StatusOK, status)
+		transactionId := resData.Message
+
+		resData, status = send("/cielo/refunds", &refund{transactionId, 1000, "BRL"})
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, "the transaction was not captured", resData.Message)
+
+		_, status = send("/cielo/captures", &capture{transactionId, 6000, "BRL"})
+		assert.Equal(t, http.StatusOK, status)
+
+		resData, status = send("/cielo/captures", &capture{transactionId, 4000, "BRL"})
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, "the transaction was already captured", resData.Message)
+
+		resData, status = send("/cielo/refunds", &refund{transactionId, 6001, "BRL"})
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, "the refund value should not exceed the transaction value", resData.Message)
+	})
+
+	t.Run("with capture greater than the authorization", func(t *testing.T) {
+		resData, status := send("/cielo/authorizations", createTransaction(10000))
+		assert.Equal(t, http.StatusOK, status)
+		transactionId := resData.Message
+
+		resData, status = send("/cielo/captures", &capture{transactionId, 10001, "BRL"})
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, "the capture value should not exceed the authorized value", resData.Message)
+	})
+
+	t.Run("with capture in another currency", func(t *testing.T) {
+		resData, status := send("/cielo/authorizations", createTransaction(10000))
+		assert.Equal(t, http.StatusOK, status)
+		transactionId := resData.Message
+
+		resData, status = send("/cielo/captures", &capture{transactionId, 10000, "USD"})
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, "the currency should match the transaction currency", resData.Message)
+	})
+
+	t.Run("with void of the authorization", func(t *testing.T) {
+		resData, status := send("/cielo/authorizations", createTransaction(10000))
+		assert.Equal(t, http.StatusOK, status)
+		transactionId := resData.Message
+
+		_, status = send("/cielo/voids", &refund{transactionId, 10000, "BRL"})
+		assert.Equal(t, http.StatusOK, status)
+
+		resData, status = send("/cielo/captures", &capture{transactionId, 10000, "BRL"})
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, "the transaction was voided", resData.Message)
+	})
+}
+
+func TestReversals(t *testing.T) {
+	app := App()
+	send := func(url string, data any) (*response, int) {
+		return send(t, app, url, "cielo-api-key", data)
+	}
+
+	t.Run("with processed transaction", func(t *testing.T) {
+		reqData := createTransaction(10000)
+		reqData.Reference = uuid.NewString()
+
+		resData, status := send("/cielo", reqData)
+		assert.Equal(t, http.StatusOK, status)
+		transactionId := resData.Message
+
+		_, status = send("/cielo/reversals", &reversal{reqData.Reference, 10000, "BRL"})
+		assert.Equal(t, http.StatusOK, status)
+
+		_, status = send("/cielo/reversals", &reversal{reqData.Reference, 10000, "BRL"})
+		assert.Equal(t, http.StatusOK, status)
+
+		resData, status = send("/cielo/refunds", &refund{transactionId, 1000, "BRL"})
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, "the transaction was voided", resData.Message)
+	})
+
+	t.Run("with unknown transaction", func(t *testing.T) {
+		reference := uuid.NewString()
+
+		resData, status := send("/cielo/reversals", &reversal{reference, 10000, "BRL"})
+		assert.Equal(t, http.StatusNotFound, status)
+		assert.Equal(t, "the transaction was not found", resData.Message)
+
+		reqData := createTransaction(10000)
+		reqData.Reference = reference
+
+		resData, status = send("/cielo", reqData)
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, "the transaction was reversed", resData.Message)
+	})
+}
+
+func TestModes(t *testing.T) {
+	t.Run("with delay", func(t *testing.T) {
+		app := App(WithDelay(200 * time.Millisecond))
+
+		start := time.Now()
+		_, status := send(t, app, "/cielo", "cielo-api-key", createTransaction(10000))
+		assert.Equal(t, http.StatusOK, status)
+		assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	})
+
+	t.Run("with drop", func(t *testing.T) {
+		app := App()
+
+		_, status := send(t, app, "/mode", "", &mode{Drop: true})
+		assert.Equal(t, http.StatusOK, status)
+
+		reqBody, err := json.Marshal(createTransaction(10000))
+		assert.Nil(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/cielo", bytes.NewReader(reqBody))
+		req.Header.Set("Api-Key", "cielo-api-key")
+		req.Header.Set("Content-Type", "application/json")
+		assert.Nil(t, err)
+
+		_, err = app.Test(req, 100)
+		assert.NotNil(t, err)
+	})
+}
+
+func send(t *testing.T, app *fiber.App, url string, key string, data any) (*response, int) {
+	reqBody, err := json.Marshal(data)
+	assert.Nil(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(reqBody))
+	req.Header.Set("Api-Key", key)
+	req.Header.Set("Content-Type", "application/json")
+	assert.Nil(t, err)
+
+	res, err := app.Test(req)
+	assert.Nil(t, err)
+
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	assert.Nil(t, err)
+
+	var resData response
+	err = json.Unmarshal(resBody, &resData)
+	assert.Nil(t, err)
+
+	return &resData, res.StatusCode
+}
+
+func createTransaction(amount int64) *transaction {
 	return &transaction{
 		CardToken:            "Token",
 		CardHolder:           "Holder",
 		CardExpiration:       "01/2030",
 		CardBrand:            "Brand",
-		PurchaseValue:        value,
+		PurchaseAmount:       amount,
+		PurchaseCurrency:     "BRL",
 		PurchaseItems:        []string{"Item 1", "Item 2"},
 		PurchaseInstallments: 2,
 		StoreIdentification:  "Identification",
@@ -1,10 +1,29 @@
 package main
 
 import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+
 	"github.com/sesaquecruz/go-payment-processor/test/acquirer"
 )
 
 func main() {
-	app := acquirer.App()
+	options := make([]acquirer.Option, 0)
+
+	if delay := os.Getenv("ACQUIRER_DELAY_MS"); delay != "" {
+		ms, err := strconv.Atoi(delay)
+		if err != nil {
+			log.Fatal("env var ACQUIRER_DELAY_MS is invalid")
+		}
+		options = append(options, acquirer.WithDelay(time.Duration(ms)*time.Millisecond))
+	}
+
+	if os.Getenv("ACQUIRER_DROP") == "true" {
+		options = append(options, acquirer.WithDrop())
+	}
+
+	app := acquirer.App(options...)
 	app.Listen(":6061")
 }
@@ -1,8 +1,11 @@
 package authentication
 
 import (
+	"encoding/base64"
 	"log/slog"
+	"math/big"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -23,17 +26,61 @@ func App() *fiber.App {
 		return c.JSON(fiber.Map{"token": token})
 	})
 
+	app.Get("/.well-known/jwks.json", func(c *fiber.Ctx) error {
+		return c.JSON(Jwks())
+	})
+
 	return app
 }
 
+// Stores are the ids of the stores seeded in the test database.
+var Stores = []string{
+	"5b0b8b3e-0f8e-4d52-9d8a-3c1f6e2a7b10",
+	"9e4c2f71-6a3d-4b8e-8f25-1d7a0c9b4e62",
+}
+
+const (
+	Issuer   = "go-authentication"
+	Audience = "payment-processor"
+)
+
+const KeyId = "go-authentication-1"
+
+var Scopes = []string{"payments:write", "payments:read", "refunds:write", "cards:write", "webhooks:write", "admin"}
+
 func GetAuthToken() (string, error) {
-	claims := jwt.MapClaims{
-		"service-id": uuid.NewString(),
+	serviceId := uuid.NewString()
+
+	return NewAuthToken(jwt.MapClaims{
+		"service-id": serviceId,
+		"sub":        serviceId,
+		"iss":        Issuer,
+		"aud":        Audience,
+		"scope":      strings.Join(Scopes, " "),
+		"stores":     Stores,
 		"exp":        time.Now().Add(5 * time.Minute).Unix(),
-	}
+	})
+}
 
+func NewAuthToken(claims jwt.MapClaims) (string, error) {
 	jwtToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	jwtToken.Header["kid"] = KeyId
 	token, err := jwtToken.SignedString(privateKey)
 
 	return token, err
 }
+
+func Jwks() fiber.Map {
+	return fiber.Map{
+		"keys": []fiber.Map{
+			{
+				"kty": "RSA",
+				"kid": KeyId,
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(PublicKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(PublicKey.E)).Bytes()),
+			},
+		},
+	}
+}
@@ -35,3 +35,31 @@ func TestGetAuthToken(t *testing.T) {
 	assert.True(t, ok)
 	assert.NotEmpty(t, token)
 }
+
+func TestGetJwks(t *testing.T) {
+	app := App()
+
+	req, err := http.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	require.Nil(t, err)
+
+	res, err := app.Test(req)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	require.Nil(t, err)
+
+	var resData struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	err = json.Unmarshal(resBody, &resData)
+	require.Nil(t, err)
+
+	require.Equal(t, 1, len(resData.Keys))
+	assert.Equal(t, "RSA", resData.Keys[0]["kty"])
+	assert.Equal(t, KeyId, resData.Keys[0]["kid"])
+	assert.Equal(t, "AQAB", resData.Keys[0]["e"])
+	assert.NotEmpty(t, resData.Keys[0]["n"])
+}
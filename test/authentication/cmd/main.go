@@ -12,7 +12,8 @@ import (
 func main() {
 	fmt.Printf("\n----- public key in base64-----\n")
 	fmt.Println(encodeAuthPublicKey(&authentication.PublicKey))
-	fmt.Printf("------------------------------\n\n")
+	fmt.Printf("------------------------------\n")
+	fmt.Printf("jwks published at /.well-known/jwks.json\n\n")
 
 	app := authentication.App()
 	app.Listen(":6062")
@@ -24,6 +24,116 @@ func (_m *IAcquirerMock) EXPECT() *IAcquirerMock_Expecter {
 	return &IAcquirerMock_Expecter{mock: &_m.Mock}
 }
 
+// CaptureRequestBuilder provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAcquirerMock) CaptureRequestBuilder(_a0 context.Context, _a1 *entity.Payment, _a2 entity.Money) (*http.Request, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *http.Request
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment, entity.Money) (*http.Request, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment, entity.Money) *http.Request); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Request)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Payment, entity.Money) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IAcquirerMock_CaptureRequestBuilder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CaptureRequestBuilder'
+type IAcquirerMock_CaptureRequestBuilder_Call struct {
+	*mock.Call
+}
+
+// CaptureRequestBuilder is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *entity.Payment
+//   - _a2 entity.Money
+func (_e *IAcquirerMock_Expecter) CaptureRequestBuilder(_a0 interface{}, _a1 interface{}, _a2 interface{}) *IAcquirerMock_CaptureRequestBuilder_Call {
+	return &IAcquirerMock_CaptureRequestBuilder_Call{Call: _e.mock.On("CaptureRequestBuilder", _a0, _a1, _a2)}
+}
+
+func (_c *IAcquirerMock_CaptureRequestBuilder_Call) Run(run func(_a0 context.Context, _a1 *entity.Payment, _a2 entity.Money)) *IAcquirerMock_CaptureRequestBuilder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Payment), args[2].(entity.Money))
+	})
+	return _c
+}
+
+func (_c *IAcquirerMock_CaptureRequestBuilder_Call) Return(_a0 *http.Request, _a1 error) *IAcquirerMock_CaptureRequestBuilder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IAcquirerMock_CaptureRequestBuilder_Call) RunAndReturn(run func(context.Context, *entity.Payment, entity.Money) (*http.Request, error)) *IAcquirerMock_CaptureRequestBuilder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CaptureResponseExtractor provides a mock function with given fields: _a0
+func (_m *IAcquirerMock) CaptureResponseExtractor(_a0 *http.Response) (*entity.AcquirerResponse, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *entity.AcquirerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*http.Response) (*entity.AcquirerResponse, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Response) *entity.AcquirerResponse); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.AcquirerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Response) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IAcquirerMock_CaptureResponseExtractor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CaptureResponseExtractor'
+type IAcquirerMock_CaptureResponseExtractor_Call struct {
+	*mock.Call
+}
+
+// CaptureResponseExtractor is a helper method to define mock.On call
+//   - _a0 *http.Response
+func (_e *IAcquirerMock_Expecter) CaptureResponseExtractor(_a0 interface{}) *IAcquirerMock_CaptureResponseExtractor_Call {
+	return &IAcquirerMock_CaptureResponseExtractor_Call{Call: _e.mock.On("CaptureResponseExtractor", _a0)}
+}
+
+func (_c *IAcquirerMock_CaptureResponseExtractor_Call) Run(run func(_a0 *http.Response)) *IAcquirerMock_CaptureResponseExtractor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Response))
+	})
+	return _c
+}
+
+func (_c *IAcquirerMock_CaptureResponseExtractor_Call) Return(_a0 *entity.AcquirerResponse, _a1 error) *IAcquirerMock_CaptureResponseExtractor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IAcquirerMock_CaptureResponseExtractor_Call) RunAndReturn(run func(*http.Response) (*entity.AcquirerResponse, error)) *IAcquirerMock_CaptureResponseExtractor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *IAcquirerMock) Name() string {
 	ret := _m.Called()
@@ -65,6 +175,116 @@ func (_c *IAcquirerMock_Name_Call) RunAndReturn(run func() string) *IAcquirerMoc
 	return _c
 }
 
+// RefundRequestBuilder provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAcquirerMock) RefundRequestBuilder(_a0 context.Context, _a1 *entity.Payment, _a2 *entity.Refund) (*http.Request, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *http.Request
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment, *entity.Refund) (*http.Request, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment, *entity.Refund) *http.Request); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Request)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Payment, *entity.Refund) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IAcquirerMock_RefundRequestBuilder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundRequestBuilder'
+type IAcquirerMock_RefundRequestBuilder_Call struct {
+	*mock.Call
+}
+
+// RefundRequestBuilder is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *entity.Payment
+//   - _a2 *entity.Refund
+func (_e *IAcquirerMock_Expecter) RefundRequestBuilder(_a0 interface{}, _a1 interface{}, _a2 interface{}) *IAcquirerMock_RefundRequestBuilder_Call {
+	return &IAcquirerMock_RefundRequestBuilder_Call{Call: _e.mock.On("RefundRequestBuilder", _a0, _a1, _a2)}
+}
+
+func (_c *IAcquirerMock_RefundRequestBuilder_Call) Run(run func(_a0 context.Context, _a1 *entity.Payment, _a2 *entity.Refund)) *IAcquirerMock_RefundRequestBuilder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Payment), args[2].(*entity.Refund))
+	})
+	return _c
+}
+
+func (_c *IAcquirerMock_RefundRequestBuilder_Call) Return(_a0 *http.Request, _a1 error) *IAcquirerMock_RefundRequestBuilder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IAcquirerMock_RefundRequestBuilder_Call) RunAndReturn(run func(context.Context, *entity.Payment, *entity.Refund) (*http.Request, error)) *IAcquirerMock_RefundRequestBuilder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundResponseExtractor provides a mock function with given fields: _a0
+func (_m *IAcquirerMock) RefundResponseExtractor(_a0 *http.Response) (*entity.AcquirerResponse, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *entity.AcquirerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*http.Response) (*entity.AcquirerResponse, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Response) *entity.AcquirerResponse); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.AcquirerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Response) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IAcquirerMock_RefundResponseExtractor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundResponseExtractor'
+type IAcquirerMock_RefundResponseExtractor_Call struct {
+	*mock.Call
+}
+
+// RefundResponseExtractor is a helper method to define mock.On call
+//   - _a0 *http.Response
+func (_e *IAcquirerMock_Expecter) RefundResponseExtractor(_a0 interface{}) *IAcquirerMock_RefundResponseExtractor_Call {
+	return &IAcquirerMock_RefundResponseExtractor_Call{Call: _e.mock.On("RefundResponseExtractor", _a0)}
+}
+
+func (_c *IAcquirerMock_RefundResponseExtractor_Call) Run(run func(_a0 *http.Response)) *IAcquirerMock_RefundResponseExtractor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Response))
+	})
+	return _c
+}
+
+func (_c *IAcquirerMock_RefundResponseExtractor_Call) Return(_a0 *entity.AcquirerResponse, _a1 error) *IAcquirerMock_RefundResponseExtractor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IAcquirerMock_RefundResponseExtractor_Call) RunAndReturn(run func(*http.Response) (*entity.AcquirerResponse, error)) *IAcquirerMock_RefundResponseExtractor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RequestBuilder provides a mock function with given fields: _a0, _a1
 func (_m *IAcquirerMock) RequestBuilder(_a0 context.Context, _a1 *entity.Transaction) (*http.Request, error) {
 	ret := _m.Called(_a0, _a1)
@@ -121,19 +341,19 @@ func (_c *IAcquirerMock_RequestBuilder_Call) RunAndReturn(run func(context.Conte
 }
 
 // ResponseExtractor provides a mock function with given fields: _a0
-func (_m *IAcquirerMock) ResponseExtractor(_a0 *http.Response) (*entity.Payment, error) {
+func (_m *IAcquirerMock) ResponseExtractor(_a0 *http.Response) (*entity.AcquirerResponse, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *entity.Payment
+	var r0 *entity.AcquirerResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*http.Response) (*entity.Payment, error)); ok {
+	if rf, ok := ret.Get(0).(func(*http.Response) (*entity.AcquirerResponse, error)); ok {
 		return rf(_a0)
 	}
-	if rf, ok := ret.Get(0).(func(*http.Response) *entity.Payment); ok {
+	if rf, ok := ret.Get(0).(func(*http.Response) *entity.AcquirerResponse); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entity.Payment)
+			r0 = ret.Get(0).(*entity.AcquirerResponse)
 		}
 	}
 
@@ -164,12 +384,121 @@ func (_c *IAcquirerMock_ResponseExtractor_Call) Run(run func(_a0 *http.Response)
 	return _c
 }
 
-func (_c *IAcquirerMock_ResponseExtractor_Call) Return(_a0 *entity.Payment, _a1 error) *IAcquirerMock_ResponseExtractor_Call {
+func (_c *IAcquirerMock_ResponseExtractor_Call) Return(_a0 *entity.AcquirerResponse, _a1 error) *IAcquirerMock_ResponseExtractor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IAcquirerMock_ResponseExtractor_Call) RunAndReturn(run func(*http.Response) (*entity.AcquirerResponse, error)) *IAcquirerMock_ResponseExtractor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReversalRequestBuilder provides a mock function with given fields: _a0, _a1
+func (_m *IAcquirerMock) ReversalRequestBuilder(_a0 context.Context, _a1 *entity.Reversal) (*http.Request, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *http.Request
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Reversal) (*http.Request, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Reversal) *http.Request); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Request)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Reversal) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IAcquirerMock_ReversalRequestBuilder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReversalRequestBuilder'
+type IAcquirerMock_ReversalRequestBuilder_Call struct {
+	*mock.Call
+}
+
+// ReversalRequestBuilder is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *entity.Reversal
+func (_e *IAcquirerMock_Expecter) ReversalRequestBuilder(_a0 interface{}, _a1 interface{}) *IAcquirerMock_ReversalRequestBuilder_Call {
+	return &IAcquirerMock_ReversalRequestBuilder_Call{Call: _e.mock.On("ReversalRequestBuilder", _a0, _a1)}
+}
+
+func (_c *IAcquirerMock_ReversalRequestBuilder_Call) Run(run func(_a0 context.Context, _a1 *entity.Reversal)) *IAcquirerMock_ReversalRequestBuilder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Reversal))
+	})
+	return _c
+}
+
+func (_c *IAcquirerMock_ReversalRequestBuilder_Call) Return(_a0 *http.Request, _a1 error) *IAcquirerMock_ReversalRequestBuilder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IAcquirerMock_ReversalRequestBuilder_Call) RunAndReturn(run func(context.Context, *entity.Reversal) (*http.Request, error)) *IAcquirerMock_ReversalRequestBuilder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReversalResponseExtractor provides a mock function with given fields: _a0
+func (_m *IAcquirerMock) ReversalResponseExtractor(_a0 *http.Response) (*entity.AcquirerResponse, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *entity.AcquirerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*http.Response) (*entity.AcquirerResponse, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Response) *entity.AcquirerResponse); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.AcquirerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Response) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IAcquirerMock_ReversalResponseExtractor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReversalResponseExtractor'
+type IAcquirerMock_ReversalResponseExtractor_Call struct {
+	*mock.Call
+}
+
+// ReversalResponseExtractor is a helper method to define mock.On call
+//   - _a0 *http.Response
+func (_e *IAcquirerMock_Expecter) ReversalResponseExtractor(_a0 interface{}) *IAcquirerMock_ReversalResponseExtractor_Call {
+	return &IAcquirerMock_ReversalResponseExtractor_Call{Call: _e.mock.On("ReversalResponseExtractor", _a0)}
+}
+
+func (_c *IAcquirerMock_ReversalResponseExtractor_Call) Run(run func(_a0 *http.Response)) *IAcquirerMock_ReversalResponseExtractor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Response))
+	})
+	return _c
+}
+
+func (_c *IAcquirerMock_ReversalResponseExtractor_Call) Return(_a0 *entity.AcquirerResponse, _a1 error) *IAcquirerMock_ReversalResponseExtractor_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IAcquirerMock_ResponseExtractor_Call) RunAndReturn(run func(*http.Response) (*entity.Payment, error)) *IAcquirerMock_ResponseExtractor_Call {
+func (_c *IAcquirerMock_ReversalResponseExtractor_Call) RunAndReturn(run func(*http.Response) (*entity.AcquirerResponse, error)) *IAcquirerMock_ReversalResponseExtractor_Call {
 	_c.Call.Return(run)
 	return _c
 }
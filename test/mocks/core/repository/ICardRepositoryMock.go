@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // ICardRepositoryMock is an autogenerated mock type for the ICardRepository type
@@ -22,6 +24,92 @@ func (_m *ICardRepositoryMock) EXPECT() *ICardRepositoryMock_Expecter {
 	return &ICardRepositoryMock_Expecter{mock: &_m.Mock}
 }
 
+// CreateCard provides a mock function with given fields: ctx, card
+func (_m *ICardRepositoryMock) CreateCard(ctx context.Context, card *entity.Card) error {
+	ret := _m.Called(ctx, card)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Card) error); ok {
+		r0 = rf(ctx, card)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ICardRepositoryMock_CreateCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCard'
+type ICardRepositoryMock_CreateCard_Call struct {
+	*mock.Call
+}
+
+// CreateCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - card *entity.Card
+func (_e *ICardRepositoryMock_Expecter) CreateCard(ctx interface{}, card interface{}) *ICardRepositoryMock_CreateCard_Call {
+	return &ICardRepositoryMock_CreateCard_Call{Call: _e.mock.On("CreateCard", ctx, card)}
+}
+
+func (_c *ICardRepositoryMock_CreateCard_Call) Run(run func(ctx context.Context, card *entity.Card)) *ICardRepositoryMock_CreateCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Card))
+	})
+	return _c
+}
+
+func (_c *ICardRepositoryMock_CreateCard_Call) Return(_a0 error) *ICardRepositoryMock_CreateCard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ICardRepositoryMock_CreateCard_Call) RunAndReturn(run func(context.Context, *entity.Card) error) *ICardRepositoryMock_CreateCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCard provides a mock function with given fields: ctx, cardToken
+func (_m *ICardRepositoryMock) DeleteCard(ctx context.Context, cardToken string) error {
+	ret := _m.Called(ctx, cardToken)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, cardToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ICardRepositoryMock_DeleteCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCard'
+type ICardRepositoryMock_DeleteCard_Call struct {
+	*mock.Call
+}
+
+// DeleteCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cardToken string
+func (_e *ICardRepositoryMock_Expecter) DeleteCard(ctx interface{}, cardToken interface{}) *ICardRepositoryMock_DeleteCard_Call {
+	return &ICardRepositoryMock_DeleteCard_Call{Call: _e.mock.On("DeleteCard", ctx, cardToken)}
+}
+
+func (_c *ICardRepositoryMock_DeleteCard_Call) Run(run func(ctx context.Context, cardToken string)) *ICardRepositoryMock_DeleteCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ICardRepositoryMock_DeleteCard_Call) Return(_a0 error) *ICardRepositoryMock_DeleteCard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ICardRepositoryMock_DeleteCard_Call) RunAndReturn(run func(context.Context, string) error) *ICardRepositoryMock_DeleteCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindCard provides a mock function with given fields: ctx, cardToken
 func (_m *ICardRepositoryMock) FindCard(ctx context.Context, cardToken string) (*entity.Card, error) {
 	ret := _m.Called(ctx, cardToken)
@@ -77,6 +165,168 @@ func (_c *ICardRepositoryMock_FindCard_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// FindCardNumber provides a mock function with given fields: ctx, cardToken
+func (_m *ICardRepositoryMock) FindCardNumber(ctx context.Context, cardToken string) (string, error) {
+	ret := _m.Called(ctx, cardToken)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, cardToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, cardToken)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, cardToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICardRepositoryMock_FindCardNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCardNumber'
+type ICardRepositoryMock_FindCardNumber_Call struct {
+	*mock.Call
+}
+
+// FindCardNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cardToken string
+func (_e *ICardRepositoryMock_Expecter) FindCardNumber(ctx interface{}, cardToken interface{}) *ICardRepositoryMock_FindCardNumber_Call {
+	return &ICardRepositoryMock_FindCardNumber_Call{Call: _e.mock.On("FindCardNumber", ctx, cardToken)}
+}
+
+func (_c *ICardRepositoryMock_FindCardNumber_Call) Run(run func(ctx context.Context, cardToken string)) *ICardRepositoryMock_FindCardNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ICardRepositoryMock_FindCardNumber_Call) Return(_a0 string, _a1 error) *ICardRepositoryMock_FindCardNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICardRepositoryMock_FindCardNumber_Call) RunAndReturn(run func(context.Context, string) (string, error)) *ICardRepositoryMock_FindCardNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExpiringCards provides a mock function with given fields: ctx, from, until
+func (_m *ICardRepositoryMock) FindExpiringCards(ctx context.Context, from time.Time, until time.Time) ([]*entity.Card, error) {
+	ret := _m.Called(ctx, from, until)
+
+	var r0 []*entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*entity.Card, error)); ok {
+		return rf(ctx, from, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*entity.Card); ok {
+		r0 = rf(ctx, from, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICardRepositoryMock_FindExpiringCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExpiringCards'
+type ICardRepositoryMock_FindExpiringCards_Call struct {
+	*mock.Call
+}
+
+// FindExpiringCards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - until time.Time
+func (_e *ICardRepositoryMock_Expecter) FindExpiringCards(ctx interface{}, from interface{}, until interface{}) *ICardRepositoryMock_FindExpiringCards_Call {
+	return &ICardRepositoryMock_FindExpiringCards_Call{Call: _e.mock.On("FindExpiringCards", ctx, from, until)}
+}
+
+func (_c *ICardRepositoryMock_FindExpiringCards_Call) Run(run func(ctx context.Context, from time.Time, until time.Time)) *ICardRepositoryMock_FindExpiringCards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ICardRepositoryMock_FindExpiringCards_Call) Return(_a0 []*entity.Card, _a1 error) *ICardRepositoryMock_FindExpiringCards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICardRepositoryMock_FindExpiringCards_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]*entity.Card, error)) *ICardRepositoryMock_FindExpiringCards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReencryptCards provides a mock function with given fields: ctx, limit
+func (_m *ICardRepositoryMock) ReencryptCards(ctx context.Context, limit int) (int, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICardRepositoryMock_ReencryptCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReencryptCards'
+type ICardRepositoryMock_ReencryptCards_Call struct {
+	*mock.Call
+}
+
+// ReencryptCards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *ICardRepositoryMock_Expecter) ReencryptCards(ctx interface{}, limit interface{}) *ICardRepositoryMock_ReencryptCards_Call {
+	return &ICardRepositoryMock_ReencryptCards_Call{Call: _e.mock.On("ReencryptCards", ctx, limit)}
+}
+
+func (_c *ICardRepositoryMock_ReencryptCards_Call) Run(run func(ctx context.Context, limit int)) *ICardRepositoryMock_ReencryptCards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ICardRepositoryMock_ReencryptCards_Call) Return(_a0 int, _a1 error) *ICardRepositoryMock_ReencryptCards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICardRepositoryMock_ReencryptCards_Call) RunAndReturn(run func(context.Context, int) (int, error)) *ICardRepositoryMock_ReencryptCards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewICardRepositoryMock creates a new instance of ICardRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewICardRepositoryMock(t interface {
@@ -0,0 +1,223 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IIdempotencyRepositoryMock is an autogenerated mock type for the IIdempotencyRepository type
+type IIdempotencyRepositoryMock struct {
+	mock.Mock
+}
+
+type IIdempotencyRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IIdempotencyRepositoryMock) EXPECT() *IIdempotencyRepositoryMock_Expecter {
+	return &IIdempotencyRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateIdempotency provides a mock function with given fields: ctx, idempotency
+func (_m *IIdempotencyRepositoryMock) CreateIdempotency(ctx context.Context, idempotency *entity.Idempotency) error {
+	ret := _m.Called(ctx, idempotency)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Idempotency) error); ok {
+		r0 = rf(ctx, idempotency)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IIdempotencyRepositoryMock_CreateIdempotency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIdempotency'
+type IIdempotencyRepositoryMock_CreateIdempotency_Call struct {
+	*mock.Call
+}
+
+// CreateIdempotency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idempotency *entity.Idempotency
+func (_e *IIdempotencyRepositoryMock_Expecter) CreateIdempotency(ctx interface{}, idempotency interface{}) *IIdempotencyRepositoryMock_CreateIdempotency_Call {
+	return &IIdempotencyRepositoryMock_CreateIdempotency_Call{Call: _e.mock.On("CreateIdempotency", ctx, idempotency)}
+}
+
+func (_c *IIdempotencyRepositoryMock_CreateIdempotency_Call) Run(run func(ctx context.Context, idempotency *entity.Idempotency)) *IIdempotencyRepositoryMock_CreateIdempotency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Idempotency))
+	})
+	return _c
+}
+
+func (_c *IIdempotencyRepositoryMock_CreateIdempotency_Call) Return(_a0 error) *IIdempotencyRepositoryMock_CreateIdempotency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IIdempotencyRepositoryMock_CreateIdempotency_Call) RunAndReturn(run func(context.Context, *entity.Idempotency) error) *IIdempotencyRepositoryMock_CreateIdempotency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteIdempotency provides a mock function with given fields: ctx, caller, key
+func (_m *IIdempotencyRepositoryMock) DeleteIdempotency(ctx context.Context, caller string, key string) error {
+	ret := _m.Called(ctx, caller, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, caller, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IIdempotencyRepositoryMock_DeleteIdempotency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIdempotency'
+type IIdempotencyRepositoryMock_DeleteIdempotency_Call struct {
+	*mock.Call
+}
+
+// DeleteIdempotency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caller string
+//   - key string
+func (_e *IIdempotencyRepositoryMock_Expecter) DeleteIdempotency(ctx interface{}, caller interface{}, key interface{}) *IIdempotencyRepositoryMock_DeleteIdempotency_Call {
+	return &IIdempotencyRepositoryMock_DeleteIdempotency_Call{Call: _e.mock.On("DeleteIdempotency", ctx, caller, key)}
+}
+
+func (_c *IIdempotencyRepositoryMock_DeleteIdempotency_Call) Run(run func(ctx context.Context, caller string, key string)) *IIdempotencyRepositoryMock_DeleteIdempotency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *IIdempotencyRepositoryMock_DeleteIdempotency_Call) Return(_a0 error) *IIdempotencyRepositoryMock_DeleteIdempotency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IIdempotencyRepositoryMock_DeleteIdempotency_Call) RunAndReturn(run func(context.Context, string, string) error) *IIdempotencyRepositoryMock_DeleteIdempotency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindIdempotency provides a mock function with given fields: ctx, caller, key
+func (_m *IIdempotencyRepositoryMock) FindIdempotency(ctx context.Context, caller string, key string) (*entity.Idempotency, error) {
+	ret := _m.Called(ctx, caller, key)
+
+	var r0 *entity.Idempotency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entity.Idempotency, error)); ok {
+		return rf(ctx, caller, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entity.Idempotency); ok {
+		r0 = rf(ctx, caller, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Idempotency)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, caller, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IIdempotencyRepositoryMock_FindIdempotency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindIdempotency'
+type IIdempotencyRepositoryMock_FindIdempotency_Call struct {
+	*mock.Call
+}
+
+// FindIdempotency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caller string
+//   - key string
+func (_e *IIdempotencyRepositoryMock_Expecter) FindIdempotency(ctx interface{}, caller interface{}, key interface{}) *IIdempotencyRepositoryMock_FindIdempotency_Call {
+	return &IIdempotencyRepositoryMock_FindIdempotency_Call{Call: _e.mock.On("FindIdempotency", ctx, caller, key)}
+}
+
+func (_c *IIdempotencyRepositoryMock_FindIdempotency_Call) Run(run func(ctx context.Context, caller string, key string)) *IIdempotencyRepositoryMock_FindIdempotency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *IIdempotencyRepositoryMock_FindIdempotency_Call) Return(_a0 *entity.Idempotency, _a1 error) *IIdempotencyRepositoryMock_FindIdempotency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IIdempotencyRepositoryMock_FindIdempotency_Call) RunAndReturn(run func(context.Context, string, string) (*entity.Idempotency, error)) *IIdempotencyRepositoryMock_FindIdempotency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateIdempotency provides a mock function with given fields: ctx, idempotency
+func (_m *IIdempotencyRepositoryMock) UpdateIdempotency(ctx context.Context, idempotency *entity.Idempotency) error {
+	ret := _m.Called(ctx, idempotency)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Idempotency) error); ok {
+		r0 = rf(ctx, idempotency)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IIdempotencyRepositoryMock_UpdateIdempotency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateIdempotency'
+type IIdempotencyRepositoryMock_UpdateIdempotency_Call struct {
+	*mock.Call
+}
+
+// UpdateIdempotency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idempotency *entity.Idempotency
+func (_e *IIdempotencyRepositoryMock_Expecter) UpdateIdempotency(ctx interface{}, idempotency interface{}) *IIdempotencyRepositoryMock_UpdateIdempotency_Call {
+	return &IIdempotencyRepositoryMock_UpdateIdempotency_Call{Call: _e.mock.On("UpdateIdempotency", ctx, idempotency)}
+}
+
+func (_c *IIdempotencyRepositoryMock_UpdateIdempotency_Call) Run(run func(ctx context.Context, idempotency *entity.Idempotency)) *IIdempotencyRepositoryMock_UpdateIdempotency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Idempotency))
+	})
+	return _c
+}
+
+func (_c *IIdempotencyRepositoryMock_UpdateIdempotency_Call) Return(_a0 error) *IIdempotencyRepositoryMock_UpdateIdempotency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IIdempotencyRepositoryMock_UpdateIdempotency_Call) RunAndReturn(run func(context.Context, *entity.Idempotency) error) *IIdempotencyRepositoryMock_UpdateIdempotency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIIdempotencyRepositoryMock creates a new instance of IIdempotencyRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIIdempotencyRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IIdempotencyRepositoryMock {
+	mock := &IIdempotencyRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,189 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IOutboxRepositoryMock is an autogenerated mock type for the IOutboxRepository type
+type IOutboxRepositoryMock struct {
+	mock.Mock
+}
+
+type IOutboxRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IOutboxRepositoryMock) EXPECT() *IOutboxRepositoryMock_Expecter {
+	return &IOutboxRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// FindPendingEvents provides a mock function with given fields: ctx, limit
+func (_m *IOutboxRepositoryMock) FindPendingEvents(ctx context.Context, limit int) ([]*entity.DomainEvent, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 []*entity.DomainEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entity.DomainEvent, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entity.DomainEvent); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.DomainEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOutboxRepositoryMock_FindPendingEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPendingEvents'
+type IOutboxRepositoryMock_FindPendingEvents_Call struct {
+	*mock.Call
+}
+
+// FindPendingEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *IOutboxRepositoryMock_Expecter) FindPendingEvents(ctx interface{}, limit interface{}) *IOutboxRepositoryMock_FindPendingEvents_Call {
+	return &IOutboxRepositoryMock_FindPendingEvents_Call{Call: _e.mock.On("FindPendingEvents", ctx, limit)}
+}
+
+func (_c *IOutboxRepositoryMock_FindPendingEvents_Call) Run(run func(ctx context.Context, limit int)) *IOutboxRepositoryMock_FindPendingEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOutboxRepositoryMock_FindPendingEvents_Call) Return(_a0 []*entity.DomainEvent, _a1 error) *IOutboxRepositoryMock_FindPendingEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOutboxRepositoryMock_FindPendingEvents_Call) RunAndReturn(run func(context.Context, int) ([]*entity.DomainEvent, error)) *IOutboxRepositoryMock_FindPendingEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockOutbox provides a mock function with given fields: ctx
+func (_m *IOutboxRepositoryMock) LockOutbox(ctx context.Context) (func(), error) {
+	ret := _m.Called(ctx)
+
+	var r0 func()
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (func(), error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) func()); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOutboxRepositoryMock_LockOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockOutbox'
+type IOutboxRepositoryMock_LockOutbox_Call struct {
+	*mock.Call
+}
+
+// LockOutbox is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IOutboxRepositoryMock_Expecter) LockOutbox(ctx interface{}) *IOutboxRepositoryMock_LockOutbox_Call {
+	return &IOutboxRepositoryMock_LockOutbox_Call{Call: _e.mock.On("LockOutbox", ctx)}
+}
+
+func (_c *IOutboxRepositoryMock_LockOutbox_Call) Run(run func(ctx context.Context)) *IOutboxRepositoryMock_LockOutbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IOutboxRepositoryMock_LockOutbox_Call) Return(_a0 func(), _a1 error) *IOutboxRepositoryMock_LockOutbox_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOutboxRepositoryMock_LockOutbox_Call) RunAndReturn(run func(context.Context) (func(), error)) *IOutboxRepositoryMock_LockOutbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEvent provides a mock function with given fields: ctx, event
+func (_m *IOutboxRepositoryMock) UpdateEvent(ctx context.Context, event *entity.DomainEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.DomainEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IOutboxRepositoryMock_UpdateEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEvent'
+type IOutboxRepositoryMock_UpdateEvent_Call struct {
+	*mock.Call
+}
+
+// UpdateEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *entity.DomainEvent
+func (_e *IOutboxRepositoryMock_Expecter) UpdateEvent(ctx interface{}, event interface{}) *IOutboxRepositoryMock_UpdateEvent_Call {
+	return &IOutboxRepositoryMock_UpdateEvent_Call{Call: _e.mock.On("UpdateEvent", ctx, event)}
+}
+
+func (_c *IOutboxRepositoryMock_UpdateEvent_Call) Run(run func(ctx context.Context, event *entity.DomainEvent)) *IOutboxRepositoryMock_UpdateEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.DomainEvent))
+	})
+	return _c
+}
+
+func (_c *IOutboxRepositoryMock_UpdateEvent_Call) Return(_a0 error) *IOutboxRepositoryMock_UpdateEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IOutboxRepositoryMock_UpdateEvent_Call) RunAndReturn(run func(context.Context, *entity.DomainEvent) error) *IOutboxRepositoryMock_UpdateEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIOutboxRepositoryMock creates a new instance of IOutboxRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIOutboxRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IOutboxRepositoryMock {
+	mock := &IOutboxRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,280 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IPaymentBatchRepositoryMock is an autogenerated mock type for the IPaymentBatchRepository type
+type IPaymentBatchRepositoryMock struct {
+	mock.Mock
+}
+
+type IPaymentBatchRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IPaymentBatchRepositoryMock) EXPECT() *IPaymentBatchRepositoryMock_Expecter {
+	return &IPaymentBatchRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// ClaimBatches provides a mock function with given fields: ctx, now, limit
+func (_m *IPaymentBatchRepositoryMock) ClaimBatches(ctx context.Context, now time.Time, limit int) ([]*entity.PaymentBatch, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	var r0 []*entity.PaymentBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*entity.PaymentBatch, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*entity.PaymentBatch); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.PaymentBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IPaymentBatchRepositoryMock_ClaimBatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimBatches'
+type IPaymentBatchRepositoryMock_ClaimBatches_Call struct {
+	*mock.Call
+}
+
+// ClaimBatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *IPaymentBatchRepositoryMock_Expecter) ClaimBatches(ctx interface{}, now interface{}, limit interface{}) *IPaymentBatchRepositoryMock_ClaimBatches_Call {
+	return &IPaymentBatchRepositoryMock_ClaimBatches_Call{Call: _e.mock.On("ClaimBatches", ctx, now, limit)}
+}
+
+func (_c *IPaymentBatchRepositoryMock_ClaimBatches_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *IPaymentBatchRepositoryMock_ClaimBatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IPaymentBatchRepositoryMock_ClaimBatches_Call) Return(_a0 []*entity.PaymentBatch, _a1 error) *IPaymentBatchRepositoryMock_ClaimBatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IPaymentBatchRepositoryMock_ClaimBatches_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*entity.PaymentBatch, error)) *IPaymentBatchRepositoryMock_ClaimBatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBatch provides a mock function with given fields: ctx, batch
+func (_m *IPaymentBatchRepositoryMock) CreateBatch(ctx context.Context, batch *entity.PaymentBatch) error {
+	ret := _m.Called(ctx, batch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.PaymentBatch) error); ok {
+		r0 = rf(ctx, batch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentBatchRepositoryMock_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type IPaymentBatchRepositoryMock_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batch *entity.PaymentBatch
+func (_e *IPaymentBatchRepositoryMock_Expecter) CreateBatch(ctx interface{}, batch interface{}) *IPaymentBatchRepositoryMock_CreateBatch_Call {
+	return &IPaymentBatchRepositoryMock_CreateBatch_Call{Call: _e.mock.On("CreateBatch", ctx, batch)}
+}
+
+func (_c *IPaymentBatchRepositoryMock_CreateBatch_Call) Run(run func(ctx context.Context, batch *entity.PaymentBatch)) *IPaymentBatchRepositoryMock_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.PaymentBatch))
+	})
+	return _c
+}
+
+func (_c *IPaymentBatchRepositoryMock_CreateBatch_Call) Return(_a0 error) *IPaymentBatchRepositoryMock_CreateBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentBatchRepositoryMock_CreateBatch_Call) RunAndReturn(run func(context.Context, *entity.PaymentBatch) error) *IPaymentBatchRepositoryMock_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBatch provides a mock function with given fields: ctx, batchId
+func (_m *IPaymentBatchRepositoryMock) FindBatch(ctx context.Context, batchId string) (*entity.PaymentBatch, error) {
+	ret := _m.Called(ctx, batchId)
+
+	var r0 *entity.PaymentBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.PaymentBatch, error)); ok {
+		return rf(ctx, batchId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.PaymentBatch); ok {
+		r0 = rf(ctx, batchId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.PaymentBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, batchId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IPaymentBatchRepositoryMock_FindBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBatch'
+type IPaymentBatchRepositoryMock_FindBatch_Call struct {
+	*mock.Call
+}
+
+// FindBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchId string
+func (_e *IPaymentBatchRepositoryMock_Expecter) FindBatch(ctx interface{}, batchId interface{}) *IPaymentBatchRepositoryMock_FindBatch_Call {
+	return &IPaymentBatchRepositoryMock_FindBatch_Call{Call: _e.mock.On("FindBatch", ctx, batchId)}
+}
+
+func (_c *IPaymentBatchRepositoryMock_FindBatch_Call) Run(run func(ctx context.Context, batchId string)) *IPaymentBatchRepositoryMock_FindBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IPaymentBatchRepositoryMock_FindBatch_Call) Return(_a0 *entity.PaymentBatch, _a1 error) *IPaymentBatchRepositoryMock_FindBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IPaymentBatchRepositoryMock_FindBatch_Call) RunAndReturn(run func(context.Context, string) (*entity.PaymentBatch, error)) *IPaymentBatchRepositoryMock_FindBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBatch provides a mock function with given fields: ctx, batch
+func (_m *IPaymentBatchRepositoryMock) UpdateBatch(ctx context.Context, batch *entity.PaymentBatch) error {
+	ret := _m.Called(ctx, batch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.PaymentBatch) error); ok {
+		r0 = rf(ctx, batch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentBatchRepositoryMock_UpdateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBatch'
+type IPaymentBatchRepositoryMock_UpdateBatch_Call struct {
+	*mock.Call
+}
+
+// UpdateBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batch *entity.PaymentBatch
+func (_e *IPaymentBatchRepositoryMock_Expecter) UpdateBatch(ctx interface{}, batch interface{}) *IPaymentBatchRepositoryMock_UpdateBatch_Call {
+	return &IPaymentBatchRepositoryMock_UpdateBatch_Call{Call: _e.mock.On("UpdateBatch", ctx, batch)}
+}
+
+func (_c *IPaymentBatchRepositoryMock_UpdateBatch_Call) Run(run func(ctx context.Context, batch *entity.PaymentBatch)) *IPaymentBatchRepositoryMock_UpdateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.PaymentBatch))
+	})
+	return _c
+}
+
+func (_c *IPaymentBatchRepositoryMock_UpdateBatch_Call) Return(_a0 error) *IPaymentBatchRepositoryMock_UpdateBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentBatchRepositoryMock_UpdateBatch_Call) RunAndReturn(run func(context.Context, *entity.PaymentBatch) error) *IPaymentBatchRepositoryMock_UpdateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBatchItem provides a mock function with given fields: ctx, batchId, item
+func (_m *IPaymentBatchRepositoryMock) UpdateBatchItem(ctx context.Context, batchId string, item *entity.PaymentBatchItem) error {
+	ret := _m.Called(ctx, batchId, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *entity.PaymentBatchItem) error); ok {
+		r0 = rf(ctx, batchId, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentBatchRepositoryMock_UpdateBatchItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBatchItem'
+type IPaymentBatchRepositoryMock_UpdateBatchItem_Call struct {
+	*mock.Call
+}
+
+// UpdateBatchItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchId string
+//   - item *entity.PaymentBatchItem
+func (_e *IPaymentBatchRepositoryMock_Expecter) UpdateBatchItem(ctx interface{}, batchId interface{}, item interface{}) *IPaymentBatchRepositoryMock_UpdateBatchItem_Call {
+	return &IPaymentBatchRepositoryMock_UpdateBatchItem_Call{Call: _e.mock.On("UpdateBatchItem", ctx, batchId, item)}
+}
+
+func (_c *IPaymentBatchRepositoryMock_UpdateBatchItem_Call) Run(run func(ctx context.Context, batchId string, item *entity.PaymentBatchItem)) *IPaymentBatchRepositoryMock_UpdateBatchItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*entity.PaymentBatchItem))
+	})
+	return _c
+}
+
+func (_c *IPaymentBatchRepositoryMock_UpdateBatchItem_Call) Return(_a0 error) *IPaymentBatchRepositoryMock_UpdateBatchItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentBatchRepositoryMock_UpdateBatchItem_Call) RunAndReturn(run func(context.Context, string, *entity.PaymentBatchItem) error) *IPaymentBatchRepositoryMock_UpdateBatchItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIPaymentBatchRepositoryMock creates a new instance of IPaymentBatchRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIPaymentBatchRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IPaymentBatchRepositoryMock {
+	mock := &IPaymentBatchRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
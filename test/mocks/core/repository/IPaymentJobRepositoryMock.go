@@ -0,0 +1,138 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IPaymentJobRepositoryMock is an autogenerated mock type for the IPaymentJobRepository type
+type IPaymentJobRepositoryMock struct {
+	mock.Mock
+}
+
+type IPaymentJobRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IPaymentJobRepositoryMock) EXPECT() *IPaymentJobRepositoryMock_Expecter {
+	return &IPaymentJobRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// ClaimJobs provides a mock function with given fields: ctx, now, limit
+func (_m *IPaymentJobRepositoryMock) ClaimJobs(ctx context.Context, now time.Time, limit int) ([]*entity.PaymentJob, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	var r0 []*entity.PaymentJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*entity.PaymentJob, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*entity.PaymentJob); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.PaymentJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IPaymentJobRepositoryMock_ClaimJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimJobs'
+type IPaymentJobRepositoryMock_ClaimJobs_Call struct {
+	*mock.Call
+}
+
+// ClaimJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *IPaymentJobRepositoryMock_Expecter) ClaimJobs(ctx interface{}, now interface{}, limit interface{}) *IPaymentJobRepositoryMock_ClaimJobs_Call {
+	return &IPaymentJobRepositoryMock_ClaimJobs_Call{Call: _e.mock.On("ClaimJobs", ctx, now, limit)}
+}
+
+func (_c *IPaymentJobRepositoryMock_ClaimJobs_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *IPaymentJobRepositoryMock_ClaimJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IPaymentJobRepositoryMock_ClaimJobs_Call) Return(_a0 []*entity.PaymentJob, _a1 error) *IPaymentJobRepositoryMock_ClaimJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IPaymentJobRepositoryMock_ClaimJobs_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*entity.PaymentJob, error)) *IPaymentJobRepositoryMock_ClaimJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateJob provides a mock function with given fields: ctx, job
+func (_m *IPaymentJobRepositoryMock) UpdateJob(ctx context.Context, job *entity.PaymentJob) error {
+	ret := _m.Called(ctx, job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.PaymentJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentJobRepositoryMock_UpdateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJob'
+type IPaymentJobRepositoryMock_UpdateJob_Call struct {
+	*mock.Call
+}
+
+// UpdateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job *entity.PaymentJob
+func (_e *IPaymentJobRepositoryMock_Expecter) UpdateJob(ctx interface{}, job interface{}) *IPaymentJobRepositoryMock_UpdateJob_Call {
+	return &IPaymentJobRepositoryMock_UpdateJob_Call{Call: _e.mock.On("UpdateJob", ctx, job)}
+}
+
+func (_c *IPaymentJobRepositoryMock_UpdateJob_Call) Run(run func(ctx context.Context, job *entity.PaymentJob)) *IPaymentJobRepositoryMock_UpdateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.PaymentJob))
+	})
+	return _c
+}
+
+func (_c *IPaymentJobRepositoryMock_UpdateJob_Call) Return(_a0 error) *IPaymentJobRepositoryMock_UpdateJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentJobRepositoryMock_UpdateJob_Call) RunAndReturn(run func(context.Context, *entity.PaymentJob) error) *IPaymentJobRepositoryMock_UpdateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIPaymentJobRepositoryMock creates a new instance of IPaymentJobRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIPaymentJobRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IPaymentJobRepositoryMock {
+	mock := &IPaymentJobRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,494 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/sesaquecruz/go-payment-processor/internal/core/repository"
+)
+
+// IPaymentRepositoryMock is an autogenerated mock type for the IPaymentRepository type
+type IPaymentRepositoryMock struct {
+	mock.Mock
+}
+
+type IPaymentRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IPaymentRepositoryMock) EXPECT() *IPaymentRepositoryMock_Expecter {
+	return &IPaymentRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CancelCapture provides a mock function with given fields: ctx, paymentId
+func (_m *IPaymentRepositoryMock) CancelCapture(ctx context.Context, paymentId string) error {
+	ret := _m.Called(ctx, paymentId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, paymentId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentRepositoryMock_CancelCapture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCapture'
+type IPaymentRepositoryMock_CancelCapture_Call struct {
+	*mock.Call
+}
+
+// CancelCapture is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentId string
+func (_e *IPaymentRepositoryMock_Expecter) CancelCapture(ctx interface{}, paymentId interface{}) *IPaymentRepositoryMock_CancelCapture_Call {
+	return &IPaymentRepositoryMock_CancelCapture_Call{Call: _e.mock.On("CancelCapture", ctx, paymentId)}
+}
+
+func (_c *IPaymentRepositoryMock_CancelCapture_Call) Run(run func(ctx context.Context, paymentId string)) *IPaymentRepositoryMock_CancelCapture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_CancelCapture_Call) Return(_a0 error) *IPaymentRepositoryMock_CancelCapture_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_CancelCapture_Call) RunAndReturn(run func(context.Context, string) error) *IPaymentRepositoryMock_CancelCapture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePayment provides a mock function with given fields: ctx, payment
+func (_m *IPaymentRepositoryMock) CreatePayment(ctx context.Context, payment *entity.Payment) error {
+	ret := _m.Called(ctx, payment)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment) error); ok {
+		r0 = rf(ctx, payment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentRepositoryMock_CreatePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePayment'
+type IPaymentRepositoryMock_CreatePayment_Call struct {
+	*mock.Call
+}
+
+// CreatePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *entity.Payment
+func (_e *IPaymentRepositoryMock_Expecter) CreatePayment(ctx interface{}, payment interface{}) *IPaymentRepositoryMock_CreatePayment_Call {
+	return &IPaymentRepositoryMock_CreatePayment_Call{Call: _e.mock.On("CreatePayment", ctx, payment)}
+}
+
+func (_c *IPaymentRepositoryMock_CreatePayment_Call) Run(run func(ctx context.Context, payment *entity.Payment)) *IPaymentRepositoryMock_CreatePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Payment))
+	})
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_CreatePayment_Call) Return(_a0 error) *IPaymentRepositoryMock_CreatePayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_CreatePayment_Call) RunAndReturn(run func(context.Context, *entity.Payment) error) *IPaymentRepositoryMock_CreatePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePaymentAttempt provides a mock function with given fields: ctx, attempt
+func (_m *IPaymentRepositoryMock) CreatePaymentAttempt(ctx context.Context, attempt *entity.PaymentAttempt) error {
+	ret := _m.Called(ctx, attempt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.PaymentAttempt) error); ok {
+		r0 = rf(ctx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentRepositoryMock_CreatePaymentAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePaymentAttempt'
+type IPaymentRepositoryMock_CreatePaymentAttempt_Call struct {
+	*mock.Call
+}
+
+// CreatePaymentAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attempt *entity.PaymentAttempt
+func (_e *IPaymentRepositoryMock_Expecter) CreatePaymentAttempt(ctx interface{}, attempt interface{}) *IPaymentRepositoryMock_CreatePaymentAttempt_Call {
+	return &IPaymentRepositoryMock_CreatePaymentAttempt_Call{Call: _e.mock.On("CreatePaymentAttempt", ctx, attempt)}
+}
+
+func (_c *IPaymentRepositoryMock_CreatePaymentAttempt_Call) Run(run func(ctx context.Context, attempt *entity.PaymentAttempt)) *IPaymentRepositoryMock_CreatePaymentAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.PaymentAttempt))
+	})
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_CreatePaymentAttempt_Call) Return(_a0 error) *IPaymentRepositoryMock_CreatePaymentAttempt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_CreatePaymentAttempt_Call) RunAndReturn(run func(context.Context, *entity.PaymentAttempt) error) *IPaymentRepositoryMock_CreatePaymentAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateQueuedPayment provides a mock function with given fields: ctx, payment
+func (_m *IPaymentRepositoryMock) CreateQueuedPayment(ctx context.Context, payment *entity.Payment) error {
+	ret := _m.Called(ctx, payment)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment) error); ok {
+		r0 = rf(ctx, payment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentRepositoryMock_CreateQueuedPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQueuedPayment'
+type IPaymentRepositoryMock_CreateQueuedPayment_Call struct {
+	*mock.Call
+}
+
+// CreateQueuedPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *entity.Payment
+func (_e *IPaymentRepositoryMock_Expecter) CreateQueuedPayment(ctx interface{}, payment interface{}) *IPaymentRepositoryMock_CreateQueuedPayment_Call {
+	return &IPaymentRepositoryMock_CreateQueuedPayment_Call{Call: _e.mock.On("CreateQueuedPayment", ctx, payment)}
+}
+
+func (_c *IPaymentRepositoryMock_CreateQueuedPayment_Call) Run(run func(ctx context.Context, payment *entity.Payment)) *IPaymentRepositoryMock_CreateQueuedPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Payment))
+	})
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_CreateQueuedPayment_Call) Return(_a0 error) *IPaymentRepositoryMock_CreateQueuedPayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_CreateQueuedPayment_Call) RunAndReturn(run func(context.Context, *entity.Payment) error) *IPaymentRepositoryMock_CreateQueuedPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPayment provides a mock function with given fields: ctx, paymentId
+func (_m *IPaymentRepositoryMock) FindPayment(ctx context.Context, paymentId string) (*entity.Payment, error) {
+	ret := _m.Called(ctx, paymentId)
+
+	var r0 *entity.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.Payment, error)); ok {
+		return rf(ctx, paymentId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.Payment); ok {
+		r0 = rf(ctx, paymentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, paymentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IPaymentRepositoryMock_FindPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPayment'
+type IPaymentRepositoryMock_FindPayment_Call struct {
+	*mock.Call
+}
+
+// FindPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentId string
+func (_e *IPaymentRepositoryMock_Expecter) FindPayment(ctx interface{}, paymentId interface{}) *IPaymentRepositoryMock_FindPayment_Call {
+	return &IPaymentRepositoryMock_FindPayment_Call{Call: _e.mock.On("FindPayment", ctx, paymentId)}
+}
+
+func (_c *IPaymentRepositoryMock_FindPayment_Call) Run(run func(ctx context.Context, paymentId string)) *IPaymentRepositoryMock_FindPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_FindPayment_Call) Return(_a0 *entity.Payment, _a1 error) *IPaymentRepositoryMock_FindPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_FindPayment_Call) RunAndReturn(run func(context.Context, string) (*entity.Payment, error)) *IPaymentRepositoryMock_FindPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchPayments provides a mock function with given fields: ctx, search
+func (_m *IPaymentRepositoryMock) SearchPayments(ctx context.Context, search *repository.PaymentSearch) ([]*entity.Payment, error) {
+	ret := _m.Called(ctx, search)
+
+	var r0 []*entity.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.PaymentSearch) ([]*entity.Payment, error)); ok {
+		return rf(ctx, search)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.PaymentSearch) []*entity.Payment); ok {
+		r0 = rf(ctx, search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.PaymentSearch) error); ok {
+		r1 = rf(ctx, search)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IPaymentRepositoryMock_SearchPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchPayments'
+type IPaymentRepositoryMock_SearchPayments_Call struct {
+	*mock.Call
+}
+
+// SearchPayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *repository.PaymentSearch
+func (_e *IPaymentRepositoryMock_Expecter) SearchPayments(ctx interface{}, search interface{}) *IPaymentRepositoryMock_SearchPayments_Call {
+	return &IPaymentRepositoryMock_SearchPayments_Call{Call: _e.mock.On("SearchPayments", ctx, search)}
+}
+
+func (_c *IPaymentRepositoryMock_SearchPayments_Call) Run(run func(ctx context.Context, search *repository.PaymentSearch)) *IPaymentRepositoryMock_SearchPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*repository.PaymentSearch))
+	})
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_SearchPayments_Call) Return(_a0 []*entity.Payment, _a1 error) *IPaymentRepositoryMock_SearchPayments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_SearchPayments_Call) RunAndReturn(run func(context.Context, *repository.PaymentSearch) ([]*entity.Payment, error)) *IPaymentRepositoryMock_SearchPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartCapture provides a mock function with given fields: ctx, paymentId
+func (_m *IPaymentRepositoryMock) StartCapture(ctx context.Context, paymentId string) error {
+	ret := _m.Called(ctx, paymentId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, paymentId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentRepositoryMock_StartCapture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartCapture'
+type IPaymentRepositoryMock_StartCapture_Call struct {
+	*mock.Call
+}
+
+// StartCapture is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentId string
+func (_e *IPaymentRepositoryMock_Expecter) StartCapture(ctx interface{}, paymentId interface{}) *IPaymentRepositoryMock_StartCapture_Call {
+	return &IPaymentRepositoryMock_StartCapture_Call{Call: _e.mock.On("StartCapture", ctx, paymentId)}
+}
+
+func (_c *IPaymentRepositoryMock_StartCapture_Call) Run(run func(ctx context.Context, paymentId string)) *IPaymentRepositoryMock_StartCapture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_StartCapture_Call) Return(_a0 error) *IPaymentRepositoryMock_StartCapture_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_StartCapture_Call) RunAndReturn(run func(context.Context, string) error) *IPaymentRepositoryMock_StartCapture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePayment provides a mock function with given fields: ctx, payment
+func (_m *IPaymentRepositoryMock) UpdatePayment(ctx context.Context, payment *entity.Payment) error {
+	ret := _m.Called(ctx, payment)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment) error); ok {
+		r0 = rf(ctx, payment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentRepositoryMock_UpdatePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePayment'
+type IPaymentRepositoryMock_UpdatePayment_Call struct {
+	*mock.Call
+}
+
+// UpdatePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *entity.Payment
+func (_e *IPaymentRepositoryMock_Expecter) UpdatePayment(ctx interface{}, payment interface{}) *IPaymentRepositoryMock_UpdatePayment_Call {
+	return &IPaymentRepositoryMock_UpdatePayment_Call{Call: _e.mock.On("UpdatePayment", ctx, payment)}
+}
+
+func (_c *IPaymentRepositoryMock_UpdatePayment_Call) Run(run func(ctx context.Context, payment *entity.Payment)) *IPaymentRepositoryMock_UpdatePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Payment))
+	})
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_UpdatePayment_Call) Return(_a0 error) *IPaymentRepositoryMock_UpdatePayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_UpdatePayment_Call) RunAndReturn(run func(context.Context, *entity.Payment) error) *IPaymentRepositoryMock_UpdatePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePaymentAttempt provides a mock function with given fields: ctx, attempt
+func (_m *IPaymentRepositoryMock) UpdatePaymentAttempt(ctx context.Context, attempt *entity.PaymentAttempt) error {
+	ret := _m.Called(ctx, attempt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.PaymentAttempt) error); ok {
+		r0 = rf(ctx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentRepositoryMock_UpdatePaymentAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePaymentAttempt'
+type IPaymentRepositoryMock_UpdatePaymentAttempt_Call struct {
+	*mock.Call
+}
+
+// UpdatePaymentAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attempt *entity.PaymentAttempt
+func (_e *IPaymentRepositoryMock_Expecter) UpdatePaymentAttempt(ctx interface{}, attempt interface{}) *IPaymentRepositoryMock_UpdatePaymentAttempt_Call {
+	return &IPaymentRepositoryMock_UpdatePaymentAttempt_Call{Call: _e.mock.On("UpdatePaymentAttempt", ctx, attempt)}
+}
+
+func (_c *IPaymentRepositoryMock_UpdatePaymentAttempt_Call) Run(run func(ctx context.Context, attempt *entity.PaymentAttempt)) *IPaymentRepositoryMock_UpdatePaymentAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.PaymentAttempt))
+	})
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_UpdatePaymentAttempt_Call) Return(_a0 error) *IPaymentRepositoryMock_UpdatePaymentAttempt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_UpdatePaymentAttempt_Call) RunAndReturn(run func(context.Context, *entity.PaymentAttempt) error) *IPaymentRepositoryMock_UpdatePaymentAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePaymentFrom provides a mock function with given fields: ctx, payment, fromStatus
+func (_m *IPaymentRepositoryMock) UpdatePaymentFrom(ctx context.Context, payment *entity.Payment, fromStatus entity.PaymentStatus) error {
+	ret := _m.Called(ctx, payment, fromStatus)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment, entity.PaymentStatus) error); ok {
+		r0 = rf(ctx, payment, fromStatus)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentRepositoryMock_UpdatePaymentFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePaymentFrom'
+type IPaymentRepositoryMock_UpdatePaymentFrom_Call struct {
+	*mock.Call
+}
+
+// UpdatePaymentFrom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *entity.Payment
+//   - fromStatus entity.PaymentStatus
+func (_e *IPaymentRepositoryMock_Expecter) UpdatePaymentFrom(ctx interface{}, payment interface{}, fromStatus interface{}) *IPaymentRepositoryMock_UpdatePaymentFrom_Call {
+	return &IPaymentRepositoryMock_UpdatePaymentFrom_Call{Call: _e.mock.On("UpdatePaymentFrom", ctx, payment, fromStatus)}
+}
+
+func (_c *IPaymentRepositoryMock_UpdatePaymentFrom_Call) Run(run func(ctx context.Context, payment *entity.Payment, fromStatus entity.PaymentStatus)) *IPaymentRepositoryMock_UpdatePaymentFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Payment), args[2].(entity.PaymentStatus))
+	})
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_UpdatePaymentFrom_Call) Return(_a0 error) *IPaymentRepositoryMock_UpdatePaymentFrom_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentRepositoryMock_UpdatePaymentFrom_Call) RunAndReturn(run func(context.Context, *entity.Payment, entity.PaymentStatus) error) *IPaymentRepositoryMock_UpdatePaymentFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIPaymentRepositoryMock creates a new instance of IPaymentRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIPaymentRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IPaymentRepositoryMock {
+	mock := &IPaymentRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,178 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IRefundRepositoryMock is an autogenerated mock type for the IRefundRepository type
+type IRefundRepositoryMock struct {
+	mock.Mock
+}
+
+type IRefundRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IRefundRepositoryMock) EXPECT() *IRefundRepositoryMock_Expecter {
+	return &IRefundRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateRefund provides a mock function with given fields: ctx, refund
+func (_m *IRefundRepositoryMock) CreateRefund(ctx context.Context, refund *entity.Refund) error {
+	ret := _m.Called(ctx, refund)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Refund) error); ok {
+		r0 = rf(ctx, refund)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IRefundRepositoryMock_CreateRefund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRefund'
+type IRefundRepositoryMock_CreateRefund_Call struct {
+	*mock.Call
+}
+
+// CreateRefund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refund *entity.Refund
+func (_e *IRefundRepositoryMock_Expecter) CreateRefund(ctx interface{}, refund interface{}) *IRefundRepositoryMock_CreateRefund_Call {
+	return &IRefundRepositoryMock_CreateRefund_Call{Call: _e.mock.On("CreateRefund", ctx, refund)}
+}
+
+func (_c *IRefundRepositoryMock_CreateRefund_Call) Run(run func(ctx context.Context, refund *entity.Refund)) *IRefundRepositoryMock_CreateRefund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Refund))
+	})
+	return _c
+}
+
+func (_c *IRefundRepositoryMock_CreateRefund_Call) Return(_a0 error) *IRefundRepositoryMock_CreateRefund_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IRefundRepositoryMock_CreateRefund_Call) RunAndReturn(run func(context.Context, *entity.Refund) error) *IRefundRepositoryMock_CreateRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRefunds provides a mock function with given fields: ctx, paymentId
+func (_m *IRefundRepositoryMock) FindRefunds(ctx context.Context, paymentId string) ([]*entity.Refund, error) {
+	ret := _m.Called(ctx, paymentId)
+
+	var r0 []*entity.Refund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.Refund, error)); ok {
+		return rf(ctx, paymentId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.Refund); ok {
+		r0 = rf(ctx, paymentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Refund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, paymentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IRefundRepositoryMock_FindRefunds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRefunds'
+type IRefundRepositoryMock_FindRefunds_Call struct {
+	*mock.Call
+}
+
+// FindRefunds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentId string
+func (_e *IRefundRepositoryMock_Expecter) FindRefunds(ctx interface{}, paymentId interface{}) *IRefundRepositoryMock_FindRefunds_Call {
+	return &IRefundRepositoryMock_FindRefunds_Call{Call: _e.mock.On("FindRefunds", ctx, paymentId)}
+}
+
+func (_c *IRefundRepositoryMock_FindRefunds_Call) Run(run func(ctx context.Context, paymentId string)) *IRefundRepositoryMock_FindRefunds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IRefundRepositoryMock_FindRefunds_Call) Return(_a0 []*entity.Refund, _a1 error) *IRefundRepositoryMock_FindRefunds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IRefundRepositoryMock_FindRefunds_Call) RunAndReturn(run func(context.Context, string) ([]*entity.Refund, error)) *IRefundRepositoryMock_FindRefunds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRefund provides a mock function with given fields: ctx, refund
+func (_m *IRefundRepositoryMock) UpdateRefund(ctx context.Context, refund *entity.Refund) error {
+	ret := _m.Called(ctx, refund)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Refund) error); ok {
+		r0 = rf(ctx, refund)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IRefundRepositoryMock_UpdateRefund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRefund'
+type IRefundRepositoryMock_UpdateRefund_Call struct {
+	*mock.Call
+}
+
+// UpdateRefund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refund *entity.Refund
+func (_e *IRefundRepositoryMock_Expecter) UpdateRefund(ctx interface{}, refund interface{}) *IRefundRepositoryMock_UpdateRefund_Call {
+	return &IRefundRepositoryMock_UpdateRefund_Call{Call: _e.mock.On("UpdateRefund", ctx, refund)}
+}
+
+func (_c *IRefundRepositoryMock_UpdateRefund_Call) Run(run func(ctx context.Context, refund *entity.Refund)) *IRefundRepositoryMock_UpdateRefund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Refund))
+	})
+	return _c
+}
+
+func (_c *IRefundRepositoryMock_UpdateRefund_Call) Return(_a0 error) *IRefundRepositoryMock_UpdateRefund_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IRefundRepositoryMock_UpdateRefund_Call) RunAndReturn(run func(context.Context, *entity.Refund) error) *IRefundRepositoryMock_UpdateRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIRefundRepositoryMock creates a new instance of IRefundRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIRefundRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IRefundRepositoryMock {
+	mock := &IRefundRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
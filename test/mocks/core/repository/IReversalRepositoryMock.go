@@ -0,0 +1,236 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IReversalRepositoryMock is an autogenerated mock type for the IReversalRepository type
+type IReversalRepositoryMock struct {
+	mock.Mock
+}
+
+type IReversalRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IReversalRepositoryMock) EXPECT() *IReversalRepositoryMock_Expecter {
+	return &IReversalRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateReversal provides a mock function with given fields: ctx, reversal
+func (_m *IReversalRepositoryMock) CreateReversal(ctx context.Context, reversal *entity.Reversal) error {
+	ret := _m.Called(ctx, reversal)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Reversal) error); ok {
+		r0 = rf(ctx, reversal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IReversalRepositoryMock_CreateReversal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReversal'
+type IReversalRepositoryMock_CreateReversal_Call struct {
+	*mock.Call
+}
+
+// CreateReversal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reversal *entity.Reversal
+func (_e *IReversalRepositoryMock_Expecter) CreateReversal(ctx interface{}, reversal interface{}) *IReversalRepositoryMock_CreateReversal_Call {
+	return &IReversalRepositoryMock_CreateReversal_Call{Call: _e.mock.On("CreateReversal", ctx, reversal)}
+}
+
+func (_c *IReversalRepositoryMock_CreateReversal_Call) Run(run func(ctx context.Context, reversal *entity.Reversal)) *IReversalRepositoryMock_CreateReversal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Reversal))
+	})
+	return _c
+}
+
+func (_c *IReversalRepositoryMock_CreateReversal_Call) Return(_a0 error) *IReversalRepositoryMock_CreateReversal_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IReversalRepositoryMock_CreateReversal_Call) RunAndReturn(run func(context.Context, *entity.Reversal) error) *IReversalRepositoryMock_CreateReversal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDueReversals provides a mock function with given fields: ctx, now, limit
+func (_m *IReversalRepositoryMock) FindDueReversals(ctx context.Context, now time.Time, limit int) ([]*entity.Reversal, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	var r0 []*entity.Reversal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*entity.Reversal, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*entity.Reversal); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Reversal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IReversalRepositoryMock_FindDueReversals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDueReversals'
+type IReversalRepositoryMock_FindDueReversals_Call struct {
+	*mock.Call
+}
+
+// FindDueReversals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *IReversalRepositoryMock_Expecter) FindDueReversals(ctx interface{}, now interface{}, limit interface{}) *IReversalRepositoryMock_FindDueReversals_Call {
+	return &IReversalRepositoryMock_FindDueReversals_Call{Call: _e.mock.On("FindDueReversals", ctx, now, limit)}
+}
+
+func (_c *IReversalRepositoryMock_FindDueReversals_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *IReversalRepositoryMock_FindDueReversals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IReversalRepositoryMock_FindDueReversals_Call) Return(_a0 []*entity.Reversal, _a1 error) *IReversalRepositoryMock_FindDueReversals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IReversalRepositoryMock_FindDueReversals_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*entity.Reversal, error)) *IReversalRepositoryMock_FindDueReversals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindReversals provides a mock function with given fields: ctx, paymentId
+func (_m *IReversalRepositoryMock) FindReversals(ctx context.Context, paymentId string) ([]*entity.Reversal, error) {
+	ret := _m.Called(ctx, paymentId)
+
+	var r0 []*entity.Reversal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.Reversal, error)); ok {
+		return rf(ctx, paymentId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.Reversal); ok {
+		r0 = rf(ctx, paymentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Reversal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, paymentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IReversalRepositoryMock_FindReversals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindReversals'
+type IReversalRepositoryMock_FindReversals_Call struct {
+	*mock.Call
+}
+
+// FindReversals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentId string
+func (_e *IReversalRepositoryMock_Expecter) FindReversals(ctx interface{}, paymentId interface{}) *IReversalRepositoryMock_FindReversals_Call {
+	return &IReversalRepositoryMock_FindReversals_Call{Call: _e.mock.On("FindReversals", ctx, paymentId)}
+}
+
+func (_c *IReversalRepositoryMock_FindReversals_Call) Run(run func(ctx context.Context, paymentId string)) *IReversalRepositoryMock_FindReversals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IReversalRepositoryMock_FindReversals_Call) Return(_a0 []*entity.Reversal, _a1 error) *IReversalRepositoryMock_FindReversals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IReversalRepositoryMock_FindReversals_Call) RunAndReturn(run func(context.Context, string) ([]*entity.Reversal, error)) *IReversalRepositoryMock_FindReversals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReversal provides a mock function with given fields: ctx, reversal
+func (_m *IReversalRepositoryMock) UpdateReversal(ctx context.Context, reversal *entity.Reversal) error {
+	ret := _m.Called(ctx, reversal)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Reversal) error); ok {
+		r0 = rf(ctx, reversal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IReversalRepositoryMock_UpdateReversal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReversal'
+type IReversalRepositoryMock_UpdateReversal_Call struct {
+	*mock.Call
+}
+
+// UpdateReversal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reversal *entity.Reversal
+func (_e *IReversalRepositoryMock_Expecter) UpdateReversal(ctx interface{}, reversal interface{}) *IReversalRepositoryMock_UpdateReversal_Call {
+	return &IReversalRepositoryMock_UpdateReversal_Call{Call: _e.mock.On("UpdateReversal", ctx, reversal)}
+}
+
+func (_c *IReversalRepositoryMock_UpdateReversal_Call) Run(run func(ctx context.Context, reversal *entity.Reversal)) *IReversalRepositoryMock_UpdateReversal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Reversal))
+	})
+	return _c
+}
+
+func (_c *IReversalRepositoryMock_UpdateReversal_Call) Return(_a0 error) *IReversalRepositoryMock_UpdateReversal_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IReversalRepositoryMock_UpdateReversal_Call) RunAndReturn(run func(context.Context, *entity.Reversal) error) *IReversalRepositoryMock_UpdateReversal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIReversalRepositoryMock creates a new instance of IReversalRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIReversalRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IReversalRepositoryMock {
+	mock := &IReversalRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
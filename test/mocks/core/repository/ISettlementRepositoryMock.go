@@ -0,0 +1,195 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ISettlementRepositoryMock is an autogenerated mock type for the ISettlementRepository type
+type ISettlementRepositoryMock struct {
+	mock.Mock
+}
+
+type ISettlementRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ISettlementRepositoryMock) EXPECT() *ISettlementRepositoryMock_Expecter {
+	return &ISettlementRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateSettlement provides a mock function with given fields: ctx, settlement
+func (_m *ISettlementRepositoryMock) CreateSettlement(ctx context.Context, settlement *entity.Settlement) error {
+	ret := _m.Called(ctx, settlement)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Settlement) error); ok {
+		r0 = rf(ctx, settlement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ISettlementRepositoryMock_CreateSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSettlement'
+type ISettlementRepositoryMock_CreateSettlement_Call struct {
+	*mock.Call
+}
+
+// CreateSettlement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settlement *entity.Settlement
+func (_e *ISettlementRepositoryMock_Expecter) CreateSettlement(ctx interface{}, settlement interface{}) *ISettlementRepositoryMock_CreateSettlement_Call {
+	return &ISettlementRepositoryMock_CreateSettlement_Call{Call: _e.mock.On("CreateSettlement", ctx, settlement)}
+}
+
+func (_c *ISettlementRepositoryMock_CreateSettlement_Call) Run(run func(ctx context.Context, settlement *entity.Settlement)) *ISettlementRepositoryMock_CreateSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Settlement))
+	})
+	return _c
+}
+
+func (_c *ISettlementRepositoryMock_CreateSettlement_Call) Return(_a0 error) *ISettlementRepositoryMock_CreateSettlement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ISettlementRepositoryMock_CreateSettlement_Call) RunAndReturn(run func(context.Context, *entity.Settlement) error) *ISettlementRepositoryMock_CreateSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSettlement provides a mock function with given fields: ctx, settlementId
+func (_m *ISettlementRepositoryMock) FindSettlement(ctx context.Context, settlementId string) (*entity.Settlement, error) {
+	ret := _m.Called(ctx, settlementId)
+
+	var r0 *entity.Settlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.Settlement, error)); ok {
+		return rf(ctx, settlementId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.Settlement); ok {
+		r0 = rf(ctx, settlementId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Settlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, settlementId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ISettlementRepositoryMock_FindSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSettlement'
+type ISettlementRepositoryMock_FindSettlement_Call struct {
+	*mock.Call
+}
+
+// FindSettlement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settlementId string
+func (_e *ISettlementRepositoryMock_Expecter) FindSettlement(ctx interface{}, settlementId interface{}) *ISettlementRepositoryMock_FindSettlement_Call {
+	return &ISettlementRepositoryMock_FindSettlement_Call{Call: _e.mock.On("FindSettlement", ctx, settlementId)}
+}
+
+func (_c *ISettlementRepositoryMock_FindSettlement_Call) Run(run func(ctx context.Context, settlementId string)) *ISettlementRepositoryMock_FindSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ISettlementRepositoryMock_FindSettlement_Call) Return(_a0 *entity.Settlement, _a1 error) *ISettlementRepositoryMock_FindSettlement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ISettlementRepositoryMock_FindSettlement_Call) RunAndReturn(run func(context.Context, string) (*entity.Settlement, error)) *ISettlementRepositoryMock_FindSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSettlementPayments provides a mock function with given fields: ctx, acquirer, acquirerIds, from, to
+func (_m *ISettlementRepositoryMock) FindSettlementPayments(ctx context.Context, acquirer string, acquirerIds []string, from time.Time, to time.Time) ([]*entity.Payment, error) {
+	ret := _m.Called(ctx, acquirer, acquirerIds, from, to)
+
+	var r0 []*entity.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, time.Time, time.Time) ([]*entity.Payment, error)); ok {
+		return rf(ctx, acquirer, acquirerIds, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, time.Time, time.Time) []*entity.Payment); ok {
+		r0 = rf(ctx, acquirer, acquirerIds, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, acquirer, acquirerIds, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ISettlementRepositoryMock_FindSettlementPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSettlementPayments'
+type ISettlementRepositoryMock_FindSettlementPayments_Call struct {
+	*mock.Call
+}
+
+// FindSettlementPayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - acquirer string
+//   - acquirerIds []string
+//   - from time.Time
+//   - to time.Time
+func (_e *ISettlementRepositoryMock_Expecter) FindSettlementPayments(ctx interface{}, acquirer interface{}, acquirerIds interface{}, from interface{}, to interface{}) *ISettlementRepositoryMock_FindSettlementPayments_Call {
+	return &ISettlementRepositoryMock_FindSettlementPayments_Call{Call: _e.mock.On("FindSettlementPayments", ctx, acquirer, acquirerIds, from, to)}
+}
+
+func (_c *ISettlementRepositoryMock_FindSettlementPayments_Call) Run(run func(ctx context.Context, acquirer string, acquirerIds []string, from time.Time, to time.Time)) *ISettlementRepositoryMock_FindSettlementPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ISettlementRepositoryMock_FindSettlementPayments_Call) Return(_a0 []*entity.Payment, _a1 error) *ISettlementRepositoryMock_FindSettlementPayments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ISettlementRepositoryMock_FindSettlementPayments_Call) RunAndReturn(run func(context.Context, string, []string, time.Time, time.Time) ([]*entity.Payment, error)) *ISettlementRepositoryMock_FindSettlementPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewISettlementRepositoryMock creates a new instance of ISettlementRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewISettlementRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ISettlementRepositoryMock {
+	mock := &ISettlementRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
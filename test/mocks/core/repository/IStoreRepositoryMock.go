@@ -0,0 +1,275 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IStoreRepositoryMock is an autogenerated mock type for the IStoreRepository type
+type IStoreRepositoryMock struct {
+	mock.Mock
+}
+
+type IStoreRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IStoreRepositoryMock) EXPECT() *IStoreRepositoryMock_Expecter {
+	return &IStoreRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateStore provides a mock function with given fields: ctx, store
+func (_m *IStoreRepositoryMock) CreateStore(ctx context.Context, store *entity.Store) error {
+	ret := _m.Called(ctx, store)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Store) error); ok {
+		r0 = rf(ctx, store)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IStoreRepositoryMock_CreateStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStore'
+type IStoreRepositoryMock_CreateStore_Call struct {
+	*mock.Call
+}
+
+// CreateStore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - store *entity.Store
+func (_e *IStoreRepositoryMock_Expecter) CreateStore(ctx interface{}, store interface{}) *IStoreRepositoryMock_CreateStore_Call {
+	return &IStoreRepositoryMock_CreateStore_Call{Call: _e.mock.On("CreateStore", ctx, store)}
+}
+
+func (_c *IStoreRepositoryMock_CreateStore_Call) Run(run func(ctx context.Context, store *entity.Store)) *IStoreRepositoryMock_CreateStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Store))
+	})
+	return _c
+}
+
+func (_c *IStoreRepositoryMock_CreateStore_Call) Return(_a0 error) *IStoreRepositoryMock_CreateStore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IStoreRepositoryMock_CreateStore_Call) RunAndReturn(run func(context.Context, *entity.Store) error) *IStoreRepositoryMock_CreateStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStore provides a mock function with given fields: ctx, storeId
+func (_m *IStoreRepositoryMock) DeleteStore(ctx context.Context, storeId string) error {
+	ret := _m.Called(ctx, storeId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, storeId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IStoreRepositoryMock_DeleteStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStore'
+type IStoreRepositoryMock_DeleteStore_Call struct {
+	*mock.Call
+}
+
+// DeleteStore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - storeId string
+func (_e *IStoreRepositoryMock_Expecter) DeleteStore(ctx interface{}, storeId interface{}) *IStoreRepositoryMock_DeleteStore_Call {
+	return &IStoreRepositoryMock_DeleteStore_Call{Call: _e.mock.On("DeleteStore", ctx, storeId)}
+}
+
+func (_c *IStoreRepositoryMock_DeleteStore_Call) Run(run func(ctx context.Context, storeId string)) *IStoreRepositoryMock_DeleteStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IStoreRepositoryMock_DeleteStore_Call) Return(_a0 error) *IStoreRepositoryMock_DeleteStore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IStoreRepositoryMock_DeleteStore_Call) RunAndReturn(run func(context.Context, string) error) *IStoreRepositoryMock_DeleteStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindStore provides a mock function with given fields: ctx, storeId
+func (_m *IStoreRepositoryMock) FindStore(ctx context.Context, storeId string) (*entity.Store, error) {
+	ret := _m.Called(ctx, storeId)
+
+	var r0 *entity.Store
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.Store, error)); ok {
+		return rf(ctx, storeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.Store); ok {
+		r0 = rf(ctx, storeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Store)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, storeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IStoreRepositoryMock_FindStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStore'
+type IStoreRepositoryMock_FindStore_Call struct {
+	*mock.Call
+}
+
+// FindStore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - storeId string
+func (_e *IStoreRepositoryMock_Expecter) FindStore(ctx interface{}, storeId interface{}) *IStoreRepositoryMock_FindStore_Call {
+	return &IStoreRepositoryMock_FindStore_Call{Call: _e.mock.On("FindStore", ctx, storeId)}
+}
+
+func (_c *IStoreRepositoryMock_FindStore_Call) Run(run func(ctx context.Context, storeId string)) *IStoreRepositoryMock_FindStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IStoreRepositoryMock_FindStore_Call) Return(_a0 *entity.Store, _a1 error) *IStoreRepositoryMock_FindStore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IStoreRepositoryMock_FindStore_Call) RunAndReturn(run func(context.Context, string) (*entity.Store, error)) *IStoreRepositoryMock_FindStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindStores provides a mock function with given fields: ctx
+func (_m *IStoreRepositoryMock) FindStores(ctx context.Context) ([]*entity.Store, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*entity.Store
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.Store, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.Store); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Store)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IStoreRepositoryMock_FindStores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStores'
+type IStoreRepositoryMock_FindStores_Call struct {
+	*mock.Call
+}
+
+// FindStores is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IStoreRepositoryMock_Expecter) FindStores(ctx interface{}) *IStoreRepositoryMock_FindStores_Call {
+	return &IStoreRepositoryMock_FindStores_Call{Call: _e.mock.On("FindStores", ctx)}
+}
+
+func (_c *IStoreRepositoryMock_FindStores_Call) Run(run func(ctx context.Context)) *IStoreRepositoryMock_FindStores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IStoreRepositoryMock_FindStores_Call) Return(_a0 []*entity.Store, _a1 error) *IStoreRepositoryMock_FindStores_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IStoreRepositoryMock_FindStores_Call) RunAndReturn(run func(context.Context) ([]*entity.Store, error)) *IStoreRepositoryMock_FindStores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStore provides a mock function with given fields: ctx, store
+func (_m *IStoreRepositoryMock) UpdateStore(ctx context.Context, store *entity.Store) error {
+	ret := _m.Called(ctx, store)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Store) error); ok {
+		r0 = rf(ctx, store)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IStoreRepositoryMock_UpdateStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStore'
+type IStoreRepositoryMock_UpdateStore_Call struct {
+	*mock.Call
+}
+
+// UpdateStore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - store *entity.Store
+func (_e *IStoreRepositoryMock_Expecter) UpdateStore(ctx interface{}, store interface{}) *IStoreRepositoryMock_UpdateStore_Call {
+	return &IStoreRepositoryMock_UpdateStore_Call{Call: _e.mock.On("UpdateStore", ctx, store)}
+}
+
+func (_c *IStoreRepositoryMock_UpdateStore_Call) Run(run func(ctx context.Context, store *entity.Store)) *IStoreRepositoryMock_UpdateStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Store))
+	})
+	return _c
+}
+
+func (_c *IStoreRepositoryMock_UpdateStore_Call) Return(_a0 error) *IStoreRepositoryMock_UpdateStore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IStoreRepositoryMock_UpdateStore_Call) RunAndReturn(run func(context.Context, *entity.Store) error) *IStoreRepositoryMock_UpdateStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIStoreRepositoryMock creates a new instance of IStoreRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIStoreRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IStoreRepositoryMock {
+	mock := &IStoreRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
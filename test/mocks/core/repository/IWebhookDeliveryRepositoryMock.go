@@ -0,0 +1,292 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IWebhookDeliveryRepositoryMock is an autogenerated mock type for the IWebhookDeliveryRepository type
+type IWebhookDeliveryRepositoryMock struct {
+	mock.Mock
+}
+
+type IWebhookDeliveryRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IWebhookDeliveryRepositoryMock) EXPECT() *IWebhookDeliveryRepositoryMock_Expecter {
+	return &IWebhookDeliveryRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateDeliveries provides a mock function with given fields: ctx, event
+func (_m *IWebhookDeliveryRepositoryMock) CreateDeliveries(ctx context.Context, event *entity.WebhookEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.WebhookEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookDeliveryRepositoryMock_CreateDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDeliveries'
+type IWebhookDeliveryRepositoryMock_CreateDeliveries_Call struct {
+	*mock.Call
+}
+
+// CreateDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *entity.WebhookEvent
+func (_e *IWebhookDeliveryRepositoryMock_Expecter) CreateDeliveries(ctx interface{}, event interface{}) *IWebhookDeliveryRepositoryMock_CreateDeliveries_Call {
+	return &IWebhookDeliveryRepositoryMock_CreateDeliveries_Call{Call: _e.mock.On("CreateDeliveries", ctx, event)}
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_CreateDeliveries_Call) Run(run func(ctx context.Context, event *entity.WebhookEvent)) *IWebhookDeliveryRepositoryMock_CreateDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.WebhookEvent))
+	})
+	return _c
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_CreateDeliveries_Call) Return(_a0 error) *IWebhookDeliveryRepositoryMock_CreateDeliveries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_CreateDeliveries_Call) RunAndReturn(run func(context.Context, *entity.WebhookEvent) error) *IWebhookDeliveryRepositoryMock_CreateDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDeliveries provides a mock function with given fields: ctx, webhookId, limit
+func (_m *IWebhookDeliveryRepositoryMock) FindDeliveries(ctx context.Context, webhookId string, limit int) ([]*entity.WebhookDelivery, error) {
+	ret := _m.Called(ctx, webhookId, limit)
+
+	var r0 []*entity.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]*entity.WebhookDelivery, error)); ok {
+		return rf(ctx, webhookId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []*entity.WebhookDelivery); ok {
+		r0 = rf(ctx, webhookId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, webhookId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IWebhookDeliveryRepositoryMock_FindDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDeliveries'
+type IWebhookDeliveryRepositoryMock_FindDeliveries_Call struct {
+	*mock.Call
+}
+
+// FindDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookId string
+//   - limit int
+func (_e *IWebhookDeliveryRepositoryMock_Expecter) FindDeliveries(ctx interface{}, webhookId interface{}, limit interface{}) *IWebhookDeliveryRepositoryMock_FindDeliveries_Call {
+	return &IWebhookDeliveryRepositoryMock_FindDeliveries_Call{Call: _e.mock.On("FindDeliveries", ctx, webhookId, limit)}
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_FindDeliveries_Call) Run(run func(ctx context.Context, webhookId string, limit int)) *IWebhookDeliveryRepositoryMock_FindDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_FindDeliveries_Call) Return(_a0 []*entity.WebhookDelivery, _a1 error) *IWebhookDeliveryRepositoryMock_FindDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_FindDeliveries_Call) RunAndReturn(run func(context.Context, string, int) ([]*entity.WebhookDelivery, error)) *IWebhookDeliveryRepositoryMock_FindDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDelivery provides a mock function with given fields: ctx, deliveryId
+func (_m *IWebhookDeliveryRepositoryMock) FindDelivery(ctx context.Context, deliveryId string) (*entity.WebhookDelivery, error) {
+	ret := _m.Called(ctx, deliveryId)
+
+	var r0 *entity.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.WebhookDelivery, error)); ok {
+		return rf(ctx, deliveryId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.WebhookDelivery); ok {
+		r0 = rf(ctx, deliveryId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, deliveryId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IWebhookDeliveryRepositoryMock_FindDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDelivery'
+type IWebhookDeliveryRepositoryMock_FindDelivery_Call struct {
+	*mock.Call
+}
+
+// FindDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryId string
+func (_e *IWebhookDeliveryRepositoryMock_Expecter) FindDelivery(ctx interface{}, deliveryId interface{}) *IWebhookDeliveryRepositoryMock_FindDelivery_Call {
+	return &IWebhookDeliveryRepositoryMock_FindDelivery_Call{Call: _e.mock.On("FindDelivery", ctx, deliveryId)}
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_FindDelivery_Call) Run(run func(ctx context.Context, deliveryId string)) *IWebhookDeliveryRepositoryMock_FindDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_FindDelivery_Call) Return(_a0 *entity.WebhookDelivery, _a1 error) *IWebhookDeliveryRepositoryMock_FindDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_FindDelivery_Call) RunAndReturn(run func(context.Context, string) (*entity.WebhookDelivery, error)) *IWebhookDeliveryRepositoryMock_FindDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDueDeliveries provides a mock function with given fields: ctx, now, limit
+func (_m *IWebhookDeliveryRepositoryMock) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	var r0 []*entity.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*entity.WebhookDelivery, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*entity.WebhookDelivery); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IWebhookDeliveryRepositoryMock_FindDueDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDueDeliveries'
+type IWebhookDeliveryRepositoryMock_FindDueDeliveries_Call struct {
+	*mock.Call
+}
+
+// FindDueDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *IWebhookDeliveryRepositoryMock_Expecter) FindDueDeliveries(ctx interface{}, now interface{}, limit interface{}) *IWebhookDeliveryRepositoryMock_FindDueDeliveries_Call {
+	return &IWebhookDeliveryRepositoryMock_FindDueDeliveries_Call{Call: _e.mock.On("FindDueDeliveries", ctx, now, limit)}
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_FindDueDeliveries_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *IWebhookDeliveryRepositoryMock_FindDueDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_FindDueDeliveries_Call) Return(_a0 []*entity.WebhookDelivery, _a1 error) *IWebhookDeliveryRepositoryMock_FindDueDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_FindDueDeliveries_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*entity.WebhookDelivery, error)) *IWebhookDeliveryRepositoryMock_FindDueDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDelivery provides a mock function with given fields: ctx, delivery
+func (_m *IWebhookDeliveryRepositoryMock) UpdateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	ret := _m.Called(ctx, delivery)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.WebhookDelivery) error); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookDeliveryRepositoryMock_UpdateDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDelivery'
+type IWebhookDeliveryRepositoryMock_UpdateDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery *entity.WebhookDelivery
+func (_e *IWebhookDeliveryRepositoryMock_Expecter) UpdateDelivery(ctx interface{}, delivery interface{}) *IWebhookDeliveryRepositoryMock_UpdateDelivery_Call {
+	return &IWebhookDeliveryRepositoryMock_UpdateDelivery_Call{Call: _e.mock.On("UpdateDelivery", ctx, delivery)}
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_UpdateDelivery_Call) Run(run func(ctx context.Context, delivery *entity.WebhookDelivery)) *IWebhookDeliveryRepositoryMock_UpdateDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_UpdateDelivery_Call) Return(_a0 error) *IWebhookDeliveryRepositoryMock_UpdateDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookDeliveryRepositoryMock_UpdateDelivery_Call) RunAndReturn(run func(context.Context, *entity.WebhookDelivery) error) *IWebhookDeliveryRepositoryMock_UpdateDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIWebhookDeliveryRepositoryMock creates a new instance of IWebhookDeliveryRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIWebhookDeliveryRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IWebhookDeliveryRepositoryMock {
+	mock := &IWebhookDeliveryRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,276 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IWebhookRepositoryMock is an autogenerated mock type for the IWebhookRepository type
+type IWebhookRepositoryMock struct {
+	mock.Mock
+}
+
+type IWebhookRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IWebhookRepositoryMock) EXPECT() *IWebhookRepositoryMock_Expecter {
+	return &IWebhookRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: ctx, webhook
+func (_m *IWebhookRepositoryMock) CreateWebhook(ctx context.Context, webhook *entity.Webhook) error {
+	ret := _m.Called(ctx, webhook)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Webhook) error); ok {
+		r0 = rf(ctx, webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookRepositoryMock_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type IWebhookRepositoryMock_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *entity.Webhook
+func (_e *IWebhookRepositoryMock_Expecter) CreateWebhook(ctx interface{}, webhook interface{}) *IWebhookRepositoryMock_CreateWebhook_Call {
+	return &IWebhookRepositoryMock_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, webhook)}
+}
+
+func (_c *IWebhookRepositoryMock_CreateWebhook_Call) Run(run func(ctx context.Context, webhook *entity.Webhook)) *IWebhookRepositoryMock_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Webhook))
+	})
+	return _c
+}
+
+func (_c *IWebhookRepositoryMock_CreateWebhook_Call) Return(_a0 error) *IWebhookRepositoryMock_CreateWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookRepositoryMock_CreateWebhook_Call) RunAndReturn(run func(context.Context, *entity.Webhook) error) *IWebhookRepositoryMock_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: ctx, webhookId
+func (_m *IWebhookRepositoryMock) DeleteWebhook(ctx context.Context, webhookId string) error {
+	ret := _m.Called(ctx, webhookId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, webhookId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookRepositoryMock_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type IWebhookRepositoryMock_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookId string
+func (_e *IWebhookRepositoryMock_Expecter) DeleteWebhook(ctx interface{}, webhookId interface{}) *IWebhookRepositoryMock_DeleteWebhook_Call {
+	return &IWebhookRepositoryMock_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, webhookId)}
+}
+
+func (_c *IWebhookRepositoryMock_DeleteWebhook_Call) Run(run func(ctx context.Context, webhookId string)) *IWebhookRepositoryMock_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IWebhookRepositoryMock_DeleteWebhook_Call) Return(_a0 error) *IWebhookRepositoryMock_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookRepositoryMock_DeleteWebhook_Call) RunAndReturn(run func(context.Context, string) error) *IWebhookRepositoryMock_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWebhook provides a mock function with given fields: ctx, webhookId
+func (_m *IWebhookRepositoryMock) FindWebhook(ctx context.Context, webhookId string) (*entity.Webhook, error) {
+	ret := _m.Called(ctx, webhookId)
+
+	var r0 *entity.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.Webhook, error)); ok {
+		return rf(ctx, webhookId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.Webhook); ok {
+		r0 = rf(ctx, webhookId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, webhookId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IWebhookRepositoryMock_FindWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWebhook'
+type IWebhookRepositoryMock_FindWebhook_Call struct {
+	*mock.Call
+}
+
+// FindWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookId string
+func (_e *IWebhookRepositoryMock_Expecter) FindWebhook(ctx interface{}, webhookId interface{}) *IWebhookRepositoryMock_FindWebhook_Call {
+	return &IWebhookRepositoryMock_FindWebhook_Call{Call: _e.mock.On("FindWebhook", ctx, webhookId)}
+}
+
+func (_c *IWebhookRepositoryMock_FindWebhook_Call) Run(run func(ctx context.Context, webhookId string)) *IWebhookRepositoryMock_FindWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IWebhookRepositoryMock_FindWebhook_Call) Return(_a0 *entity.Webhook, _a1 error) *IWebhookRepositoryMock_FindWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IWebhookRepositoryMock_FindWebhook_Call) RunAndReturn(run func(context.Context, string) (*entity.Webhook, error)) *IWebhookRepositoryMock_FindWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWebhooks provides a mock function with given fields: ctx, caller
+func (_m *IWebhookRepositoryMock) FindWebhooks(ctx context.Context, caller string) ([]*entity.Webhook, error) {
+	ret := _m.Called(ctx, caller)
+
+	var r0 []*entity.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.Webhook, error)); ok {
+		return rf(ctx, caller)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.Webhook); ok {
+		r0 = rf(ctx, caller)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, caller)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IWebhookRepositoryMock_FindWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWebhooks'
+type IWebhookRepositoryMock_FindWebhooks_Call struct {
+	*mock.Call
+}
+
+// FindWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - caller string
+func (_e *IWebhookRepositoryMock_Expecter) FindWebhooks(ctx interface{}, caller interface{}) *IWebhookRepositoryMock_FindWebhooks_Call {
+	return &IWebhookRepositoryMock_FindWebhooks_Call{Call: _e.mock.On("FindWebhooks", ctx, caller)}
+}
+
+func (_c *IWebhookRepositoryMock_FindWebhooks_Call) Run(run func(ctx context.Context, caller string)) *IWebhookRepositoryMock_FindWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IWebhookRepositoryMock_FindWebhooks_Call) Return(_a0 []*entity.Webhook, _a1 error) *IWebhookRepositoryMock_FindWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IWebhookRepositoryMock_FindWebhooks_Call) RunAndReturn(run func(context.Context, string) ([]*entity.Webhook, error)) *IWebhookRepositoryMock_FindWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhook provides a mock function with given fields: ctx, webhook
+func (_m *IWebhookRepositoryMock) UpdateWebhook(ctx context.Context, webhook *entity.Webhook) error {
+	ret := _m.Called(ctx, webhook)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Webhook) error); ok {
+		r0 = rf(ctx, webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookRepositoryMock_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type IWebhookRepositoryMock_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *entity.Webhook
+func (_e *IWebhookRepositoryMock_Expecter) UpdateWebhook(ctx interface{}, webhook interface{}) *IWebhookRepositoryMock_UpdateWebhook_Call {
+	return &IWebhookRepositoryMock_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", ctx, webhook)}
+}
+
+func (_c *IWebhookRepositoryMock_UpdateWebhook_Call) Run(run func(ctx context.Context, webhook *entity.Webhook)) *IWebhookRepositoryMock_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Webhook))
+	})
+	return _c
+}
+
+func (_c *IWebhookRepositoryMock_UpdateWebhook_Call) Return(_a0 error) *IWebhookRepositoryMock_UpdateWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookRepositoryMock_UpdateWebhook_Call) RunAndReturn(run func(context.Context, *entity.Webhook) error) *IWebhookRepositoryMock_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIWebhookRepositoryMock creates a new instance of IWebhookRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIWebhookRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IWebhookRepositoryMock {
+	mock := &IWebhookRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,123 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IAcquirerHealthServiceMock is an autogenerated mock type for the IAcquirerHealthService type
+type IAcquirerHealthServiceMock struct {
+	mock.Mock
+}
+
+type IAcquirerHealthServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IAcquirerHealthServiceMock) EXPECT() *IAcquirerHealthServiceMock_Expecter {
+	return &IAcquirerHealthServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// AcquirerHealth provides a mock function with given fields: ctx
+func (_m *IAcquirerHealthServiceMock) AcquirerHealth(ctx context.Context) []*entity.AcquirerHealth {
+	ret := _m.Called(ctx)
+
+	var r0 []*entity.AcquirerHealth
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.AcquirerHealth); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.AcquirerHealth)
+		}
+	}
+
+	return r0
+}
+
+// IAcquirerHealthServiceMock_AcquirerHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquirerHealth'
+type IAcquirerHealthServiceMock_AcquirerHealth_Call struct {
+	*mock.Call
+}
+
+// AcquirerHealth is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IAcquirerHealthServiceMock_Expecter) AcquirerHealth(ctx interface{}) *IAcquirerHealthServiceMock_AcquirerHealth_Call {
+	return &IAcquirerHealthServiceMock_AcquirerHealth_Call{Call: _e.mock.On("AcquirerHealth", ctx)}
+}
+
+func (_c *IAcquirerHealthServiceMock_AcquirerHealth_Call) Run(run func(ctx context.Context)) *IAcquirerHealthServiceMock_AcquirerHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IAcquirerHealthServiceMock_AcquirerHealth_Call) Return(_a0 []*entity.AcquirerHealth) *IAcquirerHealthServiceMock_AcquirerHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IAcquirerHealthServiceMock_AcquirerHealth_Call) RunAndReturn(run func(context.Context) []*entity.AcquirerHealth) *IAcquirerHealthServiceMock_AcquirerHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAvailable provides a mock function with given fields: acquirer
+func (_m *IAcquirerHealthServiceMock) IsAvailable(acquirer string) bool {
+	ret := _m.Called(acquirer)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(acquirer)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IAcquirerHealthServiceMock_IsAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAvailable'
+type IAcquirerHealthServiceMock_IsAvailable_Call struct {
+	*mock.Call
+}
+
+// IsAvailable is a helper method to define mock.On call
+//   - acquirer string
+func (_e *IAcquirerHealthServiceMock_Expecter) IsAvailable(acquirer interface{}) *IAcquirerHealthServiceMock_IsAvailable_Call {
+	return &IAcquirerHealthServiceMock_IsAvailable_Call{Call: _e.mock.On("IsAvailable", acquirer)}
+}
+
+func (_c *IAcquirerHealthServiceMock_IsAvailable_Call) Run(run func(acquirer string)) *IAcquirerHealthServiceMock_IsAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IAcquirerHealthServiceMock_IsAvailable_Call) Return(_a0 bool) *IAcquirerHealthServiceMock_IsAvailable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IAcquirerHealthServiceMock_IsAvailable_Call) RunAndReturn(run func(string) bool) *IAcquirerHealthServiceMock_IsAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIAcquirerHealthServiceMock creates a new instance of IAcquirerHealthServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIAcquirerHealthServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IAcquirerHealthServiceMock {
+	mock := &IAcquirerHealthServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IAddressLookupMock is an autogenerated mock type for the IAddressLookup type
+type IAddressLookupMock struct {
+	mock.Mock
+}
+
+type IAddressLookupMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IAddressLookupMock) EXPECT() *IAddressLookupMock_Expecter {
+	return &IAddressLookupMock_Expecter{mock: &_m.Mock}
+}
+
+// LookupAddress provides a mock function with given fields: ctx, cep
+func (_m *IAddressLookupMock) LookupAddress(ctx context.Context, cep string) (*entity.Address, error) {
+	ret := _m.Called(ctx, cep)
+
+	var r0 *entity.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.Address, error)); ok {
+		return rf(ctx, cep)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.Address); ok {
+		r0 = rf(ctx, cep)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, cep)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IAddressLookupMock_LookupAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LookupAddress'
+type IAddressLookupMock_LookupAddress_Call struct {
+	*mock.Call
+}
+
+// LookupAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cep string
+func (_e *IAddressLookupMock_Expecter) LookupAddress(ctx interface{}, cep interface{}) *IAddressLookupMock_LookupAddress_Call {
+	return &IAddressLookupMock_LookupAddress_Call{Call: _e.mock.On("LookupAddress", ctx, cep)}
+}
+
+func (_c *IAddressLookupMock_LookupAddress_Call) Run(run func(ctx context.Context, cep string)) *IAddressLookupMock_LookupAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IAddressLookupMock_LookupAddress_Call) Return(_a0 *entity.Address, _a1 error) *IAddressLookupMock_LookupAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IAddressLookupMock_LookupAddress_Call) RunAndReturn(run func(context.Context, string) (*entity.Address, error)) *IAddressLookupMock_LookupAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIAddressLookupMock creates a new instance of IAddressLookupMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIAddressLookupMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IAddressLookupMock {
+	mock := &IAddressLookupMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IBinServiceMock is an autogenerated mock type for the IBinService type
+type IBinServiceMock struct {
+	mock.Mock
+}
+
+type IBinServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IBinServiceMock) EXPECT() *IBinServiceMock_Expecter {
+	return &IBinServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// FindBin provides a mock function with given fields: ctx, number
+func (_m *IBinServiceMock) FindBin(ctx context.Context, number string) (*entity.BinRange, error) {
+	ret := _m.Called(ctx, number)
+
+	var r0 *entity.BinRange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.BinRange, error)); ok {
+		return rf(ctx, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.BinRange); ok {
+		r0 = rf(ctx, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.BinRange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IBinServiceMock_FindBin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBin'
+type IBinServiceMock_FindBin_Call struct {
+	*mock.Call
+}
+
+// FindBin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - number string
+func (_e *IBinServiceMock_Expecter) FindBin(ctx interface{}, number interface{}) *IBinServiceMock_FindBin_Call {
+	return &IBinServiceMock_FindBin_Call{Call: _e.mock.On("FindBin", ctx, number)}
+}
+
+func (_c *IBinServiceMock_FindBin_Call) Run(run func(ctx context.Context, number string)) *IBinServiceMock_FindBin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IBinServiceMock_FindBin_Call) Return(_a0 *entity.BinRange, _a1 error) *IBinServiceMock_FindBin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IBinServiceMock_FindBin_Call) RunAndReturn(run func(context.Context, string) (*entity.BinRange, error)) *IBinServiceMock_FindBin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIBinServiceMock creates a new instance of IBinServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIBinServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IBinServiceMock {
+	mock := &IBinServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,80 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IEventPublisherMock is an autogenerated mock type for the IEventPublisher type
+type IEventPublisherMock struct {
+	mock.Mock
+}
+
+type IEventPublisherMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IEventPublisherMock) EXPECT() *IEventPublisherMock_Expecter {
+	return &IEventPublisherMock_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: ctx, event
+func (_m *IEventPublisherMock) Publish(ctx context.Context, event *entity.DomainEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.DomainEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IEventPublisherMock_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type IEventPublisherMock_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *entity.DomainEvent
+func (_e *IEventPublisherMock_Expecter) Publish(ctx interface{}, event interface{}) *IEventPublisherMock_Publish_Call {
+	return &IEventPublisherMock_Publish_Call{Call: _e.mock.On("Publish", ctx, event)}
+}
+
+func (_c *IEventPublisherMock_Publish_Call) Run(run func(ctx context.Context, event *entity.DomainEvent)) *IEventPublisherMock_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.DomainEvent))
+	})
+	return _c
+}
+
+func (_c *IEventPublisherMock_Publish_Call) Return(_a0 error) *IEventPublisherMock_Publish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IEventPublisherMock_Publish_Call) RunAndReturn(run func(context.Context, *entity.DomainEvent) error) *IEventPublisherMock_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIEventPublisherMock creates a new instance of IEventPublisherMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIEventPublisherMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IEventPublisherMock {
+	mock := &IEventPublisherMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
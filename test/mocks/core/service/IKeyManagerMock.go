@@ -0,0 +1,240 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import mock "github.com/stretchr/testify/mock"
+
+// IKeyManagerMock is an autogenerated mock type for the IKeyManager type
+type IKeyManagerMock struct {
+	mock.Mock
+}
+
+type IKeyManagerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IKeyManagerMock) EXPECT() *IKeyManagerMock_Expecter {
+	return &IKeyManagerMock_Expecter{mock: &_m.Mock}
+}
+
+// CurrentVersion provides a mock function with given fields:
+func (_m *IKeyManagerMock) CurrentVersion() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// IKeyManagerMock_CurrentVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CurrentVersion'
+type IKeyManagerMock_CurrentVersion_Call struct {
+	*mock.Call
+}
+
+// CurrentVersion is a helper method to define mock.On call
+func (_e *IKeyManagerMock_Expecter) CurrentVersion() *IKeyManagerMock_CurrentVersion_Call {
+	return &IKeyManagerMock_CurrentVersion_Call{Call: _e.mock.On("CurrentVersion")}
+}
+
+func (_c *IKeyManagerMock_CurrentVersion_Call) Run(run func()) *IKeyManagerMock_CurrentVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IKeyManagerMock_CurrentVersion_Call) Return(_a0 int) *IKeyManagerMock_CurrentVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IKeyManagerMock_CurrentVersion_Call) RunAndReturn(run func() int) *IKeyManagerMock_CurrentVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Decrypt provides a mock function with given fields: ciphertext, version
+func (_m *IKeyManagerMock) Decrypt(ciphertext []byte, version int) ([]byte, error) {
+	ret := _m.Called(ciphertext, version)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte, int) ([]byte, error)); ok {
+		return rf(ciphertext, version)
+	}
+	if rf, ok := ret.Get(0).(func([]byte, int) []byte); ok {
+		r0 = rf(ciphertext, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte, int) error); ok {
+		r1 = rf(ciphertext, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IKeyManagerMock_Decrypt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decrypt'
+type IKeyManagerMock_Decrypt_Call struct {
+	*mock.Call
+}
+
+// Decrypt is a helper method to define mock.On call
+//   - ciphertext []byte
+//   - version int
+func (_e *IKeyManagerMock_Expecter) Decrypt(ciphertext interface{}, version interface{}) *IKeyManagerMock_Decrypt_Call {
+	return &IKeyManagerMock_Decrypt_Call{Call: _e.mock.On("Decrypt", ciphertext, version)}
+}
+
+func (_c *IKeyManagerMock_Decrypt_Call) Run(run func(ciphertext []byte, version int)) *IKeyManagerMock_Decrypt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IKeyManagerMock_Decrypt_Call) Return(_a0 []byte, _a1 error) *IKeyManagerMock_Decrypt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IKeyManagerMock_Decrypt_Call) RunAndReturn(run func([]byte, int) ([]byte, error)) *IKeyManagerMock_Decrypt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Encrypt provides a mock function with given fields: plaintext
+func (_m *IKeyManagerMock) Encrypt(plaintext []byte) ([]byte, int, error) {
+	ret := _m.Called(plaintext)
+
+	var r0 []byte
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]byte, int, error)); ok {
+		return rf(plaintext)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(plaintext)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) int); ok {
+		r1 = rf(plaintext)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func([]byte) error); ok {
+		r2 = rf(plaintext)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// IKeyManagerMock_Encrypt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Encrypt'
+type IKeyManagerMock_Encrypt_Call struct {
+	*mock.Call
+}
+
+// Encrypt is a helper method to define mock.On call
+//   - plaintext []byte
+func (_e *IKeyManagerMock_Expecter) Encrypt(plaintext interface{}) *IKeyManagerMock_Encrypt_Call {
+	return &IKeyManagerMock_Encrypt_Call{Call: _e.mock.On("Encrypt", plaintext)}
+}
+
+func (_c *IKeyManagerMock_Encrypt_Call) Run(run func(plaintext []byte)) *IKeyManagerMock_Encrypt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *IKeyManagerMock_Encrypt_Call) Return(_a0 []byte, _a1 int, _a2 error) *IKeyManagerMock_Encrypt_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *IKeyManagerMock_Encrypt_Call) RunAndReturn(run func([]byte) ([]byte, int, error)) *IKeyManagerMock_Encrypt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rotate provides a mock function with given fields:
+func (_m *IKeyManagerMock) Rotate() (int, error) {
+	ret := _m.Called()
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IKeyManagerMock_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type IKeyManagerMock_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+func (_e *IKeyManagerMock_Expecter) Rotate() *IKeyManagerMock_Rotate_Call {
+	return &IKeyManagerMock_Rotate_Call{Call: _e.mock.On("Rotate")}
+}
+
+func (_c *IKeyManagerMock_Rotate_Call) Run(run func()) *IKeyManagerMock_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IKeyManagerMock_Rotate_Call) Return(_a0 int, _a1 error) *IKeyManagerMock_Rotate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IKeyManagerMock_Rotate_Call) RunAndReturn(run func() (int, error)) *IKeyManagerMock_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIKeyManagerMock creates a new instance of IKeyManagerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIKeyManagerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IKeyManagerMock {
+	mock := &IKeyManagerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
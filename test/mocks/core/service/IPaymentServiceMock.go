@@ -22,20 +22,76 @@ func (_m *IPaymentServiceMock) EXPECT() *IPaymentServiceMock_Expecter {
 	return &IPaymentServiceMock_Expecter{mock: &_m.Mock}
 }
 
+// CaptureTransaction provides a mock function with given fields: ctx, payment, value
+func (_m *IPaymentServiceMock) CaptureTransaction(ctx context.Context, payment *entity.Payment, value entity.Money) (*entity.AcquirerResponse, error) {
+	ret := _m.Called(ctx, payment, value)
+
+	var r0 *entity.AcquirerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment, entity.Money) (*entity.AcquirerResponse, error)); ok {
+		return rf(ctx, payment, value)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment, entity.Money) *entity.AcquirerResponse); ok {
+		r0 = rf(ctx, payment, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.AcquirerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Payment, entity.Money) error); ok {
+		r1 = rf(ctx, payment, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IPaymentServiceMock_CaptureTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CaptureTransaction'
+type IPaymentServiceMock_CaptureTransaction_Call struct {
+	*mock.Call
+}
+
+// CaptureTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *entity.Payment
+//   - value entity.Money
+func (_e *IPaymentServiceMock_Expecter) CaptureTransaction(ctx interface{}, payment interface{}, value interface{}) *IPaymentServiceMock_CaptureTransaction_Call {
+	return &IPaymentServiceMock_CaptureTransaction_Call{Call: _e.mock.On("CaptureTransaction", ctx, payment, value)}
+}
+
+func (_c *IPaymentServiceMock_CaptureTransaction_Call) Run(run func(ctx context.Context, payment *entity.Payment, value entity.Money)) *IPaymentServiceMock_CaptureTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Payment), args[2].(entity.Money))
+	})
+	return _c
+}
+
+func (_c *IPaymentServiceMock_CaptureTransaction_Call) Return(_a0 *entity.AcquirerResponse, _a1 error) *IPaymentServiceMock_CaptureTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IPaymentServiceMock_CaptureTransaction_Call) RunAndReturn(run func(context.Context, *entity.Payment, entity.Money) (*entity.AcquirerResponse, error)) *IPaymentServiceMock_CaptureTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ProcessTransaction provides a mock function with given fields: ctx, transaction
-func (_m *IPaymentServiceMock) ProcessTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.Payment, error) {
+func (_m *IPaymentServiceMock) ProcessTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.AcquirerResponse, error) {
 	ret := _m.Called(ctx, transaction)
 
-	var r0 *entity.Payment
+	var r0 *entity.AcquirerResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.Transaction) (*entity.Payment, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Transaction) (*entity.AcquirerResponse, error)); ok {
 		return rf(ctx, transaction)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.Transaction) *entity.Payment); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Transaction) *entity.AcquirerResponse); ok {
 		r0 = rf(ctx, transaction)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entity.Payment)
+			r0 = ret.Get(0).(*entity.AcquirerResponse)
 		}
 	}
 
@@ -67,12 +123,123 @@ func (_c *IPaymentServiceMock_ProcessTransaction_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *IPaymentServiceMock_ProcessTransaction_Call) Return(_a0 *entity.Payment, _a1 error) *IPaymentServiceMock_ProcessTransaction_Call {
+func (_c *IPaymentServiceMock_ProcessTransaction_Call) Return(_a0 *entity.AcquirerResponse, _a1 error) *IPaymentServiceMock_ProcessTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IPaymentServiceMock_ProcessTransaction_Call) RunAndReturn(run func(context.Context, *entity.Transaction) (*entity.AcquirerResponse, error)) *IPaymentServiceMock_ProcessTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundTransaction provides a mock function with given fields: ctx, payment, refund
+func (_m *IPaymentServiceMock) RefundTransaction(ctx context.Context, payment *entity.Payment, refund *entity.Refund) (*entity.AcquirerResponse, error) {
+	ret := _m.Called(ctx, payment, refund)
+
+	var r0 *entity.AcquirerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment, *entity.Refund) (*entity.AcquirerResponse, error)); ok {
+		return rf(ctx, payment, refund)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Payment, *entity.Refund) *entity.AcquirerResponse); ok {
+		r0 = rf(ctx, payment, refund)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.AcquirerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Payment, *entity.Refund) error); ok {
+		r1 = rf(ctx, payment, refund)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IPaymentServiceMock_RefundTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundTransaction'
+type IPaymentServiceMock_RefundTransaction_Call struct {
+	*mock.Call
+}
+
+// RefundTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *entity.Payment
+//   - refund *entity.Refund
+func (_e *IPaymentServiceMock_Expecter) RefundTransaction(ctx interface{}, payment interface{}, refund interface{}) *IPaymentServiceMock_RefundTransaction_Call {
+	return &IPaymentServiceMock_RefundTransaction_Call{Call: _e.mock.On("RefundTransaction", ctx, payment, refund)}
+}
+
+func (_c *IPaymentServiceMock_RefundTransaction_Call) Run(run func(ctx context.Context, payment *entity.Payment, refund *entity.Refund)) *IPaymentServiceMock_RefundTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Payment), args[2].(*entity.Refund))
+	})
+	return _c
+}
+
+func (_c *IPaymentServiceMock_RefundTransaction_Call) Return(_a0 *entity.AcquirerResponse, _a1 error) *IPaymentServiceMock_RefundTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IPaymentServiceMock_RefundTransaction_Call) RunAndReturn(run func(context.Context, *entity.Payment, *entity.Refund) (*entity.AcquirerResponse, error)) *IPaymentServiceMock_RefundTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReverseTransaction provides a mock function with given fields: ctx, reversal
+func (_m *IPaymentServiceMock) ReverseTransaction(ctx context.Context, reversal *entity.Reversal) (*entity.AcquirerResponse, error) {
+	ret := _m.Called(ctx, reversal)
+
+	var r0 *entity.AcquirerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Reversal) (*entity.AcquirerResponse, error)); ok {
+		return rf(ctx, reversal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Reversal) *entity.AcquirerResponse); ok {
+		r0 = rf(ctx, reversal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.AcquirerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Reversal) error); ok {
+		r1 = rf(ctx, reversal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IPaymentServiceMock_ReverseTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReverseTransaction'
+type IPaymentServiceMock_ReverseTransaction_Call struct {
+	*mock.Call
+}
+
+// ReverseTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reversal *entity.Reversal
+func (_e *IPaymentServiceMock_Expecter) ReverseTransaction(ctx interface{}, reversal interface{}) *IPaymentServiceMock_ReverseTransaction_Call {
+	return &IPaymentServiceMock_ReverseTransaction_Call{Call: _e.mock.On("ReverseTransaction", ctx, reversal)}
+}
+
+func (_c *IPaymentServiceMock_ReverseTransaction_Call) Run(run func(ctx context.Context, reversal *entity.Reversal)) *IPaymentServiceMock_ReverseTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Reversal))
+	})
+	return _c
+}
+
+func (_c *IPaymentServiceMock_ReverseTransaction_Call) Return(_a0 *entity.AcquirerResponse, _a1 error) *IPaymentServiceMock_ReverseTransaction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IPaymentServiceMock_ProcessTransaction_Call) RunAndReturn(run func(context.Context, *entity.Transaction) (*entity.Payment, error)) *IPaymentServiceMock_ProcessTransaction_Call {
+func (_c *IPaymentServiceMock_ReverseTransaction_Call) RunAndReturn(run func(context.Context, *entity.Reversal) (*entity.AcquirerResponse, error)) *IPaymentServiceMock_ReverseTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
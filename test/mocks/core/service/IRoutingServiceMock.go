@@ -0,0 +1,137 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IRoutingServiceMock is an autogenerated mock type for the IRoutingService type
+type IRoutingServiceMock struct {
+	mock.Mock
+}
+
+type IRoutingServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IRoutingServiceMock) EXPECT() *IRoutingServiceMock_Expecter {
+	return &IRoutingServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// Explain provides a mock function with given fields: ctx, transaction
+func (_m *IRoutingServiceMock) Explain(ctx context.Context, transaction *entity.Transaction) *entity.Route {
+	ret := _m.Called(ctx, transaction)
+
+	var r0 *entity.Route
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Transaction) *entity.Route); ok {
+		r0 = rf(ctx, transaction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Route)
+		}
+	}
+
+	return r0
+}
+
+// IRoutingServiceMock_Explain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Explain'
+type IRoutingServiceMock_Explain_Call struct {
+	*mock.Call
+}
+
+// Explain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transaction *entity.Transaction
+func (_e *IRoutingServiceMock_Expecter) Explain(ctx interface{}, transaction interface{}) *IRoutingServiceMock_Explain_Call {
+	return &IRoutingServiceMock_Explain_Call{Call: _e.mock.On("Explain", ctx, transaction)}
+}
+
+func (_c *IRoutingServiceMock_Explain_Call) Run(run func(ctx context.Context, transaction *entity.Transaction)) *IRoutingServiceMock_Explain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Transaction))
+	})
+	return _c
+}
+
+func (_c *IRoutingServiceMock_Explain_Call) Return(_a0 *entity.Route) *IRoutingServiceMock_Explain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IRoutingServiceMock_Explain_Call) RunAndReturn(run func(context.Context, *entity.Transaction) *entity.Route) *IRoutingServiceMock_Explain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Route provides a mock function with given fields: ctx, transaction
+func (_m *IRoutingServiceMock) Route(ctx context.Context, transaction *entity.Transaction) (*entity.Route, error) {
+	ret := _m.Called(ctx, transaction)
+
+	var r0 *entity.Route
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Transaction) (*entity.Route, error)); ok {
+		return rf(ctx, transaction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Transaction) *entity.Route); ok {
+		r0 = rf(ctx, transaction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Route)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Transaction) error); ok {
+		r1 = rf(ctx, transaction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IRoutingServiceMock_Route_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Route'
+type IRoutingServiceMock_Route_Call struct {
+	*mock.Call
+}
+
+// Route is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transaction *entity.Transaction
+func (_e *IRoutingServiceMock_Expecter) Route(ctx interface{}, transaction interface{}) *IRoutingServiceMock_Route_Call {
+	return &IRoutingServiceMock_Route_Call{Call: _e.mock.On("Route", ctx, transaction)}
+}
+
+func (_c *IRoutingServiceMock_Route_Call) Run(run func(ctx context.Context, transaction *entity.Transaction)) *IRoutingServiceMock_Route_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Transaction))
+	})
+	return _c
+}
+
+func (_c *IRoutingServiceMock_Route_Call) Return(_a0 *entity.Route, _a1 error) *IRoutingServiceMock_Route_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IRoutingServiceMock_Route_Call) RunAndReturn(run func(context.Context, *entity.Transaction) (*entity.Route, error)) *IRoutingServiceMock_Route_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIRoutingServiceMock creates a new instance of IRoutingServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIRoutingServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IRoutingServiceMock {
+	mock := &IRoutingServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
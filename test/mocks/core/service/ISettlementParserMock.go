@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	io "io"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ISettlementParserMock is an autogenerated mock type for the ISettlementParser type
+type ISettlementParserMock struct {
+	mock.Mock
+}
+
+type ISettlementParserMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ISettlementParserMock) EXPECT() *ISettlementParserMock_Expecter {
+	return &ISettlementParserMock_Expecter{mock: &_m.Mock}
+}
+
+// Parse provides a mock function with given fields: format, acquirer, r
+func (_m *ISettlementParserMock) Parse(format entity.SettlementFormat, acquirer string, r io.Reader) ([]*entity.SettlementLine, error) {
+	ret := _m.Called(format, acquirer, r)
+
+	var r0 []*entity.SettlementLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entity.SettlementFormat, string, io.Reader) ([]*entity.SettlementLine, error)); ok {
+		return rf(format, acquirer, r)
+	}
+	if rf, ok := ret.Get(0).(func(entity.SettlementFormat, string, io.Reader) []*entity.SettlementLine); ok {
+		r0 = rf(format, acquirer, r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.SettlementLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(entity.SettlementFormat, string, io.Reader) error); ok {
+		r1 = rf(format, acquirer, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ISettlementParserMock_Parse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Parse'
+type ISettlementParserMock_Parse_Call struct {
+	*mock.Call
+}
+
+// Parse is a helper method to define mock.On call
+//   - format entity.SettlementFormat
+//   - acquirer string
+//   - r io.Reader
+func (_e *ISettlementParserMock_Expecter) Parse(format interface{}, acquirer interface{}, r interface{}) *ISettlementParserMock_Parse_Call {
+	return &ISettlementParserMock_Parse_Call{Call: _e.mock.On("Parse", format, acquirer, r)}
+}
+
+func (_c *ISettlementParserMock_Parse_Call) Run(run func(format entity.SettlementFormat, acquirer string, r io.Reader)) *ISettlementParserMock_Parse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entity.SettlementFormat), args[1].(string), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *ISettlementParserMock_Parse_Call) Return(_a0 []*entity.SettlementLine, _a1 error) *ISettlementParserMock_Parse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ISettlementParserMock_Parse_Call) RunAndReturn(run func(entity.SettlementFormat, string, io.Reader) ([]*entity.SettlementLine, error)) *ISettlementParserMock_Parse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewISettlementParserMock creates a new instance of ISettlementParserMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewISettlementParserMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ISettlementParserMock {
+	mock := &ISettlementParserMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,91 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-payment-processor/internal/core/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IWebhookSenderMock is an autogenerated mock type for the IWebhookSender type
+type IWebhookSenderMock struct {
+	mock.Mock
+}
+
+type IWebhookSenderMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IWebhookSenderMock) EXPECT() *IWebhookSenderMock_Expecter {
+	return &IWebhookSenderMock_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, webhook, delivery
+func (_m *IWebhookSenderMock) Send(ctx context.Context, webhook *entity.Webhook, delivery *entity.WebhookDelivery) (int, error) {
+	ret := _m.Called(ctx, webhook, delivery)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Webhook, *entity.WebhookDelivery) (int, error)); ok {
+		return rf(ctx, webhook, delivery)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Webhook, *entity.WebhookDelivery) int); ok {
+		r0 = rf(ctx, webhook, delivery)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Webhook, *entity.WebhookDelivery) error); ok {
+		r1 = rf(ctx, webhook, delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IWebhookSenderMock_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type IWebhookSenderMock_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *entity.Webhook
+//   - delivery *entity.WebhookDelivery
+func (_e *IWebhookSenderMock_Expecter) Send(ctx interface{}, webhook interface{}, delivery interface{}) *IWebhookSenderMock_Send_Call {
+	return &IWebhookSenderMock_Send_Call{Call: _e.mock.On("Send", ctx, webhook, delivery)}
+}
+
+func (_c *IWebhookSenderMock_Send_Call) Run(run func(ctx context.Context, webhook *entity.Webhook, delivery *entity.WebhookDelivery)) *IWebhookSenderMock_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Webhook), args[2].(*entity.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *IWebhookSenderMock_Send_Call) Return(_a0 int, _a1 error) *IWebhookSenderMock_Send_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IWebhookSenderMock_Send_Call) RunAndReturn(run func(context.Context, *entity.Webhook, *entity.WebhookDelivery) (int, error)) *IWebhookSenderMock_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIWebhookSenderMock creates a new instance of IWebhookSenderMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIWebhookSenderMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IWebhookSenderMock {
+	mock := &IWebhookSenderMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
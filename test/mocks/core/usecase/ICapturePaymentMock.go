@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICapturePaymentMock is an autogenerated mock type for the ICapturePayment type
+type ICapturePaymentMock struct {
+	mock.Mock
+}
+
+type ICapturePaymentMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ICapturePaymentMock) EXPECT() *ICapturePaymentMock_Expecter {
+	return &ICapturePaymentMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *ICapturePaymentMock) Execute(ctx context.Context, input *usecase.CapturePaymentInput) (*usecase.CapturePaymentOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.CapturePaymentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.CapturePaymentInput) (*usecase.CapturePaymentOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.CapturePaymentInput) *usecase.CapturePaymentOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CapturePaymentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.CapturePaymentInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICapturePaymentMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type ICapturePaymentMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.CapturePaymentInput
+func (_e *ICapturePaymentMock_Expecter) Execute(ctx interface{}, input interface{}) *ICapturePaymentMock_Execute_Call {
+	return &ICapturePaymentMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *ICapturePaymentMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.CapturePaymentInput)) *ICapturePaymentMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.CapturePaymentInput))
+	})
+	return _c
+}
+
+func (_c *ICapturePaymentMock_Execute_Call) Return(_a0 *usecase.CapturePaymentOutput, _a1 error) *ICapturePaymentMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICapturePaymentMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.CapturePaymentInput) (*usecase.CapturePaymentOutput, error)) *ICapturePaymentMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewICapturePaymentMock creates a new instance of ICapturePaymentMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICapturePaymentMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICapturePaymentMock {
+	mock := &ICapturePaymentMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
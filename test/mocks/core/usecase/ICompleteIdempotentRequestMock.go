@@ -0,0 +1,80 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICompleteIdempotentRequestMock is an autogenerated mock type for the ICompleteIdempotentRequest type
+type ICompleteIdempotentRequestMock struct {
+	mock.Mock
+}
+
+type ICompleteIdempotentRequestMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ICompleteIdempotentRequestMock) EXPECT() *ICompleteIdempotentRequestMock_Expecter {
+	return &ICompleteIdempotentRequestMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *ICompleteIdempotentRequestMock) Execute(ctx context.Context, input *usecase.CompleteIdempotentRequestInput) error {
+	ret := _m.Called(ctx, input)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.CompleteIdempotentRequestInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ICompleteIdempotentRequestMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type ICompleteIdempotentRequestMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.CompleteIdempotentRequestInput
+func (_e *ICompleteIdempotentRequestMock_Expecter) Execute(ctx interface{}, input interface{}) *ICompleteIdempotentRequestMock_Execute_Call {
+	return &ICompleteIdempotentRequestMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *ICompleteIdempotentRequestMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.CompleteIdempotentRequestInput)) *ICompleteIdempotentRequestMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.CompleteIdempotentRequestInput))
+	})
+	return _c
+}
+
+func (_c *ICompleteIdempotentRequestMock_Execute_Call) Return(_a0 error) *ICompleteIdempotentRequestMock_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ICompleteIdempotentRequestMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.CompleteIdempotentRequestInput) error) *ICompleteIdempotentRequestMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewICompleteIdempotentRequestMock creates a new instance of ICompleteIdempotentRequestMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICompleteIdempotentRequestMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICompleteIdempotentRequestMock {
+	mock := &ICompleteIdempotentRequestMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
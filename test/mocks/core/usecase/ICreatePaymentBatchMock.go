@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICreatePaymentBatchMock is an autogenerated mock type for the ICreatePaymentBatch type
+type ICreatePaymentBatchMock struct {
+	mock.Mock
+}
+
+type ICreatePaymentBatchMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ICreatePaymentBatchMock) EXPECT() *ICreatePaymentBatchMock_Expecter {
+	return &ICreatePaymentBatchMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *ICreatePaymentBatchMock) Execute(ctx context.Context, input *usecase.CreatePaymentBatchInput) (*usecase.CreatePaymentBatchOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.CreatePaymentBatchOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.CreatePaymentBatchInput) (*usecase.CreatePaymentBatchOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.CreatePaymentBatchInput) *usecase.CreatePaymentBatchOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CreatePaymentBatchOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.CreatePaymentBatchInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICreatePaymentBatchMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type ICreatePaymentBatchMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.CreatePaymentBatchInput
+func (_e *ICreatePaymentBatchMock_Expecter) Execute(ctx interface{}, input interface{}) *ICreatePaymentBatchMock_Execute_Call {
+	return &ICreatePaymentBatchMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *ICreatePaymentBatchMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.CreatePaymentBatchInput)) *ICreatePaymentBatchMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.CreatePaymentBatchInput))
+	})
+	return _c
+}
+
+func (_c *ICreatePaymentBatchMock_Execute_Call) Return(_a0 *usecase.CreatePaymentBatchOutput, _a1 error) *ICreatePaymentBatchMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICreatePaymentBatchMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.CreatePaymentBatchInput) (*usecase.CreatePaymentBatchOutput, error)) *ICreatePaymentBatchMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewICreatePaymentBatchMock creates a new instance of ICreatePaymentBatchMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICreatePaymentBatchMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICreatePaymentBatchMock {
+	mock := &ICreatePaymentBatchMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
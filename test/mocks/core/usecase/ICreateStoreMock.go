@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICreateStoreMock is an autogenerated mock type for the ICreateStore type
+type ICreateStoreMock struct {
+	mock.Mock
+}
+
+type ICreateStoreMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ICreateStoreMock) EXPECT() *ICreateStoreMock_Expecter {
+	return &ICreateStoreMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *ICreateStoreMock) Execute(ctx context.Context, input *usecase.CreateStoreInput) (*usecase.CreateStoreOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.CreateStoreOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.CreateStoreInput) (*usecase.CreateStoreOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.CreateStoreInput) *usecase.CreateStoreOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CreateStoreOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.CreateStoreInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICreateStoreMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type ICreateStoreMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.CreateStoreInput
+func (_e *ICreateStoreMock_Expecter) Execute(ctx interface{}, input interface{}) *ICreateStoreMock_Execute_Call {
+	return &ICreateStoreMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *ICreateStoreMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.CreateStoreInput)) *ICreateStoreMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.CreateStoreInput))
+	})
+	return _c
+}
+
+func (_c *ICreateStoreMock_Execute_Call) Return(_a0 *usecase.CreateStoreOutput, _a1 error) *ICreateStoreMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICreateStoreMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.CreateStoreInput) (*usecase.CreateStoreOutput, error)) *ICreateStoreMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewICreateStoreMock creates a new instance of ICreateStoreMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICreateStoreMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICreateStoreMock {
+	mock := &ICreateStoreMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
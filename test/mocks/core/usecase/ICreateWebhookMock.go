@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICreateWebhookMock is an autogenerated mock type for the ICreateWebhook type
+type ICreateWebhookMock struct {
+	mock.Mock
+}
+
+type ICreateWebhookMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ICreateWebhookMock) EXPECT() *ICreateWebhookMock_Expecter {
+	return &ICreateWebhookMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *ICreateWebhookMock) Execute(ctx context.Context, input *usecase.CreateWebhookInput) (*usecase.CreateWebhookOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.CreateWebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.CreateWebhookInput) (*usecase.CreateWebhookOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.CreateWebhookInput) *usecase.CreateWebhookOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CreateWebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.CreateWebhookInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ICreateWebhookMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type ICreateWebhookMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.CreateWebhookInput
+func (_e *ICreateWebhookMock_Expecter) Execute(ctx interface{}, input interface{}) *ICreateWebhookMock_Execute_Call {
+	return &ICreateWebhookMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *ICreateWebhookMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.CreateWebhookInput)) *ICreateWebhookMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.CreateWebhookInput))
+	})
+	return _c
+}
+
+func (_c *ICreateWebhookMock_Execute_Call) Return(_a0 *usecase.CreateWebhookOutput, _a1 error) *ICreateWebhookMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ICreateWebhookMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.CreateWebhookInput) (*usecase.CreateWebhookOutput, error)) *ICreateWebhookMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewICreateWebhookMock creates a new instance of ICreateWebhookMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICreateWebhookMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICreateWebhookMock {
+	mock := &ICreateWebhookMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
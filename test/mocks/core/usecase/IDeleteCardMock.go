@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IDeleteCardMock is an autogenerated mock type for the IDeleteCard type
+type IDeleteCardMock struct {
+	mock.Mock
+}
+
+type IDeleteCardMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IDeleteCardMock) EXPECT() *IDeleteCardMock_Expecter {
+	return &IDeleteCardMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IDeleteCardMock) Execute(ctx context.Context, input *usecase.DeleteCardInput) (*usecase.DeleteCardOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.DeleteCardOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.DeleteCardInput) (*usecase.DeleteCardOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.DeleteCardInput) *usecase.DeleteCardOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.DeleteCardOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.DeleteCardInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IDeleteCardMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IDeleteCardMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.DeleteCardInput
+func (_e *IDeleteCardMock_Expecter) Execute(ctx interface{}, input interface{}) *IDeleteCardMock_Execute_Call {
+	return &IDeleteCardMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IDeleteCardMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.DeleteCardInput)) *IDeleteCardMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.DeleteCardInput))
+	})
+	return _c
+}
+
+func (_c *IDeleteCardMock_Execute_Call) Return(_a0 *usecase.DeleteCardOutput, _a1 error) *IDeleteCardMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IDeleteCardMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.DeleteCardInput) (*usecase.DeleteCardOutput, error)) *IDeleteCardMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIDeleteCardMock creates a new instance of IDeleteCardMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIDeleteCardMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IDeleteCardMock {
+	mock := &IDeleteCardMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IDeleteStoreMock is an autogenerated mock type for the IDeleteStore type
+type IDeleteStoreMock struct {
+	mock.Mock
+}
+
+type IDeleteStoreMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IDeleteStoreMock) EXPECT() *IDeleteStoreMock_Expecter {
+	return &IDeleteStoreMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IDeleteStoreMock) Execute(ctx context.Context, input *usecase.DeleteStoreInput) (*usecase.DeleteStoreOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.DeleteStoreOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.DeleteStoreInput) (*usecase.DeleteStoreOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.DeleteStoreInput) *usecase.DeleteStoreOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.DeleteStoreOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.DeleteStoreInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IDeleteStoreMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IDeleteStoreMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.DeleteStoreInput
+func (_e *IDeleteStoreMock_Expecter) Execute(ctx interface{}, input interface{}) *IDeleteStoreMock_Execute_Call {
+	return &IDeleteStoreMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IDeleteStoreMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.DeleteStoreInput)) *IDeleteStoreMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.DeleteStoreInput))
+	})
+	return _c
+}
+
+func (_c *IDeleteStoreMock_Execute_Call) Return(_a0 *usecase.DeleteStoreOutput, _a1 error) *IDeleteStoreMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IDeleteStoreMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.DeleteStoreInput) (*usecase.DeleteStoreOutput, error)) *IDeleteStoreMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIDeleteStoreMock creates a new instance of IDeleteStoreMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIDeleteStoreMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IDeleteStoreMock {
+	mock := &IDeleteStoreMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
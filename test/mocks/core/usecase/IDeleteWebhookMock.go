@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IDeleteWebhookMock is an autogenerated mock type for the IDeleteWebhook type
+type IDeleteWebhookMock struct {
+	mock.Mock
+}
+
+type IDeleteWebhookMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IDeleteWebhookMock) EXPECT() *IDeleteWebhookMock_Expecter {
+	return &IDeleteWebhookMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IDeleteWebhookMock) Execute(ctx context.Context, input *usecase.DeleteWebhookInput) (*usecase.DeleteWebhookOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.DeleteWebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.DeleteWebhookInput) (*usecase.DeleteWebhookOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.DeleteWebhookInput) *usecase.DeleteWebhookOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.DeleteWebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.DeleteWebhookInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IDeleteWebhookMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IDeleteWebhookMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.DeleteWebhookInput
+func (_e *IDeleteWebhookMock_Expecter) Execute(ctx interface{}, input interface{}) *IDeleteWebhookMock_Execute_Call {
+	return &IDeleteWebhookMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IDeleteWebhookMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.DeleteWebhookInput)) *IDeleteWebhookMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.DeleteWebhookInput))
+	})
+	return _c
+}
+
+func (_c *IDeleteWebhookMock_Execute_Call) Return(_a0 *usecase.DeleteWebhookOutput, _a1 error) *IDeleteWebhookMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IDeleteWebhookMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.DeleteWebhookInput) (*usecase.DeleteWebhookOutput, error)) *IDeleteWebhookMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIDeleteWebhookMock creates a new instance of IDeleteWebhookMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIDeleteWebhookMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IDeleteWebhookMock {
+	mock := &IDeleteWebhookMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IDeliverWebhooksMock is an autogenerated mock type for the IDeliverWebhooks type
+type IDeliverWebhooksMock struct {
+	mock.Mock
+}
+
+type IDeliverWebhooksMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IDeliverWebhooksMock) EXPECT() *IDeliverWebhooksMock_Expecter {
+	return &IDeliverWebhooksMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IDeliverWebhooksMock) Execute(ctx context.Context, input *usecase.DeliverWebhooksInput) (*usecase.DeliverWebhooksOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.DeliverWebhooksOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.DeliverWebhooksInput) (*usecase.DeliverWebhooksOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.DeliverWebhooksInput) *usecase.DeliverWebhooksOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.DeliverWebhooksOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.DeliverWebhooksInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IDeliverWebhooksMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IDeliverWebhooksMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.DeliverWebhooksInput
+func (_e *IDeliverWebhooksMock_Expecter) Execute(ctx interface{}, input interface{}) *IDeliverWebhooksMock_Execute_Call {
+	return &IDeliverWebhooksMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IDeliverWebhooksMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.DeliverWebhooksInput)) *IDeliverWebhooksMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.DeliverWebhooksInput))
+	})
+	return _c
+}
+
+func (_c *IDeliverWebhooksMock_Execute_Call) Return(_a0 *usecase.DeliverWebhooksOutput, _a1 error) *IDeliverWebhooksMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IDeliverWebhooksMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.DeliverWebhooksInput) (*usecase.DeliverWebhooksOutput, error)) *IDeliverWebhooksMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIDeliverWebhooksMock creates a new instance of IDeliverWebhooksMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIDeliverWebhooksMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IDeliverWebhooksMock {
+	mock := &IDeliverWebhooksMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
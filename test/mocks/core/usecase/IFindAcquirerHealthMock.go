@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IFindAcquirerHealthMock is an autogenerated mock type for the IFindAcquirerHealth type
+type IFindAcquirerHealthMock struct {
+	mock.Mock
+}
+
+type IFindAcquirerHealthMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IFindAcquirerHealthMock) EXPECT() *IFindAcquirerHealthMock_Expecter {
+	return &IFindAcquirerHealthMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IFindAcquirerHealthMock) Execute(ctx context.Context, input *usecase.FindAcquirerHealthInput) (*usecase.FindAcquirerHealthOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.FindAcquirerHealthOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindAcquirerHealthInput) (*usecase.FindAcquirerHealthOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindAcquirerHealthInput) *usecase.FindAcquirerHealthOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.FindAcquirerHealthOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.FindAcquirerHealthInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IFindAcquirerHealthMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IFindAcquirerHealthMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.FindAcquirerHealthInput
+func (_e *IFindAcquirerHealthMock_Expecter) Execute(ctx interface{}, input interface{}) *IFindAcquirerHealthMock_Execute_Call {
+	return &IFindAcquirerHealthMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IFindAcquirerHealthMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.FindAcquirerHealthInput)) *IFindAcquirerHealthMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.FindAcquirerHealthInput))
+	})
+	return _c
+}
+
+func (_c *IFindAcquirerHealthMock_Execute_Call) Return(_a0 *usecase.FindAcquirerHealthOutput, _a1 error) *IFindAcquirerHealthMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IFindAcquirerHealthMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.FindAcquirerHealthInput) (*usecase.FindAcquirerHealthOutput, error)) *IFindAcquirerHealthMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIFindAcquirerHealthMock creates a new instance of IFindAcquirerHealthMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIFindAcquirerHealthMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IFindAcquirerHealthMock {
+	mock := &IFindAcquirerHealthMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IFindPaymentBatchMock is an autogenerated mock type for the IFindPaymentBatch type
+type IFindPaymentBatchMock struct {
+	mock.Mock
+}
+
+type IFindPaymentBatchMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IFindPaymentBatchMock) EXPECT() *IFindPaymentBatchMock_Expecter {
+	return &IFindPaymentBatchMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IFindPaymentBatchMock) Execute(ctx context.Context, input *usecase.FindPaymentBatchInput) (*usecase.FindPaymentBatchOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.FindPaymentBatchOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindPaymentBatchInput) (*usecase.FindPaymentBatchOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindPaymentBatchInput) *usecase.FindPaymentBatchOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.FindPaymentBatchOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.FindPaymentBatchInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IFindPaymentBatchMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IFindPaymentBatchMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.FindPaymentBatchInput
+func (_e *IFindPaymentBatchMock_Expecter) Execute(ctx interface{}, input interface{}) *IFindPaymentBatchMock_Execute_Call {
+	return &IFindPaymentBatchMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IFindPaymentBatchMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.FindPaymentBatchInput)) *IFindPaymentBatchMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.FindPaymentBatchInput))
+	})
+	return _c
+}
+
+func (_c *IFindPaymentBatchMock_Execute_Call) Return(_a0 *usecase.FindPaymentBatchOutput, _a1 error) *IFindPaymentBatchMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IFindPaymentBatchMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.FindPaymentBatchInput) (*usecase.FindPaymentBatchOutput, error)) *IFindPaymentBatchMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIFindPaymentBatchMock creates a new instance of IFindPaymentBatchMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIFindPaymentBatchMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IFindPaymentBatchMock {
+	mock := &IFindPaymentBatchMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
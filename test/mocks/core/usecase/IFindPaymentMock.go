@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IFindPaymentMock is an autogenerated mock type for the IFindPayment type
+type IFindPaymentMock struct {
+	mock.Mock
+}
+
+type IFindPaymentMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IFindPaymentMock) EXPECT() *IFindPaymentMock_Expecter {
+	return &IFindPaymentMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IFindPaymentMock) Execute(ctx context.Context, input *usecase.FindPaymentInput) (*usecase.FindPaymentOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.FindPaymentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindPaymentInput) (*usecase.FindPaymentOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindPaymentInput) *usecase.FindPaymentOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.FindPaymentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.FindPaymentInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IFindPaymentMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IFindPaymentMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.FindPaymentInput
+func (_e *IFindPaymentMock_Expecter) Execute(ctx interface{}, input interface{}) *IFindPaymentMock_Execute_Call {
+	return &IFindPaymentMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IFindPaymentMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.FindPaymentInput)) *IFindPaymentMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.FindPaymentInput))
+	})
+	return _c
+}
+
+func (_c *IFindPaymentMock_Execute_Call) Return(_a0 *usecase.FindPaymentOutput, _a1 error) *IFindPaymentMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IFindPaymentMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.FindPaymentInput) (*usecase.FindPaymentOutput, error)) *IFindPaymentMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIFindPaymentMock creates a new instance of IFindPaymentMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIFindPaymentMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IFindPaymentMock {
+	mock := &IFindPaymentMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IFindSettlementMock is an autogenerated mock type for the IFindSettlement type
+type IFindSettlementMock struct {
+	mock.Mock
+}
+
+type IFindSettlementMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IFindSettlementMock) EXPECT() *IFindSettlementMock_Expecter {
+	return &IFindSettlementMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IFindSettlementMock) Execute(ctx context.Context, input *usecase.FindSettlementInput) (*usecase.FindSettlementOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.FindSettlementOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindSettlementInput) (*usecase.FindSettlementOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindSettlementInput) *usecase.FindSettlementOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.FindSettlementOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.FindSettlementInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IFindSettlementMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IFindSettlementMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.FindSettlementInput
+func (_e *IFindSettlementMock_Expecter) Execute(ctx interface{}, input interface{}) *IFindSettlementMock_Execute_Call {
+	return &IFindSettlementMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IFindSettlementMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.FindSettlementInput)) *IFindSettlementMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.FindSettlementInput))
+	})
+	return _c
+}
+
+func (_c *IFindSettlementMock_Execute_Call) Return(_a0 *usecase.FindSettlementOutput, _a1 error) *IFindSettlementMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IFindSettlementMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.FindSettlementInput) (*usecase.FindSettlementOutput, error)) *IFindSettlementMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIFindSettlementMock creates a new instance of IFindSettlementMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIFindSettlementMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IFindSettlementMock {
+	mock := &IFindSettlementMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
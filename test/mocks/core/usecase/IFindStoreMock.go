@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IFindStoreMock is an autogenerated mock type for the IFindStore type
+type IFindStoreMock struct {
+	mock.Mock
+}
+
+type IFindStoreMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IFindStoreMock) EXPECT() *IFindStoreMock_Expecter {
+	return &IFindStoreMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IFindStoreMock) Execute(ctx context.Context, input *usecase.FindStoreInput) (*usecase.FindStoreOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.FindStoreOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindStoreInput) (*usecase.FindStoreOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindStoreInput) *usecase.FindStoreOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.FindStoreOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.FindStoreInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IFindStoreMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IFindStoreMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.FindStoreInput
+func (_e *IFindStoreMock_Expecter) Execute(ctx interface{}, input interface{}) *IFindStoreMock_Execute_Call {
+	return &IFindStoreMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IFindStoreMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.FindStoreInput)) *IFindStoreMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.FindStoreInput))
+	})
+	return _c
+}
+
+func (_c *IFindStoreMock_Execute_Call) Return(_a0 *usecase.FindStoreOutput, _a1 error) *IFindStoreMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IFindStoreMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.FindStoreInput) (*usecase.FindStoreOutput, error)) *IFindStoreMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIFindStoreMock creates a new instance of IFindStoreMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIFindStoreMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IFindStoreMock {
+	mock := &IFindStoreMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
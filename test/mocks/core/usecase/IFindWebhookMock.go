@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IFindWebhookMock is an autogenerated mock type for the IFindWebhook type
+type IFindWebhookMock struct {
+	mock.Mock
+}
+
+type IFindWebhookMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IFindWebhookMock) EXPECT() *IFindWebhookMock_Expecter {
+	return &IFindWebhookMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IFindWebhookMock) Execute(ctx context.Context, input *usecase.FindWebhookInput) (*usecase.FindWebhookOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.FindWebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindWebhookInput) (*usecase.FindWebhookOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.FindWebhookInput) *usecase.FindWebhookOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.FindWebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.FindWebhookInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IFindWebhookMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IFindWebhookMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.FindWebhookInput
+func (_e *IFindWebhookMock_Expecter) Execute(ctx interface{}, input interface{}) *IFindWebhookMock_Execute_Call {
+	return &IFindWebhookMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IFindWebhookMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.FindWebhookInput)) *IFindWebhookMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.FindWebhookInput))
+	})
+	return _c
+}
+
+func (_c *IFindWebhookMock_Execute_Call) Return(_a0 *usecase.FindWebhookOutput, _a1 error) *IFindWebhookMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IFindWebhookMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.FindWebhookInput) (*usecase.FindWebhookOutput, error)) *IFindWebhookMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIFindWebhookMock creates a new instance of IFindWebhookMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIFindWebhookMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IFindWebhookMock {
+	mock := &IFindWebhookMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
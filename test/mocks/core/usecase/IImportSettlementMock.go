@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IImportSettlementMock is an autogenerated mock type for the IImportSettlement type
+type IImportSettlementMock struct {
+	mock.Mock
+}
+
+type IImportSettlementMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IImportSettlementMock) EXPECT() *IImportSettlementMock_Expecter {
+	return &IImportSettlementMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IImportSettlementMock) Execute(ctx context.Context, input *usecase.ImportSettlementInput) (*usecase.ImportSettlementOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.ImportSettlementOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ImportSettlementInput) (*usecase.ImportSettlementOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ImportSettlementInput) *usecase.ImportSettlementOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ImportSettlementOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.ImportSettlementInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IImportSettlementMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IImportSettlementMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.ImportSettlementInput
+func (_e *IImportSettlementMock_Expecter) Execute(ctx interface{}, input interface{}) *IImportSettlementMock_Execute_Call {
+	return &IImportSettlementMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IImportSettlementMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.ImportSettlementInput)) *IImportSettlementMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.ImportSettlementInput))
+	})
+	return _c
+}
+
+func (_c *IImportSettlementMock_Execute_Call) Return(_a0 *usecase.ImportSettlementOutput, _a1 error) *IImportSettlementMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IImportSettlementMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.ImportSettlementInput) (*usecase.ImportSettlementOutput, error)) *IImportSettlementMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIImportSettlementMock creates a new instance of IImportSettlementMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIImportSettlementMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IImportSettlementMock {
+	mock := &IImportSettlementMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IListStoresMock is an autogenerated mock type for the IListStores type
+type IListStoresMock struct {
+	mock.Mock
+}
+
+type IListStoresMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IListStoresMock) EXPECT() *IListStoresMock_Expecter {
+	return &IListStoresMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IListStoresMock) Execute(ctx context.Context, input *usecase.ListStoresInput) (*usecase.ListStoresOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.ListStoresOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ListStoresInput) (*usecase.ListStoresOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ListStoresInput) *usecase.ListStoresOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListStoresOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.ListStoresInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IListStoresMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IListStoresMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.ListStoresInput
+func (_e *IListStoresMock_Expecter) Execute(ctx interface{}, input interface{}) *IListStoresMock_Execute_Call {
+	return &IListStoresMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IListStoresMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.ListStoresInput)) *IListStoresMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.ListStoresInput))
+	})
+	return _c
+}
+
+func (_c *IListStoresMock_Execute_Call) Return(_a0 *usecase.ListStoresOutput, _a1 error) *IListStoresMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IListStoresMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.ListStoresInput) (*usecase.ListStoresOutput, error)) *IListStoresMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIListStoresMock creates a new instance of IListStoresMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIListStoresMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IListStoresMock {
+	mock := &IListStoresMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
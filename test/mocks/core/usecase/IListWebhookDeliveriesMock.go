@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IListWebhookDeliveriesMock is an autogenerated mock type for the IListWebhookDeliveries type
+type IListWebhookDeliveriesMock struct {
+	mock.Mock
+}
+
+type IListWebhookDeliveriesMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IListWebhookDeliveriesMock) EXPECT() *IListWebhookDeliveriesMock_Expecter {
+	return &IListWebhookDeliveriesMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IListWebhookDeliveriesMock) Execute(ctx context.Context, input *usecase.ListWebhookDeliveriesInput) (*usecase.ListWebhookDeliveriesOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.ListWebhookDeliveriesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ListWebhookDeliveriesInput) (*usecase.ListWebhookDeliveriesOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ListWebhookDeliveriesInput) *usecase.ListWebhookDeliveriesOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListWebhookDeliveriesOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.ListWebhookDeliveriesInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IListWebhookDeliveriesMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IListWebhookDeliveriesMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.ListWebhookDeliveriesInput
+func (_e *IListWebhookDeliveriesMock_Expecter) Execute(ctx interface{}, input interface{}) *IListWebhookDeliveriesMock_Execute_Call {
+	return &IListWebhookDeliveriesMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IListWebhookDeliveriesMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.ListWebhookDeliveriesInput)) *IListWebhookDeliveriesMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.ListWebhookDeliveriesInput))
+	})
+	return _c
+}
+
+func (_c *IListWebhookDeliveriesMock_Execute_Call) Return(_a0 *usecase.ListWebhookDeliveriesOutput, _a1 error) *IListWebhookDeliveriesMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IListWebhookDeliveriesMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.ListWebhookDeliveriesInput) (*usecase.ListWebhookDeliveriesOutput, error)) *IListWebhookDeliveriesMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIListWebhookDeliveriesMock creates a new instance of IListWebhookDeliveriesMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIListWebhookDeliveriesMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IListWebhookDeliveriesMock {
+	mock := &IListWebhookDeliveriesMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
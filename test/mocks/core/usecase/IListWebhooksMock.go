@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IListWebhooksMock is an autogenerated mock type for the IListWebhooks type
+type IListWebhooksMock struct {
+	mock.Mock
+}
+
+type IListWebhooksMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IListWebhooksMock) EXPECT() *IListWebhooksMock_Expecter {
+	return &IListWebhooksMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IListWebhooksMock) Execute(ctx context.Context, input *usecase.ListWebhooksInput) (*usecase.ListWebhooksOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.ListWebhooksOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ListWebhooksInput) (*usecase.ListWebhooksOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ListWebhooksInput) *usecase.ListWebhooksOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListWebhooksOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.ListWebhooksInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IListWebhooksMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IListWebhooksMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.ListWebhooksInput
+func (_e *IListWebhooksMock_Expecter) Execute(ctx interface{}, input interface{}) *IListWebhooksMock_Execute_Call {
+	return &IListWebhooksMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IListWebhooksMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.ListWebhooksInput)) *IListWebhooksMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.ListWebhooksInput))
+	})
+	return _c
+}
+
+func (_c *IListWebhooksMock_Execute_Call) Return(_a0 *usecase.ListWebhooksOutput, _a1 error) *IListWebhooksMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IListWebhooksMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.ListWebhooksInput) (*usecase.ListWebhooksOutput, error)) *IListWebhooksMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIListWebhooksMock creates a new instance of IListWebhooksMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIListWebhooksMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IListWebhooksMock {
+	mock := &IListWebhooksMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IProcessPaymentBatchesMock is an autogenerated mock type for the IProcessPaymentBatches type
+type IProcessPaymentBatchesMock struct {
+	mock.Mock
+}
+
+type IProcessPaymentBatchesMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IProcessPaymentBatchesMock) EXPECT() *IProcessPaymentBatchesMock_Expecter {
+	return &IProcessPaymentBatchesMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IProcessPaymentBatchesMock) Execute(ctx context.Context, input *usecase.ProcessPaymentBatchesInput) (*usecase.ProcessPaymentBatchesOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.ProcessPaymentBatchesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ProcessPaymentBatchesInput) (*usecase.ProcessPaymentBatchesOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ProcessPaymentBatchesInput) *usecase.ProcessPaymentBatchesOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ProcessPaymentBatchesOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.ProcessPaymentBatchesInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IProcessPaymentBatchesMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IProcessPaymentBatchesMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.ProcessPaymentBatchesInput
+func (_e *IProcessPaymentBatchesMock_Expecter) Execute(ctx interface{}, input interface{}) *IProcessPaymentBatchesMock_Execute_Call {
+	return &IProcessPaymentBatchesMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IProcessPaymentBatchesMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.ProcessPaymentBatchesInput)) *IProcessPaymentBatchesMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.ProcessPaymentBatchesInput))
+	})
+	return _c
+}
+
+func (_c *IProcessPaymentBatchesMock_Execute_Call) Return(_a0 *usecase.ProcessPaymentBatchesOutput, _a1 error) *IProcessPaymentBatchesMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IProcessPaymentBatchesMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.ProcessPaymentBatchesInput) (*usecase.ProcessPaymentBatchesOutput, error)) *IProcessPaymentBatchesMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIProcessPaymentBatchesMock creates a new instance of IProcessPaymentBatchesMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIProcessPaymentBatchesMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IProcessPaymentBatchesMock {
+	mock := &IProcessPaymentBatchesMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IProcessQueuedPaymentsMock is an autogenerated mock type for the IProcessQueuedPayments type
+type IProcessQueuedPaymentsMock struct {
+	mock.Mock
+}
+
+type IProcessQueuedPaymentsMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IProcessQueuedPaymentsMock) EXPECT() *IProcessQueuedPaymentsMock_Expecter {
+	return &IProcessQueuedPaymentsMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IProcessQueuedPaymentsMock) Execute(ctx context.Context, input *usecase.ProcessQueuedPaymentsInput) (*usecase.ProcessQueuedPaymentsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.ProcessQueuedPaymentsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ProcessQueuedPaymentsInput) (*usecase.ProcessQueuedPaymentsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ProcessQueuedPaymentsInput) *usecase.ProcessQueuedPaymentsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ProcessQueuedPaymentsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.ProcessQueuedPaymentsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IProcessQueuedPaymentsMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IProcessQueuedPaymentsMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.ProcessQueuedPaymentsInput
+func (_e *IProcessQueuedPaymentsMock_Expecter) Execute(ctx interface{}, input interface{}) *IProcessQueuedPaymentsMock_Execute_Call {
+	return &IProcessQueuedPaymentsMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IProcessQueuedPaymentsMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.ProcessQueuedPaymentsInput)) *IProcessQueuedPaymentsMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.ProcessQueuedPaymentsInput))
+	})
+	return _c
+}
+
+func (_c *IProcessQueuedPaymentsMock_Execute_Call) Return(_a0 *usecase.ProcessQueuedPaymentsOutput, _a1 error) *IProcessQueuedPaymentsMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IProcessQueuedPaymentsMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.ProcessQueuedPaymentsInput) (*usecase.ProcessQueuedPaymentsOutput, error)) *IProcessQueuedPaymentsMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIProcessQueuedPaymentsMock creates a new instance of IProcessQueuedPaymentsMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIProcessQueuedPaymentsMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IProcessQueuedPaymentsMock {
+	mock := &IProcessQueuedPaymentsMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
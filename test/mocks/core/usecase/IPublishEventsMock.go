@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IPublishEventsMock is an autogenerated mock type for the IPublishEvents type
+type IPublishEventsMock struct {
+	mock.Mock
+}
+
+type IPublishEventsMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IPublishEventsMock) EXPECT() *IPublishEventsMock_Expecter {
+	return &IPublishEventsMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IPublishEventsMock) Execute(ctx context.Context, input *usecase.PublishEventsInput) (*usecase.PublishEventsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.PublishEventsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.PublishEventsInput) (*usecase.PublishEventsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.PublishEventsInput) *usecase.PublishEventsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.PublishEventsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.PublishEventsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IPublishEventsMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IPublishEventsMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.PublishEventsInput
+func (_e *IPublishEventsMock_Expecter) Execute(ctx interface{}, input interface{}) *IPublishEventsMock_Execute_Call {
+	return &IPublishEventsMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IPublishEventsMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.PublishEventsInput)) *IPublishEventsMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.PublishEventsInput))
+	})
+	return _c
+}
+
+func (_c *IPublishEventsMock_Execute_Call) Return(_a0 *usecase.PublishEventsOutput, _a1 error) *IPublishEventsMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IPublishEventsMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.PublishEventsInput) (*usecase.PublishEventsOutput, error)) *IPublishEventsMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIPublishEventsMock creates a new instance of IPublishEventsMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIPublishEventsMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IPublishEventsMock {
+	mock := &IPublishEventsMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IRedeliverWebhookMock is an autogenerated mock type for the IRedeliverWebhook type
+type IRedeliverWebhookMock struct {
+	mock.Mock
+}
+
+type IRedeliverWebhookMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IRedeliverWebhookMock) EXPECT() *IRedeliverWebhookMock_Expecter {
+	return &IRedeliverWebhookMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IRedeliverWebhookMock) Execute(ctx context.Context, input *usecase.RedeliverWebhookInput) (*usecase.RedeliverWebhookOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.RedeliverWebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.RedeliverWebhookInput) (*usecase.RedeliverWebhookOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.RedeliverWebhookInput) *usecase.RedeliverWebhookOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.RedeliverWebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.RedeliverWebhookInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IRedeliverWebhookMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IRedeliverWebhookMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.RedeliverWebhookInput
+func (_e *IRedeliverWebhookMock_Expecter) Execute(ctx interface{}, input interface{}) *IRedeliverWebhookMock_Execute_Call {
+	return &IRedeliverWebhookMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IRedeliverWebhookMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.RedeliverWebhookInput)) *IRedeliverWebhookMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.RedeliverWebhookInput))
+	})
+	return _c
+}
+
+func (_c *IRedeliverWebhookMock_Execute_Call) Return(_a0 *usecase.RedeliverWebhookOutput, _a1 error) *IRedeliverWebhookMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IRedeliverWebhookMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.RedeliverWebhookInput) (*usecase.RedeliverWebhookOutput, error)) *IRedeliverWebhookMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIRedeliverWebhookMock creates a new instance of IRedeliverWebhookMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIRedeliverWebhookMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IRedeliverWebhookMock {
+	mock := &IRedeliverWebhookMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IReencryptCardsMock is an autogenerated mock type for the IReencryptCards type
+type IReencryptCardsMock struct {
+	mock.Mock
+}
+
+type IReencryptCardsMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IReencryptCardsMock) EXPECT() *IReencryptCardsMock_Expecter {
+	return &IReencryptCardsMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IReencryptCardsMock) Execute(ctx context.Context, input *usecase.ReencryptCardsInput) (*usecase.ReencryptCardsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.ReencryptCardsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ReencryptCardsInput) (*usecase.ReencryptCardsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ReencryptCardsInput) *usecase.ReencryptCardsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ReencryptCardsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.ReencryptCardsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IReencryptCardsMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IReencryptCardsMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.ReencryptCardsInput
+func (_e *IReencryptCardsMock_Expecter) Execute(ctx interface{}, input interface{}) *IReencryptCardsMock_Execute_Call {
+	return &IReencryptCardsMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IReencryptCardsMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.ReencryptCardsInput)) *IReencryptCardsMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.ReencryptCardsInput))
+	})
+	return _c
+}
+
+func (_c *IReencryptCardsMock_Execute_Call) Return(_a0 *usecase.ReencryptCardsOutput, _a1 error) *IReencryptCardsMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IReencryptCardsMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.ReencryptCardsInput) (*usecase.ReencryptCardsOutput, error)) *IReencryptCardsMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIReencryptCardsMock creates a new instance of IReencryptCardsMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIReencryptCardsMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IReencryptCardsMock {
+	mock := &IReencryptCardsMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IRefundPaymentMock is an autogenerated mock type for the IRefundPayment type
+type IRefundPaymentMock struct {
+	mock.Mock
+}
+
+type IRefundPaymentMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IRefundPaymentMock) EXPECT() *IRefundPaymentMock_Expecter {
+	return &IRefundPaymentMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IRefundPaymentMock) Execute(ctx context.Context, input *usecase.RefundPaymentInput) (*usecase.RefundPaymentOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.RefundPaymentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.RefundPaymentInput) (*usecase.RefundPaymentOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.RefundPaymentInput) *usecase.RefundPaymentOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.RefundPaymentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.RefundPaymentInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IRefundPaymentMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IRefundPaymentMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.RefundPaymentInput
+func (_e *IRefundPaymentMock_Expecter) Execute(ctx interface{}, input interface{}) *IRefundPaymentMock_Execute_Call {
+	return &IRefundPaymentMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IRefundPaymentMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.RefundPaymentInput)) *IRefundPaymentMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.RefundPaymentInput))
+	})
+	return _c
+}
+
+func (_c *IRefundPaymentMock_Execute_Call) Return(_a0 *usecase.RefundPaymentOutput, _a1 error) *IRefundPaymentMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IRefundPaymentMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.RefundPaymentInput) (*usecase.RefundPaymentOutput, error)) *IRefundPaymentMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIRefundPaymentMock creates a new instance of IRefundPaymentMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIRefundPaymentMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IRefundPaymentMock {
+	mock := &IRefundPaymentMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
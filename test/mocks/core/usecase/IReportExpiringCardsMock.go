@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IReportExpiringCardsMock is an autogenerated mock type for the IReportExpiringCards type
+type IReportExpiringCardsMock struct {
+	mock.Mock
+}
+
+type IReportExpiringCardsMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IReportExpiringCardsMock) EXPECT() *IReportExpiringCardsMock_Expecter {
+	return &IReportExpiringCardsMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IReportExpiringCardsMock) Execute(ctx context.Context, input *usecase.ReportExpiringCardsInput) (*usecase.ReportExpiringCardsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.ReportExpiringCardsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ReportExpiringCardsInput) (*usecase.ReportExpiringCardsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ReportExpiringCardsInput) *usecase.ReportExpiringCardsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ReportExpiringCardsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.ReportExpiringCardsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IReportExpiringCardsMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IReportExpiringCardsMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.ReportExpiringCardsInput
+func (_e *IReportExpiringCardsMock_Expecter) Execute(ctx interface{}, input interface{}) *IReportExpiringCardsMock_Execute_Call {
+	return &IReportExpiringCardsMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IReportExpiringCardsMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.ReportExpiringCardsInput)) *IReportExpiringCardsMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.ReportExpiringCardsInput))
+	})
+	return _c
+}
+
+func (_c *IReportExpiringCardsMock_Execute_Call) Return(_a0 *usecase.ReportExpiringCardsOutput, _a1 error) *IReportExpiringCardsMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IReportExpiringCardsMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.ReportExpiringCardsInput) (*usecase.ReportExpiringCardsOutput, error)) *IReportExpiringCardsMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIReportExpiringCardsMock creates a new instance of IReportExpiringCardsMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIReportExpiringCardsMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IReportExpiringCardsMock {
+	mock := &IReportExpiringCardsMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
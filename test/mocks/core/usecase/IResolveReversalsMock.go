@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IResolveReversalsMock is an autogenerated mock type for the IResolveReversals type
+type IResolveReversalsMock struct {
+	mock.Mock
+}
+
+type IResolveReversalsMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IResolveReversalsMock) EXPECT() *IResolveReversalsMock_Expecter {
+	return &IResolveReversalsMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IResolveReversalsMock) Execute(ctx context.Context, input *usecase.ResolveReversalsInput) (*usecase.ResolveReversalsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.ResolveReversalsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ResolveReversalsInput) (*usecase.ResolveReversalsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.ResolveReversalsInput) *usecase.ResolveReversalsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ResolveReversalsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.ResolveReversalsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IResolveReversalsMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IResolveReversalsMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.ResolveReversalsInput
+func (_e *IResolveReversalsMock_Expecter) Execute(ctx interface{}, input interface{}) *IResolveReversalsMock_Execute_Call {
+	return &IResolveReversalsMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IResolveReversalsMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.ResolveReversalsInput)) *IResolveReversalsMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.ResolveReversalsInput))
+	})
+	return _c
+}
+
+func (_c *IResolveReversalsMock_Execute_Call) Return(_a0 *usecase.ResolveReversalsOutput, _a1 error) *IResolveReversalsMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IResolveReversalsMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.ResolveReversalsInput) (*usecase.ResolveReversalsOutput, error)) *IResolveReversalsMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIResolveReversalsMock creates a new instance of IResolveReversalsMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIResolveReversalsMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IResolveReversalsMock {
+	mock := &IResolveReversalsMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
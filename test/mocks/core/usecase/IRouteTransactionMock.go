@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IRouteTransactionMock is an autogenerated mock type for the IRouteTransaction type
+type IRouteTransactionMock struct {
+	mock.Mock
+}
+
+type IRouteTransactionMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IRouteTransactionMock) EXPECT() *IRouteTransactionMock_Expecter {
+	return &IRouteTransactionMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IRouteTransactionMock) Execute(ctx context.Context, input *usecase.RouteTransactionInput) (*usecase.RouteTransactionOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.RouteTransactionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.RouteTransactionInput) (*usecase.RouteTransactionOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.RouteTransactionInput) *usecase.RouteTransactionOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.RouteTransactionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.RouteTransactionInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IRouteTransactionMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IRouteTransactionMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.RouteTransactionInput
+func (_e *IRouteTransactionMock_Expecter) Execute(ctx interface{}, input interface{}) *IRouteTransactionMock_Execute_Call {
+	return &IRouteTransactionMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IRouteTransactionMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.RouteTransactionInput)) *IRouteTransactionMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.RouteTransactionInput))
+	})
+	return _c
+}
+
+func (_c *IRouteTransactionMock_Execute_Call) Return(_a0 *usecase.RouteTransactionOutput, _a1 error) *IRouteTransactionMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IRouteTransactionMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.RouteTransactionInput) (*usecase.RouteTransactionOutput, error)) *IRouteTransactionMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIRouteTransactionMock creates a new instance of IRouteTransactionMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIRouteTransactionMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IRouteTransactionMock {
+	mock := &IRouteTransactionMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
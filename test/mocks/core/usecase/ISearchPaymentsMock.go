@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ISearchPaymentsMock is an autogenerated mock type for the ISearchPayments type
+type ISearchPaymentsMock struct {
+	mock.Mock
+}
+
+type ISearchPaymentsMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ISearchPaymentsMock) EXPECT() *ISearchPaymentsMock_Expecter {
+	return &ISearchPaymentsMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *ISearchPaymentsMock) Execute(ctx context.Context, input *usecase.SearchPaymentsInput) (*usecase.SearchPaymentsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.SearchPaymentsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.SearchPaymentsInput) (*usecase.SearchPaymentsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.SearchPaymentsInput) *usecase.SearchPaymentsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.SearchPaymentsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.SearchPaymentsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ISearchPaymentsMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type ISearchPaymentsMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.SearchPaymentsInput
+func (_e *ISearchPaymentsMock_Expecter) Execute(ctx interface{}, input interface{}) *ISearchPaymentsMock_Execute_Call {
+	return &ISearchPaymentsMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *ISearchPaymentsMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.SearchPaymentsInput)) *ISearchPaymentsMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.SearchPaymentsInput))
+	})
+	return _c
+}
+
+func (_c *ISearchPaymentsMock_Execute_Call) Return(_a0 *usecase.SearchPaymentsOutput, _a1 error) *ISearchPaymentsMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ISearchPaymentsMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.SearchPaymentsInput) (*usecase.SearchPaymentsOutput, error)) *ISearchPaymentsMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewISearchPaymentsMock creates a new instance of ISearchPaymentsMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewISearchPaymentsMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ISearchPaymentsMock {
+	mock := &ISearchPaymentsMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
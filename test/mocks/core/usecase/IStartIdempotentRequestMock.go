@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IStartIdempotentRequestMock is an autogenerated mock type for the IStartIdempotentRequest type
+type IStartIdempotentRequestMock struct {
+	mock.Mock
+}
+
+type IStartIdempotentRequestMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IStartIdempotentRequestMock) EXPECT() *IStartIdempotentRequestMock_Expecter {
+	return &IStartIdempotentRequestMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IStartIdempotentRequestMock) Execute(ctx context.Context, input *usecase.StartIdempotentRequestInput) (*usecase.StartIdempotentRequestOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.StartIdempotentRequestOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.StartIdempotentRequestInput) (*usecase.StartIdempotentRequestOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.StartIdempotentRequestInput) *usecase.StartIdempotentRequestOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.StartIdempotentRequestOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.StartIdempotentRequestInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IStartIdempotentRequestMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IStartIdempotentRequestMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.StartIdempotentRequestInput
+func (_e *IStartIdempotentRequestMock_Expecter) Execute(ctx interface{}, input interface{}) *IStartIdempotentRequestMock_Execute_Call {
+	return &IStartIdempotentRequestMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IStartIdempotentRequestMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.StartIdempotentRequestInput)) *IStartIdempotentRequestMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.StartIdempotentRequestInput))
+	})
+	return _c
+}
+
+func (_c *IStartIdempotentRequestMock_Execute_Call) Return(_a0 *usecase.StartIdempotentRequestOutput, _a1 error) *IStartIdempotentRequestMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IStartIdempotentRequestMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.StartIdempotentRequestInput) (*usecase.StartIdempotentRequestOutput, error)) *IStartIdempotentRequestMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIStartIdempotentRequestMock creates a new instance of IStartIdempotentRequestMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIStartIdempotentRequestMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IStartIdempotentRequestMock {
+	mock := &IStartIdempotentRequestMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
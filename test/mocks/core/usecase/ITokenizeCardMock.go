@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ITokenizeCardMock is an autogenerated mock type for the ITokenizeCard type
+type ITokenizeCardMock struct {
+	mock.Mock
+}
+
+type ITokenizeCardMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ITokenizeCardMock) EXPECT() *ITokenizeCardMock_Expecter {
+	return &ITokenizeCardMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *ITokenizeCardMock) Execute(ctx context.Context, input *usecase.TokenizeCardInput) (*usecase.TokenizeCardOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.TokenizeCardOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.TokenizeCardInput) (*usecase.TokenizeCardOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.TokenizeCardInput) *usecase.TokenizeCardOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.TokenizeCardOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.TokenizeCardInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ITokenizeCardMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type ITokenizeCardMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.TokenizeCardInput
+func (_e *ITokenizeCardMock_Expecter) Execute(ctx interface{}, input interface{}) *ITokenizeCardMock_Execute_Call {
+	return &ITokenizeCardMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *ITokenizeCardMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.TokenizeCardInput)) *ITokenizeCardMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.TokenizeCardInput))
+	})
+	return _c
+}
+
+func (_c *ITokenizeCardMock_Execute_Call) Return(_a0 *usecase.TokenizeCardOutput, _a1 error) *ITokenizeCardMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ITokenizeCardMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.TokenizeCardInput) (*usecase.TokenizeCardOutput, error)) *ITokenizeCardMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewITokenizeCardMock creates a new instance of ITokenizeCardMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewITokenizeCardMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ITokenizeCardMock {
+	mock := &ITokenizeCardMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
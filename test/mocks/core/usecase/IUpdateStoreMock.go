@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IUpdateStoreMock is an autogenerated mock type for the IUpdateStore type
+type IUpdateStoreMock struct {
+	mock.Mock
+}
+
+type IUpdateStoreMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IUpdateStoreMock) EXPECT() *IUpdateStoreMock_Expecter {
+	return &IUpdateStoreMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IUpdateStoreMock) Execute(ctx context.Context, input *usecase.UpdateStoreInput) (*usecase.UpdateStoreOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.UpdateStoreOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.UpdateStoreInput) (*usecase.UpdateStoreOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.UpdateStoreInput) *usecase.UpdateStoreOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.UpdateStoreOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.UpdateStoreInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IUpdateStoreMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IUpdateStoreMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.UpdateStoreInput
+func (_e *IUpdateStoreMock_Expecter) Execute(ctx interface{}, input interface{}) *IUpdateStoreMock_Execute_Call {
+	return &IUpdateStoreMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IUpdateStoreMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.UpdateStoreInput)) *IUpdateStoreMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.UpdateStoreInput))
+	})
+	return _c
+}
+
+func (_c *IUpdateStoreMock_Execute_Call) Return(_a0 *usecase.UpdateStoreOutput, _a1 error) *IUpdateStoreMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IUpdateStoreMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.UpdateStoreInput) (*usecase.UpdateStoreOutput, error)) *IUpdateStoreMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIUpdateStoreMock creates a new instance of IUpdateStoreMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIUpdateStoreMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IUpdateStoreMock {
+	mock := &IUpdateStoreMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package usecase
+
+import (
+	context "context"
+
+	usecase "github.com/sesaquecruz/go-payment-processor/internal/core/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IUpdateWebhookMock is an autogenerated mock type for the IUpdateWebhook type
+type IUpdateWebhookMock struct {
+	mock.Mock
+}
+
+type IUpdateWebhookMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IUpdateWebhookMock) EXPECT() *IUpdateWebhookMock_Expecter {
+	return &IUpdateWebhookMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, input
+func (_m *IUpdateWebhookMock) Execute(ctx context.Context, input *usecase.UpdateWebhookInput) (*usecase.UpdateWebhookOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 *usecase.UpdateWebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.UpdateWebhookInput) (*usecase.UpdateWebhookOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *usecase.UpdateWebhookInput) *usecase.UpdateWebhookOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.UpdateWebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *usecase.UpdateWebhookInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IUpdateWebhookMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type IUpdateWebhookMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input *usecase.UpdateWebhookInput
+func (_e *IUpdateWebhookMock_Expecter) Execute(ctx interface{}, input interface{}) *IUpdateWebhookMock_Execute_Call {
+	return &IUpdateWebhookMock_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *IUpdateWebhookMock_Execute_Call) Run(run func(ctx context.Context, input *usecase.UpdateWebhookInput)) *IUpdateWebhookMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*usecase.UpdateWebhookInput))
+	})
+	return _c
+}
+
+func (_c *IUpdateWebhookMock_Execute_Call) Return(_a0 *usecase.UpdateWebhookOutput, _a1 error) *IUpdateWebhookMock_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IUpdateWebhookMock_Execute_Call) RunAndReturn(run func(context.Context, *usecase.UpdateWebhookInput) (*usecase.UpdateWebhookOutput, error)) *IUpdateWebhookMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIUpdateWebhookMock creates a new instance of IUpdateWebhookMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIUpdateWebhookMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IUpdateWebhookMock {
+	mock := &IUpdateWebhookMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,120 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IAcquirerHandlerMock is an autogenerated mock type for the IAcquirerHandler type
+type IAcquirerHandlerMock struct {
+	mock.Mock
+}
+
+type IAcquirerHandlerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IAcquirerHandlerMock) EXPECT() *IAcquirerHandlerMock_Expecter {
+	return &IAcquirerHandlerMock_Expecter{mock: &_m.Mock}
+}
+
+// AcquirerHealth provides a mock function with given fields: c
+func (_m *IAcquirerHandlerMock) AcquirerHealth(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IAcquirerHandlerMock_AcquirerHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquirerHealth'
+type IAcquirerHandlerMock_AcquirerHealth_Call struct {
+	*mock.Call
+}
+
+// AcquirerHealth is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IAcquirerHandlerMock_Expecter) AcquirerHealth(c interface{}) *IAcquirerHandlerMock_AcquirerHealth_Call {
+	return &IAcquirerHandlerMock_AcquirerHealth_Call{Call: _e.mock.On("AcquirerHealth", c)}
+}
+
+func (_c *IAcquirerHandlerMock_AcquirerHealth_Call) Run(run func(c *fiber.Ctx)) *IAcquirerHandlerMock_AcquirerHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IAcquirerHandlerMock_AcquirerHealth_Call) Return(_a0 error) *IAcquirerHandlerMock_AcquirerHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IAcquirerHandlerMock_AcquirerHealth_Call) RunAndReturn(run func(*fiber.Ctx) error) *IAcquirerHandlerMock_AcquirerHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Metrics provides a mock function with given fields: c
+func (_m *IAcquirerHandlerMock) Metrics(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IAcquirerHandlerMock_Metrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Metrics'
+type IAcquirerHandlerMock_Metrics_Call struct {
+	*mock.Call
+}
+
+// Metrics is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IAcquirerHandlerMock_Expecter) Metrics(c interface{}) *IAcquirerHandlerMock_Metrics_Call {
+	return &IAcquirerHandlerMock_Metrics_Call{Call: _e.mock.On("Metrics", c)}
+}
+
+func (_c *IAcquirerHandlerMock_Metrics_Call) Run(run func(c *fiber.Ctx)) *IAcquirerHandlerMock_Metrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IAcquirerHandlerMock_Metrics_Call) Return(_a0 error) *IAcquirerHandlerMock_Metrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IAcquirerHandlerMock_Metrics_Call) RunAndReturn(run func(*fiber.Ctx) error) *IAcquirerHandlerMock_Metrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIAcquirerHandlerMock creates a new instance of IAcquirerHandlerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIAcquirerHandlerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IAcquirerHandlerMock {
+	mock := &IAcquirerHandlerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,120 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICardHandlerMock is an autogenerated mock type for the ICardHandler type
+type ICardHandlerMock struct {
+	mock.Mock
+}
+
+type ICardHandlerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ICardHandlerMock) EXPECT() *ICardHandlerMock_Expecter {
+	return &ICardHandlerMock_Expecter{mock: &_m.Mock}
+}
+
+// DeleteCard provides a mock function with given fields: c
+func (_m *ICardHandlerMock) DeleteCard(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ICardHandlerMock_DeleteCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCard'
+type ICardHandlerMock_DeleteCard_Call struct {
+	*mock.Call
+}
+
+// DeleteCard is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *ICardHandlerMock_Expecter) DeleteCard(c interface{}) *ICardHandlerMock_DeleteCard_Call {
+	return &ICardHandlerMock_DeleteCard_Call{Call: _e.mock.On("DeleteCard", c)}
+}
+
+func (_c *ICardHandlerMock_DeleteCard_Call) Run(run func(c *fiber.Ctx)) *ICardHandlerMock_DeleteCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *ICardHandlerMock_DeleteCard_Call) Return(_a0 error) *ICardHandlerMock_DeleteCard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ICardHandlerMock_DeleteCard_Call) RunAndReturn(run func(*fiber.Ctx) error) *ICardHandlerMock_DeleteCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TokenizeCard provides a mock function with given fields: c
+func (_m *ICardHandlerMock) TokenizeCard(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ICardHandlerMock_TokenizeCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TokenizeCard'
+type ICardHandlerMock_TokenizeCard_Call struct {
+	*mock.Call
+}
+
+// TokenizeCard is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *ICardHandlerMock_Expecter) TokenizeCard(c interface{}) *ICardHandlerMock_TokenizeCard_Call {
+	return &ICardHandlerMock_TokenizeCard_Call{Call: _e.mock.On("TokenizeCard", c)}
+}
+
+func (_c *ICardHandlerMock_TokenizeCard_Call) Run(run func(c *fiber.Ctx)) *ICardHandlerMock_TokenizeCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *ICardHandlerMock_TokenizeCard_Call) Return(_a0 error) *ICardHandlerMock_TokenizeCard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ICardHandlerMock_TokenizeCard_Call) RunAndReturn(run func(*fiber.Ctx) error) *ICardHandlerMock_TokenizeCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewICardHandlerMock creates a new instance of ICardHandlerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICardHandlerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICardHandlerMock {
+	mock := &ICardHandlerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
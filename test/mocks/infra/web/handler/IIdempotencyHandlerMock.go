@@ -0,0 +1,78 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IIdempotencyHandlerMock is an autogenerated mock type for the IIdempotencyHandler type
+type IIdempotencyHandlerMock struct {
+	mock.Mock
+}
+
+type IIdempotencyHandlerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IIdempotencyHandlerMock) EXPECT() *IIdempotencyHandlerMock_Expecter {
+	return &IIdempotencyHandlerMock_Expecter{mock: &_m.Mock}
+}
+
+// CheckIdempotency provides a mock function with given fields: c
+func (_m *IIdempotencyHandlerMock) CheckIdempotency(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IIdempotencyHandlerMock_CheckIdempotency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckIdempotency'
+type IIdempotencyHandlerMock_CheckIdempotency_Call struct {
+	*mock.Call
+}
+
+// CheckIdempotency is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IIdempotencyHandlerMock_Expecter) CheckIdempotency(c interface{}) *IIdempotencyHandlerMock_CheckIdempotency_Call {
+	return &IIdempotencyHandlerMock_CheckIdempotency_Call{Call: _e.mock.On("CheckIdempotency", c)}
+}
+
+func (_c *IIdempotencyHandlerMock_CheckIdempotency_Call) Run(run func(c *fiber.Ctx)) *IIdempotencyHandlerMock_CheckIdempotency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IIdempotencyHandlerMock_CheckIdempotency_Call) Return(_a0 error) *IIdempotencyHandlerMock_CheckIdempotency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IIdempotencyHandlerMock_CheckIdempotency_Call) RunAndReturn(run func(*fiber.Ctx) error) *IIdempotencyHandlerMock_CheckIdempotency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIIdempotencyHandlerMock creates a new instance of IIdempotencyHandlerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIIdempotencyHandlerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IIdempotencyHandlerMock {
+	mock := &IIdempotencyHandlerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,162 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IPaymentBatchHandlerMock is an autogenerated mock type for the IPaymentBatchHandler type
+type IPaymentBatchHandlerMock struct {
+	mock.Mock
+}
+
+type IPaymentBatchHandlerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IPaymentBatchHandlerMock) EXPECT() *IPaymentBatchHandlerMock_Expecter {
+	return &IPaymentBatchHandlerMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateBatch provides a mock function with given fields: c
+func (_m *IPaymentBatchHandlerMock) CreateBatch(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentBatchHandlerMock_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type IPaymentBatchHandlerMock_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IPaymentBatchHandlerMock_Expecter) CreateBatch(c interface{}) *IPaymentBatchHandlerMock_CreateBatch_Call {
+	return &IPaymentBatchHandlerMock_CreateBatch_Call{Call: _e.mock.On("CreateBatch", c)}
+}
+
+func (_c *IPaymentBatchHandlerMock_CreateBatch_Call) Run(run func(c *fiber.Ctx)) *IPaymentBatchHandlerMock_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IPaymentBatchHandlerMock_CreateBatch_Call) Return(_a0 error) *IPaymentBatchHandlerMock_CreateBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentBatchHandlerMock_CreateBatch_Call) RunAndReturn(run func(*fiber.Ctx) error) *IPaymentBatchHandlerMock_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBatchV2 provides a mock function with given fields: c
+func (_m *IPaymentBatchHandlerMock) CreateBatchV2(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentBatchHandlerMock_CreateBatchV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatchV2'
+type IPaymentBatchHandlerMock_CreateBatchV2_Call struct {
+	*mock.Call
+}
+
+// CreateBatchV2 is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IPaymentBatchHandlerMock_Expecter) CreateBatchV2(c interface{}) *IPaymentBatchHandlerMock_CreateBatchV2_Call {
+	return &IPaymentBatchHandlerMock_CreateBatchV2_Call{Call: _e.mock.On("CreateBatchV2", c)}
+}
+
+func (_c *IPaymentBatchHandlerMock_CreateBatchV2_Call) Run(run func(c *fiber.Ctx)) *IPaymentBatchHandlerMock_CreateBatchV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IPaymentBatchHandlerMock_CreateBatchV2_Call) Return(_a0 error) *IPaymentBatchHandlerMock_CreateBatchV2_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentBatchHandlerMock_CreateBatchV2_Call) RunAndReturn(run func(*fiber.Ctx) error) *IPaymentBatchHandlerMock_CreateBatchV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBatch provides a mock function with given fields: c
+func (_m *IPaymentBatchHandlerMock) FindBatch(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentBatchHandlerMock_FindBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBatch'
+type IPaymentBatchHandlerMock_FindBatch_Call struct {
+	*mock.Call
+}
+
+// FindBatch is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IPaymentBatchHandlerMock_Expecter) FindBatch(c interface{}) *IPaymentBatchHandlerMock_FindBatch_Call {
+	return &IPaymentBatchHandlerMock_FindBatch_Call{Call: _e.mock.On("FindBatch", c)}
+}
+
+func (_c *IPaymentBatchHandlerMock_FindBatch_Call) Run(run func(c *fiber.Ctx)) *IPaymentBatchHandlerMock_FindBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IPaymentBatchHandlerMock_FindBatch_Call) Return(_a0 error) *IPaymentBatchHandlerMock_FindBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentBatchHandlerMock_FindBatch_Call) RunAndReturn(run func(*fiber.Ctx) error) *IPaymentBatchHandlerMock_FindBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIPaymentBatchHandlerMock creates a new instance of IPaymentBatchHandlerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIPaymentBatchHandlerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IPaymentBatchHandlerMock {
+	mock := &IPaymentBatchHandlerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
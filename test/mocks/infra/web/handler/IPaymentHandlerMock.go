@@ -21,6 +21,132 @@ func (_m *IPaymentHandlerMock) EXPECT() *IPaymentHandlerMock_Expecter {
 	return &IPaymentHandlerMock_Expecter{mock: &_m.Mock}
 }
 
+// CapturePayment provides a mock function with given fields: c
+func (_m *IPaymentHandlerMock) CapturePayment(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentHandlerMock_CapturePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CapturePayment'
+type IPaymentHandlerMock_CapturePayment_Call struct {
+	*mock.Call
+}
+
+// CapturePayment is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IPaymentHandlerMock_Expecter) CapturePayment(c interface{}) *IPaymentHandlerMock_CapturePayment_Call {
+	return &IPaymentHandlerMock_CapturePayment_Call{Call: _e.mock.On("CapturePayment", c)}
+}
+
+func (_c *IPaymentHandlerMock_CapturePayment_Call) Run(run func(c *fiber.Ctx)) *IPaymentHandlerMock_CapturePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_CapturePayment_Call) Return(_a0 error) *IPaymentHandlerMock_CapturePayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_CapturePayment_Call) RunAndReturn(run func(*fiber.Ctx) error) *IPaymentHandlerMock_CapturePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CapturePaymentV2 provides a mock function with given fields: c
+func (_m *IPaymentHandlerMock) CapturePaymentV2(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentHandlerMock_CapturePaymentV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CapturePaymentV2'
+type IPaymentHandlerMock_CapturePaymentV2_Call struct {
+	*mock.Call
+}
+
+// CapturePaymentV2 is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IPaymentHandlerMock_Expecter) CapturePaymentV2(c interface{}) *IPaymentHandlerMock_CapturePaymentV2_Call {
+	return &IPaymentHandlerMock_CapturePaymentV2_Call{Call: _e.mock.On("CapturePaymentV2", c)}
+}
+
+func (_c *IPaymentHandlerMock_CapturePaymentV2_Call) Run(run func(c *fiber.Ctx)) *IPaymentHandlerMock_CapturePaymentV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_CapturePaymentV2_Call) Return(_a0 error) *IPaymentHandlerMock_CapturePaymentV2_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_CapturePaymentV2_Call) RunAndReturn(run func(*fiber.Ctx) error) *IPaymentHandlerMock_CapturePaymentV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPayment provides a mock function with given fields: c
+func (_m *IPaymentHandlerMock) FindPayment(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentHandlerMock_FindPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPayment'
+type IPaymentHandlerMock_FindPayment_Call struct {
+	*mock.Call
+}
+
+// FindPayment is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IPaymentHandlerMock_Expecter) FindPayment(c interface{}) *IPaymentHandlerMock_FindPayment_Call {
+	return &IPaymentHandlerMock_FindPayment_Call{Call: _e.mock.On("FindPayment", c)}
+}
+
+func (_c *IPaymentHandlerMock_FindPayment_Call) Run(run func(c *fiber.Ctx)) *IPaymentHandlerMock_FindPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_FindPayment_Call) Return(_a0 error) *IPaymentHandlerMock_FindPayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_FindPayment_Call) RunAndReturn(run func(*fiber.Ctx) error) *IPaymentHandlerMock_FindPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ProcessPayment provides a mock function with given fields: c
 func (_m *IPaymentHandlerMock) ProcessPayment(c *fiber.Ctx) error {
 	ret := _m.Called(c)
@@ -63,6 +189,174 @@ func (_c *IPaymentHandlerMock_ProcessPayment_Call) RunAndReturn(run func(*fiber.
 	return _c
 }
 
+// ProcessPaymentV2 provides a mock function with given fields: c
+func (_m *IPaymentHandlerMock) ProcessPaymentV2(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentHandlerMock_ProcessPaymentV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessPaymentV2'
+type IPaymentHandlerMock_ProcessPaymentV2_Call struct {
+	*mock.Call
+}
+
+// ProcessPaymentV2 is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IPaymentHandlerMock_Expecter) ProcessPaymentV2(c interface{}) *IPaymentHandlerMock_ProcessPaymentV2_Call {
+	return &IPaymentHandlerMock_ProcessPaymentV2_Call{Call: _e.mock.On("ProcessPaymentV2", c)}
+}
+
+func (_c *IPaymentHandlerMock_ProcessPaymentV2_Call) Run(run func(c *fiber.Ctx)) *IPaymentHandlerMock_ProcessPaymentV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_ProcessPaymentV2_Call) Return(_a0 error) *IPaymentHandlerMock_ProcessPaymentV2_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_ProcessPaymentV2_Call) RunAndReturn(run func(*fiber.Ctx) error) *IPaymentHandlerMock_ProcessPaymentV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function with given fields: c
+func (_m *IPaymentHandlerMock) RefundPayment(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentHandlerMock_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type IPaymentHandlerMock_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IPaymentHandlerMock_Expecter) RefundPayment(c interface{}) *IPaymentHandlerMock_RefundPayment_Call {
+	return &IPaymentHandlerMock_RefundPayment_Call{Call: _e.mock.On("RefundPayment", c)}
+}
+
+func (_c *IPaymentHandlerMock_RefundPayment_Call) Run(run func(c *fiber.Ctx)) *IPaymentHandlerMock_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_RefundPayment_Call) Return(_a0 error) *IPaymentHandlerMock_RefundPayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_RefundPayment_Call) RunAndReturn(run func(*fiber.Ctx) error) *IPaymentHandlerMock_RefundPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPaymentV2 provides a mock function with given fields: c
+func (_m *IPaymentHandlerMock) RefundPaymentV2(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentHandlerMock_RefundPaymentV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPaymentV2'
+type IPaymentHandlerMock_RefundPaymentV2_Call struct {
+	*mock.Call
+}
+
+// RefundPaymentV2 is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IPaymentHandlerMock_Expecter) RefundPaymentV2(c interface{}) *IPaymentHandlerMock_RefundPaymentV2_Call {
+	return &IPaymentHandlerMock_RefundPaymentV2_Call{Call: _e.mock.On("RefundPaymentV2", c)}
+}
+
+func (_c *IPaymentHandlerMock_RefundPaymentV2_Call) Run(run func(c *fiber.Ctx)) *IPaymentHandlerMock_RefundPaymentV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_RefundPaymentV2_Call) Return(_a0 error) *IPaymentHandlerMock_RefundPaymentV2_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_RefundPaymentV2_Call) RunAndReturn(run func(*fiber.Ctx) error) *IPaymentHandlerMock_RefundPaymentV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VoidPayment provides a mock function with given fields: c
+func (_m *IPaymentHandlerMock) VoidPayment(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentHandlerMock_VoidPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VoidPayment'
+type IPaymentHandlerMock_VoidPayment_Call struct {
+	*mock.Call
+}
+
+// VoidPayment is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IPaymentHandlerMock_Expecter) VoidPayment(c interface{}) *IPaymentHandlerMock_VoidPayment_Call {
+	return &IPaymentHandlerMock_VoidPayment_Call{Call: _e.mock.On("VoidPayment", c)}
+}
+
+func (_c *IPaymentHandlerMock_VoidPayment_Call) Run(run func(c *fiber.Ctx)) *IPaymentHandlerMock_VoidPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_VoidPayment_Call) Return(_a0 error) *IPaymentHandlerMock_VoidPayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentHandlerMock_VoidPayment_Call) RunAndReturn(run func(*fiber.Ctx) error) *IPaymentHandlerMock_VoidPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewIPaymentHandlerMock creates a new instance of IPaymentHandlerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIPaymentHandlerMock(t interface {
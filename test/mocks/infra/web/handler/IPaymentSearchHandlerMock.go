@@ -0,0 +1,78 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IPaymentSearchHandlerMock is an autogenerated mock type for the IPaymentSearchHandler type
+type IPaymentSearchHandlerMock struct {
+	mock.Mock
+}
+
+type IPaymentSearchHandlerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IPaymentSearchHandlerMock) EXPECT() *IPaymentSearchHandlerMock_Expecter {
+	return &IPaymentSearchHandlerMock_Expecter{mock: &_m.Mock}
+}
+
+// SearchPayments provides a mock function with given fields: c
+func (_m *IPaymentSearchHandlerMock) SearchPayments(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IPaymentSearchHandlerMock_SearchPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchPayments'
+type IPaymentSearchHandlerMock_SearchPayments_Call struct {
+	*mock.Call
+}
+
+// SearchPayments is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IPaymentSearchHandlerMock_Expecter) SearchPayments(c interface{}) *IPaymentSearchHandlerMock_SearchPayments_Call {
+	return &IPaymentSearchHandlerMock_SearchPayments_Call{Call: _e.mock.On("SearchPayments", c)}
+}
+
+func (_c *IPaymentSearchHandlerMock_SearchPayments_Call) Run(run func(c *fiber.Ctx)) *IPaymentSearchHandlerMock_SearchPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IPaymentSearchHandlerMock_SearchPayments_Call) Return(_a0 error) *IPaymentSearchHandlerMock_SearchPayments_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IPaymentSearchHandlerMock_SearchPayments_Call) RunAndReturn(run func(*fiber.Ctx) error) *IPaymentSearchHandlerMock_SearchPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIPaymentSearchHandlerMock creates a new instance of IPaymentSearchHandlerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIPaymentSearchHandlerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IPaymentSearchHandlerMock {
+	mock := &IPaymentSearchHandlerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,78 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IRoutingHandlerMock is an autogenerated mock type for the IRoutingHandler type
+type IRoutingHandlerMock struct {
+	mock.Mock
+}
+
+type IRoutingHandlerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IRoutingHandlerMock) EXPECT() *IRoutingHandlerMock_Expecter {
+	return &IRoutingHandlerMock_Expecter{mock: &_m.Mock}
+}
+
+// RouteTransaction provides a mock function with given fields: c
+func (_m *IRoutingHandlerMock) RouteTransaction(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IRoutingHandlerMock_RouteTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RouteTransaction'
+type IRoutingHandlerMock_RouteTransaction_Call struct {
+	*mock.Call
+}
+
+// RouteTransaction is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IRoutingHandlerMock_Expecter) RouteTransaction(c interface{}) *IRoutingHandlerMock_RouteTransaction_Call {
+	return &IRoutingHandlerMock_RouteTransaction_Call{Call: _e.mock.On("RouteTransaction", c)}
+}
+
+func (_c *IRoutingHandlerMock_RouteTransaction_Call) Run(run func(c *fiber.Ctx)) *IRoutingHandlerMock_RouteTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IRoutingHandlerMock_RouteTransaction_Call) Return(_a0 error) *IRoutingHandlerMock_RouteTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IRoutingHandlerMock_RouteTransaction_Call) RunAndReturn(run func(*fiber.Ctx) error) *IRoutingHandlerMock_RouteTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIRoutingHandlerMock creates a new instance of IRoutingHandlerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIRoutingHandlerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IRoutingHandlerMock {
+	mock := &IRoutingHandlerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,120 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ISettlementHandlerMock is an autogenerated mock type for the ISettlementHandler type
+type ISettlementHandlerMock struct {
+	mock.Mock
+}
+
+type ISettlementHandlerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ISettlementHandlerMock) EXPECT() *ISettlementHandlerMock_Expecter {
+	return &ISettlementHandlerMock_Expecter{mock: &_m.Mock}
+}
+
+// FindSettlement provides a mock function with given fields: c
+func (_m *ISettlementHandlerMock) FindSettlement(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ISettlementHandlerMock_FindSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSettlement'
+type ISettlementHandlerMock_FindSettlement_Call struct {
+	*mock.Call
+}
+
+// FindSettlement is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *ISettlementHandlerMock_Expecter) FindSettlement(c interface{}) *ISettlementHandlerMock_FindSettlement_Call {
+	return &ISettlementHandlerMock_FindSettlement_Call{Call: _e.mock.On("FindSettlement", c)}
+}
+
+func (_c *ISettlementHandlerMock_FindSettlement_Call) Run(run func(c *fiber.Ctx)) *ISettlementHandlerMock_FindSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *ISettlementHandlerMock_FindSettlement_Call) Return(_a0 error) *ISettlementHandlerMock_FindSettlement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ISettlementHandlerMock_FindSettlement_Call) RunAndReturn(run func(*fiber.Ctx) error) *ISettlementHandlerMock_FindSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportSettlement provides a mock function with given fields: c
+func (_m *ISettlementHandlerMock) ImportSettlement(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ISettlementHandlerMock_ImportSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportSettlement'
+type ISettlementHandlerMock_ImportSettlement_Call struct {
+	*mock.Call
+}
+
+// ImportSettlement is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *ISettlementHandlerMock_Expecter) ImportSettlement(c interface{}) *ISettlementHandlerMock_ImportSettlement_Call {
+	return &ISettlementHandlerMock_ImportSettlement_Call{Call: _e.mock.On("ImportSettlement", c)}
+}
+
+func (_c *ISettlementHandlerMock_ImportSettlement_Call) Run(run func(c *fiber.Ctx)) *ISettlementHandlerMock_ImportSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *ISettlementHandlerMock_ImportSettlement_Call) Return(_a0 error) *ISettlementHandlerMock_ImportSettlement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ISettlementHandlerMock_ImportSettlement_Call) RunAndReturn(run func(*fiber.Ctx) error) *ISettlementHandlerMock_ImportSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewISettlementHandlerMock creates a new instance of ISettlementHandlerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewISettlementHandlerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ISettlementHandlerMock {
+	mock := &ISettlementHandlerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
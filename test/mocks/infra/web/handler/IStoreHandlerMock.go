@@ -0,0 +1,246 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IStoreHandlerMock is an autogenerated mock type for the IStoreHandler type
+type IStoreHandlerMock struct {
+	mock.Mock
+}
+
+type IStoreHandlerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IStoreHandlerMock) EXPECT() *IStoreHandlerMock_Expecter {
+	return &IStoreHandlerMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateStore provides a mock function with given fields: c
+func (_m *IStoreHandlerMock) CreateStore(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IStoreHandlerMock_CreateStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStore'
+type IStoreHandlerMock_CreateStore_Call struct {
+	*mock.Call
+}
+
+// CreateStore is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IStoreHandlerMock_Expecter) CreateStore(c interface{}) *IStoreHandlerMock_CreateStore_Call {
+	return &IStoreHandlerMock_CreateStore_Call{Call: _e.mock.On("CreateStore", c)}
+}
+
+func (_c *IStoreHandlerMock_CreateStore_Call) Run(run func(c *fiber.Ctx)) *IStoreHandlerMock_CreateStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IStoreHandlerMock_CreateStore_Call) Return(_a0 error) *IStoreHandlerMock_CreateStore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IStoreHandlerMock_CreateStore_Call) RunAndReturn(run func(*fiber.Ctx) error) *IStoreHandlerMock_CreateStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStore provides a mock function with given fields: c
+func (_m *IStoreHandlerMock) DeleteStore(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IStoreHandlerMock_DeleteStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStore'
+type IStoreHandlerMock_DeleteStore_Call struct {
+	*mock.Call
+}
+
+// DeleteStore is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IStoreHandlerMock_Expecter) DeleteStore(c interface{}) *IStoreHandlerMock_DeleteStore_Call {
+	return &IStoreHandlerMock_DeleteStore_Call{Call: _e.mock.On("DeleteStore", c)}
+}
+
+func (_c *IStoreHandlerMock_DeleteStore_Call) Run(run func(c *fiber.Ctx)) *IStoreHandlerMock_DeleteStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IStoreHandlerMock_DeleteStore_Call) Return(_a0 error) *IStoreHandlerMock_DeleteStore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IStoreHandlerMock_DeleteStore_Call) RunAndReturn(run func(*fiber.Ctx) error) *IStoreHandlerMock_DeleteStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindStore provides a mock function with given fields: c
+func (_m *IStoreHandlerMock) FindStore(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IStoreHandlerMock_FindStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStore'
+type IStoreHandlerMock_FindStore_Call struct {
+	*mock.Call
+}
+
+// FindStore is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IStoreHandlerMock_Expecter) FindStore(c interface{}) *IStoreHandlerMock_FindStore_Call {
+	return &IStoreHandlerMock_FindStore_Call{Call: _e.mock.On("FindStore", c)}
+}
+
+func (_c *IStoreHandlerMock_FindStore_Call) Run(run func(c *fiber.Ctx)) *IStoreHandlerMock_FindStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IStoreHandlerMock_FindStore_Call) Return(_a0 error) *IStoreHandlerMock_FindStore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IStoreHandlerMock_FindStore_Call) RunAndReturn(run func(*fiber.Ctx) error) *IStoreHandlerMock_FindStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStores provides a mock function with given fields: c
+func (_m *IStoreHandlerMock) ListStores(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IStoreHandlerMock_ListStores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStores'
+type IStoreHandlerMock_ListStores_Call struct {
+	*mock.Call
+}
+
+// ListStores is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IStoreHandlerMock_Expecter) ListStores(c interface{}) *IStoreHandlerMock_ListStores_Call {
+	return &IStoreHandlerMock_ListStores_Call{Call: _e.mock.On("ListStores", c)}
+}
+
+func (_c *IStoreHandlerMock_ListStores_Call) Run(run func(c *fiber.Ctx)) *IStoreHandlerMock_ListStores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IStoreHandlerMock_ListStores_Call) Return(_a0 error) *IStoreHandlerMock_ListStores_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IStoreHandlerMock_ListStores_Call) RunAndReturn(run func(*fiber.Ctx) error) *IStoreHandlerMock_ListStores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStore provides a mock function with given fields: c
+func (_m *IStoreHandlerMock) UpdateStore(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IStoreHandlerMock_UpdateStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStore'
+type IStoreHandlerMock_UpdateStore_Call struct {
+	*mock.Call
+}
+
+// UpdateStore is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IStoreHandlerMock_Expecter) UpdateStore(c interface{}) *IStoreHandlerMock_UpdateStore_Call {
+	return &IStoreHandlerMock_UpdateStore_Call{Call: _e.mock.On("UpdateStore", c)}
+}
+
+func (_c *IStoreHandlerMock_UpdateStore_Call) Run(run func(c *fiber.Ctx)) *IStoreHandlerMock_UpdateStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IStoreHandlerMock_UpdateStore_Call) Return(_a0 error) *IStoreHandlerMock_UpdateStore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IStoreHandlerMock_UpdateStore_Call) RunAndReturn(run func(*fiber.Ctx) error) *IStoreHandlerMock_UpdateStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIStoreHandlerMock creates a new instance of IStoreHandlerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIStoreHandlerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IStoreHandlerMock {
+	mock := &IStoreHandlerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
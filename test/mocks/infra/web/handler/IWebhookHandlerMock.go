@@ -0,0 +1,330 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package handler
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IWebhookHandlerMock is an autogenerated mock type for the IWebhookHandler type
+type IWebhookHandlerMock struct {
+	mock.Mock
+}
+
+type IWebhookHandlerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IWebhookHandlerMock) EXPECT() *IWebhookHandlerMock_Expecter {
+	return &IWebhookHandlerMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: c
+func (_m *IWebhookHandlerMock) CreateWebhook(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookHandlerMock_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type IWebhookHandlerMock_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IWebhookHandlerMock_Expecter) CreateWebhook(c interface{}) *IWebhookHandlerMock_CreateWebhook_Call {
+	return &IWebhookHandlerMock_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", c)}
+}
+
+func (_c *IWebhookHandlerMock_CreateWebhook_Call) Run(run func(c *fiber.Ctx)) *IWebhookHandlerMock_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_CreateWebhook_Call) Return(_a0 error) *IWebhookHandlerMock_CreateWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_CreateWebhook_Call) RunAndReturn(run func(*fiber.Ctx) error) *IWebhookHandlerMock_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: c
+func (_m *IWebhookHandlerMock) DeleteWebhook(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookHandlerMock_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type IWebhookHandlerMock_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IWebhookHandlerMock_Expecter) DeleteWebhook(c interface{}) *IWebhookHandlerMock_DeleteWebhook_Call {
+	return &IWebhookHandlerMock_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", c)}
+}
+
+func (_c *IWebhookHandlerMock_DeleteWebhook_Call) Run(run func(c *fiber.Ctx)) *IWebhookHandlerMock_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_DeleteWebhook_Call) Return(_a0 error) *IWebhookHandlerMock_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_DeleteWebhook_Call) RunAndReturn(run func(*fiber.Ctx) error) *IWebhookHandlerMock_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWebhook provides a mock function with given fields: c
+func (_m *IWebhookHandlerMock) FindWebhook(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookHandlerMock_FindWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWebhook'
+type IWebhookHandlerMock_FindWebhook_Call struct {
+	*mock.Call
+}
+
+// FindWebhook is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IWebhookHandlerMock_Expecter) FindWebhook(c interface{}) *IWebhookHandlerMock_FindWebhook_Call {
+	return &IWebhookHandlerMock_FindWebhook_Call{Call: _e.mock.On("FindWebhook", c)}
+}
+
+func (_c *IWebhookHandlerMock_FindWebhook_Call) Run(run func(c *fiber.Ctx)) *IWebhookHandlerMock_FindWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_FindWebhook_Call) Return(_a0 error) *IWebhookHandlerMock_FindWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_FindWebhook_Call) RunAndReturn(run func(*fiber.Ctx) error) *IWebhookHandlerMock_FindWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeliveries provides a mock function with given fields: c
+func (_m *IWebhookHandlerMock) ListDeliveries(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookHandlerMock_ListDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeliveries'
+type IWebhookHandlerMock_ListDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListDeliveries is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IWebhookHandlerMock_Expecter) ListDeliveries(c interface{}) *IWebhookHandlerMock_ListDeliveries_Call {
+	return &IWebhookHandlerMock_ListDeliveries_Call{Call: _e.mock.On("ListDeliveries", c)}
+}
+
+func (_c *IWebhookHandlerMock_ListDeliveries_Call) Run(run func(c *fiber.Ctx)) *IWebhookHandlerMock_ListDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_ListDeliveries_Call) Return(_a0 error) *IWebhookHandlerMock_ListDeliveries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_ListDeliveries_Call) RunAndReturn(run func(*fiber.Ctx) error) *IWebhookHandlerMock_ListDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhooks provides a mock function with given fields: c
+func (_m *IWebhookHandlerMock) ListWebhooks(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookHandlerMock_ListWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhooks'
+type IWebhookHandlerMock_ListWebhooks_Call struct {
+	*mock.Call
+}
+
+// ListWebhooks is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IWebhookHandlerMock_Expecter) ListWebhooks(c interface{}) *IWebhookHandlerMock_ListWebhooks_Call {
+	return &IWebhookHandlerMock_ListWebhooks_Call{Call: _e.mock.On("ListWebhooks", c)}
+}
+
+func (_c *IWebhookHandlerMock_ListWebhooks_Call) Run(run func(c *fiber.Ctx)) *IWebhookHandlerMock_ListWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_ListWebhooks_Call) Return(_a0 error) *IWebhookHandlerMock_ListWebhooks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_ListWebhooks_Call) RunAndReturn(run func(*fiber.Ctx) error) *IWebhookHandlerMock_ListWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedeliverWebhook provides a mock function with given fields: c
+func (_m *IWebhookHandlerMock) RedeliverWebhook(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookHandlerMock_RedeliverWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedeliverWebhook'
+type IWebhookHandlerMock_RedeliverWebhook_Call struct {
+	*mock.Call
+}
+
+// RedeliverWebhook is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IWebhookHandlerMock_Expecter) RedeliverWebhook(c interface{}) *IWebhookHandlerMock_RedeliverWebhook_Call {
+	return &IWebhookHandlerMock_RedeliverWebhook_Call{Call: _e.mock.On("RedeliverWebhook", c)}
+}
+
+func (_c *IWebhookHandlerMock_RedeliverWebhook_Call) Run(run func(c *fiber.Ctx)) *IWebhookHandlerMock_RedeliverWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_RedeliverWebhook_Call) Return(_a0 error) *IWebhookHandlerMock_RedeliverWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_RedeliverWebhook_Call) RunAndReturn(run func(*fiber.Ctx) error) *IWebhookHandlerMock_RedeliverWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhook provides a mock function with given fields: c
+func (_m *IWebhookHandlerMock) UpdateWebhook(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IWebhookHandlerMock_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type IWebhookHandlerMock_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - c *fiber.Ctx
+func (_e *IWebhookHandlerMock_Expecter) UpdateWebhook(c interface{}) *IWebhookHandlerMock_UpdateWebhook_Call {
+	return &IWebhookHandlerMock_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", c)}
+}
+
+func (_c *IWebhookHandlerMock_UpdateWebhook_Call) Run(run func(c *fiber.Ctx)) *IWebhookHandlerMock_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*fiber.Ctx))
+	})
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_UpdateWebhook_Call) Return(_a0 error) *IWebhookHandlerMock_UpdateWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IWebhookHandlerMock_UpdateWebhook_Call) RunAndReturn(run func(*fiber.Ctx) error) *IWebhookHandlerMock_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIWebhookHandlerMock creates a new instance of IWebhookHandlerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIWebhookHandlerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IWebhookHandlerMock {
+	mock := &IWebhookHandlerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}